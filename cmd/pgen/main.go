@@ -0,0 +1,52 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"github.com/lincaiyong/log"
+	"github.com/lincaiyong/pgen"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+func usage() {
+	_, _ = fmt.Fprintf(os.Stderr, "usage: pgen doc [-o dir] grammar.txt\n")
+	os.Exit(2)
+}
+
+func main() {
+	if len(os.Args) < 2 || os.Args[1] != "doc" {
+		usage()
+	}
+	fs := flag.NewFlagSet("doc", flag.ExitOnError)
+	out := fs.String("o", "doc", "output directory")
+	fs.Usage = usage
+	_ = fs.Parse(os.Args[2:])
+	if fs.NArg() != 1 {
+		usage()
+	}
+	start := time.Now()
+	grammar, err := pgen.PreProcess(fs.Arg(0))
+	if err != nil {
+		log.ErrorLog("fail to preprocess: %v", err)
+		os.Exit(1)
+	}
+	files, err := pgen.RunDoc(grammar)
+	if err != nil {
+		log.ErrorLog("fail to run: %v", err)
+		os.Exit(1)
+	}
+	for name, content := range files {
+		path := filepath.Join(*out, name)
+		if err = os.MkdirAll(filepath.Dir(path), os.ModePerm); err != nil {
+			log.ErrorLog("fail to create directory: %v", err)
+			os.Exit(1)
+		}
+		if err = os.WriteFile(path, []byte(content), 0644); err != nil {
+			log.ErrorLog("fail to write file: %v", err)
+			os.Exit(1)
+		}
+	}
+	log.InfoLog("wrote %d files to %s in %s\n", len(files), *out, time.Since(start))
+}
@@ -3,13 +3,18 @@ package config
 import "regexp"
 
 var g struct {
-	debugMode         bool
-	reservedVariables map[string]struct{}
-	builtinTokens     []string
-	operatorCharName  map[byte]string
-	operatorRegex     *regexp.Regexp
-	keywordRegex      *regexp.Regexp
-	nodeRegex         *regexp.Regexp
+	debugMode          bool
+	reservedVariables  map[string]struct{}
+	builtinTokens      []string
+	operatorCharName   map[byte]string
+	operatorRegex      *regexp.Regexp
+	keywordRegex       *regexp.Regexp
+	nodeRegex          *regexp.Regexp
+	nodeFieldRegex     *regexp.Regexp
+	categoryRegex      *regexp.Regexp
+	reservedCategories map[string]struct{}
+	stateRegex         *regexp.Regexp
+	stateFieldRegex    *regexp.Regexp
 }
 
 func DebugMode() bool {
@@ -40,10 +45,30 @@ func NodeRegex() *regexp.Regexp {
 	return g.nodeRegex
 }
 
+func NodeFieldRegex() *regexp.Regexp {
+	return g.nodeFieldRegex
+}
+
+func CategoryRegex() *regexp.Regexp {
+	return g.categoryRegex
+}
+
+func ReservedCategories() map[string]struct{} {
+	return g.reservedCategories
+}
+
+func StateRegex() *regexp.Regexp {
+	return g.stateRegex
+}
+
+func StateFieldRegex() *regexp.Regexp {
+	return g.stateFieldRegex
+}
+
 func init() {
 	g.debugMode = true
-	g.reservedVariables = makeMap([]string{"_", "ps", "tk", "pos", "group"})
-	g.builtinTokens = []string{"end_of_file", "pseudo", "whitespace", "newline"}
+	g.reservedVariables = makeMap([]string{"_", "ps", "tk", "pos", "group", "state"})
+	g.builtinTokens = []string{"end_of_file", "pseudo", "literal", "whitespace", "newline"}
 	g.operatorCharName = map[byte]string{
 		'!':  "not", // exclamation
 		'%':  "percent",
@@ -76,7 +101,13 @@ func init() {
 	}
 	g.operatorRegex = regexp.MustCompile(`^[!%&()*+,./:;<=>?@\[\\\]^{|}~#$-]+$`)
 	g.keywordRegex = regexp.MustCompile(`^[a-zA-Z0-9_]+$`)
-	g.nodeRegex = regexp.MustCompile(`^(\w+) +<([\w ]+)?>$`)
+	g.nodeRegex = regexp.MustCompile(`^(\w+) +<([\w ?*:]+)?>$`)
+	g.nodeFieldRegex = regexp.MustCompile(`^(\w+)([?*])?(:token)?$`)
+	g.categoryRegex = regexp.MustCompile(`^@([a-z][a-z0-9_]*)((?: +\w+)+)$`)
+	g.reservedCategories = makeMap([]string{"node", "nodes", "token", "position", "cursor", "visitor", "rewriter",
+		"parser", "tokenizer", "pattern", "query", "change", "line_index", "path_step", "limit_error"})
+	g.stateRegex = regexp.MustCompile(`(?s)^state\s*\{(.*)}$`)
+	g.stateFieldRegex = regexp.MustCompile(`^([a-zA-Z_]\w*)\s+(\S.*)$`)
 }
 
 func makeMap(keys []string) map[string]struct{} {
@@ -0,0 +1,258 @@
+package langparse
+
+import (
+	"fmt"
+	"github.com/lincaiyong/pgen/models"
+	"strings"
+)
+
+const (
+	actionTokenIdent  = "identifier"
+	actionTokenString = "string"
+	actionTokenNumber = "number"
+	actionTokenPunct  = "punctuation"
+)
+
+type actionToken struct {
+	kind  string
+	text  string
+	start models.Position
+	end   models.Position
+}
+
+func (t *actionToken) String() string {
+	return fmt.Sprintf("%s %s", t.kind, t.text)
+}
+
+// actionParser parses the tokens of a choice action:
+//
+//	expr    := span ('??' expr)?
+//	span    := postfix ('..' postfix)?
+//	postfix := primary ('.' name)*
+//	primary := '_' | string | number | name | name '(' (expr (',' expr)*)? ')' | '[' (elem (',' elem)*)? ']'
+//	elem    := '*'? expr
+type actionParser struct {
+	p      *GrammarParser
+	tokens []*actionToken
+	idx    int
+}
+
+func (p *GrammarParser) parseChoiceAction(parent *models.GrammarRuleNode) (*models.GrammarRuleNode, error) {
+	p.stepForward()
+	tokens, err := p.tokenizeAction()
+	if err != nil {
+		return nil, err
+	}
+	ap := &actionParser{p: p, tokens: tokens}
+	action, err := ap.parseExpr(parent)
+	if err != nil {
+		return nil, err
+	}
+	if !ap.accept("}") {
+		return nil, ap.unexpected("'??', '..' or '}'")
+	}
+	return action, nil
+}
+
+func (p *GrammarParser) tokenizeAction() ([]*actionToken, error) {
+	tokens := make([]*actionToken, 0)
+	for {
+		p.skipWhitespace()
+		start := p.mark()
+		kind := actionTokenPunct
+		if p.reachEnd() {
+			return nil, p.errorAt(start, "expect '}' to close action")
+		} else if p.la == '"' {
+			kind = actionTokenString
+			p.stepForward()
+			escaped := false
+			p.forwardUtil(func(b byte) bool {
+				if escaped {
+					escaped = false
+				} else if b == '\\' {
+					escaped = true
+				} else if b == '"' || b == '\n' {
+					return true
+				}
+				return false
+			})
+			if !p.expect('"') {
+				return nil, p.errorAt(start, "unterminated string in action")
+			}
+		} else if p.la >= '0' && p.la <= '9' {
+			kind = actionTokenNumber
+			p.forwardUtil(func(b byte) bool {
+				return !(b >= '0' && b <= '9')
+			})
+		} else if p.la == '_' || (p.la >= 'a' && p.la <= 'z') || (p.la >= 'A' && p.la <= 'Z') {
+			kind = actionTokenIdent
+			p.expectIdentifier()
+		} else if !p.expectString("..") && !p.expectString("??") {
+			if strings.IndexByte("()[],.*}", p.la) < 0 {
+				return nil, p.errorAt(start, "unexpected character '%c' in action", p.la)
+			}
+			p.stepForward()
+		}
+		end := p.mark()
+		tok := &actionToken{kind: kind, text: p.input.Fork(start, end).Text(), start: start, end: end}
+		tokens = append(tokens, tok)
+		if kind == actionTokenPunct && tok.text == "}" {
+			return tokens, nil
+		}
+	}
+}
+
+func (ap *actionParser) peek() *actionToken {
+	return ap.tokens[ap.idx]
+}
+
+func (ap *actionParser) next() *actionToken {
+	tok := ap.tokens[ap.idx]
+	if ap.idx < len(ap.tokens)-1 {
+		ap.idx++
+	}
+	return tok
+}
+
+func (ap *actionParser) accept(punct string) bool {
+	if tok := ap.peek(); tok.kind == actionTokenPunct && tok.text == punct {
+		ap.next()
+		return true
+	}
+	return false
+}
+
+func (ap *actionParser) unexpected(expected string) error {
+	tok := ap.peek()
+	return ap.p.errorAt(tok.start, "expect %s in action, got %s", expected, tok)
+}
+
+func (ap *actionParser) finish(action *models.GrammarRuleNode, start *actionToken) *models.GrammarRuleNode {
+	action.SetSnippet(ap.p.input.Fork(start.start, ap.tokens[ap.idx-1].end))
+	return action
+}
+
+func (ap *actionParser) parseExpr(parent *models.GrammarRuleNode) (*models.GrammarRuleNode, error) {
+	start := ap.peek()
+	lhs, err := ap.parseSpan(parent)
+	if err != nil {
+		return nil, err
+	}
+	if !ap.accept("??") {
+		return lhs, nil
+	}
+	action := models.NewGrammarRuleNode(models.GrammarRuleNodeTypeCoalesceAction, parent)
+	rhs, err := ap.parseExpr(action)
+	if err != nil {
+		return nil, err
+	}
+	lhs.SetParent(action)
+	action.SetChildren([]*models.GrammarRuleNode{lhs, rhs})
+	return ap.finish(action, start), nil
+}
+
+func (ap *actionParser) parseSpan(parent *models.GrammarRuleNode) (*models.GrammarRuleNode, error) {
+	start := ap.peek()
+	first, err := ap.parsePostfix(parent)
+	if err != nil {
+		return nil, err
+	}
+	if !ap.accept("..") {
+		return first, nil
+	}
+	action := models.NewGrammarRuleNode(models.GrammarRuleNodeTypeSpanAction, parent)
+	last, err := ap.parsePostfix(action)
+	if err != nil {
+		return nil, err
+	}
+	first.SetParent(action)
+	action.SetChildren([]*models.GrammarRuleNode{first, last})
+	return ap.finish(action, start), nil
+}
+
+func (ap *actionParser) parsePostfix(parent *models.GrammarRuleNode) (*models.GrammarRuleNode, error) {
+	start := ap.peek()
+	action, err := ap.parsePrimary(parent)
+	if err != nil {
+		return nil, err
+	}
+	for {
+		dot := ap.peek()
+		if dot.kind != actionTokenPunct || dot.text != "." {
+			return action, nil
+		}
+		if !dot.start.SameAs(ap.tokens[ap.idx-1].end) {
+			return nil, ap.p.errorAt(dot.start, "unexpected space before '.' in action")
+		}
+		ap.next()
+		if name := ap.peek(); name.kind != actionTokenIdent || !name.start.SameAs(dot.end) {
+			return nil, ap.p.errorAt(dot.end, "expect field name right after '.' in action, got %s", name)
+		}
+		field := models.NewGrammarRuleNode(models.GrammarRuleNodeTypeFieldAction, parent)
+		field.SetName(ap.next().text)
+		action.SetParent(field)
+		field.SetChild(action)
+		action = ap.finish(field, start)
+	}
+}
+
+func (ap *actionParser) parsePrimary(parent *models.GrammarRuleNode) (*models.GrammarRuleNode, error) {
+	start := ap.peek()
+	action := models.NewGrammarRuleNode("", parent)
+	switch {
+	case start.kind == actionTokenString || start.kind == actionTokenNumber:
+		ap.next()
+		action.SetKind(models.GrammarRuleNodeTypeLiteralAction)
+	case start.kind == actionTokenIdent && start.text == "_":
+		ap.next()
+		action.SetKind(models.GrammarRuleNodeTypeNullAction)
+	case start.kind == actionTokenIdent:
+		ap.next()
+		action.SetName(start.text)
+		if !ap.accept("(") {
+			action.SetKind(models.GrammarRuleNodeTypeNameAction)
+			break
+		}
+		action.SetKind(models.GrammarRuleNodeTypeCallAction)
+		args := make([]*models.GrammarRuleNode, 0)
+		for !ap.accept(")") {
+			if len(args) > 0 && !ap.accept(",") {
+				return nil, ap.unexpected("',' or ')'")
+			}
+			arg, err := ap.parseExpr(action)
+			if err != nil {
+				return nil, err
+			}
+			args = append(args, arg)
+		}
+		action.SetChildren(args)
+	case ap.accept("["):
+		action.SetKind(models.GrammarRuleNodeTypeListAction)
+		elems := make([]*models.GrammarRuleNode, 0)
+		for !ap.accept("]") {
+			if len(elems) > 0 && !ap.accept(",") {
+				return nil, ap.unexpected("',' or ']'")
+			}
+			elemStart := ap.peek()
+			if ap.accept("*") {
+				spread := models.NewGrammarRuleNode(models.GrammarRuleNodeTypeSpreadAction, action)
+				elem, err := ap.parseExpr(spread)
+				if err != nil {
+					return nil, err
+				}
+				spread.SetChild(elem)
+				elems = append(elems, ap.finish(spread, elemStart))
+			} else {
+				elem, err := ap.parseExpr(action)
+				if err != nil {
+					return nil, err
+				}
+				elems = append(elems, elem)
+			}
+		}
+		action.SetChildren(elems)
+	default:
+		return nil, ap.unexpected("action expression")
+	}
+	return ap.finish(action, start), nil
+}
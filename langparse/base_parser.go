@@ -28,17 +28,21 @@ func NewBaseParser(input *models.Snippet) *BaseParser {
 }
 
 func (p *BaseParser) expectError(expected string) error {
+	return p.errorAt(p.max, "expect %s", expected)
+}
+
+func (p *BaseParser) errorAt(pos models.Position, format string, args ...any) error {
 	var sb strings.Builder
 	content := string(p.input.FileContent)
 	lines := strings.Split(content, "\n")
-	startLine := max(0, p.max.LineIdx-3)
-	endLine := min(len(lines), p.max.LineIdx+4)
+	startLine := max(0, pos.LineIdx-3)
+	endLine := min(len(lines), pos.LineIdx+4)
 	for i := startLine; i < endLine; i++ {
 		sb.WriteString(fmt.Sprintf("%d\t%s\n", i+1, lines[i]))
 	}
 	sb.WriteString("----------------\n")
 	pc := make([]uintptr, 100)
-	n := runtime.Callers(2, pc)
+	n := runtime.Callers(3, pc)
 	frames := runtime.CallersFrames(pc[:n])
 	for {
 		frame, more := frames.Next()
@@ -50,11 +54,11 @@ func (p *BaseParser) expectError(expected string) error {
 			break
 		}
 	}
-	line := string(p.input.FileContent[p.max.Offset:])
+	line := string(p.input.FileContent[pos.Offset:])
 	if idx := strings.Index(line, "\n"); idx != -1 {
 		line = line[:idx]
 	}
-	return fmt.Errorf("expect %s at %d:%d, \"%s\"\n%s", expected, p.max.LineIdx+1, p.max.CharIdx+1, line, sb.String())
+	return fmt.Errorf("%s at %d:%d, \"%s\"\n%s", fmt.Sprintf(format, args...), pos.LineIdx+1, pos.CharIdx+1, line, sb.String())
 }
 
 func (p *BaseParser) mark() models.Position {
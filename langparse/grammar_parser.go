@@ -2,6 +2,7 @@ package langparse
 
 import (
 	"github.com/lincaiyong/pgen/models"
+	"strings"
 )
 
 func ParseGrammarRule(input *models.Snippet) (*models.GrammarRuleNode, error) {
@@ -31,6 +32,15 @@ func (p *GrammarParser) run() {
 		return
 	}
 	p.RuleNode.SetName(name.Text())
+	// params
+	if p.expect('<') {
+		params, err := p.parseParams()
+		if err != nil {
+			p.Error.AddError(err)
+			return
+		}
+		p.RuleNode.SetParams(params)
+	}
 	// memo
 	p.skipWhitespace()
 	if p.expectString("(memo)") {
@@ -42,9 +52,15 @@ func (p *GrammarParser) run() {
 		p.Error.AddError(p.expectError(`":"`))
 		return
 	}
+	// precedences
+	precedences, err := p.parsePrecedences(p.RuleNode)
+	if err != nil {
+		p.Error.AddError(err)
+		return
+	}
+	p.RuleNode.SetPrecedences(precedences)
 	// choices
 	var choices []*models.GrammarRuleNode
-	var err error
 	choices, err = p.parseChoices(p.RuleNode)
 	if err != nil {
 		p.Error.AddError(err)
@@ -61,6 +77,75 @@ func (p *GrammarParser) run() {
 	}
 }
 
+func (p *GrammarParser) parseParams() ([]string, error) {
+	params := make([]string, 0)
+	for {
+		p.skipWhitespace()
+		if len(params) > 0 {
+			if p.expect('>') {
+				return params, nil
+			}
+			if !p.expect(',') {
+				return nil, p.expectError("',' or '>'")
+			}
+			p.skipWhitespace()
+		}
+		param := p.expectIdentifier()
+		if param == nil {
+			return nil, p.expectError("template parameter")
+		}
+		params = append(params, param.Text())
+	}
+}
+
+func (p *GrammarParser) parsePrecedences(parent *models.GrammarRuleNode) ([]*models.GrammarRuleNode, error) {
+	levels := make([]*models.GrammarRuleNode, 0)
+	for {
+		p.skipWhitespace()
+		start := p.mark()
+		level := models.NewGrammarRuleNode(models.GrammarRuleNodeTypePrecedenceLevel, parent)
+		if p.expectString("%left") {
+			level.SetName("left")
+		} else if p.expectString("%right") {
+			level.SetName("right")
+		} else {
+			return levels, nil
+		}
+		operators := make([]*models.GrammarRuleNode, 0)
+		for {
+			p.skipWhitespace()
+			if len(operators) > 0 && p.expect('|') {
+				p.skipWhitespace()
+			}
+			if p.la != '\'' {
+				break
+			}
+			operator, err := p.parseStringAtom(level)
+			if err != nil {
+				return nil, err
+			}
+			operators = append(operators, operator)
+		}
+		if len(operators) == 0 {
+			return nil, p.expectError("precedence operator")
+		}
+		level.SetChildren(operators)
+		if p.la != '{' {
+			return nil, p.expectError("'{'")
+		}
+		action, err := p.parseChoiceAction(level)
+		if err != nil {
+			return nil, err
+		}
+		if err = p.checkActionNames(action, map[string]bool{"lhs": true, "op": true, "rhs": true}); err != nil {
+			return nil, err
+		}
+		level.SetAction(action)
+		level.SetSnippet(p.input.Fork(start, p.mark()))
+		levels = append(levels, level)
+	}
+}
+
 func (p *GrammarParser) parseChoices(parent *models.GrammarRuleNode) ([]*models.GrammarRuleNode, error) {
 	p.skipWhitespace()
 	choices := make([]*models.GrammarRuleNode, 0)
@@ -105,6 +190,9 @@ func (p *GrammarParser) parseChoice(parent *models.GrammarRuleNode) (*models.Gra
 		if err != nil {
 			return nil, err
 		}
+		if err = p.checkActionNames(choiceAction, p.boundNames(choice, map[string]bool{})); err != nil {
+			return nil, err
+		}
 		choice.SetAction(choiceAction)
 		end = p.mark()
 	}
@@ -113,12 +201,38 @@ func (p *GrammarParser) parseChoice(parent *models.GrammarRuleNode) (*models.Gra
 	return choice, nil
 }
 
+// boundNames collects the item names an action of the choice may refer to,
+// including names inside groups that stay inlined into the choice.
+func (p *GrammarParser) boundNames(choice *models.GrammarRuleNode, names map[string]bool) map[string]bool {
+	for _, item := range choice.Children() {
+		if item.Name() != "" {
+			names[item.Name()] = true
+		}
+		atom := item.Child()
+		if atom != nil && atom.Kind() == models.GrammarRuleNodeTypeGroupAtom &&
+			len(atom.Children()) == 1 && atom.Child().Action() == nil {
+			p.boundNames(atom.Child(), names)
+		}
+	}
+	return names
+}
+
+func (p *GrammarParser) checkActionNames(action *models.GrammarRuleNode, names map[string]bool) error {
+	var err error
+	action.Visit(func(node *models.GrammarRuleNode) {
+		if err == nil && node.Kind() == models.GrammarRuleNodeTypeNameAction && !names[node.Name()] {
+			err = p.errorAt(node.Snippet().Start, "unbound name %s in action", node.Name())
+		}
+	})
+	return err
+}
+
 func (p *GrammarParser) prefixOfAtom(b byte) bool {
 	return (b >= 'a' && b <= 'z') || (b >= 'A' && b <= 'Z') || b == '_' || b == '\'' || b == '('
 }
 
 func (p *GrammarParser) prefixOfItem(b byte) bool {
-	return b == '[' || b == ']' || b == '~' || b == '&' || b == '!' || p.prefixOfAtom(b)
+	return b == '[' || b == ']' || b == '~' || b == '&' || b == '!' || b == '@' || p.prefixOfAtom(b)
 }
 
 func (p *GrammarParser) parseChoiceRule(choice *models.GrammarRuleNode) error {
@@ -146,93 +260,6 @@ func (p *GrammarParser) parseChoiceRule(choice *models.GrammarRuleNode) error {
 	return nil
 }
 
-func (p *GrammarParser) parseChoiceAction(parent *models.GrammarRuleNode) (*models.GrammarRuleNode, error) {
-	p.stepForward()
-	action, err := p.parseActionExpr(parent)
-	if err != nil {
-		return nil, err
-	}
-	p.skipWhitespace()
-	if !p.expect('}') {
-		return nil, p.expectError("'}'")
-	}
-	return action, nil
-}
-
-func (p *GrammarParser) parseActionExpr(parent *models.GrammarRuleNode) (*models.GrammarRuleNode, error) {
-	p.skipWhitespace()
-	action := models.NewGrammarRuleNode("", parent)
-	start := p.mark()
-	if p.la == '_' {
-		p.stepForward()
-		if !(p.la >= 'a' && p.la <= 'z') {
-			action.SetKind(models.GrammarRuleNodeTypeNullAction)
-		} else {
-			p.reset(start)
-		}
-	}
-	if action.Kind() == "" {
-		if p.expect('[') {
-			action.SetKind(models.GrammarRuleNodeTypeListAction)
-			elem, err := p.parseActionExpr(action)
-			if err != nil {
-				return nil, err
-			}
-			if !p.expect(']') {
-				return nil, p.expectError("']'")
-			}
-			action.SetChild(elem)
-		} else if p.la == '_' || (p.la >= 'a' && p.la <= 'z') {
-			if tmp, err := p.parseCallActionExpr(parent); err != nil {
-				return nil, err
-			} else if tmp != nil {
-				action = tmp
-			} else {
-				p.expectIdentifier()
-				action.SetKind(models.GrammarRuleNodeTypeNameAction)
-			}
-		} else {
-			return nil, p.expectError("action prefix [\\[_a-z]")
-		}
-	}
-
-	end := p.mark()
-	action.SetSnippet(p.input.Fork(start, end))
-	return action, nil
-}
-
-func (p *GrammarParser) parseCallActionExpr(parent *models.GrammarRuleNode) (*models.GrammarRuleNode, error) {
-	pos := p.mark()
-	name := p.expectIdentifier()
-	if !p.expect('(') {
-		p.reset(pos)
-		return nil, nil
-	}
-
-	callAction := models.NewGrammarRuleNode(models.GrammarRuleNodeTypeCallAction, parent)
-	callAction.SetName(name.Text())
-	args := make([]*models.GrammarRuleNode, 0)
-	for {
-		if p.la == ')' {
-			break
-		}
-		if len(args) > 0 {
-			p.skipWhitespace()
-			if !p.expect(',') {
-				return nil, p.expectError("','")
-			}
-		}
-		arg, err := p.parseActionExpr(callAction)
-		if err != nil {
-			return nil, err
-		}
-		args = append(args, arg)
-	}
-	callAction.SetChildren(args)
-	p.stepForward()
-	return callAction, nil
-}
-
 func (p *GrammarParser) tryParseItemName() *models.Snippet {
 	pos := p.mark()
 	snippet := p.expectIdentifier()
@@ -255,11 +282,24 @@ func (p *GrammarParser) parseItem(parent *models.GrammarRuleNode) (*models.Gramm
 	var atom *models.GrammarRuleNode
 	var err error
 	if p.expect('!') {
-		item.SetKind(models.GrammarRuleNodeTypeNegativeLookaheadItem)
-		atom, err = p.parseAtom(item)
+		if p.la == '{' {
+			item.SetKind(models.GrammarRuleNodeTypeNegativePredicateItem)
+			atom, err = p.parseCodeAtom(item)
+		} else {
+			item.SetKind(models.GrammarRuleNodeTypeNegativeLookaheadItem)
+			atom, err = p.parseAtom(item)
+		}
 	} else if p.expect('&') {
-		item.SetKind(models.GrammarRuleNodeTypePositiveLookaheadItem)
-		atom, err = p.parseAtom(item)
+		if p.la == '{' {
+			item.SetKind(models.GrammarRuleNodeTypePositivePredicateItem)
+			atom, err = p.parseCodeAtom(item)
+		} else {
+			item.SetKind(models.GrammarRuleNodeTypePositiveLookaheadItem)
+			atom, err = p.parseAtom(item)
+		}
+	} else if p.expect('@') {
+		item.SetKind(models.GrammarRuleNodeTypeStateActionItem)
+		atom, err = p.parseStateAtom(item)
 	} else if p.expect('~') {
 		item.SetKind(models.GrammarRuleNodeTypeForwardIfNotMatchItem)
 		atom, err = p.parseAtom(item)
@@ -294,6 +334,9 @@ func (p *GrammarParser) parseItem(parent *models.GrammarRuleNode) (*models.Gramm
 	if err != nil {
 		return nil, err
 	}
+	if item.Name() != "" && atom.Kind() == models.GrammarRuleNodeTypeCodeAtom {
+		return nil, p.expectError("unnamed predicate or state action")
+	}
 	item.SetChild(atom)
 	end := p.mark()
 	item.SetSnippet(p.input.Fork(start, end))
@@ -351,8 +394,30 @@ func (p *GrammarParser) parseNameAtom(parent *models.GrammarRuleNode) (*models.G
 	start, end := p.forwardUtil(func(b byte) bool {
 		return !((b >= 'a' && b <= 'z') || (b >= '0' && b <= '9') || b == '_')
 	})
+	atom.SetName(p.input.Fork(start, end).Text())
+	if p.expect('<') {
+		args := make([]*models.GrammarRuleNode, 0)
+		for {
+			p.skipWhitespace()
+			if len(args) > 0 {
+				if p.expect('>') {
+					break
+				}
+				if !p.expect(',') {
+					return nil, p.expectError("',' or '>'")
+				}
+				p.skipWhitespace()
+			}
+			arg, err := p.parseAtom(atom)
+			if err != nil {
+				return nil, err
+			}
+			args = append(args, arg)
+		}
+		atom.SetChildren(args) // template arguments
+		end = p.mark()
+	}
 	atom.SetSnippet(p.input.Fork(start, end))
-	atom.SetName(atom.Snippet().Text())
 	return atom, nil
 }
 
@@ -365,6 +430,59 @@ func (p *GrammarParser) parseTokenAtom(parent *models.GrammarRuleNode) (*models.
 	return atom, nil
 }
 
+func (p *GrammarParser) parseCodeAtom(parent *models.GrammarRuleNode) (*models.GrammarRuleNode, error) {
+	atom := models.NewGrammarRuleNode(models.GrammarRuleNodeTypeCodeAtom, parent)
+	p.stepForward()
+	depth := 0
+	var quote byte
+	escaped := false
+	start, end := p.forwardUtil(func(b byte) bool {
+		if quote != 0 {
+			if escaped {
+				escaped = false
+			} else if b == '\\' && quote != '`' {
+				escaped = true
+			} else if b == quote {
+				quote = 0
+			}
+			return false
+		}
+		switch b {
+		case '"', '\'', '`':
+			quote = b
+		case '{':
+			depth++
+		case '}':
+			if depth == 0 {
+				return true
+			}
+			depth--
+		}
+		return false
+	})
+	if !p.expect('}') {
+		return nil, p.expectError("'}'")
+	}
+	atom.SetSnippet(p.input.Fork(start, end))
+	if strings.TrimSpace(atom.Snippet().Text()) == "" {
+		return nil, p.expectError("go expression")
+	}
+	return atom, nil
+}
+
+func (p *GrammarParser) parseStateAtom(parent *models.GrammarRuleNode) (*models.GrammarRuleNode, error) {
+	atom := models.NewGrammarRuleNode(models.GrammarRuleNodeTypeCodeAtom, parent)
+	start, end := p.forwardUtil(func(b byte) bool {
+		return !((b >= 'a' && b <= 'z') || (b >= '0' && b <= '9') || b == '_')
+	})
+	if start.SameAs(end) {
+		return nil, p.expectError("state action name")
+	}
+	atom.SetSnippet(p.input.Fork(start, end))
+	atom.SetName(atom.Snippet().Text())
+	return atom, nil
+}
+
 func (p *GrammarParser) tryParseBracketEllipsisAtom(parent *models.GrammarRuleNode) *models.GrammarRuleNode {
 	atom := models.NewGrammarRuleNode(models.GrammarRuleNodeTypeBracketEllipsisAtom, parent)
 	start := p.mark()
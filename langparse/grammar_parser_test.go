@@ -0,0 +1,33 @@
+package langparse
+
+import (
+	"github.com/lincaiyong/pgen/models"
+	"strings"
+	"testing"
+)
+
+func TestGrammarParserActions(t *testing.T) {
+	input := models.NewSnippet("", []byte(`expr:
+    %left '+' {binary(lhs, op, rhs)}
+    | a=IDENT (',' b=IDENT)? c=IDENT* {call(a, [b, *c].x, "}" ?? _, a..b)}`))
+	if _, err := ParseGrammarRule(input); err != nil {
+		t.Fatal(err)
+	}
+	for action, msg := range map[string]string{
+		`{add(lhs, op. rhs)}`: "expect field name right after '.' in action, got identifier rhs",
+		`{add(lhs, op .rhs)}`: "unexpected space before '.' in action",
+		`{add(lhs, op.)}`:     "expect field name right after '.' in action",
+		`{add(lhs, x)}`:       "unbound name x in action",
+		`{add(lhs "op")}`:     "expect ',' or ')' in action",
+		`{add(lhs, "op)}`:     "unterminated string in action",
+		`{add(lhs; op)}`:      "unexpected character ';' in action",
+		`{add(lhs, op) rhs}`:  "expect '??', '..' or '}' in action",
+		`{add(lhs, op`:        "expect '}' to close action",
+	} {
+		input = models.NewSnippet("", []byte("expr: lhs=IDENT op='+' rhs=IDENT "+action))
+		_, err := ParseGrammarRule(input)
+		if err == nil || !strings.HasPrefix(err.Error(), msg) {
+			t.Fatalf("expect %q for %s, got %v", msg, action, err)
+		}
+	}
+}
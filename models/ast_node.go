@@ -1,13 +1,14 @@
 package models
 
-func NewAstNode(name string, args []string, snippet *Snippet) *AstNode {
-	args2 := make([]*Name, len(args))
-	for i, arg := range args {
-		args2[i] = NewName(arg)
+func NewAstNode(name string, fields []*AstField, snippet *Snippet) *AstNode {
+	args := make([]*Name, len(fields))
+	for i, field := range fields {
+		args[i] = field.Name
 	}
 	return &AstNode{
 		name:    name,
-		args:    args2,
+		args:    args,
+		fields:  fields,
 		snippet: snippet,
 	}
 }
@@ -15,6 +16,8 @@ func NewAstNode(name string, args []string, snippet *Snippet) *AstNode {
 type AstNode struct {
 	name    string
 	args    []*Name
+	fields  []*AstField
+	doc     string
 	snippet *Snippet
 }
 
@@ -26,6 +29,48 @@ func (a *AstNode) Args() []*Name {
 	return a.args
 }
 
+func (a *AstNode) Fields() []*AstField {
+	return a.fields
+}
+
+func (a *AstNode) Doc() string {
+	return a.doc
+}
+
+func (a *AstNode) SetDoc(doc string) {
+	a.doc = doc
+}
+
 func (a *AstNode) Snippet() *Snippet {
 	return a.snippet
 }
+
+func NewAstField(name string, optional, list, token bool) *AstField {
+	return &AstField{
+		Name:     NewName(name),
+		optional: optional,
+		list:     list,
+		token:    token,
+	}
+}
+
+// AstField is a field of an ast node: `name?` may be missing, `name*` holds
+// a list of nodes and `name:token` holds a token.
+type AstField struct {
+	*Name
+	optional bool
+	list     bool
+	token    bool
+}
+
+func (f *AstField) Optional() bool {
+	return f.optional
+}
+
+func (f *AstField) List() bool {
+	return f.list
+}
+
+func (f *AstField) Token() bool {
+	return f.token
+}
@@ -11,17 +11,27 @@ const (
 	GrammarRuleNodeTypeNegativeLookaheadItem = "negative-lookahead-item"
 	GrammarRuleNodeTypePositiveLookaheadItem = "positive-lookahead-item"
 	GrammarRuleNodeTypeForwardIfNotMatchItem = "forward-if-not-match-item"
+	GrammarRuleNodeTypePositivePredicateItem = "positive-predicate-item"
+	GrammarRuleNodeTypeNegativePredicateItem = "negative-predicate-item"
+	GrammarRuleNodeTypeStateActionItem       = "state-action-item"
 	GrammarRuleNodeTypeAtomItem              = "atom-item"
 	GrammarRuleNodeTypeNameAtom              = "name-atom"
 	GrammarRuleNodeTypeTokenAtom             = "token-atom"
 	GrammarRuleNodeTypeStringAtom            = "string-atom"
 	GrammarRuleNodeTypeGroupAtom             = "group-atom"
 	GrammarRuleNodeTypeBracketEllipsisAtom   = "bracket-ellipsis-atom"
-
-	GrammarRuleNodeTypeCallAction = "call-action"
-	GrammarRuleNodeTypeNameAction = "name-action"
-	GrammarRuleNodeTypeListAction = "list-action"
-	GrammarRuleNodeTypeNullAction = "null-action"
+	GrammarRuleNodeTypeCodeAtom              = "code-atom"
+	GrammarRuleNodeTypePrecedenceLevel       = "precedence-level"
+
+	GrammarRuleNodeTypeCallAction     = "call-action"
+	GrammarRuleNodeTypeNameAction     = "name-action"
+	GrammarRuleNodeTypeListAction     = "list-action"
+	GrammarRuleNodeTypeNullAction     = "null-action"
+	GrammarRuleNodeTypeLiteralAction  = "literal-action"
+	GrammarRuleNodeTypeFieldAction    = "field-action"
+	GrammarRuleNodeTypeSpreadAction   = "spread-action"
+	GrammarRuleNodeTypeCoalesceAction = "coalesce-action"
+	GrammarRuleNodeTypeSpanAction     = "span-action"
 )
 
 func NewGrammarRuleNode(kind string, parent *GrammarRuleNode) *GrammarRuleNode {
@@ -38,8 +48,13 @@ type GrammarRuleNode struct {
 	snippet  *Snippet
 
 	name string // rule name / item name
+	doc  string
 
 	ruleMemo bool
+	params   []string // template parameters
+	instance string   // template instantiation, e.g. semi<statement>
+
+	precedences []*GrammarRuleNode // lowest first
 
 	separator *GrammarRuleNode
 	action    *GrammarRuleNode
@@ -55,6 +70,9 @@ func (g *GrammarRuleNode) Visit(fn func(*GrammarRuleNode)) {
 	if g.separator != nil {
 		g.separator.Visit(fn)
 	}
+	for _, level := range g.precedences {
+		level.Visit(fn)
+	}
 }
 
 func (g *GrammarRuleNode) Kind() string {
@@ -112,6 +130,14 @@ func (g *GrammarRuleNode) SetName(name string) {
 	g.name = name
 }
 
+func (g *GrammarRuleNode) Doc() string {
+	return g.doc
+}
+
+func (g *GrammarRuleNode) SetDoc(doc string) {
+	g.doc = doc
+}
+
 func (g *GrammarRuleNode) RuleMemo() bool {
 	return g.ruleMemo
 }
@@ -120,6 +146,30 @@ func (g *GrammarRuleNode) SetRuleMemo(memo bool) {
 	g.ruleMemo = memo
 }
 
+func (g *GrammarRuleNode) Params() []string {
+	return g.params
+}
+
+func (g *GrammarRuleNode) SetParams(params []string) {
+	g.params = params
+}
+
+func (g *GrammarRuleNode) Instance() string {
+	return g.instance
+}
+
+func (g *GrammarRuleNode) SetInstance(instance string) {
+	g.instance = instance
+}
+
+func (g *GrammarRuleNode) Precedences() []*GrammarRuleNode {
+	return g.precedences
+}
+
+func (g *GrammarRuleNode) SetPrecedences(precedences []*GrammarRuleNode) {
+	g.precedences = precedences
+}
+
 func (g *GrammarRuleNode) Separator() *GrammarRuleNode {
 	return g.separator
 }
@@ -143,3 +193,27 @@ func (g *GrammarRuleNode) Suffix() string {
 func (g *GrammarRuleNode) SetSuffix(suffix string) {
 	g.suffix = suffix
 }
+
+func (g *GrammarRuleNode) Clone(parent *GrammarRuleNode) *GrammarRuleNode {
+	if g == nil {
+		return nil
+	}
+	ret := *g
+	ret.parent = parent
+	ret.children = cloneGrammarRuleNodes(g.children, &ret)
+	ret.precedences = cloneGrammarRuleNodes(g.precedences, &ret)
+	ret.separator = g.separator.Clone(&ret)
+	ret.action = g.action.Clone(&ret)
+	return &ret
+}
+
+func cloneGrammarRuleNodes(nodes []*GrammarRuleNode, parent *GrammarRuleNode) []*GrammarRuleNode {
+	if nodes == nil {
+		return nil
+	}
+	ret := make([]*GrammarRuleNode, len(nodes))
+	for i, node := range nodes {
+		ret[i] = node.Clone(parent)
+	}
+	return ret
+}
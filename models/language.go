@@ -11,7 +11,10 @@ type Language struct {
 	keywords     []string
 	operators    []string
 	astNodes     []*AstNode
+	categories   []*NodeCategory
 	grammarRules []*GrammarRuleNode
+	templates    map[string]*GrammarRuleNode
+	stateFields  []*StateField
 	hackCode     string
 
 	operatorMap map[string]string
@@ -36,6 +39,7 @@ func NewLanguage() *Language {
 		operatorMap: make(map[string]string),
 		keywordMap:  make(map[string]struct{}),
 		memoIdMap:   make(map[*GrammarRuleNode]int),
+		templates:   make(map[string]*GrammarRuleNode),
 	}
 }
 
@@ -86,6 +90,23 @@ func (lang *Language) AddAstNode(node *AstNode) {
 	lang.astNodes = append(lang.astNodes, node)
 }
 
+func (lang *Language) NodeCategories() []*NodeCategory {
+	return lang.categories
+}
+
+func (lang *Language) NodeCategory(name string) *NodeCategory {
+	for _, category := range lang.categories {
+		if category.Name() == name {
+			return category
+		}
+	}
+	return nil
+}
+
+func (lang *Language) AddNodeCategory(category *NodeCategory) {
+	lang.categories = append(lang.categories, category)
+}
+
 func (lang *Language) GrammarRules() []*GrammarRuleNode {
 	return lang.grammarRules
 }
@@ -97,6 +118,22 @@ func (lang *Language) AddGrammarRule(rule *GrammarRuleNode) {
 	}
 }
 
+func (lang *Language) GrammarTemplate(name string) *GrammarRuleNode {
+	return lang.templates[name]
+}
+
+func (lang *Language) AddGrammarTemplate(rule *GrammarRuleNode) {
+	lang.templates[rule.Name()] = rule
+}
+
+func (lang *Language) StateFields() []*StateField {
+	return lang.stateFields
+}
+
+func (lang *Language) AddStateField(field *StateField) {
+	lang.stateFields = append(lang.stateFields, field)
+}
+
 func (lang *Language) HackCode() string {
 	return lang.hackCode
 }
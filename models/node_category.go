@@ -0,0 +1,39 @@
+package models
+
+func NewNodeCategory(name string, snippet *Snippet) *NodeCategory {
+	return &NodeCategory{
+		name:    name,
+		snippet: snippet,
+	}
+}
+
+type NodeCategory struct {
+	name    string
+	nodes   []string
+	doc     string
+	snippet *Snippet
+}
+
+func (c *NodeCategory) Name() string {
+	return c.name
+}
+
+func (c *NodeCategory) Nodes() []string {
+	return c.nodes
+}
+
+func (c *NodeCategory) AddNode(node string) {
+	c.nodes = append(c.nodes, node)
+}
+
+func (c *NodeCategory) Doc() string {
+	return c.doc
+}
+
+func (c *NodeCategory) SetDoc(doc string) {
+	c.doc = doc
+}
+
+func (c *NodeCategory) Snippet() *Snippet {
+	return c.snippet
+}
@@ -0,0 +1,27 @@
+package models
+
+func NewStateField(name, typ string, snippet *Snippet) *StateField {
+	return &StateField{
+		name:    name,
+		typ:     typ,
+		snippet: snippet,
+	}
+}
+
+type StateField struct {
+	name    string
+	typ     string
+	snippet *Snippet
+}
+
+func (f *StateField) Name() string {
+	return f.name
+}
+
+func (f *StateField) Type() string {
+	return f.typ
+}
+
+func (f *StateField) Snippet() *Snippet {
+	return f.snippet
+}
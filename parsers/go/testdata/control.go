@@ -0,0 +1,30 @@
+package control
+
+func Classify(xs []int, done chan struct{}) (int, error) {
+	var names = map[int]string{0: "low", 1: "mid", 2: "high"}
+	count := 0
+	for i := 0; i < len(xs); i++ {
+		switch x := xs[i]; {
+		case x < 0:
+			continue
+		case x > 100:
+			return count, nil
+		default:
+			count++
+		}
+	}
+	select {
+	case <-done:
+		return count, nil
+	default:
+	}
+	var v any = names[count]
+	switch t := v.(type) {
+	case string:
+		defer func() { _ = t }()
+	}
+	go func(n int) {
+		done <- struct{}{}
+	}(count)
+	return count, nil
+}
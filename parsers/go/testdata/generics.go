@@ -0,0 +1,25 @@
+package generics
+
+func Map[T, U any](xs []T, fn func(T) U) []U {
+	out := make([]U, 0, len(xs))
+	for _, x := range xs {
+		out = append(out, fn(x))
+	}
+	return out
+}
+
+func Sum[T int | float64](xs ...T) T {
+	var s T = 0
+	for _, x := range xs {
+		s += x
+	}
+	return s
+}
+
+func Pairs() []Pair[string, int] {
+	ps := []Pair[string, int]{{Key: "a", Val: 1}, {"b", 2}}
+	p := &ps[0]
+	p.Val <<= 2
+	_ = ps[1:2:2]
+	return ps
+}
@@ -0,0 +1,30 @@
+package shapes
+
+import "math"
+
+func (r Rect) Area() float64 {
+	return r.W * r.H
+}
+
+func (r *Rect) Perimeter() float64 {
+	return 2 * (r.W + r.H)
+}
+
+func (c Circle) Area() float64 {
+	return math.Pi * c.R * c.R
+}
+
+func Total(shapes ...Circle) (sum float64) {
+	type pair struct {
+		W, H float64
+		Tag  string `json:"tag"`
+	}
+	ps := []pair{{1, 2, "a"}, {W: 3, H: 4}}
+	for _, s := range shapes {
+		sum += s.Area()
+	}
+	for i := range ps {
+		sum += ps[i].W * ps[i].H
+	}
+	return
+}
@@ -3,15 +3,19 @@ package goparser
 import (
 	"bufio"
 	"bytes"
+	"context"
+	"encoding/binary"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"golang.org/x/text/encoding/simplifiedchinese"
 	"golang.org/x/text/encoding/unicode"
 	"golang.org/x/text/transform"
+	"hash/fnv"
 	"os"
 	"reflect"
 	"regexp"
+	"slices"
 	"sort"
 	"strconv"
 	"strings"
@@ -68,6 +72,8 @@ type Node interface {
 	SetChild(nodes []Node)
 	Fork() Node
 	Visit(func(Node) (visitChildren, exit bool), func(Node) (exit bool)) (exit bool)
+	Accept(Visitor) (visitChildren bool)
+	Rewrite(Rewriter) Node
 	FilePath() string
 	FileContent() []rune
 	Code() []rune
@@ -81,6 +87,7 @@ type Node interface {
 const TokenTypeDummy = "dummy"
 const TokenTypeEndOfFile = "end_of_file"
 const TokenTypePseudo = "pseudo"
+const TokenTypeLiteral = "literal"
 const TokenTypeWhitespace = "whitespace"
 const TokenTypeNewline = "newline"
 const TokenTypeComment = "comment"
@@ -164,127 +171,147 @@ const TokenTypeKwVar = "kw_var"
 const NodeTypeDummy = "dummy"
 const NodeTypeToken = "token"
 const NodeTypeNodes = "nodes"
-const NodeTypeFile = "file"
+const NodeTypeAddOpExpr = "add_op_expr"
+const NodeTypeArgument = "argument"
+const NodeTypeArgumentDecl = "argument_decl"
+const NodeTypeArrayType = "array_type"
+const NodeTypeAssignStmt = "assign_stmt"
+const NodeTypeAugAssignStmt = "aug_assign_stmt"
 const NodeTypeBasicLit = "basic_lit"
-const NodeTypeCompositeLit = "composite_lit"
-const NodeTypeFunctionLit = "function_lit"
-const NodeTypeFieldList = "field_list"
-const NodeTypeField = "field"
-const NodeTypeImportDecl = "import_decl"
-const NodeTypeImportSpec = "import_spec"
-const NodeTypeConstSpec = "const_spec"
-const NodeTypeVarSpec = "var_spec"
-const NodeTypeTypeEqSpec = "type_eq_spec"
-const NodeTypeTypeSpec = "type_spec"
-const NodeTypeConstDecl = "const_decl"
-const NodeTypeVarDecl = "var_decl"
-const NodeTypeTypeDecl = "type_decl"
+const NodeTypeBlockStmt = "block_stmt"
+const NodeTypeChanType = "chan_type"
+const NodeTypeCompareExpr = "compare_expr"
+const NodeTypeConstGroupDecl = "const_group_decl"
+const NodeTypeConstIdent = "const_ident"
+const NodeTypeConstOneDecl = "const_one_decl"
+const NodeTypeDecStmt = "dec_stmt"
+const NodeTypeDeferStmt = "defer_stmt"
 const NodeTypeEllipsis = "ellipsis"
-const NodeTypeLabeledStmt = "labeled_stmt"
+const NodeTypeEllipsisArgument = "ellipsis_argument"
+const NodeTypeEllipsisParameter = "ellipsis_parameter"
+const NodeTypeExprStmt = "expr_stmt"
+const NodeTypeFallthroughStmt = "fallthrough_stmt"
+const NodeTypeField = "field"
+const NodeTypeFieldList = "field_list"
+const NodeTypeFunctionIdent = "function_ident"
+const NodeTypeFunctionLit = "function_lit"
+const NodeTypeGenericParameterDecl = "generic_parameter_decl"
+const NodeTypeGenericParameterIdent = "generic_parameter_ident"
+const NodeTypeGenericTypeConstraint = "generic_type_constraint"
 const NodeTypeGenericTypeInstantiation = "generic_type_instantiation"
+const NodeTypeGenericUnderlyingTypeConstraint = "generic_underlying_type_constraint"
+const NodeTypeGenericUnionConstraint = "generic_union_constraint"
+const NodeTypeGoStmt = "go_stmt"
+const NodeTypeGotoStmt = "goto_stmt"
 const NodeTypeIdent = "ident"
-const NodeTypeMakeExpr = "make_expr"
-const NodeTypeNewExpr = "new_expr"
-const NodeTypePackageIdent = "package_ident"
+const NodeTypeIfStmt = "if_stmt"
 const NodeTypeImportDot = "import_dot"
+const NodeTypeImportGroupDecl = "import_group_decl"
 const NodeTypeImportIdent = "import_ident"
+const NodeTypeImportOneDecl = "import_one_decl"
 const NodeTypeImportPath = "import_path"
-const NodeTypeConstIdent = "const_ident"
-const NodeTypeVarIdent = "var_ident"
-const NodeTypeTypeIdent = "type_ident"
-const NodeTypeFunctionIdent = "function_ident"
+const NodeTypeIncStmt = "inc_stmt"
+const NodeTypeIndexExpr = "index_expr"
+const NodeTypeInterfaceType = "interface_type"
+const NodeTypeKeyValueExpr = "key_value_expr"
+const NodeTypeLabelIdent = "label_ident"
+const NodeTypeLabeledStmt = "labeled_stmt"
+const NodeTypeLogicalAndExpr = "logical_and_expr"
+const NodeTypeLogicalOrExpr = "logical_or_expr"
+const NodeTypeMapType = "map_type"
 const NodeTypeMethodIdent = "method_ident"
-const NodeTypeGenericParameter = "generic_parameter"
-const NodeTypeGenericParameterIdent = "generic_parameter_ident"
-const NodeTypeGenericUnionConstraint = "generic_union_constraint"
-const NodeTypeGenericUnderlyingTypeConstraint = "generic_underlying_type_constraint"
-const NodeTypeGenericTypeConstraint = "generic_type_constraint"
-const NodeTypeEllipsisParameter = "ellipsis_parameter"
-const NodeTypeParameter = "parameter"
+const NodeTypeMulOpExpr = "mul_op_expr"
+const NodeTypeNameParameter = "name_parameter"
+const NodeTypeNameTypeParameter = "name_type_parameter"
+const NodeTypeNewExpr = "new_expr"
+const NodeTypeNumberExpr = "number_expr"
+const NodeTypePackageDecl = "package_decl"
+const NodeTypePackageIdent = "package_ident"
+const NodeTypeParameterDecl = "parameter_decl"
 const NodeTypeParameterIdent = "parameter_ident"
-const NodeTypeFunctionResult = "function_result"
-const NodeTypeFunctionResultIdent = "function_result_ident"
-const NodeTypeFunctionDecl = "function_decl"
-const NodeTypeMethodDecl = "method_decl"
+const NodeTypeParenExpr = "paren_expr"
+const NodeTypeReceiverGenericTypeDecl = "receiver_generic_type_decl"
+const NodeTypeReceiverGenericTypeIdent = "receiver_generic_type_ident"
 const NodeTypeReceiverIdent = "receiver_ident"
 const NodeTypeReceiverTypeIdent = "receiver_type_ident"
-const NodeTypeReceiverGenericTypeIdent = "receiver_generic_type_ident"
-const NodeTypeReceiver = "receiver"
-const NodeTypeBinaryExpr = "binary_expr"
-const NodeTypeCallExpr = "call_expr"
-const NodeTypeIndexExpr = "index_expr"
-const NodeTypeKeyValueExpr = "key_value_expr"
-const NodeTypeParenExpr = "paren_expr"
+const NodeTypeResultGroupDecl = "result_group_decl"
+const NodeTypeResultIdent = "result_ident"
+const NodeTypeResultName = "result_name"
+const NodeTypeResultNameType = "result_name_type"
+const NodeTypeResultOneDecl = "result_one_decl"
+const NodeTypeResultTypesDecl = "result_types_decl"
+const NodeTypeSelectStmt = "select_stmt"
 const NodeTypeSelectorExpr = "selector_expr"
+const NodeTypeSendStmt = "send_stmt"
+const NodeTypeShortVarDecl = "short_var_decl"
 const NodeTypeStarExpr = "star_expr"
+const NodeTypeStringExpr = "string_expr"
+const NodeTypeStructType = "struct_type"
+const NodeTypeTypeArgumentDecl = "type_argument_decl"
 const NodeTypeTypeAssertExpr = "type_assert_expr"
-const NodeTypeSliceExpr = "slice_expr"
+const NodeTypeTypeDecl = "type_decl"
+const NodeTypeTypeIdent = "type_ident"
+const NodeTypeTypeSwitchGuardIdent = "type_switch_guard_ident"
 const NodeTypeUnaryExpr = "unary_expr"
-const NodeTypeAssignStmt = "assign_stmt"
-const NodeTypeAugAssignStmt = "aug_assign_stmt"
-const NodeTypeShortVarDecl = "short_var_decl"
-const NodeTypeBlockStmt = "block_stmt"
-const NodeTypeBranchStmt = "branch_stmt"
-const NodeTypeSendStmt = "send_stmt"
-const NodeTypeExprStmt = "expr_stmt"
-const NodeTypeIncStmt = "inc_stmt"
-const NodeTypeDecStmt = "dec_stmt"
-const NodeTypeIfStmt = "if_stmt"
-const NodeTypeForStmt = "for_stmt"
-const NodeTypeForAssignRangeStmt = "for_assign_range_stmt"
-const NodeTypeForDeclRangeStmt = "for_decl_range_stmt"
-const NodeTypeSelectStmt = "select_stmt"
-const NodeTypeSwitchStmt = "switch_stmt"
-const NodeTypeTypeSwitchStmt = "type_switch_stmt"
-const NodeTypeTypeSwitchGuard = "type_switch_guard"
+const NodeTypeVarDecl = "var_decl"
+const NodeTypeVarIdent = "var_ident"
+const NodeTypeBreakStmt = "break_stmt"
+const NodeTypeCallExpr = "call_expr"
+const NodeTypeCompositeLit = "composite_lit"
+const NodeTypeConstNameTypeValue = "const_name_type_value"
+const NodeTypeContinueStmt = "continue_stmt"
 const NodeTypeDefaultClause = "default_clause"
 const NodeTypeExprCaseClause = "expr_case_clause"
+const NodeTypeFile = "file"
+const NodeTypeForAssignRangeStmt = "for_assign_range_stmt"
+const NodeTypeForDeclRangeStmt = "for_decl_range_stmt"
+const NodeTypeForStmt = "for_stmt"
+const NodeTypeFullSliceExpr = "full_slice_expr"
+const NodeTypeFunctionDecl = "function_decl"
+const NodeTypeFunctionType = "function_type"
+const NodeTypeGenericParameter = "generic_parameter"
+const NodeTypeImportNamePath = "import_name_path"
+const NodeTypeMakeChanExpr = "make_chan_expr"
+const NodeTypeMakeMapExpr = "make_map_expr"
+const NodeTypeMakeSliceExpr = "make_slice_expr"
+const NodeTypeMethodDecl = "method_decl"
+const NodeTypeReceiverDecl = "receiver_decl"
+const NodeTypeReceiverType = "receiver_type"
+const NodeTypeReturnStmt = "return_stmt"
 const NodeTypeSelectCaseClause = "select_case_clause"
+const NodeTypeSliceExpr = "slice_expr"
+const NodeTypeStarReceiverType = "star_receiver_type"
+const NodeTypeSwitchStmt = "switch_stmt"
 const NodeTypeTypeCaseClause = "type_case_clause"
-const NodeTypeReturnStmt = "return_stmt"
-const NodeTypeFallthroughStmt = "fallthrough_stmt"
-const NodeTypeContinueStmt = "continue_stmt"
-const NodeTypeGotoStmt = "goto_stmt"
-const NodeTypeBreakStmt = "break_stmt"
-const NodeTypeDeferStmt = "defer_stmt"
-const NodeTypeGoStmt = "go_stmt"
-const NodeTypeArrayType = "array_type"
-const NodeTypeChanType = "chan_type"
-const NodeTypeFunctionType = "function_type"
-const NodeTypeInterfaceType = "interface_type"
-const NodeTypeMapType = "map_type"
-const NodeTypeStructType = "struct_type"
+const NodeTypeTypeEqSpec = "type_eq_spec"
+const NodeTypeTypeSpec = "type_spec"
+const NodeTypeTypeSwitchGuard = "type_switch_guard"
+const NodeTypeTypeSwitchStmt = "type_switch_stmt"
+const NodeTypeVarSpec = "var_spec"
 
-func errorContext(filePath string, fileContent []rune, offset, lineIdx, charIdx int) string {
-	var lineStartOffset int
-	for i := offset; i >= 0; i-- {
-		if i < len(fileContent) && fileContent[i] == '\n' {
-			lineStartOffset = i + 1
-			break
-		}
-	}
-	lineText := regexp.MustCompile("[^\\t]").ReplaceAllString(string(fileContent[lineStartOffset:offset]), " ")
+func errorContext(filePath string, lines *LineIndex, offset int) string {
+	pos := lines.OffsetToPosition(offset)
+	lineText := regexp.MustCompile("[^\\t]").ReplaceAllString(string(lines.content[lines.LineStart(pos.LineIdx):pos.Offset]), " ")
 
-	lines := strings.Split(string(fileContent), "\n")
 	contextLines := 3
-	startLine := lineIdx - contextLines
+	startLine := pos.LineIdx - contextLines
 	if startLine < 0 {
 		startLine = 0
 	}
-	endLine := lineIdx + contextLines
-	if endLine >= len(lines) {
-		endLine = len(lines) - 1
+	endLine := pos.LineIdx + contextLines
+	if endLine >= lines.LineCount() {
+		endLine = lines.LineCount() - 1
 	}
 	var sb strings.Builder
-	sb.WriteString(fmt.Sprintf("=== error context (%s:%d:%d) ===\n", filePath, lineIdx+1, charIdx+1))
+	sb.WriteString(fmt.Sprintf("=== error context (%s:%d:%d) ===\n", filePath, pos.LineIdx+1, pos.CharIdx+1))
 	for i := startLine; i <= endLine; i++ {
 		prefix := "   "
 		var t string
-		if i == lineIdx {
+		if i == pos.LineIdx {
 			prefix = ">>>"
 			t = fmt.Sprintf("          %s^\n", lineText)
 		}
-		sb.WriteString(fmt.Sprintf("%s %4d: %s\n", prefix, i+1, lines[i]))
+		sb.WriteString(fmt.Sprintf("%s %4d: %s\n", prefix, i+1, string(lines.Line(i))))
 		if t != "" {
 			sb.WriteString(t)
 		}
@@ -293,6 +320,142 @@ func errorContext(filePath string, fileContent []rune, offset, lineIdx, charIdx
 	return sb.String()
 }
 
+// lineBreakAt tells whether content[i] ends a line: a \n, or a \r that is not
+// followed by \n, including one at the end of the content. The tokenizer and
+// LineIndex share this rule, so token positions and LineIndex positions agree.
+func lineBreakAt(content []rune, i int) bool {
+	return content[i] == '\n' || (content[i] == '\r' && (i+1 >= len(content) || content[i+1] != '\n'))
+}
+
+func NewLineIndex(content []rune) *LineIndex {
+	lineStarts := []int{0}
+	for i := range content {
+		if lineBreakAt(content, i) {
+			lineStarts = append(lineStarts, i+1)
+		}
+	}
+	return &LineIndex{content: content, lineStarts: lineStarts}
+}
+
+type LineIndex struct {
+	content    []rune
+	lineStarts []int
+}
+
+func (idx *LineIndex) LineCount() int {
+	return len(idx.lineStarts)
+}
+
+func (idx *LineIndex) LineStart(lineIdx int) int {
+	if lineIdx < 0 {
+		return 0
+	}
+	if lineIdx >= len(idx.lineStarts) {
+		return len(idx.content)
+	}
+	return idx.lineStarts[lineIdx]
+}
+
+func (idx *LineIndex) LineEnd(lineIdx int) int {
+	if lineIdx < 0 {
+		lineIdx = 0
+	}
+	if lineIdx >= len(idx.lineStarts) {
+		return len(idx.content)
+	}
+	end := len(idx.content)
+	if lineIdx+1 < len(idx.lineStarts) {
+		end = idx.lineStarts[lineIdx+1]
+	}
+	for end > idx.lineStarts[lineIdx] && (idx.content[end-1] == '\n' || idx.content[end-1] == '\r') {
+		end--
+	}
+	return end
+}
+
+func (idx *LineIndex) Line(lineIdx int) []rune {
+	return idx.content[idx.LineStart(lineIdx):idx.LineEnd(lineIdx)]
+}
+
+func (idx *LineIndex) clampOffset(offset int) int {
+	if offset < 0 {
+		return 0
+	}
+	if offset > len(idx.content) {
+		return len(idx.content)
+	}
+	return offset
+}
+
+func (idx *LineIndex) lineOf(offset int) int {
+	return sort.Search(len(idx.lineStarts), func(i int) bool {
+		return idx.lineStarts[i] > offset
+	}) - 1
+}
+
+func (idx *LineIndex) OffsetToPosition(offset int) Position {
+	offset = idx.clampOffset(offset)
+	lineIdx := idx.lineOf(offset)
+	return Position{Offset: offset, LineIdx: lineIdx, CharIdx: offset - idx.lineStarts[lineIdx]}
+}
+
+func (idx *LineIndex) PositionToOffset(lineIdx, charIdx int) int {
+	start, end := idx.LineStart(lineIdx), idx.LineEnd(lineIdx)
+	if lineIdx >= len(idx.lineStarts) || charIdx < 0 {
+		return start
+	}
+	if start+charIdx > end {
+		return end
+	}
+	return start + charIdx
+}
+
+func (idx *LineIndex) offsetToColumn(offset int, width func(rune) int) (lineIdx, col int) {
+	pos := idx.OffsetToPosition(offset)
+	for _, ch := range idx.content[idx.lineStarts[pos.LineIdx]:pos.Offset] {
+		col += width(ch)
+	}
+	return pos.LineIdx, col
+}
+
+func (idx *LineIndex) columnToOffset(lineIdx, col int, width func(rune) int) int {
+	offset, end := idx.LineStart(lineIdx), idx.LineEnd(lineIdx)
+	for ; offset < end && col > 0; offset++ {
+		col -= width(idx.content[offset])
+	}
+	return offset
+}
+
+func utf8Width(ch rune) int {
+	if n := utf8.RuneLen(ch); n > 0 {
+		return n
+	}
+	return utf8.RuneLen(utf8.RuneError)
+}
+
+func utf16Width(ch rune) int {
+	if ch >= 0x10000 && ch <= utf8.MaxRune {
+		return 2
+	}
+	return 1
+}
+
+func (idx *LineIndex) OffsetToUTF8Column(offset int) (lineIdx, col int) {
+	return idx.offsetToColumn(offset, utf8Width)
+}
+
+func (idx *LineIndex) UTF8ColumnToOffset(lineIdx, col int) int {
+	return idx.columnToOffset(lineIdx, col, utf8Width)
+}
+
+func (idx *LineIndex) OffsetToUTF16Column(offset int) (lineIdx, col int) {
+	return idx.offsetToColumn(offset, utf16Width)
+}
+
+func (idx *LineIndex) UTF16ColumnToOffset(lineIdx, col int) int {
+	return idx.columnToOffset(lineIdx, col, utf16Width)
+}
+
 func toSnakeCase(camelCaseString string) string {
 	var sb strings.Builder
 	for i, char := range camelCaseString {
@@ -409,6 +572,30 @@ func nodesVisit(nodes []Node, before func(Node) (visitChild, exit bool), after f
 	return false
 }
 
+func Walk(v Visitor, node Node) {
+	node.Visit(func(n Node) (bool, bool) {
+		return n.Accept(v), false
+	}, func(Node) bool {
+		return false
+	})
+}
+
+func Rewrite(r Rewriter, node Node) Node {
+	node = rewriteChild(r, node)
+	node.BuildLink()
+	return node
+}
+
+func rewriteChild(r Rewriter, node Node) Node {
+	if node == nil || node.IsDummy() {
+		return DummyNode
+	}
+	if node = node.Rewrite(r); node == nil {
+		return DummyNode
+	}
+	return node
+}
+
 var creationHook = func(Node) {}
 
 func SetCreationHook(h func(Node)) {
@@ -528,6 +715,14 @@ func (n *BaseNode) Visit(func(Node) (bool, bool), func(Node) bool) bool {
 	return false
 }
 
+func (n *BaseNode) Accept(Visitor) bool {
+	return false
+}
+
+func (n *BaseNode) Rewrite(Rewriter) Node {
+	return n
+}
+
 func (n *BaseNode) Code() []rune {
 	if n.fileContent == nil {
 		return nil
@@ -604,9 +799,15 @@ func (n *NodesNode) Fields() []string {
 }
 
 func (n *NodesNode) BuildLink() {
-	nodesSetParent(n.nodes, n, "")
 	for _, target := range n.nodes {
 		target.BuildLink()
+	}
+	n.linkNodes()
+}
+
+func (n *NodesNode) linkNodes() {
+	nodesSetParent(n.nodes, n, "")
+	for _, target := range n.nodes {
 		target.SetReplaceSelf(func(n Node) {
 			i, _ := strconv.Atoi(n.SelfField())
 			n.Parent().(*NodesNode).Nodes()[i] = n
@@ -659,6 +860,21 @@ func (n *NodesNode) Visit(beforeChildren func(Node) (visitChildren, exit bool),
 	return false
 }
 
+func (n *NodesNode) Accept(v Visitor) bool {
+	return v.VisitNodesNode(n)
+}
+
+func (n *NodesNode) Rewrite(r Rewriter) Node {
+	nodes := make([]Node, 0, len(n.nodes))
+	for _, node := range n.nodes {
+		if node = rewriteChild(r, node); !node.IsDummy() {
+			nodes = append(nodes, node)
+		}
+	}
+	n.nodes = nodes
+	return r.RewriteNodesNode(n)
+}
+
 func (n *NodesNode) dumpNodes(hook func(Node, map[string]string) string) string {
 	items := make([]string, 0)
 	for _, t := range n.nodes {
@@ -696,6 +912,13 @@ func (n *TokenNode) Token() *Token {
 	return n.token
 }
 
+func (n *TokenNode) Code() []rune {
+	if n.token.Kind == TokenTypeLiteral {
+		return n.token.Value
+	}
+	return n.BaseNode.Code()
+}
+
 func (n *TokenNode) Visit(beforeChildren func(Node) (visitChildren, exit bool), afterChildren func(Node) (exit bool)) (exit bool) {
 	vc, e := beforeChildren(n)
 	if e {
@@ -710,6 +933,14 @@ func (n *TokenNode) Visit(beforeChildren func(Node) (visitChildren, exit bool),
 	return false
 }
 
+func (n *TokenNode) Accept(v Visitor) bool {
+	return v.VisitTokenNode(n)
+}
+
+func (n *TokenNode) Rewrite(r Rewriter) Node {
+	return r.RewriteTokenNode(n)
+}
+
 func (n *TokenNode) Fork() Node {
 	return &TokenNode{
 		BaseNode: n.BaseNode.fork(),
@@ -718,2603 +949,2372 @@ func (n *TokenNode) Fork() Node {
 }
 
 func (n *TokenNode) Dump(func(Node, map[string]string) string) map[string]string {
-	val := string(n.Code())
-	val = strings.ReplaceAll(val, "\\", "\\\\")
-	val = strings.ReplaceAll(val, "\"", "\\\"")
-	val = strings.ReplaceAll(val, "\n", "\\n")
-	val = strings.ReplaceAll(val, "\r", "\\r")
-	val = strings.ReplaceAll(val, "\t", "\\t")
-	val = fmt.Sprintf("\"%s\"", val)
 	return map[string]string{
 		"kind": "\"token\"",
-		"code": val,
+		"type": dumpString(n.token.Kind),
+		"code": dumpString(string(n.Code())),
 	}
 }
 
-func NewFileNode(filePath string, fileContent []rune, package_ Node, imports Node, declarations Node, start, end Position) Node {
-	if package_ == nil {
-		package_ = DummyNode
-	}
-	if imports == nil {
-		imports = DummyNode
-	}
-	if declarations == nil {
-		declarations = DummyNode
-	}
-	_1 := &FileNode{
-		BaseNode:     NewBaseNode(filePath, fileContent, NodeTypeFile, start, end),
-		package_:     package_,
-		imports:      imports,
-		declarations: declarations,
-	}
-	creationHook(_1)
-	return _1
+type PathStep struct {
+	Field string
+	Index int
 }
 
-type FileNode struct {
-	*BaseNode
-	package_     Node
-	imports      Node
-	declarations Node
+func NewCursor(node Node) *Cursor {
+	return &Cursor{node: node}
 }
 
-func (n *FileNode) Package() Node {
-	return n.package_
+type Cursor struct {
+	node Node
 }
 
-func (n *FileNode) SetPackage(v Node) {
-	n.package_ = v
+func (c *Cursor) Node() Node {
+	return c.node
 }
 
-func (n *FileNode) Imports() Node {
-	return n.imports
+func (c *Cursor) list() *NodesNode {
+	if c.node.Parent() == nil {
+		return nil
+	}
+	l, _ := c.node.Parent().(*NodesNode)
+	return l
 }
 
-func (n *FileNode) SetImports(v Node) {
-	n.imports = v
+func (c *Cursor) Parent() *Cursor {
+	parent := c.node.Parent()
+	if l := c.list(); l != nil {
+		parent = l.Parent()
+	}
+	if parent == nil {
+		return nil
+	}
+	return &Cursor{node: parent}
 }
 
-func (n *FileNode) Declarations() Node {
-	return n.declarations
+func (c *Cursor) Field() string {
+	if l := c.list(); l != nil {
+		return l.SelfField()
+	}
+	return c.node.SelfField()
 }
 
-func (n *FileNode) SetDeclarations(v Node) {
-	n.declarations = v
+func (c *Cursor) Index() int {
+	if c.list() == nil {
+		return -1
+	}
+	i, err := strconv.Atoi(c.node.SelfField())
+	if err != nil {
+		return -1
+	}
+	return i
 }
 
-func (n *FileNode) BuildLink() {
-	if !n.Package().IsDummy() {
-		package_ := n.Package()
-		package_.BuildLink()
-		package_.SetParent(n)
-		package_.SetSelfField("package_")
-		package_.SetReplaceSelf(func(n Node) {
-			n.Parent().(*FileNode).SetPackage(n)
-		})
+func (c *Cursor) Path() []PathStep {
+	ret := make([]PathStep, 0)
+	for cur := c; cur.Parent() != nil; cur = cur.Parent() {
+		ret = append(ret, PathStep{Field: cur.Field(), Index: cur.Index()})
 	}
-	if !n.Imports().IsDummy() {
-		imports := n.Imports()
-		imports.BuildLink()
-		imports.SetParent(n)
-		imports.SetSelfField("imports")
-		imports.SetReplaceSelf(func(n Node) {
-			n.Parent().(*FileNode).SetImports(n)
-		})
-	}
-	if !n.Declarations().IsDummy() {
-		declarations := n.Declarations()
-		declarations.BuildLink()
-		declarations.SetParent(n)
-		declarations.SetSelfField("declarations")
-		declarations.SetReplaceSelf(func(n Node) {
-			n.Parent().(*FileNode).SetDeclarations(n)
-		})
+	for i, j := 0, len(ret)-1; i < j; i, j = i+1, j-1 {
+		ret[i], ret[j] = ret[j], ret[i]
 	}
+	return ret
 }
 
-func (n *FileNode) Fields() []string {
-	return []string{
-		"package_",
-		"imports",
-		"declarations",
-	}
+func (c *Cursor) Depth() int {
+	return len(c.Path())
 }
 
-func (n *FileNode) Child(field string) Node {
-	if field == "" {
-		return nil
-	}
-	if field == "package_" {
-		return n.Package()
+func (c *Cursor) sibling(step int) *Cursor {
+	if l := c.list(); l != nil {
+		i := c.Index() + step
+		if i < 0 || i >= len(l.nodes) {
+			return nil
+		}
+		return &Cursor{node: l.nodes[i]}
 	}
-	if field == "imports" {
-		return n.Imports()
+	parent := c.node.Parent()
+	if parent == nil {
+		return nil
 	}
-	if field == "declarations" {
-		return n.Declarations()
+	fields := parent.Fields()
+	for i, field := range fields {
+		if field != c.node.SelfField() {
+			continue
+		}
+		for j := i + step; j >= 0 && j < len(fields); j += step {
+			if child := parent.Child(fields[j]); child != nil && !child.IsDummy() {
+				return &Cursor{node: child}
+			}
+		}
+		break
 	}
 	return nil
 }
 
-func (n *FileNode) SetChild(nodes []Node) {
-	if len(nodes) != 3 {
-		return
-	}
-	n.SetPackage(nodes[0])
-	n.SetImports(nodes[1])
-	n.SetDeclarations(nodes[2])
+func (c *Cursor) NextSibling() *Cursor {
+	return c.sibling(1)
 }
 
-func (n *FileNode) Fork() Node {
-	_ret := &FileNode{
-		BaseNode:     n.BaseNode.fork(),
-		package_:     n.package_.Fork(),
-		imports:      n.imports.Fork(),
-		declarations: n.declarations.Fork(),
-	}
-	_ret.package_.SetParent(_ret)
-	_ret.imports.SetParent(_ret)
-	_ret.declarations.SetParent(_ret)
-	return _ret
+func (c *Cursor) PrevSibling() *Cursor {
+	return c.sibling(-1)
 }
 
-func (n *FileNode) Visit(beforeChildren func(node Node) (visitChildren, exit bool), afterChildren func(node Node) (exit bool)) (exit bool) {
-	vc, e := beforeChildren(n)
-	if e {
-		return true
-	}
-	if !vc {
-		return false
-	}
-	if n.package_.Visit(beforeChildren, afterChildren) {
-		return true
-	}
-	if n.imports.Visit(beforeChildren, afterChildren) {
-		return true
-	}
-	if n.declarations.Visit(beforeChildren, afterChildren) {
-		return true
+func (c *Cursor) Replace(node Node) {
+	if node == nil {
+		node = DummyNode
 	}
-	if afterChildren(n) {
-		return true
+	if c.node.Parent() != nil {
+		if node.IsDummy() {
+			_ = c.Delete()
+		} else {
+			node.BuildLink()
+			c.node.ReplaceSelf(node)
+		}
 	}
-	return false
-}
-
-func (n *FileNode) Dump(hook func(Node, map[string]string) string) map[string]string {
-	ret := make(map[string]string)
-	ret["kind"] = "\"file\""
-	ret["package"] = DumpNode(n.Package(), hook)
-	ret["imports"] = DumpNode(n.Imports(), hook)
-	ret["declarations"] = DumpNode(n.Declarations(), hook)
-	return ret
+	c.node = node
 }
 
-func NewBasicLitNode(filePath string, fileContent []rune, value Node, start, end Position) Node {
-	if value == nil {
-		value = DummyNode
-	}
-	_1 := &BasicLitNode{
-		BaseNode: NewBaseNode(filePath, fileContent, NodeTypeBasicLit, start, end),
-		value:    value,
+func (c *Cursor) clearField() {
+	parent := c.node.Parent()
+	children := make([]Node, 0)
+	for _, field := range parent.Fields() {
+		child := parent.Child(field)
+		if field == c.node.SelfField() {
+			child = DummyNode
+		}
+		children = append(children, child)
 	}
-	creationHook(_1)
-	return _1
+	parent.SetChild(children)
 }
 
-type BasicLitNode struct {
-	*BaseNode
-	value Node
+func (c *Cursor) insert(node Node, offset int) error {
+	l := c.list()
+	if l == nil {
+		return fmt.Errorf("cannot insert next to %s: not in a list", c.node.Kind())
+	}
+	i := c.Index() + offset
+	node.BuildLink()
+	nodes := make([]Node, 0, len(l.nodes)+1)
+	nodes = append(nodes, l.nodes[:i]...)
+	nodes = append(nodes, node)
+	nodes = append(nodes, l.nodes[i:]...)
+	l.nodes = nodes
+	l.linkNodes()
+	return nil
 }
 
-func (n *BasicLitNode) Value() Node {
-	return n.value
+func (c *Cursor) InsertBefore(node Node) error {
+	return c.insert(node, 0)
 }
 
-func (n *BasicLitNode) SetValue(v Node) {
-	n.value = v
+func (c *Cursor) InsertAfter(node Node) error {
+	return c.insert(node, 1)
 }
 
-func (n *BasicLitNode) BuildLink() {
-	if !n.Value().IsDummy() {
-		value := n.Value()
-		value.BuildLink()
-		value.SetParent(n)
-		value.SetSelfField("value")
-		value.SetReplaceSelf(func(n Node) {
-			n.Parent().(*BasicLitNode).SetValue(n)
-		})
+func (c *Cursor) Delete() error {
+	if c.node.Parent() == nil {
+		return fmt.Errorf("cannot delete %s: no parent", c.node.Kind())
 	}
-}
-
-func (n *BasicLitNode) Fields() []string {
-	return []string{
-		"value",
+	if l := c.list(); l != nil {
+		i := c.Index()
+		l.nodes = append(l.nodes[:i:i], l.nodes[i+1:]...)
+		l.linkNodes()
+		return nil
 	}
+	c.clearField()
+	return nil
 }
 
-func (n *BasicLitNode) Child(field string) Node {
-	if field == "" {
-		return nil
+func childNodes(node Node) []Node {
+	if nodes := node.UnpackNodes(); nodes != nil {
+		return nodes
 	}
-	if field == "value" {
-		return n.Value()
+	ret := make([]Node, 0)
+	for _, field := range node.Fields() {
+		if child := node.Child(field); child != nil && !child.IsDummy() {
+			ret = append(ret, child)
+		}
 	}
-	return nil
+	return ret
 }
 
-func (n *BasicLitNode) SetChild(nodes []Node) {
-	if len(nodes) != 1 {
-		return
+// NodeAt returns the path from root to the innermost node at pos. lines is the
+// line index of the file, built once with NewLineIndex and shared by lookups; nil
+// builds one for this call.
+func NodeAt(root Node, lines *LineIndex, pos Position) []Node {
+	if lines == nil {
+		lines = NewLineIndex(root.FileContent())
 	}
-	n.SetValue(nodes[0])
+	return NodeAtOffset(root, lines.PositionToOffset(pos.LineIdx, pos.CharIdx))
 }
 
-func (n *BasicLitNode) Fork() Node {
-	_ret := &BasicLitNode{
-		BaseNode: n.BaseNode.fork(),
-		value:    n.value.Fork(),
+func NodeAtOffset(root Node, offset int) []Node {
+	ret := make([]Node, 0)
+	node := root
+	for node != nil && !node.IsDummy() {
+		start, end := node.Range()
+		if offset < start.Offset || offset >= end.Offset {
+			break
+		}
+		ret = append(ret, node)
+		var next Node
+		for _, child := range childNodes(node) {
+			if child.RangeStart().Offset > offset {
+				break
+			}
+			if offset < child.RangeEnd().Offset {
+				next = child
+				break
+			}
+		}
+		node = next
 	}
-	_ret.value.SetParent(_ret)
-	return _ret
+	return ret
 }
 
-func (n *BasicLitNode) Visit(beforeChildren func(node Node) (visitChildren, exit bool), afterChildren func(node Node) (exit bool)) (exit bool) {
-	vc, e := beforeChildren(n)
-	if e {
-		return true
+// NodesInRange returns the nodes inside [start, end], with lines as in NodeAt.
+func NodesInRange(root Node, lines *LineIndex, start, end Position) []Node {
+	if lines == nil {
+		lines = NewLineIndex(root.FileContent())
 	}
-	if !vc {
-		return false
+	return NodesInOffsetRange(root, lines.PositionToOffset(start.LineIdx, start.CharIdx), lines.PositionToOffset(end.LineIdx, end.CharIdx))
+}
+
+func NodesInOffsetRange(root Node, start, end int) []Node {
+	ret := make([]Node, 0)
+	var visit func(node Node)
+	visit = func(node Node) {
+		nodeStart, nodeEnd := node.Range()
+		if nodeEnd.Offset < start || nodeStart.Offset > end {
+			return
+		}
+		if nodeStart.Offset >= start && nodeEnd.Offset <= end {
+			ret = append(ret, node)
+		}
+		for _, child := range childNodes(node) {
+			if child.RangeStart().Offset > end {
+				break
+			}
+			visit(child)
+		}
 	}
-	if n.value.Visit(beforeChildren, afterChildren) {
-		return true
+	if root != nil && !root.IsDummy() {
+		visit(root)
 	}
-	if afterChildren(n) {
-		return true
+	return ret
+}
+
+type FieldInfo struct {
+	Name     string
+	Optional bool
+	List     bool
+	Token    bool
+}
+
+func NodeFieldInfos(kind string) []FieldInfo {
+	return nodeKindFieldInfos[kind]
+}
+
+func NodeFieldInfo(kind, field string) (FieldInfo, bool) {
+	for _, info := range nodeKindFieldInfos[kind] {
+		if info.Name == field {
+			return info, true
+		}
+	}
+	return FieldInfo{}, false
+}
+
+var nodeKinds = []string{
+	NodeTypeToken,
+	NodeTypeNodes,
+	NodeTypeAddOpExpr,
+	NodeTypeArgument,
+	NodeTypeArgumentDecl,
+	NodeTypeArrayType,
+	NodeTypeAssignStmt,
+	NodeTypeAugAssignStmt,
+	NodeTypeBasicLit,
+	NodeTypeBlockStmt,
+	NodeTypeChanType,
+	NodeTypeCompareExpr,
+	NodeTypeConstGroupDecl,
+	NodeTypeConstIdent,
+	NodeTypeConstOneDecl,
+	NodeTypeDecStmt,
+	NodeTypeDeferStmt,
+	NodeTypeEllipsis,
+	NodeTypeEllipsisArgument,
+	NodeTypeEllipsisParameter,
+	NodeTypeExprStmt,
+	NodeTypeFallthroughStmt,
+	NodeTypeField,
+	NodeTypeFieldList,
+	NodeTypeFunctionIdent,
+	NodeTypeFunctionLit,
+	NodeTypeGenericParameterDecl,
+	NodeTypeGenericParameterIdent,
+	NodeTypeGenericTypeConstraint,
+	NodeTypeGenericTypeInstantiation,
+	NodeTypeGenericUnderlyingTypeConstraint,
+	NodeTypeGenericUnionConstraint,
+	NodeTypeGoStmt,
+	NodeTypeGotoStmt,
+	NodeTypeIdent,
+	NodeTypeIfStmt,
+	NodeTypeImportDot,
+	NodeTypeImportGroupDecl,
+	NodeTypeImportIdent,
+	NodeTypeImportOneDecl,
+	NodeTypeImportPath,
+	NodeTypeIncStmt,
+	NodeTypeIndexExpr,
+	NodeTypeInterfaceType,
+	NodeTypeKeyValueExpr,
+	NodeTypeLabelIdent,
+	NodeTypeLabeledStmt,
+	NodeTypeLogicalAndExpr,
+	NodeTypeLogicalOrExpr,
+	NodeTypeMapType,
+	NodeTypeMethodIdent,
+	NodeTypeMulOpExpr,
+	NodeTypeNameParameter,
+	NodeTypeNameTypeParameter,
+	NodeTypeNewExpr,
+	NodeTypeNumberExpr,
+	NodeTypePackageDecl,
+	NodeTypePackageIdent,
+	NodeTypeParameterDecl,
+	NodeTypeParameterIdent,
+	NodeTypeParenExpr,
+	NodeTypeReceiverGenericTypeDecl,
+	NodeTypeReceiverGenericTypeIdent,
+	NodeTypeReceiverIdent,
+	NodeTypeReceiverTypeIdent,
+	NodeTypeResultGroupDecl,
+	NodeTypeResultIdent,
+	NodeTypeResultName,
+	NodeTypeResultNameType,
+	NodeTypeResultOneDecl,
+	NodeTypeResultTypesDecl,
+	NodeTypeSelectStmt,
+	NodeTypeSelectorExpr,
+	NodeTypeSendStmt,
+	NodeTypeShortVarDecl,
+	NodeTypeStarExpr,
+	NodeTypeStringExpr,
+	NodeTypeStructType,
+	NodeTypeTypeArgumentDecl,
+	NodeTypeTypeAssertExpr,
+	NodeTypeTypeDecl,
+	NodeTypeTypeIdent,
+	NodeTypeTypeSwitchGuardIdent,
+	NodeTypeUnaryExpr,
+	NodeTypeVarDecl,
+	NodeTypeVarIdent,
+	NodeTypeBreakStmt,
+	NodeTypeCallExpr,
+	NodeTypeCompositeLit,
+	NodeTypeConstNameTypeValue,
+	NodeTypeContinueStmt,
+	NodeTypeDefaultClause,
+	NodeTypeExprCaseClause,
+	NodeTypeFile,
+	NodeTypeForAssignRangeStmt,
+	NodeTypeForDeclRangeStmt,
+	NodeTypeForStmt,
+	NodeTypeFullSliceExpr,
+	NodeTypeFunctionDecl,
+	NodeTypeFunctionType,
+	NodeTypeGenericParameter,
+	NodeTypeImportNamePath,
+	NodeTypeMakeChanExpr,
+	NodeTypeMakeMapExpr,
+	NodeTypeMakeSliceExpr,
+	NodeTypeMethodDecl,
+	NodeTypeReceiverDecl,
+	NodeTypeReceiverType,
+	NodeTypeReturnStmt,
+	NodeTypeSelectCaseClause,
+	NodeTypeSliceExpr,
+	NodeTypeStarReceiverType,
+	NodeTypeSwitchStmt,
+	NodeTypeTypeCaseClause,
+	NodeTypeTypeEqSpec,
+	NodeTypeTypeSpec,
+	NodeTypeTypeSwitchGuard,
+	NodeTypeTypeSwitchStmt,
+	NodeTypeVarSpec,
+}
+
+const nodeSchemaHash uint64 = 0x81a301308f7db85d
+
+var nodeKindFields = map[string][]string{
+	NodeTypeToken: nil,
+	NodeTypeNodes: nil,
+	NodeTypeAddOpExpr: {"lhs", "op", "rhs"},
+	NodeTypeArgument: {"expr"},
+	NodeTypeArgumentDecl: {"arguments"},
+	NodeTypeArrayType: {"e", "x"},
+	NodeTypeAssignStmt: {"l", "r"},
+	NodeTypeAugAssignStmt: {"l", "op", "r"},
+	NodeTypeBasicLit: {"x"},
+	NodeTypeBlockStmt: {"x"},
+	NodeTypeChanType: {"t", "x"},
+	NodeTypeCompareExpr: {"lhs", "op", "rhs"},
+	NodeTypeConstGroupDecl: {"constants"},
+	NodeTypeConstIdent: {"ident"},
+	NodeTypeConstOneDecl: {"constant"},
+	NodeTypeDecStmt: {"x"},
+	NodeTypeDeferStmt: {"x"},
+	NodeTypeEllipsis: {},
+	NodeTypeEllipsisArgument: {"expr"},
+	NodeTypeEllipsisParameter: {"name", "type_"},
+	NodeTypeExprStmt: {"x"},
+	NodeTypeFallthroughStmt: {},
+	NodeTypeField: {"names", "type_", "tag"},
+	NodeTypeFieldList: {"x"},
+	NodeTypeFunctionIdent: {"ident"},
+	NodeTypeFunctionLit: {"x", "y"},
+	NodeTypeGenericParameterDecl: {"parameters"},
+	NodeTypeGenericParameterIdent: {"ident"},
+	NodeTypeGenericTypeConstraint: {"type_"},
+	NodeTypeGenericTypeInstantiation: {"x", "y"},
+	NodeTypeGenericUnderlyingTypeConstraint: {"type_"},
+	NodeTypeGenericUnionConstraint: {"types"},
+	NodeTypeGoStmt: {"x"},
+	NodeTypeGotoStmt: {"x"},
+	NodeTypeIdent: {"i"},
+	NodeTypeIfStmt: {"init", "cond", "body", "else_"},
+	NodeTypeImportDot: {},
+	NodeTypeImportGroupDecl: {"targets"},
+	NodeTypeImportIdent: {"ident"},
+	NodeTypeImportOneDecl: {"target"},
+	NodeTypeImportPath: {"path"},
+	NodeTypeIncStmt: {"x"},
+	NodeTypeIndexExpr: {"target", "index"},
+	NodeTypeInterfaceType: {"b"},
+	NodeTypeKeyValueExpr: {"x", "y"},
+	NodeTypeLabelIdent: {"i"},
+	NodeTypeLabeledStmt: {"x", "b"},
+	NodeTypeLogicalAndExpr: {"lhs", "rhs"},
+	NodeTypeLogicalOrExpr: {"lhs", "rhs"},
+	NodeTypeMapType: {"x", "y"},
+	NodeTypeMethodIdent: {"ident"},
+	NodeTypeMulOpExpr: {"lhs", "op", "rhs"},
+	NodeTypeNameParameter: {"name"},
+	NodeTypeNameTypeParameter: {"name", "type_"},
+	NodeTypeNewExpr: {"type_"},
+	NodeTypeNumberExpr: {"number"},
+	NodeTypePackageDecl: {"ident"},
+	NodeTypePackageIdent: {"ident"},
+	NodeTypeParameterDecl: {"parameters"},
+	NodeTypeParameterIdent: {"ident"},
+	NodeTypeParenExpr: {"x"},
+	NodeTypeReceiverGenericTypeDecl: {"types"},
+	NodeTypeReceiverGenericTypeIdent: {"ident"},
+	NodeTypeReceiverIdent: {"ident"},
+	NodeTypeReceiverTypeIdent: {"ident"},
+	NodeTypeResultGroupDecl: {"results"},
+	NodeTypeResultIdent: {"ident"},
+	NodeTypeResultName: {"name"},
+	NodeTypeResultNameType: {"name", "type_"},
+	NodeTypeResultOneDecl: {"type_"},
+	NodeTypeResultTypesDecl: {"types"},
+	NodeTypeSelectStmt: {"s"},
+	NodeTypeSelectorExpr: {"target", "selector"},
+	NodeTypeSendStmt: {"x", "y"},
+	NodeTypeShortVarDecl: {"l", "r"},
+	NodeTypeStarExpr: {"x"},
+	NodeTypeStringExpr: {"string_"},
+	NodeTypeStructType: {"b"},
+	NodeTypeTypeArgumentDecl: {"types"},
+	NodeTypeTypeAssertExpr: {"expr", "type_"},
+	NodeTypeTypeDecl: {"x"},
+	NodeTypeTypeIdent: {"n"},
+	NodeTypeTypeSwitchGuardIdent: {"i"},
+	NodeTypeUnaryExpr: {"op", "expr"},
+	NodeTypeVarDecl: {"x"},
+	NodeTypeVarIdent: {"n"},
+	NodeTypeBreakStmt: {"x"},
+	NodeTypeCallExpr: {"callee", "type_argument", "argument"},
+	NodeTypeCompositeLit: {"x", "y"},
+	NodeTypeConstNameTypeValue: {"names", "type_", "values"},
+	NodeTypeContinueStmt: {"x"},
+	NodeTypeDefaultClause: {"x"},
+	NodeTypeExprCaseClause: {"x", "y"},
+	NodeTypeFile: {"package_", "imports", "decls"},
+	NodeTypeForAssignRangeStmt: {"k", "v", "x", "b"},
+	NodeTypeForDeclRangeStmt: {"k", "v", "x", "b"},
+	NodeTypeForStmt: {"i", "c", "u", "b"},
+	NodeTypeFullSliceExpr: {"target", "low", "high", "max_"},
+	NodeTypeFunctionDecl: {"name", "generic_parameter", "parameter", "result", "body"},
+	NodeTypeFunctionType: {"parameter", "result"},
+	NodeTypeGenericParameter: {"ident", "constraint"},
+	NodeTypeImportNamePath: {"name", "path"},
+	NodeTypeMakeChanExpr: {"type_", "buffer"},
+	NodeTypeMakeMapExpr: {"k", "v", "hint"},
+	NodeTypeMakeSliceExpr: {"type_", "len_", "cap"},
+	NodeTypeMethodDecl: {"receiver", "name", "parameter", "result", "body"},
+	NodeTypeReceiverDecl: {"name", "type_"},
+	NodeTypeReceiverType: {"type_", "generic_type"},
+	NodeTypeReturnStmt: {"x"},
+	NodeTypeSelectCaseClause: {"x", "y"},
+	NodeTypeSliceExpr: {"target", "low", "high"},
+	NodeTypeStarReceiverType: {"type_", "generic_type"},
+	NodeTypeSwitchStmt: {"init", "tag", "s"},
+	NodeTypeTypeCaseClause: {"x", "y"},
+	NodeTypeTypeEqSpec: {"x", "t", "y"},
+	NodeTypeTypeSpec: {"x", "t", "y"},
+	NodeTypeTypeSwitchGuard: {"i", "r"},
+	NodeTypeTypeSwitchStmt: {"init", "assign", "s"},
+	NodeTypeVarSpec: {"i", "t", "e"},
+}
+
+var nodeKindFieldInfos = map[string][]FieldInfo{
+	NodeTypeToken: nil,
+	NodeTypeNodes: nil,
+	NodeTypeAddOpExpr: {{Name: "lhs"}, {Name: "op"}, {Name: "rhs"}},
+	NodeTypeArgument: {{Name: "expr"}},
+	NodeTypeArgumentDecl: {{Name: "arguments"}},
+	NodeTypeArrayType: {{Name: "e", Optional: true}, {Name: "x"}},
+	NodeTypeAssignStmt: {{Name: "l"}, {Name: "r"}},
+	NodeTypeAugAssignStmt: {{Name: "l"}, {Name: "op"}, {Name: "r"}},
+	NodeTypeBasicLit: {{Name: "x"}},
+	NodeTypeBlockStmt: {{Name: "x", Optional: true}},
+	NodeTypeChanType: {{Name: "t"}, {Name: "x"}},
+	NodeTypeCompareExpr: {{Name: "lhs"}, {Name: "op"}, {Name: "rhs"}},
+	NodeTypeConstGroupDecl: {{Name: "constants"}},
+	NodeTypeConstIdent: {{Name: "ident"}},
+	NodeTypeConstOneDecl: {{Name: "constant"}},
+	NodeTypeDecStmt: {{Name: "x"}},
+	NodeTypeDeferStmt: {{Name: "x"}},
+	NodeTypeEllipsis: {},
+	NodeTypeEllipsisArgument: {{Name: "expr"}},
+	NodeTypeEllipsisParameter: {{Name: "name"}, {Name: "type_"}},
+	NodeTypeExprStmt: {{Name: "x"}},
+	NodeTypeFallthroughStmt: {},
+	NodeTypeField: {{Name: "names", Optional: true}, {Name: "type_", Optional: true}, {Name: "tag", Optional: true}},
+	NodeTypeFieldList: {{Name: "x"}},
+	NodeTypeFunctionIdent: {{Name: "ident"}},
+	NodeTypeFunctionLit: {{Name: "x"}, {Name: "y"}},
+	NodeTypeGenericParameterDecl: {{Name: "parameters"}},
+	NodeTypeGenericParameterIdent: {{Name: "ident"}},
+	NodeTypeGenericTypeConstraint: {{Name: "type_"}},
+	NodeTypeGenericTypeInstantiation: {{Name: "x"}, {Name: "y"}},
+	NodeTypeGenericUnderlyingTypeConstraint: {{Name: "type_"}},
+	NodeTypeGenericUnionConstraint: {{Name: "types"}},
+	NodeTypeGoStmt: {{Name: "x"}},
+	NodeTypeGotoStmt: {{Name: "x"}},
+	NodeTypeIdent: {{Name: "i"}},
+	NodeTypeIfStmt: {{Name: "init", Optional: true}, {Name: "cond"}, {Name: "body"}, {Name: "else_", Optional: true}},
+	NodeTypeImportDot: {},
+	NodeTypeImportGroupDecl: {{Name: "targets"}},
+	NodeTypeImportIdent: {{Name: "ident"}},
+	NodeTypeImportOneDecl: {{Name: "target"}},
+	NodeTypeImportPath: {{Name: "path"}},
+	NodeTypeIncStmt: {{Name: "x"}},
+	NodeTypeIndexExpr: {{Name: "target"}, {Name: "index"}},
+	NodeTypeInterfaceType: {{Name: "b"}},
+	NodeTypeKeyValueExpr: {{Name: "x"}, {Name: "y"}},
+	NodeTypeLabelIdent: {{Name: "i"}},
+	NodeTypeLabeledStmt: {{Name: "x"}, {Name: "b", Optional: true}},
+	NodeTypeLogicalAndExpr: {{Name: "lhs"}, {Name: "rhs"}},
+	NodeTypeLogicalOrExpr: {{Name: "lhs"}, {Name: "rhs"}},
+	NodeTypeMapType: {{Name: "x"}, {Name: "y"}},
+	NodeTypeMethodIdent: {{Name: "ident"}},
+	NodeTypeMulOpExpr: {{Name: "lhs"}, {Name: "op"}, {Name: "rhs"}},
+	NodeTypeNameParameter: {{Name: "name"}},
+	NodeTypeNameTypeParameter: {{Name: "name"}, {Name: "type_"}},
+	NodeTypeNewExpr: {{Name: "type_"}},
+	NodeTypeNumberExpr: {{Name: "number"}},
+	NodeTypePackageDecl: {{Name: "ident"}},
+	NodeTypePackageIdent: {{Name: "ident"}},
+	NodeTypeParameterDecl: {{Name: "parameters"}},
+	NodeTypeParameterIdent: {{Name: "ident"}},
+	NodeTypeParenExpr: {{Name: "x"}},
+	NodeTypeReceiverGenericTypeDecl: {{Name: "types"}},
+	NodeTypeReceiverGenericTypeIdent: {{Name: "ident"}},
+	NodeTypeReceiverIdent: {{Name: "ident"}},
+	NodeTypeReceiverTypeIdent: {{Name: "ident"}},
+	NodeTypeResultGroupDecl: {{Name: "results"}},
+	NodeTypeResultIdent: {{Name: "ident"}},
+	NodeTypeResultName: {{Name: "name"}},
+	NodeTypeResultNameType: {{Name: "name"}, {Name: "type_"}},
+	NodeTypeResultOneDecl: {{Name: "type_"}},
+	NodeTypeResultTypesDecl: {{Name: "types"}},
+	NodeTypeSelectStmt: {{Name: "s"}},
+	NodeTypeSelectorExpr: {{Name: "target"}, {Name: "selector"}},
+	NodeTypeSendStmt: {{Name: "x"}, {Name: "y"}},
+	NodeTypeShortVarDecl: {{Name: "l"}, {Name: "r"}},
+	NodeTypeStarExpr: {{Name: "x"}},
+	NodeTypeStringExpr: {{Name: "string_"}},
+	NodeTypeStructType: {{Name: "b"}},
+	NodeTypeTypeArgumentDecl: {{Name: "types"}},
+	NodeTypeTypeAssertExpr: {{Name: "expr"}, {Name: "type_"}},
+	NodeTypeTypeDecl: {{Name: "x"}},
+	NodeTypeTypeIdent: {{Name: "n"}},
+	NodeTypeTypeSwitchGuardIdent: {{Name: "i"}},
+	NodeTypeUnaryExpr: {{Name: "op"}, {Name: "expr"}},
+	NodeTypeVarDecl: {{Name: "x"}},
+	NodeTypeVarIdent: {{Name: "n"}},
+	NodeTypeBreakStmt: {{Name: "x", Optional: true}},
+	NodeTypeCallExpr: {{Name: "callee"}, {Name: "type_argument", Optional: true}, {Name: "argument"}},
+	NodeTypeCompositeLit: {{Name: "x", Optional: true}, {Name: "y"}},
+	NodeTypeConstNameTypeValue: {{Name: "names"}, {Name: "type_", Optional: true}, {Name: "values", Optional: true}},
+	NodeTypeContinueStmt: {{Name: "x", Optional: true}},
+	NodeTypeDefaultClause: {{Name: "x", Optional: true}},
+	NodeTypeExprCaseClause: {{Name: "x"}, {Name: "y", Optional: true}},
+	NodeTypeFile: {{Name: "package_"}, {Name: "imports", List: true}, {Name: "decls", List: true}},
+	NodeTypeForAssignRangeStmt: {{Name: "k", Optional: true}, {Name: "v", Optional: true}, {Name: "x"}, {Name: "b"}},
+	NodeTypeForDeclRangeStmt: {{Name: "k", Optional: true}, {Name: "v", Optional: true}, {Name: "x"}, {Name: "b"}},
+	NodeTypeForStmt: {{Name: "i", Optional: true}, {Name: "c", Optional: true}, {Name: "u", Optional: true}, {Name: "b"}},
+	NodeTypeFullSliceExpr: {{Name: "target"}, {Name: "low", Optional: true}, {Name: "high", Optional: true}, {Name: "max_"}},
+	NodeTypeFunctionDecl: {{Name: "name"}, {Name: "generic_parameter", Optional: true}, {Name: "parameter"}, {Name: "result", Optional: true}, {Name: "body", Optional: true}},
+	NodeTypeFunctionType: {{Name: "parameter"}, {Name: "result", Optional: true}},
+	NodeTypeGenericParameter: {{Name: "ident"}, {Name: "constraint", Optional: true}},
+	NodeTypeImportNamePath: {{Name: "name", Optional: true}, {Name: "path"}},
+	NodeTypeMakeChanExpr: {{Name: "type_"}, {Name: "buffer", Optional: true}},
+	NodeTypeMakeMapExpr: {{Name: "k"}, {Name: "v"}, {Name: "hint", Optional: true}},
+	NodeTypeMakeSliceExpr: {{Name: "type_"}, {Name: "len_"}, {Name: "cap", Optional: true}},
+	NodeTypeMethodDecl: {{Name: "receiver"}, {Name: "name"}, {Name: "parameter"}, {Name: "result", Optional: true}, {Name: "body", Optional: true}},
+	NodeTypeReceiverDecl: {{Name: "name", Optional: true}, {Name: "type_"}},
+	NodeTypeReceiverType: {{Name: "type_"}, {Name: "generic_type", Optional: true}},
+	NodeTypeReturnStmt: {{Name: "x", Optional: true}},
+	NodeTypeSelectCaseClause: {{Name: "x"}, {Name: "y", Optional: true}},
+	NodeTypeSliceExpr: {{Name: "target"}, {Name: "low", Optional: true}, {Name: "high", Optional: true}},
+	NodeTypeStarReceiverType: {{Name: "type_"}, {Name: "generic_type", Optional: true}},
+	NodeTypeSwitchStmt: {{Name: "init", Optional: true}, {Name: "tag", Optional: true}, {Name: "s"}},
+	NodeTypeTypeCaseClause: {{Name: "x"}, {Name: "y", Optional: true}},
+	NodeTypeTypeEqSpec: {{Name: "x"}, {Name: "t", Optional: true}, {Name: "y"}},
+	NodeTypeTypeSpec: {{Name: "x"}, {Name: "t", Optional: true}, {Name: "y"}},
+	NodeTypeTypeSwitchGuard: {{Name: "i", Optional: true}, {Name: "r"}},
+	NodeTypeTypeSwitchStmt: {{Name: "init", Optional: true}, {Name: "assign"}, {Name: "s"}},
+	NodeTypeVarSpec: {{Name: "i"}, {Name: "t", Optional: true}, {Name: "e", Optional: true}},
+}
+
+func newNodeOfKind(kind, filePath string, fileContent []rune, children []Node, start, end Position) Node {
+	switch kind {
+	case NodeTypeAddOpExpr:
+		return NewAddOpExprNode(filePath, fileContent, children[0], children[1], children[2], start, end)
+	case NodeTypeArgument:
+		return NewArgumentNode(filePath, fileContent, children[0], start, end)
+	case NodeTypeArgumentDecl:
+		return NewArgumentDeclNode(filePath, fileContent, children[0], start, end)
+	case NodeTypeArrayType:
+		return NewArrayTypeNode(filePath, fileContent, children[0], children[1], start, end)
+	case NodeTypeAssignStmt:
+		return NewAssignStmtNode(filePath, fileContent, children[0], children[1], start, end)
+	case NodeTypeAugAssignStmt:
+		return NewAugAssignStmtNode(filePath, fileContent, children[0], children[1], children[2], start, end)
+	case NodeTypeBasicLit:
+		return NewBasicLitNode(filePath, fileContent, children[0], start, end)
+	case NodeTypeBlockStmt:
+		return NewBlockStmtNode(filePath, fileContent, children[0], start, end)
+	case NodeTypeChanType:
+		return NewChanTypeNode(filePath, fileContent, children[0], children[1], start, end)
+	case NodeTypeCompareExpr:
+		return NewCompareExprNode(filePath, fileContent, children[0], children[1], children[2], start, end)
+	case NodeTypeConstGroupDecl:
+		return NewConstGroupDeclNode(filePath, fileContent, children[0], start, end)
+	case NodeTypeConstIdent:
+		return NewConstIdentNode(filePath, fileContent, children[0], start, end)
+	case NodeTypeConstOneDecl:
+		return NewConstOneDeclNode(filePath, fileContent, children[0], start, end)
+	case NodeTypeDecStmt:
+		return NewDecStmtNode(filePath, fileContent, children[0], start, end)
+	case NodeTypeDeferStmt:
+		return NewDeferStmtNode(filePath, fileContent, children[0], start, end)
+	case NodeTypeEllipsis:
+		return NewEllipsisNode(filePath, fileContent, start, end)
+	case NodeTypeEllipsisArgument:
+		return NewEllipsisArgumentNode(filePath, fileContent, children[0], start, end)
+	case NodeTypeEllipsisParameter:
+		return NewEllipsisParameterNode(filePath, fileContent, children[0], children[1], start, end)
+	case NodeTypeExprStmt:
+		return NewExprStmtNode(filePath, fileContent, children[0], start, end)
+	case NodeTypeFallthroughStmt:
+		return NewFallthroughStmtNode(filePath, fileContent, start, end)
+	case NodeTypeField:
+		return NewFieldNode(filePath, fileContent, children[0], children[1], children[2], start, end)
+	case NodeTypeFieldList:
+		return NewFieldListNode(filePath, fileContent, children[0], start, end)
+	case NodeTypeFunctionIdent:
+		return NewFunctionIdentNode(filePath, fileContent, children[0], start, end)
+	case NodeTypeFunctionLit:
+		return NewFunctionLitNode(filePath, fileContent, children[0], children[1], start, end)
+	case NodeTypeGenericParameterDecl:
+		return NewGenericParameterDeclNode(filePath, fileContent, children[0], start, end)
+	case NodeTypeGenericParameterIdent:
+		return NewGenericParameterIdentNode(filePath, fileContent, children[0], start, end)
+	case NodeTypeGenericTypeConstraint:
+		return NewGenericTypeConstraintNode(filePath, fileContent, children[0], start, end)
+	case NodeTypeGenericTypeInstantiation:
+		return NewGenericTypeInstantiationNode(filePath, fileContent, children[0], children[1], start, end)
+	case NodeTypeGenericUnderlyingTypeConstraint:
+		return NewGenericUnderlyingTypeConstraintNode(filePath, fileContent, children[0], start, end)
+	case NodeTypeGenericUnionConstraint:
+		return NewGenericUnionConstraintNode(filePath, fileContent, children[0], start, end)
+	case NodeTypeGoStmt:
+		return NewGoStmtNode(filePath, fileContent, children[0], start, end)
+	case NodeTypeGotoStmt:
+		return NewGotoStmtNode(filePath, fileContent, children[0], start, end)
+	case NodeTypeIdent:
+		return NewIdentNode(filePath, fileContent, children[0], start, end)
+	case NodeTypeIfStmt:
+		return NewIfStmtNode(filePath, fileContent, children[0], children[1], children[2], children[3], start, end)
+	case NodeTypeImportDot:
+		return NewImportDotNode(filePath, fileContent, start, end)
+	case NodeTypeImportGroupDecl:
+		return NewImportGroupDeclNode(filePath, fileContent, children[0], start, end)
+	case NodeTypeImportIdent:
+		return NewImportIdentNode(filePath, fileContent, children[0], start, end)
+	case NodeTypeImportOneDecl:
+		return NewImportOneDeclNode(filePath, fileContent, children[0], start, end)
+	case NodeTypeImportPath:
+		return NewImportPathNode(filePath, fileContent, children[0], start, end)
+	case NodeTypeIncStmt:
+		return NewIncStmtNode(filePath, fileContent, children[0], start, end)
+	case NodeTypeIndexExpr:
+		return NewIndexExprNode(filePath, fileContent, children[0], children[1], start, end)
+	case NodeTypeInterfaceType:
+		return NewInterfaceTypeNode(filePath, fileContent, children[0], start, end)
+	case NodeTypeKeyValueExpr:
+		return NewKeyValueExprNode(filePath, fileContent, children[0], children[1], start, end)
+	case NodeTypeLabelIdent:
+		return NewLabelIdentNode(filePath, fileContent, children[0], start, end)
+	case NodeTypeLabeledStmt:
+		return NewLabeledStmtNode(filePath, fileContent, children[0], children[1], start, end)
+	case NodeTypeLogicalAndExpr:
+		return NewLogicalAndExprNode(filePath, fileContent, children[0], children[1], start, end)
+	case NodeTypeLogicalOrExpr:
+		return NewLogicalOrExprNode(filePath, fileContent, children[0], children[1], start, end)
+	case NodeTypeMapType:
+		return NewMapTypeNode(filePath, fileContent, children[0], children[1], start, end)
+	case NodeTypeMethodIdent:
+		return NewMethodIdentNode(filePath, fileContent, children[0], start, end)
+	case NodeTypeMulOpExpr:
+		return NewMulOpExprNode(filePath, fileContent, children[0], children[1], children[2], start, end)
+	case NodeTypeNameParameter:
+		return NewNameParameterNode(filePath, fileContent, children[0], start, end)
+	case NodeTypeNameTypeParameter:
+		return NewNameTypeParameterNode(filePath, fileContent, children[0], children[1], start, end)
+	case NodeTypeNewExpr:
+		return NewNewExprNode(filePath, fileContent, children[0], start, end)
+	case NodeTypeNumberExpr:
+		return NewNumberExprNode(filePath, fileContent, children[0], start, end)
+	case NodeTypePackageDecl:
+		return NewPackageDeclNode(filePath, fileContent, children[0], start, end)
+	case NodeTypePackageIdent:
+		return NewPackageIdentNode(filePath, fileContent, children[0], start, end)
+	case NodeTypeParameterDecl:
+		return NewParameterDeclNode(filePath, fileContent, children[0], start, end)
+	case NodeTypeParameterIdent:
+		return NewParameterIdentNode(filePath, fileContent, children[0], start, end)
+	case NodeTypeParenExpr:
+		return NewParenExprNode(filePath, fileContent, children[0], start, end)
+	case NodeTypeReceiverGenericTypeDecl:
+		return NewReceiverGenericTypeDeclNode(filePath, fileContent, children[0], start, end)
+	case NodeTypeReceiverGenericTypeIdent:
+		return NewReceiverGenericTypeIdentNode(filePath, fileContent, children[0], start, end)
+	case NodeTypeReceiverIdent:
+		return NewReceiverIdentNode(filePath, fileContent, children[0], start, end)
+	case NodeTypeReceiverTypeIdent:
+		return NewReceiverTypeIdentNode(filePath, fileContent, children[0], start, end)
+	case NodeTypeResultGroupDecl:
+		return NewResultGroupDeclNode(filePath, fileContent, children[0], start, end)
+	case NodeTypeResultIdent:
+		return NewResultIdentNode(filePath, fileContent, children[0], start, end)
+	case NodeTypeResultName:
+		return NewResultNameNode(filePath, fileContent, children[0], start, end)
+	case NodeTypeResultNameType:
+		return NewResultNameTypeNode(filePath, fileContent, children[0], children[1], start, end)
+	case NodeTypeResultOneDecl:
+		return NewResultOneDeclNode(filePath, fileContent, children[0], start, end)
+	case NodeTypeResultTypesDecl:
+		return NewResultTypesDeclNode(filePath, fileContent, children[0], start, end)
+	case NodeTypeSelectStmt:
+		return NewSelectStmtNode(filePath, fileContent, children[0], start, end)
+	case NodeTypeSelectorExpr:
+		return NewSelectorExprNode(filePath, fileContent, children[0], children[1], start, end)
+	case NodeTypeSendStmt:
+		return NewSendStmtNode(filePath, fileContent, children[0], children[1], start, end)
+	case NodeTypeShortVarDecl:
+		return NewShortVarDeclNode(filePath, fileContent, children[0], children[1], start, end)
+	case NodeTypeStarExpr:
+		return NewStarExprNode(filePath, fileContent, children[0], start, end)
+	case NodeTypeStringExpr:
+		return NewStringExprNode(filePath, fileContent, children[0], start, end)
+	case NodeTypeStructType:
+		return NewStructTypeNode(filePath, fileContent, children[0], start, end)
+	case NodeTypeTypeArgumentDecl:
+		return NewTypeArgumentDeclNode(filePath, fileContent, children[0], start, end)
+	case NodeTypeTypeAssertExpr:
+		return NewTypeAssertExprNode(filePath, fileContent, children[0], children[1], start, end)
+	case NodeTypeTypeDecl:
+		return NewTypeDeclNode(filePath, fileContent, children[0], start, end)
+	case NodeTypeTypeIdent:
+		return NewTypeIdentNode(filePath, fileContent, children[0], start, end)
+	case NodeTypeTypeSwitchGuardIdent:
+		return NewTypeSwitchGuardIdentNode(filePath, fileContent, children[0], start, end)
+	case NodeTypeUnaryExpr:
+		return NewUnaryExprNode(filePath, fileContent, children[0], children[1], start, end)
+	case NodeTypeVarDecl:
+		return NewVarDeclNode(filePath, fileContent, children[0], start, end)
+	case NodeTypeVarIdent:
+		return NewVarIdentNode(filePath, fileContent, children[0], start, end)
+	case NodeTypeBreakStmt:
+		return NewBreakStmtNode(filePath, fileContent, children[0], start, end)
+	case NodeTypeCallExpr:
+		return NewCallExprNode(filePath, fileContent, children[0], children[1], children[2], start, end)
+	case NodeTypeCompositeLit:
+		return NewCompositeLitNode(filePath, fileContent, children[0], children[1], start, end)
+	case NodeTypeConstNameTypeValue:
+		return NewConstNameTypeValueNode(filePath, fileContent, children[0], children[1], children[2], start, end)
+	case NodeTypeContinueStmt:
+		return NewContinueStmtNode(filePath, fileContent, children[0], start, end)
+	case NodeTypeDefaultClause:
+		return NewDefaultClauseNode(filePath, fileContent, children[0], start, end)
+	case NodeTypeExprCaseClause:
+		return NewExprCaseClauseNode(filePath, fileContent, children[0], children[1], start, end)
+	case NodeTypeFile:
+		return NewFileNode(filePath, fileContent, children[0], children[1], children[2], start, end)
+	case NodeTypeForAssignRangeStmt:
+		return NewForAssignRangeStmtNode(filePath, fileContent, children[0], children[1], children[2], children[3], start, end)
+	case NodeTypeForDeclRangeStmt:
+		return NewForDeclRangeStmtNode(filePath, fileContent, children[0], children[1], children[2], children[3], start, end)
+	case NodeTypeForStmt:
+		return NewForStmtNode(filePath, fileContent, children[0], children[1], children[2], children[3], start, end)
+	case NodeTypeFullSliceExpr:
+		return NewFullSliceExprNode(filePath, fileContent, children[0], children[1], children[2], children[3], start, end)
+	case NodeTypeFunctionDecl:
+		return NewFunctionDeclNode(filePath, fileContent, children[0], children[1], children[2], children[3], children[4], start, end)
+	case NodeTypeFunctionType:
+		return NewFunctionTypeNode(filePath, fileContent, children[0], children[1], start, end)
+	case NodeTypeGenericParameter:
+		return NewGenericParameterNode(filePath, fileContent, children[0], children[1], start, end)
+	case NodeTypeImportNamePath:
+		return NewImportNamePathNode(filePath, fileContent, children[0], children[1], start, end)
+	case NodeTypeMakeChanExpr:
+		return NewMakeChanExprNode(filePath, fileContent, children[0], children[1], start, end)
+	case NodeTypeMakeMapExpr:
+		return NewMakeMapExprNode(filePath, fileContent, children[0], children[1], children[2], start, end)
+	case NodeTypeMakeSliceExpr:
+		return NewMakeSliceExprNode(filePath, fileContent, children[0], children[1], children[2], start, end)
+	case NodeTypeMethodDecl:
+		return NewMethodDeclNode(filePath, fileContent, children[0], children[1], children[2], children[3], children[4], start, end)
+	case NodeTypeReceiverDecl:
+		return NewReceiverDeclNode(filePath, fileContent, children[0], children[1], start, end)
+	case NodeTypeReceiverType:
+		return NewReceiverTypeNode(filePath, fileContent, children[0], children[1], start, end)
+	case NodeTypeReturnStmt:
+		return NewReturnStmtNode(filePath, fileContent, children[0], start, end)
+	case NodeTypeSelectCaseClause:
+		return NewSelectCaseClauseNode(filePath, fileContent, children[0], children[1], start, end)
+	case NodeTypeSliceExpr:
+		return NewSliceExprNode(filePath, fileContent, children[0], children[1], children[2], start, end)
+	case NodeTypeStarReceiverType:
+		return NewStarReceiverTypeNode(filePath, fileContent, children[0], children[1], start, end)
+	case NodeTypeSwitchStmt:
+		return NewSwitchStmtNode(filePath, fileContent, children[0], children[1], children[2], start, end)
+	case NodeTypeTypeCaseClause:
+		return NewTypeCaseClauseNode(filePath, fileContent, children[0], children[1], start, end)
+	case NodeTypeTypeEqSpec:
+		return NewTypeEqSpecNode(filePath, fileContent, children[0], children[1], children[2], start, end)
+	case NodeTypeTypeSpec:
+		return NewTypeSpecNode(filePath, fileContent, children[0], children[1], children[2], start, end)
+	case NodeTypeTypeSwitchGuard:
+		return NewTypeSwitchGuardNode(filePath, fileContent, children[0], children[1], start, end)
+	case NodeTypeTypeSwitchStmt:
+		return NewTypeSwitchStmtNode(filePath, fileContent, children[0], children[1], children[2], start, end)
+	case NodeTypeVarSpec:
+		return NewVarSpecNode(filePath, fileContent, children[0], children[1], children[2], start, end)
 	}
-	return false
+	return nil
 }
 
-func (n *BasicLitNode) Dump(hook func(Node, map[string]string) string) map[string]string {
-	ret := make(map[string]string)
-	ret["kind"] = "\"basic_lit\""
-	ret["value"] = DumpNode(n.Value(), hook)
-	return ret
+type Visitor interface {
+	VisitTokenNode(n *TokenNode) (visitChildren bool)
+	VisitNodesNode(n *NodesNode) (visitChildren bool)
+	VisitAddOpExprNode(n *AddOpExprNode) (visitChildren bool)
+	VisitArgumentNode(n *ArgumentNode) (visitChildren bool)
+	VisitArgumentDeclNode(n *ArgumentDeclNode) (visitChildren bool)
+	VisitArrayTypeNode(n *ArrayTypeNode) (visitChildren bool)
+	VisitAssignStmtNode(n *AssignStmtNode) (visitChildren bool)
+	VisitAugAssignStmtNode(n *AugAssignStmtNode) (visitChildren bool)
+	VisitBasicLitNode(n *BasicLitNode) (visitChildren bool)
+	VisitBlockStmtNode(n *BlockStmtNode) (visitChildren bool)
+	VisitChanTypeNode(n *ChanTypeNode) (visitChildren bool)
+	VisitCompareExprNode(n *CompareExprNode) (visitChildren bool)
+	VisitConstGroupDeclNode(n *ConstGroupDeclNode) (visitChildren bool)
+	VisitConstIdentNode(n *ConstIdentNode) (visitChildren bool)
+	VisitConstOneDeclNode(n *ConstOneDeclNode) (visitChildren bool)
+	VisitDecStmtNode(n *DecStmtNode) (visitChildren bool)
+	VisitDeferStmtNode(n *DeferStmtNode) (visitChildren bool)
+	VisitEllipsisNode(n *EllipsisNode) (visitChildren bool)
+	VisitEllipsisArgumentNode(n *EllipsisArgumentNode) (visitChildren bool)
+	VisitEllipsisParameterNode(n *EllipsisParameterNode) (visitChildren bool)
+	VisitExprStmtNode(n *ExprStmtNode) (visitChildren bool)
+	VisitFallthroughStmtNode(n *FallthroughStmtNode) (visitChildren bool)
+	VisitFieldNode(n *FieldNode) (visitChildren bool)
+	VisitFieldListNode(n *FieldListNode) (visitChildren bool)
+	VisitFunctionIdentNode(n *FunctionIdentNode) (visitChildren bool)
+	VisitFunctionLitNode(n *FunctionLitNode) (visitChildren bool)
+	VisitGenericParameterDeclNode(n *GenericParameterDeclNode) (visitChildren bool)
+	VisitGenericParameterIdentNode(n *GenericParameterIdentNode) (visitChildren bool)
+	VisitGenericTypeConstraintNode(n *GenericTypeConstraintNode) (visitChildren bool)
+	VisitGenericTypeInstantiationNode(n *GenericTypeInstantiationNode) (visitChildren bool)
+	VisitGenericUnderlyingTypeConstraintNode(n *GenericUnderlyingTypeConstraintNode) (visitChildren bool)
+	VisitGenericUnionConstraintNode(n *GenericUnionConstraintNode) (visitChildren bool)
+	VisitGoStmtNode(n *GoStmtNode) (visitChildren bool)
+	VisitGotoStmtNode(n *GotoStmtNode) (visitChildren bool)
+	VisitIdentNode(n *IdentNode) (visitChildren bool)
+	VisitIfStmtNode(n *IfStmtNode) (visitChildren bool)
+	VisitImportDotNode(n *ImportDotNode) (visitChildren bool)
+	VisitImportGroupDeclNode(n *ImportGroupDeclNode) (visitChildren bool)
+	VisitImportIdentNode(n *ImportIdentNode) (visitChildren bool)
+	VisitImportOneDeclNode(n *ImportOneDeclNode) (visitChildren bool)
+	VisitImportPathNode(n *ImportPathNode) (visitChildren bool)
+	VisitIncStmtNode(n *IncStmtNode) (visitChildren bool)
+	VisitIndexExprNode(n *IndexExprNode) (visitChildren bool)
+	VisitInterfaceTypeNode(n *InterfaceTypeNode) (visitChildren bool)
+	VisitKeyValueExprNode(n *KeyValueExprNode) (visitChildren bool)
+	VisitLabelIdentNode(n *LabelIdentNode) (visitChildren bool)
+	VisitLabeledStmtNode(n *LabeledStmtNode) (visitChildren bool)
+	VisitLogicalAndExprNode(n *LogicalAndExprNode) (visitChildren bool)
+	VisitLogicalOrExprNode(n *LogicalOrExprNode) (visitChildren bool)
+	VisitMapTypeNode(n *MapTypeNode) (visitChildren bool)
+	VisitMethodIdentNode(n *MethodIdentNode) (visitChildren bool)
+	VisitMulOpExprNode(n *MulOpExprNode) (visitChildren bool)
+	VisitNameParameterNode(n *NameParameterNode) (visitChildren bool)
+	VisitNameTypeParameterNode(n *NameTypeParameterNode) (visitChildren bool)
+	VisitNewExprNode(n *NewExprNode) (visitChildren bool)
+	VisitNumberExprNode(n *NumberExprNode) (visitChildren bool)
+	VisitPackageDeclNode(n *PackageDeclNode) (visitChildren bool)
+	VisitPackageIdentNode(n *PackageIdentNode) (visitChildren bool)
+	VisitParameterDeclNode(n *ParameterDeclNode) (visitChildren bool)
+	VisitParameterIdentNode(n *ParameterIdentNode) (visitChildren bool)
+	VisitParenExprNode(n *ParenExprNode) (visitChildren bool)
+	VisitReceiverGenericTypeDeclNode(n *ReceiverGenericTypeDeclNode) (visitChildren bool)
+	VisitReceiverGenericTypeIdentNode(n *ReceiverGenericTypeIdentNode) (visitChildren bool)
+	VisitReceiverIdentNode(n *ReceiverIdentNode) (visitChildren bool)
+	VisitReceiverTypeIdentNode(n *ReceiverTypeIdentNode) (visitChildren bool)
+	VisitResultGroupDeclNode(n *ResultGroupDeclNode) (visitChildren bool)
+	VisitResultIdentNode(n *ResultIdentNode) (visitChildren bool)
+	VisitResultNameNode(n *ResultNameNode) (visitChildren bool)
+	VisitResultNameTypeNode(n *ResultNameTypeNode) (visitChildren bool)
+	VisitResultOneDeclNode(n *ResultOneDeclNode) (visitChildren bool)
+	VisitResultTypesDeclNode(n *ResultTypesDeclNode) (visitChildren bool)
+	VisitSelectStmtNode(n *SelectStmtNode) (visitChildren bool)
+	VisitSelectorExprNode(n *SelectorExprNode) (visitChildren bool)
+	VisitSendStmtNode(n *SendStmtNode) (visitChildren bool)
+	VisitShortVarDeclNode(n *ShortVarDeclNode) (visitChildren bool)
+	VisitStarExprNode(n *StarExprNode) (visitChildren bool)
+	VisitStringExprNode(n *StringExprNode) (visitChildren bool)
+	VisitStructTypeNode(n *StructTypeNode) (visitChildren bool)
+	VisitTypeArgumentDeclNode(n *TypeArgumentDeclNode) (visitChildren bool)
+	VisitTypeAssertExprNode(n *TypeAssertExprNode) (visitChildren bool)
+	VisitTypeDeclNode(n *TypeDeclNode) (visitChildren bool)
+	VisitTypeIdentNode(n *TypeIdentNode) (visitChildren bool)
+	VisitTypeSwitchGuardIdentNode(n *TypeSwitchGuardIdentNode) (visitChildren bool)
+	VisitUnaryExprNode(n *UnaryExprNode) (visitChildren bool)
+	VisitVarDeclNode(n *VarDeclNode) (visitChildren bool)
+	VisitVarIdentNode(n *VarIdentNode) (visitChildren bool)
+	VisitBreakStmtNode(n *BreakStmtNode) (visitChildren bool)
+	VisitCallExprNode(n *CallExprNode) (visitChildren bool)
+	VisitCompositeLitNode(n *CompositeLitNode) (visitChildren bool)
+	VisitConstNameTypeValueNode(n *ConstNameTypeValueNode) (visitChildren bool)
+	VisitContinueStmtNode(n *ContinueStmtNode) (visitChildren bool)
+	VisitDefaultClauseNode(n *DefaultClauseNode) (visitChildren bool)
+	VisitExprCaseClauseNode(n *ExprCaseClauseNode) (visitChildren bool)
+	VisitFileNode(n *FileNode) (visitChildren bool)
+	VisitForAssignRangeStmtNode(n *ForAssignRangeStmtNode) (visitChildren bool)
+	VisitForDeclRangeStmtNode(n *ForDeclRangeStmtNode) (visitChildren bool)
+	VisitForStmtNode(n *ForStmtNode) (visitChildren bool)
+	VisitFullSliceExprNode(n *FullSliceExprNode) (visitChildren bool)
+	VisitFunctionDeclNode(n *FunctionDeclNode) (visitChildren bool)
+	VisitFunctionTypeNode(n *FunctionTypeNode) (visitChildren bool)
+	VisitGenericParameterNode(n *GenericParameterNode) (visitChildren bool)
+	VisitImportNamePathNode(n *ImportNamePathNode) (visitChildren bool)
+	VisitMakeChanExprNode(n *MakeChanExprNode) (visitChildren bool)
+	VisitMakeMapExprNode(n *MakeMapExprNode) (visitChildren bool)
+	VisitMakeSliceExprNode(n *MakeSliceExprNode) (visitChildren bool)
+	VisitMethodDeclNode(n *MethodDeclNode) (visitChildren bool)
+	VisitReceiverDeclNode(n *ReceiverDeclNode) (visitChildren bool)
+	VisitReceiverTypeNode(n *ReceiverTypeNode) (visitChildren bool)
+	VisitReturnStmtNode(n *ReturnStmtNode) (visitChildren bool)
+	VisitSelectCaseClauseNode(n *SelectCaseClauseNode) (visitChildren bool)
+	VisitSliceExprNode(n *SliceExprNode) (visitChildren bool)
+	VisitStarReceiverTypeNode(n *StarReceiverTypeNode) (visitChildren bool)
+	VisitSwitchStmtNode(n *SwitchStmtNode) (visitChildren bool)
+	VisitTypeCaseClauseNode(n *TypeCaseClauseNode) (visitChildren bool)
+	VisitTypeEqSpecNode(n *TypeEqSpecNode) (visitChildren bool)
+	VisitTypeSpecNode(n *TypeSpecNode) (visitChildren bool)
+	VisitTypeSwitchGuardNode(n *TypeSwitchGuardNode) (visitChildren bool)
+	VisitTypeSwitchStmtNode(n *TypeSwitchStmtNode) (visitChildren bool)
+	VisitVarSpecNode(n *VarSpecNode) (visitChildren bool)
+}
+
+type BaseVisitor struct{}
+
+func (v *BaseVisitor) VisitTokenNode(*TokenNode) bool {
+	return true
 }
 
-func NewCompositeLitNode(filePath string, fileContent []rune, type_ Node, elts Node, start, end Position) Node {
-	if type_ == nil {
-		type_ = DummyNode
-	}
-	if elts == nil {
-		elts = DummyNode
-	}
-	_1 := &CompositeLitNode{
-		BaseNode: NewBaseNode(filePath, fileContent, NodeTypeCompositeLit, start, end),
-		type_:    type_,
-		elts:     elts,
-	}
-	creationHook(_1)
-	return _1
+func (v *BaseVisitor) VisitNodesNode(*NodesNode) bool {
+	return true
 }
 
-type CompositeLitNode struct {
-	*BaseNode
-	type_ Node
-	elts  Node
+func (v *BaseVisitor) VisitAddOpExprNode(*AddOpExprNode) bool {
+	return true
 }
 
-func (n *CompositeLitNode) Type() Node {
-	return n.type_
+func (v *BaseVisitor) VisitArgumentNode(*ArgumentNode) bool {
+	return true
 }
 
-func (n *CompositeLitNode) SetType(v Node) {
-	n.type_ = v
+func (v *BaseVisitor) VisitArgumentDeclNode(*ArgumentDeclNode) bool {
+	return true
 }
 
-func (n *CompositeLitNode) Elts() Node {
-	return n.elts
+func (v *BaseVisitor) VisitArrayTypeNode(*ArrayTypeNode) bool {
+	return true
 }
 
-func (n *CompositeLitNode) SetElts(v Node) {
-	n.elts = v
+func (v *BaseVisitor) VisitAssignStmtNode(*AssignStmtNode) bool {
+	return true
 }
 
-func (n *CompositeLitNode) BuildLink() {
-	if !n.Type().IsDummy() {
-		type_ := n.Type()
-		type_.BuildLink()
-		type_.SetParent(n)
-		type_.SetSelfField("type_")
-		type_.SetReplaceSelf(func(n Node) {
-			n.Parent().(*CompositeLitNode).SetType(n)
-		})
-	}
-	if !n.Elts().IsDummy() {
-		elts := n.Elts()
-		elts.BuildLink()
-		elts.SetParent(n)
-		elts.SetSelfField("elts")
-		elts.SetReplaceSelf(func(n Node) {
-			n.Parent().(*CompositeLitNode).SetElts(n)
-		})
-	}
+func (v *BaseVisitor) VisitAugAssignStmtNode(*AugAssignStmtNode) bool {
+	return true
 }
 
-func (n *CompositeLitNode) Fields() []string {
-	return []string{
-		"type_",
-		"elts",
-	}
+func (v *BaseVisitor) VisitBasicLitNode(*BasicLitNode) bool {
+	return true
 }
 
-func (n *CompositeLitNode) Child(field string) Node {
-	if field == "" {
-		return nil
-	}
-	if field == "type_" {
-		return n.Type()
-	}
-	if field == "elts" {
-		return n.Elts()
-	}
-	return nil
+func (v *BaseVisitor) VisitBlockStmtNode(*BlockStmtNode) bool {
+	return true
 }
 
-func (n *CompositeLitNode) SetChild(nodes []Node) {
-	if len(nodes) != 2 {
-		return
-	}
-	n.SetType(nodes[0])
-	n.SetElts(nodes[1])
+func (v *BaseVisitor) VisitChanTypeNode(*ChanTypeNode) bool {
+	return true
 }
 
-func (n *CompositeLitNode) Fork() Node {
-	_ret := &CompositeLitNode{
-		BaseNode: n.BaseNode.fork(),
-		type_:    n.type_.Fork(),
-		elts:     n.elts.Fork(),
-	}
-	_ret.type_.SetParent(_ret)
-	_ret.elts.SetParent(_ret)
-	return _ret
+func (v *BaseVisitor) VisitCompareExprNode(*CompareExprNode) bool {
+	return true
 }
 
-func (n *CompositeLitNode) Visit(beforeChildren func(node Node) (visitChildren, exit bool), afterChildren func(node Node) (exit bool)) (exit bool) {
-	vc, e := beforeChildren(n)
-	if e {
-		return true
-	}
-	if !vc {
-		return false
-	}
-	if n.type_.Visit(beforeChildren, afterChildren) {
-		return true
-	}
-	if n.elts.Visit(beforeChildren, afterChildren) {
-		return true
-	}
-	if afterChildren(n) {
-		return true
-	}
-	return false
+func (v *BaseVisitor) VisitConstGroupDeclNode(*ConstGroupDeclNode) bool {
+	return true
 }
 
-func (n *CompositeLitNode) Dump(hook func(Node, map[string]string) string) map[string]string {
-	ret := make(map[string]string)
-	ret["kind"] = "\"composite_lit\""
-	ret["type"] = DumpNode(n.Type(), hook)
-	ret["elts"] = DumpNode(n.Elts(), hook)
-	return ret
+func (v *BaseVisitor) VisitConstIdentNode(*ConstIdentNode) bool {
+	return true
 }
 
-func NewFunctionLitNode(filePath string, fileContent []rune, type_ Node, body Node, start, end Position) Node {
-	if type_ == nil {
-		type_ = DummyNode
-	}
-	if body == nil {
-		body = DummyNode
-	}
-	_1 := &FunctionLitNode{
-		BaseNode: NewBaseNode(filePath, fileContent, NodeTypeFunctionLit, start, end),
-		type_:    type_,
-		body:     body,
-	}
-	creationHook(_1)
-	return _1
+func (v *BaseVisitor) VisitConstOneDeclNode(*ConstOneDeclNode) bool {
+	return true
 }
 
-type FunctionLitNode struct {
-	*BaseNode
-	type_ Node
-	body  Node
+func (v *BaseVisitor) VisitDecStmtNode(*DecStmtNode) bool {
+	return true
 }
 
-func (n *FunctionLitNode) Type() Node {
-	return n.type_
+func (v *BaseVisitor) VisitDeferStmtNode(*DeferStmtNode) bool {
+	return true
 }
 
-func (n *FunctionLitNode) SetType(v Node) {
-	n.type_ = v
+func (v *BaseVisitor) VisitEllipsisNode(*EllipsisNode) bool {
+	return true
 }
 
-func (n *FunctionLitNode) Body() Node {
-	return n.body
+func (v *BaseVisitor) VisitEllipsisArgumentNode(*EllipsisArgumentNode) bool {
+	return true
 }
 
-func (n *FunctionLitNode) SetBody(v Node) {
-	n.body = v
+func (v *BaseVisitor) VisitEllipsisParameterNode(*EllipsisParameterNode) bool {
+	return true
 }
 
-func (n *FunctionLitNode) BuildLink() {
-	if !n.Type().IsDummy() {
-		type_ := n.Type()
-		type_.BuildLink()
-		type_.SetParent(n)
-		type_.SetSelfField("type_")
-		type_.SetReplaceSelf(func(n Node) {
-			n.Parent().(*FunctionLitNode).SetType(n)
-		})
-	}
-	if !n.Body().IsDummy() {
-		body := n.Body()
-		body.BuildLink()
-		body.SetParent(n)
-		body.SetSelfField("body")
-		body.SetReplaceSelf(func(n Node) {
-			n.Parent().(*FunctionLitNode).SetBody(n)
-		})
-	}
+func (v *BaseVisitor) VisitExprStmtNode(*ExprStmtNode) bool {
+	return true
 }
 
-func (n *FunctionLitNode) Fields() []string {
-	return []string{
-		"type_",
-		"body",
-	}
+func (v *BaseVisitor) VisitFallthroughStmtNode(*FallthroughStmtNode) bool {
+	return true
 }
 
-func (n *FunctionLitNode) Child(field string) Node {
-	if field == "" {
-		return nil
-	}
-	if field == "type_" {
-		return n.Type()
-	}
-	if field == "body" {
-		return n.Body()
-	}
-	return nil
+func (v *BaseVisitor) VisitFieldNode(*FieldNode) bool {
+	return true
 }
 
-func (n *FunctionLitNode) SetChild(nodes []Node) {
-	if len(nodes) != 2 {
-		return
-	}
-	n.SetType(nodes[0])
-	n.SetBody(nodes[1])
+func (v *BaseVisitor) VisitFieldListNode(*FieldListNode) bool {
+	return true
 }
 
-func (n *FunctionLitNode) Fork() Node {
-	_ret := &FunctionLitNode{
-		BaseNode: n.BaseNode.fork(),
-		type_:    n.type_.Fork(),
-		body:     n.body.Fork(),
-	}
-	_ret.type_.SetParent(_ret)
-	_ret.body.SetParent(_ret)
-	return _ret
+func (v *BaseVisitor) VisitFunctionIdentNode(*FunctionIdentNode) bool {
+	return true
 }
 
-func (n *FunctionLitNode) Visit(beforeChildren func(node Node) (visitChildren, exit bool), afterChildren func(node Node) (exit bool)) (exit bool) {
-	vc, e := beforeChildren(n)
-	if e {
-		return true
-	}
-	if !vc {
-		return false
-	}
-	if n.type_.Visit(beforeChildren, afterChildren) {
-		return true
-	}
-	if n.body.Visit(beforeChildren, afterChildren) {
-		return true
-	}
-	if afterChildren(n) {
-		return true
-	}
-	return false
+func (v *BaseVisitor) VisitFunctionLitNode(*FunctionLitNode) bool {
+	return true
 }
 
-func (n *FunctionLitNode) Dump(hook func(Node, map[string]string) string) map[string]string {
-	ret := make(map[string]string)
-	ret["kind"] = "\"function_lit\""
-	ret["type"] = DumpNode(n.Type(), hook)
-	ret["body"] = DumpNode(n.Body(), hook)
-	return ret
+func (v *BaseVisitor) VisitGenericParameterDeclNode(*GenericParameterDeclNode) bool {
+	return true
 }
 
-func NewFieldListNode(filePath string, fileContent []rune, list Node, start, end Position) Node {
-	if list == nil {
-		list = DummyNode
-	}
-	_1 := &FieldListNode{
-		BaseNode: NewBaseNode(filePath, fileContent, NodeTypeFieldList, start, end),
-		list:     list,
-	}
-	creationHook(_1)
-	return _1
+func (v *BaseVisitor) VisitGenericParameterIdentNode(*GenericParameterIdentNode) bool {
+	return true
 }
 
-type FieldListNode struct {
-	*BaseNode
-	list Node
+func (v *BaseVisitor) VisitGenericTypeConstraintNode(*GenericTypeConstraintNode) bool {
+	return true
 }
 
-func (n *FieldListNode) List() Node {
-	return n.list
+func (v *BaseVisitor) VisitGenericTypeInstantiationNode(*GenericTypeInstantiationNode) bool {
+	return true
 }
 
-func (n *FieldListNode) SetList(v Node) {
-	n.list = v
+func (v *BaseVisitor) VisitGenericUnderlyingTypeConstraintNode(*GenericUnderlyingTypeConstraintNode) bool {
+	return true
 }
 
-func (n *FieldListNode) BuildLink() {
-	if !n.List().IsDummy() {
-		list := n.List()
-		list.BuildLink()
-		list.SetParent(n)
-		list.SetSelfField("list")
-		list.SetReplaceSelf(func(n Node) {
-			n.Parent().(*FieldListNode).SetList(n)
-		})
-	}
+func (v *BaseVisitor) VisitGenericUnionConstraintNode(*GenericUnionConstraintNode) bool {
+	return true
 }
 
-func (n *FieldListNode) Fields() []string {
-	return []string{
-		"list",
-	}
+func (v *BaseVisitor) VisitGoStmtNode(*GoStmtNode) bool {
+	return true
 }
 
-func (n *FieldListNode) Child(field string) Node {
-	if field == "" {
-		return nil
-	}
-	if field == "list" {
-		return n.List()
-	}
-	return nil
+func (v *BaseVisitor) VisitGotoStmtNode(*GotoStmtNode) bool {
+	return true
 }
 
-func (n *FieldListNode) SetChild(nodes []Node) {
-	if len(nodes) != 1 {
-		return
-	}
-	n.SetList(nodes[0])
+func (v *BaseVisitor) VisitIdentNode(*IdentNode) bool {
+	return true
 }
 
-func (n *FieldListNode) Fork() Node {
-	_ret := &FieldListNode{
-		BaseNode: n.BaseNode.fork(),
-		list:     n.list.Fork(),
-	}
-	_ret.list.SetParent(_ret)
-	return _ret
+func (v *BaseVisitor) VisitIfStmtNode(*IfStmtNode) bool {
+	return true
 }
 
-func (n *FieldListNode) Visit(beforeChildren func(node Node) (visitChildren, exit bool), afterChildren func(node Node) (exit bool)) (exit bool) {
-	vc, e := beforeChildren(n)
-	if e {
-		return true
-	}
-	if !vc {
-		return false
-	}
-	if n.list.Visit(beforeChildren, afterChildren) {
-		return true
-	}
-	if afterChildren(n) {
-		return true
-	}
-	return false
+func (v *BaseVisitor) VisitImportDotNode(*ImportDotNode) bool {
+	return true
 }
 
-func (n *FieldListNode) Dump(hook func(Node, map[string]string) string) map[string]string {
-	ret := make(map[string]string)
-	ret["kind"] = "\"field_list\""
-	ret["list"] = DumpNode(n.List(), hook)
-	return ret
+func (v *BaseVisitor) VisitImportGroupDeclNode(*ImportGroupDeclNode) bool {
+	return true
 }
 
-func NewFieldNode(filePath string, fileContent []rune, names Node, type_ Node, tag Node, start, end Position) Node {
-	if names == nil {
-		names = DummyNode
-	}
-	if type_ == nil {
-		type_ = DummyNode
-	}
-	if tag == nil {
-		tag = DummyNode
-	}
-	_1 := &FieldNode{
-		BaseNode: NewBaseNode(filePath, fileContent, NodeTypeField, start, end),
-		names:    names,
-		type_:    type_,
-		tag:      tag,
-	}
-	creationHook(_1)
-	return _1
+func (v *BaseVisitor) VisitImportIdentNode(*ImportIdentNode) bool {
+	return true
 }
 
-type FieldNode struct {
-	*BaseNode
-	names Node
-	type_ Node
-	tag   Node
+func (v *BaseVisitor) VisitImportOneDeclNode(*ImportOneDeclNode) bool {
+	return true
 }
 
-func (n *FieldNode) Names() Node {
-	return n.names
+func (v *BaseVisitor) VisitImportPathNode(*ImportPathNode) bool {
+	return true
 }
 
-func (n *FieldNode) SetNames(v Node) {
-	n.names = v
+func (v *BaseVisitor) VisitIncStmtNode(*IncStmtNode) bool {
+	return true
 }
 
-func (n *FieldNode) Type() Node {
-	return n.type_
+func (v *BaseVisitor) VisitIndexExprNode(*IndexExprNode) bool {
+	return true
 }
 
-func (n *FieldNode) SetType(v Node) {
-	n.type_ = v
+func (v *BaseVisitor) VisitInterfaceTypeNode(*InterfaceTypeNode) bool {
+	return true
 }
 
-func (n *FieldNode) Tag() Node {
-	return n.tag
+func (v *BaseVisitor) VisitKeyValueExprNode(*KeyValueExprNode) bool {
+	return true
 }
 
-func (n *FieldNode) SetTag(v Node) {
-	n.tag = v
+func (v *BaseVisitor) VisitLabelIdentNode(*LabelIdentNode) bool {
+	return true
 }
 
-func (n *FieldNode) BuildLink() {
-	if !n.Names().IsDummy() {
-		names := n.Names()
-		names.BuildLink()
-		names.SetParent(n)
-		names.SetSelfField("names")
-		names.SetReplaceSelf(func(n Node) {
-			n.Parent().(*FieldNode).SetNames(n)
-		})
-	}
-	if !n.Type().IsDummy() {
-		type_ := n.Type()
-		type_.BuildLink()
-		type_.SetParent(n)
-		type_.SetSelfField("type_")
-		type_.SetReplaceSelf(func(n Node) {
-			n.Parent().(*FieldNode).SetType(n)
-		})
-	}
-	if !n.Tag().IsDummy() {
-		tag := n.Tag()
-		tag.BuildLink()
-		tag.SetParent(n)
-		tag.SetSelfField("tag")
-		tag.SetReplaceSelf(func(n Node) {
-			n.Parent().(*FieldNode).SetTag(n)
-		})
-	}
+func (v *BaseVisitor) VisitLabeledStmtNode(*LabeledStmtNode) bool {
+	return true
 }
 
-func (n *FieldNode) Fields() []string {
-	return []string{
-		"names",
-		"type_",
-		"tag",
-	}
+func (v *BaseVisitor) VisitLogicalAndExprNode(*LogicalAndExprNode) bool {
+	return true
 }
 
-func (n *FieldNode) Child(field string) Node {
-	if field == "" {
-		return nil
-	}
-	if field == "names" {
-		return n.Names()
-	}
-	if field == "type_" {
-		return n.Type()
-	}
-	if field == "tag" {
-		return n.Tag()
-	}
-	return nil
+func (v *BaseVisitor) VisitLogicalOrExprNode(*LogicalOrExprNode) bool {
+	return true
 }
 
-func (n *FieldNode) SetChild(nodes []Node) {
-	if len(nodes) != 3 {
-		return
-	}
-	n.SetNames(nodes[0])
-	n.SetType(nodes[1])
-	n.SetTag(nodes[2])
+func (v *BaseVisitor) VisitMapTypeNode(*MapTypeNode) bool {
+	return true
 }
 
-func (n *FieldNode) Fork() Node {
-	_ret := &FieldNode{
-		BaseNode: n.BaseNode.fork(),
-		names:    n.names.Fork(),
-		type_:    n.type_.Fork(),
-		tag:      n.tag.Fork(),
-	}
-	_ret.names.SetParent(_ret)
-	_ret.type_.SetParent(_ret)
-	_ret.tag.SetParent(_ret)
-	return _ret
+func (v *BaseVisitor) VisitMethodIdentNode(*MethodIdentNode) bool {
+	return true
 }
 
-func (n *FieldNode) Visit(beforeChildren func(node Node) (visitChildren, exit bool), afterChildren func(node Node) (exit bool)) (exit bool) {
-	vc, e := beforeChildren(n)
-	if e {
-		return true
-	}
-	if !vc {
-		return false
-	}
-	if n.names.Visit(beforeChildren, afterChildren) {
-		return true
-	}
-	if n.type_.Visit(beforeChildren, afterChildren) {
-		return true
-	}
-	if n.tag.Visit(beforeChildren, afterChildren) {
-		return true
-	}
-	if afterChildren(n) {
-		return true
-	}
-	return false
+func (v *BaseVisitor) VisitMulOpExprNode(*MulOpExprNode) bool {
+	return true
 }
 
-func (n *FieldNode) Dump(hook func(Node, map[string]string) string) map[string]string {
-	ret := make(map[string]string)
-	ret["kind"] = "\"field\""
-	ret["names"] = DumpNode(n.Names(), hook)
-	ret["type"] = DumpNode(n.Type(), hook)
-	ret["tag"] = DumpNode(n.Tag(), hook)
-	return ret
+func (v *BaseVisitor) VisitNameParameterNode(*NameParameterNode) bool {
+	return true
 }
 
-func NewImportDeclNode(filePath string, fileContent []rune, x Node, start, end Position) Node {
-	if x == nil {
-		x = DummyNode
-	}
-	_1 := &ImportDeclNode{
-		BaseNode: NewBaseNode(filePath, fileContent, NodeTypeImportDecl, start, end),
-		x:        x,
-	}
-	creationHook(_1)
-	return _1
+func (v *BaseVisitor) VisitNameTypeParameterNode(*NameTypeParameterNode) bool {
+	return true
 }
 
-type ImportDeclNode struct {
-	*BaseNode
-	x Node
+func (v *BaseVisitor) VisitNewExprNode(*NewExprNode) bool {
+	return true
 }
 
-func (n *ImportDeclNode) X() Node {
-	return n.x
+func (v *BaseVisitor) VisitNumberExprNode(*NumberExprNode) bool {
+	return true
 }
 
-func (n *ImportDeclNode) SetX(v Node) {
-	n.x = v
+func (v *BaseVisitor) VisitPackageDeclNode(*PackageDeclNode) bool {
+	return true
 }
 
-func (n *ImportDeclNode) BuildLink() {
-	if !n.X().IsDummy() {
-		x := n.X()
-		x.BuildLink()
-		x.SetParent(n)
-		x.SetSelfField("x")
-		x.SetReplaceSelf(func(n Node) {
-			n.Parent().(*ImportDeclNode).SetX(n)
-		})
-	}
+func (v *BaseVisitor) VisitPackageIdentNode(*PackageIdentNode) bool {
+	return true
 }
 
-func (n *ImportDeclNode) Fields() []string {
-	return []string{
-		"x",
-	}
+func (v *BaseVisitor) VisitParameterDeclNode(*ParameterDeclNode) bool {
+	return true
 }
 
-func (n *ImportDeclNode) Child(field string) Node {
-	if field == "" {
-		return nil
-	}
-	if field == "x" {
-		return n.X()
-	}
-	return nil
+func (v *BaseVisitor) VisitParameterIdentNode(*ParameterIdentNode) bool {
+	return true
 }
 
-func (n *ImportDeclNode) SetChild(nodes []Node) {
-	if len(nodes) != 1 {
-		return
-	}
-	n.SetX(nodes[0])
+func (v *BaseVisitor) VisitParenExprNode(*ParenExprNode) bool {
+	return true
 }
 
-func (n *ImportDeclNode) Fork() Node {
-	_ret := &ImportDeclNode{
-		BaseNode: n.BaseNode.fork(),
-		x:        n.x.Fork(),
-	}
-	_ret.x.SetParent(_ret)
-	return _ret
+func (v *BaseVisitor) VisitReceiverGenericTypeDeclNode(*ReceiverGenericTypeDeclNode) bool {
+	return true
 }
 
-func (n *ImportDeclNode) Visit(beforeChildren func(node Node) (visitChildren, exit bool), afterChildren func(node Node) (exit bool)) (exit bool) {
-	vc, e := beforeChildren(n)
-	if e {
-		return true
-	}
-	if !vc {
-		return false
-	}
-	if n.x.Visit(beforeChildren, afterChildren) {
-		return true
-	}
-	if afterChildren(n) {
-		return true
-	}
-	return false
+func (v *BaseVisitor) VisitReceiverGenericTypeIdentNode(*ReceiverGenericTypeIdentNode) bool {
+	return true
 }
 
-func (n *ImportDeclNode) Dump(hook func(Node, map[string]string) string) map[string]string {
-	ret := make(map[string]string)
-	ret["kind"] = "\"import_decl\""
-	ret["x"] = DumpNode(n.X(), hook)
-	return ret
+func (v *BaseVisitor) VisitReceiverIdentNode(*ReceiverIdentNode) bool {
+	return true
 }
 
-func NewImportSpecNode(filePath string, fileContent []rune, name Node, source Node, start, end Position) Node {
-	if name == nil {
-		name = DummyNode
-	}
-	if source == nil {
-		source = DummyNode
-	}
-	_1 := &ImportSpecNode{
-		BaseNode: NewBaseNode(filePath, fileContent, NodeTypeImportSpec, start, end),
-		name:     name,
-		source:   source,
-	}
-	creationHook(_1)
-	return _1
+func (v *BaseVisitor) VisitReceiverTypeIdentNode(*ReceiverTypeIdentNode) bool {
+	return true
 }
 
-type ImportSpecNode struct {
-	*BaseNode
-	name   Node
-	source Node
+func (v *BaseVisitor) VisitResultGroupDeclNode(*ResultGroupDeclNode) bool {
+	return true
 }
 
-func (n *ImportSpecNode) Name() Node {
-	return n.name
+func (v *BaseVisitor) VisitResultIdentNode(*ResultIdentNode) bool {
+	return true
 }
 
-func (n *ImportSpecNode) SetName(v Node) {
-	n.name = v
+func (v *BaseVisitor) VisitResultNameNode(*ResultNameNode) bool {
+	return true
 }
 
-func (n *ImportSpecNode) Source() Node {
-	return n.source
+func (v *BaseVisitor) VisitResultNameTypeNode(*ResultNameTypeNode) bool {
+	return true
 }
 
-func (n *ImportSpecNode) SetSource(v Node) {
-	n.source = v
+func (v *BaseVisitor) VisitResultOneDeclNode(*ResultOneDeclNode) bool {
+	return true
 }
 
-func (n *ImportSpecNode) BuildLink() {
-	if !n.Name().IsDummy() {
-		name := n.Name()
-		name.BuildLink()
-		name.SetParent(n)
-		name.SetSelfField("name")
-		name.SetReplaceSelf(func(n Node) {
-			n.Parent().(*ImportSpecNode).SetName(n)
-		})
-	}
-	if !n.Source().IsDummy() {
-		source := n.Source()
-		source.BuildLink()
-		source.SetParent(n)
-		source.SetSelfField("source")
-		source.SetReplaceSelf(func(n Node) {
-			n.Parent().(*ImportSpecNode).SetSource(n)
-		})
-	}
+func (v *BaseVisitor) VisitResultTypesDeclNode(*ResultTypesDeclNode) bool {
+	return true
 }
 
-func (n *ImportSpecNode) Fields() []string {
-	return []string{
-		"name",
-		"source",
-	}
+func (v *BaseVisitor) VisitSelectStmtNode(*SelectStmtNode) bool {
+	return true
 }
 
-func (n *ImportSpecNode) Child(field string) Node {
-	if field == "" {
-		return nil
-	}
-	if field == "name" {
-		return n.Name()
-	}
-	if field == "source" {
-		return n.Source()
-	}
-	return nil
+func (v *BaseVisitor) VisitSelectorExprNode(*SelectorExprNode) bool {
+	return true
 }
 
-func (n *ImportSpecNode) SetChild(nodes []Node) {
-	if len(nodes) != 2 {
-		return
-	}
-	n.SetName(nodes[0])
-	n.SetSource(nodes[1])
+func (v *BaseVisitor) VisitSendStmtNode(*SendStmtNode) bool {
+	return true
 }
 
-func (n *ImportSpecNode) Fork() Node {
-	_ret := &ImportSpecNode{
-		BaseNode: n.BaseNode.fork(),
-		name:     n.name.Fork(),
-		source:   n.source.Fork(),
-	}
-	_ret.name.SetParent(_ret)
-	_ret.source.SetParent(_ret)
-	return _ret
+func (v *BaseVisitor) VisitShortVarDeclNode(*ShortVarDeclNode) bool {
+	return true
 }
 
-func (n *ImportSpecNode) Visit(beforeChildren func(node Node) (visitChildren, exit bool), afterChildren func(node Node) (exit bool)) (exit bool) {
-	vc, e := beforeChildren(n)
-	if e {
-		return true
-	}
-	if !vc {
-		return false
-	}
-	if n.name.Visit(beforeChildren, afterChildren) {
-		return true
-	}
-	if n.source.Visit(beforeChildren, afterChildren) {
-		return true
-	}
-	if afterChildren(n) {
-		return true
-	}
-	return false
+func (v *BaseVisitor) VisitStarExprNode(*StarExprNode) bool {
+	return true
 }
 
-func (n *ImportSpecNode) Dump(hook func(Node, map[string]string) string) map[string]string {
-	ret := make(map[string]string)
-	ret["kind"] = "\"import_spec\""
-	ret["name"] = DumpNode(n.Name(), hook)
-	ret["source"] = DumpNode(n.Source(), hook)
-	return ret
+func (v *BaseVisitor) VisitStringExprNode(*StringExprNode) bool {
+	return true
 }
 
-func NewConstSpecNode(filePath string, fileContent []rune, names Node, type_ Node, values Node, start, end Position) Node {
-	if names == nil {
-		names = DummyNode
-	}
-	if type_ == nil {
-		type_ = DummyNode
-	}
-	if values == nil {
-		values = DummyNode
-	}
-	_1 := &ConstSpecNode{
-		BaseNode: NewBaseNode(filePath, fileContent, NodeTypeConstSpec, start, end),
-		names:    names,
-		type_:    type_,
-		values:   values,
-	}
-	creationHook(_1)
-	return _1
+func (v *BaseVisitor) VisitStructTypeNode(*StructTypeNode) bool {
+	return true
 }
 
-type ConstSpecNode struct {
-	*BaseNode
-	names  Node
-	type_  Node
-	values Node
+func (v *BaseVisitor) VisitTypeArgumentDeclNode(*TypeArgumentDeclNode) bool {
+	return true
 }
 
-func (n *ConstSpecNode) Names() Node {
-	return n.names
+func (v *BaseVisitor) VisitTypeAssertExprNode(*TypeAssertExprNode) bool {
+	return true
 }
 
-func (n *ConstSpecNode) SetNames(v Node) {
-	n.names = v
+func (v *BaseVisitor) VisitTypeDeclNode(*TypeDeclNode) bool {
+	return true
 }
 
-func (n *ConstSpecNode) Type() Node {
-	return n.type_
+func (v *BaseVisitor) VisitTypeIdentNode(*TypeIdentNode) bool {
+	return true
 }
 
-func (n *ConstSpecNode) SetType(v Node) {
-	n.type_ = v
+func (v *BaseVisitor) VisitTypeSwitchGuardIdentNode(*TypeSwitchGuardIdentNode) bool {
+	return true
 }
 
-func (n *ConstSpecNode) Values() Node {
-	return n.values
+func (v *BaseVisitor) VisitUnaryExprNode(*UnaryExprNode) bool {
+	return true
 }
 
-func (n *ConstSpecNode) SetValues(v Node) {
-	n.values = v
+func (v *BaseVisitor) VisitVarDeclNode(*VarDeclNode) bool {
+	return true
 }
 
-func (n *ConstSpecNode) BuildLink() {
-	if !n.Names().IsDummy() {
-		names := n.Names()
-		names.BuildLink()
-		names.SetParent(n)
-		names.SetSelfField("names")
-		names.SetReplaceSelf(func(n Node) {
-			n.Parent().(*ConstSpecNode).SetNames(n)
-		})
-	}
-	if !n.Type().IsDummy() {
-		type_ := n.Type()
-		type_.BuildLink()
-		type_.SetParent(n)
-		type_.SetSelfField("type_")
-		type_.SetReplaceSelf(func(n Node) {
-			n.Parent().(*ConstSpecNode).SetType(n)
-		})
-	}
-	if !n.Values().IsDummy() {
-		values := n.Values()
-		values.BuildLink()
-		values.SetParent(n)
-		values.SetSelfField("values")
-		values.SetReplaceSelf(func(n Node) {
-			n.Parent().(*ConstSpecNode).SetValues(n)
-		})
-	}
+func (v *BaseVisitor) VisitVarIdentNode(*VarIdentNode) bool {
+	return true
 }
 
-func (n *ConstSpecNode) Fields() []string {
-	return []string{
-		"names",
-		"type_",
-		"values",
-	}
+func (v *BaseVisitor) VisitBreakStmtNode(*BreakStmtNode) bool {
+	return true
 }
 
-func (n *ConstSpecNode) Child(field string) Node {
-	if field == "" {
-		return nil
-	}
-	if field == "names" {
-		return n.Names()
-	}
-	if field == "type_" {
-		return n.Type()
-	}
-	if field == "values" {
-		return n.Values()
-	}
-	return nil
+func (v *BaseVisitor) VisitCallExprNode(*CallExprNode) bool {
+	return true
 }
 
-func (n *ConstSpecNode) SetChild(nodes []Node) {
-	if len(nodes) != 3 {
-		return
-	}
-	n.SetNames(nodes[0])
-	n.SetType(nodes[1])
-	n.SetValues(nodes[2])
+func (v *BaseVisitor) VisitCompositeLitNode(*CompositeLitNode) bool {
+	return true
 }
 
-func (n *ConstSpecNode) Fork() Node {
-	_ret := &ConstSpecNode{
-		BaseNode: n.BaseNode.fork(),
-		names:    n.names.Fork(),
-		type_:    n.type_.Fork(),
-		values:   n.values.Fork(),
-	}
-	_ret.names.SetParent(_ret)
-	_ret.type_.SetParent(_ret)
-	_ret.values.SetParent(_ret)
-	return _ret
+func (v *BaseVisitor) VisitConstNameTypeValueNode(*ConstNameTypeValueNode) bool {
+	return true
 }
 
-func (n *ConstSpecNode) Visit(beforeChildren func(node Node) (visitChildren, exit bool), afterChildren func(node Node) (exit bool)) (exit bool) {
-	vc, e := beforeChildren(n)
-	if e {
-		return true
-	}
-	if !vc {
-		return false
-	}
-	if n.names.Visit(beforeChildren, afterChildren) {
-		return true
-	}
-	if n.type_.Visit(beforeChildren, afterChildren) {
-		return true
-	}
-	if n.values.Visit(beforeChildren, afterChildren) {
-		return true
-	}
-	if afterChildren(n) {
-		return true
-	}
-	return false
+func (v *BaseVisitor) VisitContinueStmtNode(*ContinueStmtNode) bool {
+	return true
 }
 
-func (n *ConstSpecNode) Dump(hook func(Node, map[string]string) string) map[string]string {
-	ret := make(map[string]string)
-	ret["kind"] = "\"const_spec\""
-	ret["names"] = DumpNode(n.Names(), hook)
-	ret["type"] = DumpNode(n.Type(), hook)
-	ret["values"] = DumpNode(n.Values(), hook)
-	return ret
+func (v *BaseVisitor) VisitDefaultClauseNode(*DefaultClauseNode) bool {
+	return true
 }
 
-func NewVarSpecNode(filePath string, fileContent []rune, names Node, type_ Node, values Node, start, end Position) Node {
-	if names == nil {
-		names = DummyNode
-	}
-	if type_ == nil {
-		type_ = DummyNode
-	}
-	if values == nil {
-		values = DummyNode
-	}
-	_1 := &VarSpecNode{
-		BaseNode: NewBaseNode(filePath, fileContent, NodeTypeVarSpec, start, end),
-		names:    names,
-		type_:    type_,
-		values:   values,
-	}
-	creationHook(_1)
-	return _1
+func (v *BaseVisitor) VisitExprCaseClauseNode(*ExprCaseClauseNode) bool {
+	return true
 }
 
-type VarSpecNode struct {
-	*BaseNode
-	names  Node
-	type_  Node
-	values Node
+func (v *BaseVisitor) VisitFileNode(*FileNode) bool {
+	return true
 }
 
-func (n *VarSpecNode) Names() Node {
-	return n.names
+func (v *BaseVisitor) VisitForAssignRangeStmtNode(*ForAssignRangeStmtNode) bool {
+	return true
 }
 
-func (n *VarSpecNode) SetNames(v Node) {
-	n.names = v
+func (v *BaseVisitor) VisitForDeclRangeStmtNode(*ForDeclRangeStmtNode) bool {
+	return true
 }
 
-func (n *VarSpecNode) Type() Node {
-	return n.type_
+func (v *BaseVisitor) VisitForStmtNode(*ForStmtNode) bool {
+	return true
 }
 
-func (n *VarSpecNode) SetType(v Node) {
-	n.type_ = v
+func (v *BaseVisitor) VisitFullSliceExprNode(*FullSliceExprNode) bool {
+	return true
 }
 
-func (n *VarSpecNode) Values() Node {
-	return n.values
+func (v *BaseVisitor) VisitFunctionDeclNode(*FunctionDeclNode) bool {
+	return true
 }
 
-func (n *VarSpecNode) SetValues(v Node) {
-	n.values = v
+func (v *BaseVisitor) VisitFunctionTypeNode(*FunctionTypeNode) bool {
+	return true
 }
 
-func (n *VarSpecNode) BuildLink() {
-	if !n.Names().IsDummy() {
-		names := n.Names()
-		names.BuildLink()
-		names.SetParent(n)
-		names.SetSelfField("names")
-		names.SetReplaceSelf(func(n Node) {
-			n.Parent().(*VarSpecNode).SetNames(n)
-		})
-	}
-	if !n.Type().IsDummy() {
-		type_ := n.Type()
-		type_.BuildLink()
-		type_.SetParent(n)
-		type_.SetSelfField("type_")
-		type_.SetReplaceSelf(func(n Node) {
-			n.Parent().(*VarSpecNode).SetType(n)
-		})
-	}
-	if !n.Values().IsDummy() {
-		values := n.Values()
-		values.BuildLink()
-		values.SetParent(n)
-		values.SetSelfField("values")
-		values.SetReplaceSelf(func(n Node) {
-			n.Parent().(*VarSpecNode).SetValues(n)
-		})
-	}
+func (v *BaseVisitor) VisitGenericParameterNode(*GenericParameterNode) bool {
+	return true
 }
 
-func (n *VarSpecNode) Fields() []string {
-	return []string{
-		"names",
-		"type_",
-		"values",
-	}
+func (v *BaseVisitor) VisitImportNamePathNode(*ImportNamePathNode) bool {
+	return true
 }
 
-func (n *VarSpecNode) Child(field string) Node {
-	if field == "" {
-		return nil
-	}
-	if field == "names" {
-		return n.Names()
-	}
-	if field == "type_" {
-		return n.Type()
-	}
-	if field == "values" {
-		return n.Values()
-	}
-	return nil
+func (v *BaseVisitor) VisitMakeChanExprNode(*MakeChanExprNode) bool {
+	return true
 }
 
-func (n *VarSpecNode) SetChild(nodes []Node) {
-	if len(nodes) != 3 {
-		return
-	}
-	n.SetNames(nodes[0])
-	n.SetType(nodes[1])
-	n.SetValues(nodes[2])
+func (v *BaseVisitor) VisitMakeMapExprNode(*MakeMapExprNode) bool {
+	return true
 }
 
-func (n *VarSpecNode) Fork() Node {
-	_ret := &VarSpecNode{
-		BaseNode: n.BaseNode.fork(),
-		names:    n.names.Fork(),
-		type_:    n.type_.Fork(),
-		values:   n.values.Fork(),
-	}
-	_ret.names.SetParent(_ret)
-	_ret.type_.SetParent(_ret)
-	_ret.values.SetParent(_ret)
-	return _ret
+func (v *BaseVisitor) VisitMakeSliceExprNode(*MakeSliceExprNode) bool {
+	return true
 }
 
-func (n *VarSpecNode) Visit(beforeChildren func(node Node) (visitChildren, exit bool), afterChildren func(node Node) (exit bool)) (exit bool) {
-	vc, e := beforeChildren(n)
-	if e {
-		return true
-	}
-	if !vc {
-		return false
-	}
-	if n.names.Visit(beforeChildren, afterChildren) {
-		return true
-	}
-	if n.type_.Visit(beforeChildren, afterChildren) {
-		return true
-	}
-	if n.values.Visit(beforeChildren, afterChildren) {
-		return true
-	}
-	if afterChildren(n) {
-		return true
-	}
-	return false
+func (v *BaseVisitor) VisitMethodDeclNode(*MethodDeclNode) bool {
+	return true
 }
 
-func (n *VarSpecNode) Dump(hook func(Node, map[string]string) string) map[string]string {
-	ret := make(map[string]string)
-	ret["kind"] = "\"var_spec\""
-	ret["names"] = DumpNode(n.Names(), hook)
-	ret["type"] = DumpNode(n.Type(), hook)
-	ret["values"] = DumpNode(n.Values(), hook)
-	return ret
+func (v *BaseVisitor) VisitReceiverDeclNode(*ReceiverDeclNode) bool {
+	return true
 }
 
-func NewTypeEqSpecNode(filePath string, fileContent []rune, name Node, typeParams Node, type_ Node, start, end Position) Node {
-	if name == nil {
-		name = DummyNode
-	}
-	if typeParams == nil {
-		typeParams = DummyNode
-	}
-	if type_ == nil {
-		type_ = DummyNode
-	}
-	_1 := &TypeEqSpecNode{
-		BaseNode:   NewBaseNode(filePath, fileContent, NodeTypeTypeEqSpec, start, end),
-		name:       name,
-		typeParams: typeParams,
-		type_:      type_,
-	}
-	creationHook(_1)
-	return _1
+func (v *BaseVisitor) VisitReceiverTypeNode(*ReceiverTypeNode) bool {
+	return true
 }
 
-type TypeEqSpecNode struct {
-	*BaseNode
-	name       Node
-	typeParams Node
-	type_      Node
+func (v *BaseVisitor) VisitReturnStmtNode(*ReturnStmtNode) bool {
+	return true
 }
 
-func (n *TypeEqSpecNode) Name() Node {
-	return n.name
+func (v *BaseVisitor) VisitSelectCaseClauseNode(*SelectCaseClauseNode) bool {
+	return true
 }
 
-func (n *TypeEqSpecNode) SetName(v Node) {
-	n.name = v
+func (v *BaseVisitor) VisitSliceExprNode(*SliceExprNode) bool {
+	return true
 }
 
-func (n *TypeEqSpecNode) TypeParams() Node {
-	return n.typeParams
+func (v *BaseVisitor) VisitStarReceiverTypeNode(*StarReceiverTypeNode) bool {
+	return true
 }
 
-func (n *TypeEqSpecNode) SetTypeParams(v Node) {
-	n.typeParams = v
+func (v *BaseVisitor) VisitSwitchStmtNode(*SwitchStmtNode) bool {
+	return true
 }
 
-func (n *TypeEqSpecNode) Type() Node {
-	return n.type_
+func (v *BaseVisitor) VisitTypeCaseClauseNode(*TypeCaseClauseNode) bool {
+	return true
 }
 
-func (n *TypeEqSpecNode) SetType(v Node) {
-	n.type_ = v
+func (v *BaseVisitor) VisitTypeEqSpecNode(*TypeEqSpecNode) bool {
+	return true
 }
 
-func (n *TypeEqSpecNode) BuildLink() {
-	if !n.Name().IsDummy() {
-		name := n.Name()
-		name.BuildLink()
-		name.SetParent(n)
-		name.SetSelfField("name")
-		name.SetReplaceSelf(func(n Node) {
-			n.Parent().(*TypeEqSpecNode).SetName(n)
-		})
-	}
-	if !n.TypeParams().IsDummy() {
-		typeParams := n.TypeParams()
-		typeParams.BuildLink()
-		typeParams.SetParent(n)
-		typeParams.SetSelfField("type_params")
-		typeParams.SetReplaceSelf(func(n Node) {
-			n.Parent().(*TypeEqSpecNode).SetTypeParams(n)
-		})
-	}
-	if !n.Type().IsDummy() {
-		type_ := n.Type()
-		type_.BuildLink()
-		type_.SetParent(n)
-		type_.SetSelfField("type_")
-		type_.SetReplaceSelf(func(n Node) {
-			n.Parent().(*TypeEqSpecNode).SetType(n)
-		})
-	}
+func (v *BaseVisitor) VisitTypeSpecNode(*TypeSpecNode) bool {
+	return true
 }
 
-func (n *TypeEqSpecNode) Fields() []string {
-	return []string{
-		"name",
-		"type_params",
-		"type_",
-	}
+func (v *BaseVisitor) VisitTypeSwitchGuardNode(*TypeSwitchGuardNode) bool {
+	return true
 }
 
-func (n *TypeEqSpecNode) Child(field string) Node {
-	if field == "" {
-		return nil
-	}
-	if field == "name" {
-		return n.Name()
-	}
-	if field == "type_params" {
-		return n.TypeParams()
-	}
-	if field == "type_" {
-		return n.Type()
-	}
-	return nil
+func (v *BaseVisitor) VisitTypeSwitchStmtNode(*TypeSwitchStmtNode) bool {
+	return true
 }
 
-func (n *TypeEqSpecNode) SetChild(nodes []Node) {
-	if len(nodes) != 3 {
-		return
-	}
-	n.SetName(nodes[0])
-	n.SetTypeParams(nodes[1])
-	n.SetType(nodes[2])
+func (v *BaseVisitor) VisitVarSpecNode(*VarSpecNode) bool {
+	return true
 }
 
-func (n *TypeEqSpecNode) Fork() Node {
-	_ret := &TypeEqSpecNode{
-		BaseNode:   n.BaseNode.fork(),
-		name:       n.name.Fork(),
-		typeParams: n.typeParams.Fork(),
-		type_:      n.type_.Fork(),
-	}
-	_ret.name.SetParent(_ret)
-	_ret.typeParams.SetParent(_ret)
-	_ret.type_.SetParent(_ret)
-	return _ret
+type Rewriter interface {
+	RewriteTokenNode(n *TokenNode) Node
+	RewriteNodesNode(n *NodesNode) Node
+	RewriteAddOpExprNode(n *AddOpExprNode) Node
+	RewriteArgumentNode(n *ArgumentNode) Node
+	RewriteArgumentDeclNode(n *ArgumentDeclNode) Node
+	RewriteArrayTypeNode(n *ArrayTypeNode) Node
+	RewriteAssignStmtNode(n *AssignStmtNode) Node
+	RewriteAugAssignStmtNode(n *AugAssignStmtNode) Node
+	RewriteBasicLitNode(n *BasicLitNode) Node
+	RewriteBlockStmtNode(n *BlockStmtNode) Node
+	RewriteChanTypeNode(n *ChanTypeNode) Node
+	RewriteCompareExprNode(n *CompareExprNode) Node
+	RewriteConstGroupDeclNode(n *ConstGroupDeclNode) Node
+	RewriteConstIdentNode(n *ConstIdentNode) Node
+	RewriteConstOneDeclNode(n *ConstOneDeclNode) Node
+	RewriteDecStmtNode(n *DecStmtNode) Node
+	RewriteDeferStmtNode(n *DeferStmtNode) Node
+	RewriteEllipsisNode(n *EllipsisNode) Node
+	RewriteEllipsisArgumentNode(n *EllipsisArgumentNode) Node
+	RewriteEllipsisParameterNode(n *EllipsisParameterNode) Node
+	RewriteExprStmtNode(n *ExprStmtNode) Node
+	RewriteFallthroughStmtNode(n *FallthroughStmtNode) Node
+	RewriteFieldNode(n *FieldNode) Node
+	RewriteFieldListNode(n *FieldListNode) Node
+	RewriteFunctionIdentNode(n *FunctionIdentNode) Node
+	RewriteFunctionLitNode(n *FunctionLitNode) Node
+	RewriteGenericParameterDeclNode(n *GenericParameterDeclNode) Node
+	RewriteGenericParameterIdentNode(n *GenericParameterIdentNode) Node
+	RewriteGenericTypeConstraintNode(n *GenericTypeConstraintNode) Node
+	RewriteGenericTypeInstantiationNode(n *GenericTypeInstantiationNode) Node
+	RewriteGenericUnderlyingTypeConstraintNode(n *GenericUnderlyingTypeConstraintNode) Node
+	RewriteGenericUnionConstraintNode(n *GenericUnionConstraintNode) Node
+	RewriteGoStmtNode(n *GoStmtNode) Node
+	RewriteGotoStmtNode(n *GotoStmtNode) Node
+	RewriteIdentNode(n *IdentNode) Node
+	RewriteIfStmtNode(n *IfStmtNode) Node
+	RewriteImportDotNode(n *ImportDotNode) Node
+	RewriteImportGroupDeclNode(n *ImportGroupDeclNode) Node
+	RewriteImportIdentNode(n *ImportIdentNode) Node
+	RewriteImportOneDeclNode(n *ImportOneDeclNode) Node
+	RewriteImportPathNode(n *ImportPathNode) Node
+	RewriteIncStmtNode(n *IncStmtNode) Node
+	RewriteIndexExprNode(n *IndexExprNode) Node
+	RewriteInterfaceTypeNode(n *InterfaceTypeNode) Node
+	RewriteKeyValueExprNode(n *KeyValueExprNode) Node
+	RewriteLabelIdentNode(n *LabelIdentNode) Node
+	RewriteLabeledStmtNode(n *LabeledStmtNode) Node
+	RewriteLogicalAndExprNode(n *LogicalAndExprNode) Node
+	RewriteLogicalOrExprNode(n *LogicalOrExprNode) Node
+	RewriteMapTypeNode(n *MapTypeNode) Node
+	RewriteMethodIdentNode(n *MethodIdentNode) Node
+	RewriteMulOpExprNode(n *MulOpExprNode) Node
+	RewriteNameParameterNode(n *NameParameterNode) Node
+	RewriteNameTypeParameterNode(n *NameTypeParameterNode) Node
+	RewriteNewExprNode(n *NewExprNode) Node
+	RewriteNumberExprNode(n *NumberExprNode) Node
+	RewritePackageDeclNode(n *PackageDeclNode) Node
+	RewritePackageIdentNode(n *PackageIdentNode) Node
+	RewriteParameterDeclNode(n *ParameterDeclNode) Node
+	RewriteParameterIdentNode(n *ParameterIdentNode) Node
+	RewriteParenExprNode(n *ParenExprNode) Node
+	RewriteReceiverGenericTypeDeclNode(n *ReceiverGenericTypeDeclNode) Node
+	RewriteReceiverGenericTypeIdentNode(n *ReceiverGenericTypeIdentNode) Node
+	RewriteReceiverIdentNode(n *ReceiverIdentNode) Node
+	RewriteReceiverTypeIdentNode(n *ReceiverTypeIdentNode) Node
+	RewriteResultGroupDeclNode(n *ResultGroupDeclNode) Node
+	RewriteResultIdentNode(n *ResultIdentNode) Node
+	RewriteResultNameNode(n *ResultNameNode) Node
+	RewriteResultNameTypeNode(n *ResultNameTypeNode) Node
+	RewriteResultOneDeclNode(n *ResultOneDeclNode) Node
+	RewriteResultTypesDeclNode(n *ResultTypesDeclNode) Node
+	RewriteSelectStmtNode(n *SelectStmtNode) Node
+	RewriteSelectorExprNode(n *SelectorExprNode) Node
+	RewriteSendStmtNode(n *SendStmtNode) Node
+	RewriteShortVarDeclNode(n *ShortVarDeclNode) Node
+	RewriteStarExprNode(n *StarExprNode) Node
+	RewriteStringExprNode(n *StringExprNode) Node
+	RewriteStructTypeNode(n *StructTypeNode) Node
+	RewriteTypeArgumentDeclNode(n *TypeArgumentDeclNode) Node
+	RewriteTypeAssertExprNode(n *TypeAssertExprNode) Node
+	RewriteTypeDeclNode(n *TypeDeclNode) Node
+	RewriteTypeIdentNode(n *TypeIdentNode) Node
+	RewriteTypeSwitchGuardIdentNode(n *TypeSwitchGuardIdentNode) Node
+	RewriteUnaryExprNode(n *UnaryExprNode) Node
+	RewriteVarDeclNode(n *VarDeclNode) Node
+	RewriteVarIdentNode(n *VarIdentNode) Node
+	RewriteBreakStmtNode(n *BreakStmtNode) Node
+	RewriteCallExprNode(n *CallExprNode) Node
+	RewriteCompositeLitNode(n *CompositeLitNode) Node
+	RewriteConstNameTypeValueNode(n *ConstNameTypeValueNode) Node
+	RewriteContinueStmtNode(n *ContinueStmtNode) Node
+	RewriteDefaultClauseNode(n *DefaultClauseNode) Node
+	RewriteExprCaseClauseNode(n *ExprCaseClauseNode) Node
+	RewriteFileNode(n *FileNode) Node
+	RewriteForAssignRangeStmtNode(n *ForAssignRangeStmtNode) Node
+	RewriteForDeclRangeStmtNode(n *ForDeclRangeStmtNode) Node
+	RewriteForStmtNode(n *ForStmtNode) Node
+	RewriteFullSliceExprNode(n *FullSliceExprNode) Node
+	RewriteFunctionDeclNode(n *FunctionDeclNode) Node
+	RewriteFunctionTypeNode(n *FunctionTypeNode) Node
+	RewriteGenericParameterNode(n *GenericParameterNode) Node
+	RewriteImportNamePathNode(n *ImportNamePathNode) Node
+	RewriteMakeChanExprNode(n *MakeChanExprNode) Node
+	RewriteMakeMapExprNode(n *MakeMapExprNode) Node
+	RewriteMakeSliceExprNode(n *MakeSliceExprNode) Node
+	RewriteMethodDeclNode(n *MethodDeclNode) Node
+	RewriteReceiverDeclNode(n *ReceiverDeclNode) Node
+	RewriteReceiverTypeNode(n *ReceiverTypeNode) Node
+	RewriteReturnStmtNode(n *ReturnStmtNode) Node
+	RewriteSelectCaseClauseNode(n *SelectCaseClauseNode) Node
+	RewriteSliceExprNode(n *SliceExprNode) Node
+	RewriteStarReceiverTypeNode(n *StarReceiverTypeNode) Node
+	RewriteSwitchStmtNode(n *SwitchStmtNode) Node
+	RewriteTypeCaseClauseNode(n *TypeCaseClauseNode) Node
+	RewriteTypeEqSpecNode(n *TypeEqSpecNode) Node
+	RewriteTypeSpecNode(n *TypeSpecNode) Node
+	RewriteTypeSwitchGuardNode(n *TypeSwitchGuardNode) Node
+	RewriteTypeSwitchStmtNode(n *TypeSwitchStmtNode) Node
+	RewriteVarSpecNode(n *VarSpecNode) Node
 }
 
-func (n *TypeEqSpecNode) Visit(beforeChildren func(node Node) (visitChildren, exit bool), afterChildren func(node Node) (exit bool)) (exit bool) {
-	vc, e := beforeChildren(n)
-	if e {
-		return true
-	}
-	if !vc {
-		return false
-	}
-	if n.name.Visit(beforeChildren, afterChildren) {
-		return true
-	}
-	if n.typeParams.Visit(beforeChildren, afterChildren) {
-		return true
-	}
-	if n.type_.Visit(beforeChildren, afterChildren) {
-		return true
-	}
-	if afterChildren(n) {
-		return true
-	}
-	return false
+type BaseRewriter struct{}
+
+func (r *BaseRewriter) RewriteTokenNode(n *TokenNode) Node {
+	return n
 }
 
-func (n *TypeEqSpecNode) Dump(hook func(Node, map[string]string) string) map[string]string {
-	ret := make(map[string]string)
-	ret["kind"] = "\"type_eq_spec\""
-	ret["name"] = DumpNode(n.Name(), hook)
-	ret["type_params"] = DumpNode(n.TypeParams(), hook)
-	ret["type"] = DumpNode(n.Type(), hook)
-	return ret
+func (r *BaseRewriter) RewriteNodesNode(n *NodesNode) Node {
+	return n
 }
 
-func NewTypeSpecNode(filePath string, fileContent []rune, name Node, typeParams Node, type_ Node, start, end Position) Node {
-	if name == nil {
-		name = DummyNode
-	}
-	if typeParams == nil {
-		typeParams = DummyNode
-	}
-	if type_ == nil {
-		type_ = DummyNode
-	}
-	_1 := &TypeSpecNode{
-		BaseNode:   NewBaseNode(filePath, fileContent, NodeTypeTypeSpec, start, end),
-		name:       name,
-		typeParams: typeParams,
-		type_:      type_,
-	}
-	creationHook(_1)
-	return _1
+func (r *BaseRewriter) RewriteAddOpExprNode(n *AddOpExprNode) Node {
+	return n
 }
 
-type TypeSpecNode struct {
-	*BaseNode
-	name       Node
-	typeParams Node
-	type_      Node
+func (r *BaseRewriter) RewriteArgumentNode(n *ArgumentNode) Node {
+	return n
 }
 
-func (n *TypeSpecNode) Name() Node {
-	return n.name
+func (r *BaseRewriter) RewriteArgumentDeclNode(n *ArgumentDeclNode) Node {
+	return n
 }
 
-func (n *TypeSpecNode) SetName(v Node) {
-	n.name = v
+func (r *BaseRewriter) RewriteArrayTypeNode(n *ArrayTypeNode) Node {
+	return n
 }
 
-func (n *TypeSpecNode) TypeParams() Node {
-	return n.typeParams
+func (r *BaseRewriter) RewriteAssignStmtNode(n *AssignStmtNode) Node {
+	return n
 }
 
-func (n *TypeSpecNode) SetTypeParams(v Node) {
-	n.typeParams = v
+func (r *BaseRewriter) RewriteAugAssignStmtNode(n *AugAssignStmtNode) Node {
+	return n
 }
 
-func (n *TypeSpecNode) Type() Node {
-	return n.type_
+func (r *BaseRewriter) RewriteBasicLitNode(n *BasicLitNode) Node {
+	return n
 }
 
-func (n *TypeSpecNode) SetType(v Node) {
-	n.type_ = v
+func (r *BaseRewriter) RewriteBlockStmtNode(n *BlockStmtNode) Node {
+	return n
 }
 
-func (n *TypeSpecNode) BuildLink() {
-	if !n.Name().IsDummy() {
-		name := n.Name()
-		name.BuildLink()
-		name.SetParent(n)
-		name.SetSelfField("name")
-		name.SetReplaceSelf(func(n Node) {
-			n.Parent().(*TypeSpecNode).SetName(n)
-		})
-	}
-	if !n.TypeParams().IsDummy() {
-		typeParams := n.TypeParams()
-		typeParams.BuildLink()
-		typeParams.SetParent(n)
-		typeParams.SetSelfField("type_params")
-		typeParams.SetReplaceSelf(func(n Node) {
-			n.Parent().(*TypeSpecNode).SetTypeParams(n)
-		})
-	}
-	if !n.Type().IsDummy() {
-		type_ := n.Type()
-		type_.BuildLink()
-		type_.SetParent(n)
-		type_.SetSelfField("type_")
-		type_.SetReplaceSelf(func(n Node) {
-			n.Parent().(*TypeSpecNode).SetType(n)
-		})
-	}
+func (r *BaseRewriter) RewriteChanTypeNode(n *ChanTypeNode) Node {
+	return n
 }
 
-func (n *TypeSpecNode) Fields() []string {
-	return []string{
-		"name",
-		"type_params",
-		"type_",
-	}
+func (r *BaseRewriter) RewriteCompareExprNode(n *CompareExprNode) Node {
+	return n
 }
 
-func (n *TypeSpecNode) Child(field string) Node {
-	if field == "" {
-		return nil
-	}
-	if field == "name" {
-		return n.Name()
-	}
-	if field == "type_params" {
-		return n.TypeParams()
-	}
-	if field == "type_" {
-		return n.Type()
-	}
-	return nil
+func (r *BaseRewriter) RewriteConstGroupDeclNode(n *ConstGroupDeclNode) Node {
+	return n
 }
 
-func (n *TypeSpecNode) SetChild(nodes []Node) {
-	if len(nodes) != 3 {
-		return
-	}
-	n.SetName(nodes[0])
-	n.SetTypeParams(nodes[1])
-	n.SetType(nodes[2])
+func (r *BaseRewriter) RewriteConstIdentNode(n *ConstIdentNode) Node {
+	return n
 }
 
-func (n *TypeSpecNode) Fork() Node {
-	_ret := &TypeSpecNode{
-		BaseNode:   n.BaseNode.fork(),
-		name:       n.name.Fork(),
-		typeParams: n.typeParams.Fork(),
-		type_:      n.type_.Fork(),
-	}
-	_ret.name.SetParent(_ret)
-	_ret.typeParams.SetParent(_ret)
-	_ret.type_.SetParent(_ret)
-	return _ret
+func (r *BaseRewriter) RewriteConstOneDeclNode(n *ConstOneDeclNode) Node {
+	return n
 }
 
-func (n *TypeSpecNode) Visit(beforeChildren func(node Node) (visitChildren, exit bool), afterChildren func(node Node) (exit bool)) (exit bool) {
-	vc, e := beforeChildren(n)
-	if e {
-		return true
-	}
-	if !vc {
-		return false
-	}
-	if n.name.Visit(beforeChildren, afterChildren) {
-		return true
-	}
-	if n.typeParams.Visit(beforeChildren, afterChildren) {
-		return true
-	}
-	if n.type_.Visit(beforeChildren, afterChildren) {
-		return true
-	}
-	if afterChildren(n) {
-		return true
-	}
-	return false
+func (r *BaseRewriter) RewriteDecStmtNode(n *DecStmtNode) Node {
+	return n
 }
 
-func (n *TypeSpecNode) Dump(hook func(Node, map[string]string) string) map[string]string {
-	ret := make(map[string]string)
-	ret["kind"] = "\"type_spec\""
-	ret["name"] = DumpNode(n.Name(), hook)
-	ret["type_params"] = DumpNode(n.TypeParams(), hook)
-	ret["type"] = DumpNode(n.Type(), hook)
-	return ret
+func (r *BaseRewriter) RewriteDeferStmtNode(n *DeferStmtNode) Node {
+	return n
 }
 
-func NewConstDeclNode(filePath string, fileContent []rune, specs Node, start, end Position) Node {
-	if specs == nil {
-		specs = DummyNode
-	}
-	_1 := &ConstDeclNode{
-		BaseNode: NewBaseNode(filePath, fileContent, NodeTypeConstDecl, start, end),
-		specs:    specs,
-	}
-	creationHook(_1)
-	return _1
+func (r *BaseRewriter) RewriteEllipsisNode(n *EllipsisNode) Node {
+	return n
 }
 
-type ConstDeclNode struct {
-	*BaseNode
-	specs Node
+func (r *BaseRewriter) RewriteEllipsisArgumentNode(n *EllipsisArgumentNode) Node {
+	return n
 }
 
-func (n *ConstDeclNode) Specs() Node {
-	return n.specs
+func (r *BaseRewriter) RewriteEllipsisParameterNode(n *EllipsisParameterNode) Node {
+	return n
 }
 
-func (n *ConstDeclNode) SetSpecs(v Node) {
-	n.specs = v
+func (r *BaseRewriter) RewriteExprStmtNode(n *ExprStmtNode) Node {
+	return n
 }
 
-func (n *ConstDeclNode) BuildLink() {
-	if !n.Specs().IsDummy() {
-		specs := n.Specs()
-		specs.BuildLink()
-		specs.SetParent(n)
-		specs.SetSelfField("specs")
-		specs.SetReplaceSelf(func(n Node) {
-			n.Parent().(*ConstDeclNode).SetSpecs(n)
-		})
-	}
+func (r *BaseRewriter) RewriteFallthroughStmtNode(n *FallthroughStmtNode) Node {
+	return n
 }
 
-func (n *ConstDeclNode) Fields() []string {
-	return []string{
-		"specs",
-	}
+func (r *BaseRewriter) RewriteFieldNode(n *FieldNode) Node {
+	return n
 }
 
-func (n *ConstDeclNode) Child(field string) Node {
-	if field == "" {
-		return nil
-	}
-	if field == "specs" {
-		return n.Specs()
-	}
-	return nil
+func (r *BaseRewriter) RewriteFieldListNode(n *FieldListNode) Node {
+	return n
 }
 
-func (n *ConstDeclNode) SetChild(nodes []Node) {
-	if len(nodes) != 1 {
-		return
-	}
-	n.SetSpecs(nodes[0])
+func (r *BaseRewriter) RewriteFunctionIdentNode(n *FunctionIdentNode) Node {
+	return n
 }
 
-func (n *ConstDeclNode) Fork() Node {
-	_ret := &ConstDeclNode{
-		BaseNode: n.BaseNode.fork(),
-		specs:    n.specs.Fork(),
-	}
-	_ret.specs.SetParent(_ret)
-	return _ret
+func (r *BaseRewriter) RewriteFunctionLitNode(n *FunctionLitNode) Node {
+	return n
 }
 
-func (n *ConstDeclNode) Visit(beforeChildren func(node Node) (visitChildren, exit bool), afterChildren func(node Node) (exit bool)) (exit bool) {
-	vc, e := beforeChildren(n)
-	if e {
-		return true
-	}
-	if !vc {
-		return false
-	}
-	if n.specs.Visit(beforeChildren, afterChildren) {
-		return true
-	}
-	if afterChildren(n) {
-		return true
-	}
-	return false
+func (r *BaseRewriter) RewriteGenericParameterDeclNode(n *GenericParameterDeclNode) Node {
+	return n
 }
 
-func (n *ConstDeclNode) Dump(hook func(Node, map[string]string) string) map[string]string {
-	ret := make(map[string]string)
-	ret["kind"] = "\"const_decl\""
-	ret["specs"] = DumpNode(n.Specs(), hook)
-	return ret
+func (r *BaseRewriter) RewriteGenericParameterIdentNode(n *GenericParameterIdentNode) Node {
+	return n
 }
 
-func NewVarDeclNode(filePath string, fileContent []rune, specs Node, start, end Position) Node {
-	if specs == nil {
-		specs = DummyNode
-	}
-	_1 := &VarDeclNode{
-		BaseNode: NewBaseNode(filePath, fileContent, NodeTypeVarDecl, start, end),
-		specs:    specs,
-	}
-	creationHook(_1)
-	return _1
+func (r *BaseRewriter) RewriteGenericTypeConstraintNode(n *GenericTypeConstraintNode) Node {
+	return n
 }
 
-type VarDeclNode struct {
-	*BaseNode
-	specs Node
+func (r *BaseRewriter) RewriteGenericTypeInstantiationNode(n *GenericTypeInstantiationNode) Node {
+	return n
 }
 
-func (n *VarDeclNode) Specs() Node {
-	return n.specs
+func (r *BaseRewriter) RewriteGenericUnderlyingTypeConstraintNode(n *GenericUnderlyingTypeConstraintNode) Node {
+	return n
 }
 
-func (n *VarDeclNode) SetSpecs(v Node) {
-	n.specs = v
+func (r *BaseRewriter) RewriteGenericUnionConstraintNode(n *GenericUnionConstraintNode) Node {
+	return n
 }
 
-func (n *VarDeclNode) BuildLink() {
-	if !n.Specs().IsDummy() {
-		specs := n.Specs()
-		specs.BuildLink()
-		specs.SetParent(n)
-		specs.SetSelfField("specs")
-		specs.SetReplaceSelf(func(n Node) {
-			n.Parent().(*VarDeclNode).SetSpecs(n)
-		})
-	}
+func (r *BaseRewriter) RewriteGoStmtNode(n *GoStmtNode) Node {
+	return n
 }
 
-func (n *VarDeclNode) Fields() []string {
-	return []string{
-		"specs",
-	}
+func (r *BaseRewriter) RewriteGotoStmtNode(n *GotoStmtNode) Node {
+	return n
 }
 
-func (n *VarDeclNode) Child(field string) Node {
-	if field == "" {
-		return nil
-	}
-	if field == "specs" {
-		return n.Specs()
-	}
-	return nil
+func (r *BaseRewriter) RewriteIdentNode(n *IdentNode) Node {
+	return n
 }
 
-func (n *VarDeclNode) SetChild(nodes []Node) {
-	if len(nodes) != 1 {
-		return
-	}
-	n.SetSpecs(nodes[0])
+func (r *BaseRewriter) RewriteIfStmtNode(n *IfStmtNode) Node {
+	return n
 }
 
-func (n *VarDeclNode) Fork() Node {
-	_ret := &VarDeclNode{
-		BaseNode: n.BaseNode.fork(),
-		specs:    n.specs.Fork(),
-	}
-	_ret.specs.SetParent(_ret)
-	return _ret
+func (r *BaseRewriter) RewriteImportDotNode(n *ImportDotNode) Node {
+	return n
 }
 
-func (n *VarDeclNode) Visit(beforeChildren func(node Node) (visitChildren, exit bool), afterChildren func(node Node) (exit bool)) (exit bool) {
-	vc, e := beforeChildren(n)
-	if e {
-		return true
-	}
-	if !vc {
-		return false
-	}
-	if n.specs.Visit(beforeChildren, afterChildren) {
-		return true
-	}
-	if afterChildren(n) {
-		return true
-	}
-	return false
+func (r *BaseRewriter) RewriteImportGroupDeclNode(n *ImportGroupDeclNode) Node {
+	return n
 }
 
-func (n *VarDeclNode) Dump(hook func(Node, map[string]string) string) map[string]string {
-	ret := make(map[string]string)
-	ret["kind"] = "\"var_decl\""
-	ret["specs"] = DumpNode(n.Specs(), hook)
-	return ret
+func (r *BaseRewriter) RewriteImportIdentNode(n *ImportIdentNode) Node {
+	return n
 }
 
-func NewTypeDeclNode(filePath string, fileContent []rune, specs Node, start, end Position) Node {
-	if specs == nil {
-		specs = DummyNode
-	}
-	_1 := &TypeDeclNode{
-		BaseNode: NewBaseNode(filePath, fileContent, NodeTypeTypeDecl, start, end),
-		specs:    specs,
-	}
-	creationHook(_1)
-	return _1
+func (r *BaseRewriter) RewriteImportOneDeclNode(n *ImportOneDeclNode) Node {
+	return n
 }
 
-type TypeDeclNode struct {
-	*BaseNode
-	specs Node
+func (r *BaseRewriter) RewriteImportPathNode(n *ImportPathNode) Node {
+	return n
 }
 
-func (n *TypeDeclNode) Specs() Node {
-	return n.specs
+func (r *BaseRewriter) RewriteIncStmtNode(n *IncStmtNode) Node {
+	return n
 }
 
-func (n *TypeDeclNode) SetSpecs(v Node) {
-	n.specs = v
+func (r *BaseRewriter) RewriteIndexExprNode(n *IndexExprNode) Node {
+	return n
 }
 
-func (n *TypeDeclNode) BuildLink() {
-	if !n.Specs().IsDummy() {
-		specs := n.Specs()
-		specs.BuildLink()
-		specs.SetParent(n)
-		specs.SetSelfField("specs")
-		specs.SetReplaceSelf(func(n Node) {
-			n.Parent().(*TypeDeclNode).SetSpecs(n)
-		})
-	}
+func (r *BaseRewriter) RewriteInterfaceTypeNode(n *InterfaceTypeNode) Node {
+	return n
 }
 
-func (n *TypeDeclNode) Fields() []string {
-	return []string{
-		"specs",
-	}
+func (r *BaseRewriter) RewriteKeyValueExprNode(n *KeyValueExprNode) Node {
+	return n
 }
 
-func (n *TypeDeclNode) Child(field string) Node {
-	if field == "" {
-		return nil
-	}
-	if field == "specs" {
-		return n.Specs()
-	}
-	return nil
+func (r *BaseRewriter) RewriteLabelIdentNode(n *LabelIdentNode) Node {
+	return n
 }
 
-func (n *TypeDeclNode) SetChild(nodes []Node) {
-	if len(nodes) != 1 {
-		return
-	}
-	n.SetSpecs(nodes[0])
+func (r *BaseRewriter) RewriteLabeledStmtNode(n *LabeledStmtNode) Node {
+	return n
 }
 
-func (n *TypeDeclNode) Fork() Node {
-	_ret := &TypeDeclNode{
-		BaseNode: n.BaseNode.fork(),
-		specs:    n.specs.Fork(),
-	}
-	_ret.specs.SetParent(_ret)
-	return _ret
+func (r *BaseRewriter) RewriteLogicalAndExprNode(n *LogicalAndExprNode) Node {
+	return n
 }
 
-func (n *TypeDeclNode) Visit(beforeChildren func(node Node) (visitChildren, exit bool), afterChildren func(node Node) (exit bool)) (exit bool) {
-	vc, e := beforeChildren(n)
-	if e {
-		return true
-	}
-	if !vc {
-		return false
-	}
-	if n.specs.Visit(beforeChildren, afterChildren) {
-		return true
-	}
-	if afterChildren(n) {
-		return true
-	}
-	return false
+func (r *BaseRewriter) RewriteLogicalOrExprNode(n *LogicalOrExprNode) Node {
+	return n
 }
 
-func (n *TypeDeclNode) Dump(hook func(Node, map[string]string) string) map[string]string {
-	ret := make(map[string]string)
-	ret["kind"] = "\"type_decl\""
-	ret["specs"] = DumpNode(n.Specs(), hook)
-	return ret
+func (r *BaseRewriter) RewriteMapTypeNode(n *MapTypeNode) Node {
+	return n
 }
 
-func NewEllipsisNode(filePath string, fileContent []rune, elt Node, start, end Position) Node {
-	if elt == nil {
-		elt = DummyNode
-	}
-	_1 := &EllipsisNode{
-		BaseNode: NewBaseNode(filePath, fileContent, NodeTypeEllipsis, start, end),
-		elt:      elt,
-	}
-	creationHook(_1)
-	return _1
+func (r *BaseRewriter) RewriteMethodIdentNode(n *MethodIdentNode) Node {
+	return n
 }
 
-type EllipsisNode struct {
-	*BaseNode
-	elt Node
+func (r *BaseRewriter) RewriteMulOpExprNode(n *MulOpExprNode) Node {
+	return n
 }
 
-func (n *EllipsisNode) Elt() Node {
-	return n.elt
+func (r *BaseRewriter) RewriteNameParameterNode(n *NameParameterNode) Node {
+	return n
 }
 
-func (n *EllipsisNode) SetElt(v Node) {
-	n.elt = v
+func (r *BaseRewriter) RewriteNameTypeParameterNode(n *NameTypeParameterNode) Node {
+	return n
 }
 
-func (n *EllipsisNode) BuildLink() {
-	if !n.Elt().IsDummy() {
-		elt := n.Elt()
-		elt.BuildLink()
-		elt.SetParent(n)
-		elt.SetSelfField("elt")
-		elt.SetReplaceSelf(func(n Node) {
-			n.Parent().(*EllipsisNode).SetElt(n)
-		})
-	}
+func (r *BaseRewriter) RewriteNewExprNode(n *NewExprNode) Node {
+	return n
 }
 
-func (n *EllipsisNode) Fields() []string {
-	return []string{
-		"elt",
-	}
+func (r *BaseRewriter) RewriteNumberExprNode(n *NumberExprNode) Node {
+	return n
 }
 
-func (n *EllipsisNode) Child(field string) Node {
-	if field == "" {
-		return nil
-	}
-	if field == "elt" {
-		return n.Elt()
-	}
-	return nil
+func (r *BaseRewriter) RewritePackageDeclNode(n *PackageDeclNode) Node {
+	return n
 }
 
-func (n *EllipsisNode) SetChild(nodes []Node) {
-	if len(nodes) != 1 {
-		return
-	}
-	n.SetElt(nodes[0])
+func (r *BaseRewriter) RewritePackageIdentNode(n *PackageIdentNode) Node {
+	return n
 }
 
-func (n *EllipsisNode) Fork() Node {
-	_ret := &EllipsisNode{
-		BaseNode: n.BaseNode.fork(),
-		elt:      n.elt.Fork(),
-	}
-	_ret.elt.SetParent(_ret)
-	return _ret
+func (r *BaseRewriter) RewriteParameterDeclNode(n *ParameterDeclNode) Node {
+	return n
 }
 
-func (n *EllipsisNode) Visit(beforeChildren func(node Node) (visitChildren, exit bool), afterChildren func(node Node) (exit bool)) (exit bool) {
-	vc, e := beforeChildren(n)
-	if e {
-		return true
-	}
-	if !vc {
-		return false
-	}
-	if n.elt.Visit(beforeChildren, afterChildren) {
-		return true
-	}
-	if afterChildren(n) {
-		return true
-	}
-	return false
+func (r *BaseRewriter) RewriteParameterIdentNode(n *ParameterIdentNode) Node {
+	return n
 }
 
-func (n *EllipsisNode) Dump(hook func(Node, map[string]string) string) map[string]string {
-	ret := make(map[string]string)
-	ret["kind"] = "\"ellipsis\""
-	ret["elt"] = DumpNode(n.Elt(), hook)
-	return ret
+func (r *BaseRewriter) RewriteParenExprNode(n *ParenExprNode) Node {
+	return n
 }
 
-func NewLabeledStmtNode(filePath string, fileContent []rune, label Node, stmt Node, start, end Position) Node {
-	if label == nil {
-		label = DummyNode
-	}
-	if stmt == nil {
-		stmt = DummyNode
-	}
-	_1 := &LabeledStmtNode{
-		BaseNode: NewBaseNode(filePath, fileContent, NodeTypeLabeledStmt, start, end),
-		label:    label,
-		stmt:     stmt,
-	}
-	creationHook(_1)
-	return _1
+func (r *BaseRewriter) RewriteReceiverGenericTypeDeclNode(n *ReceiverGenericTypeDeclNode) Node {
+	return n
 }
 
-type LabeledStmtNode struct {
-	*BaseNode
-	label Node
-	stmt  Node
+func (r *BaseRewriter) RewriteReceiverGenericTypeIdentNode(n *ReceiverGenericTypeIdentNode) Node {
+	return n
 }
 
-func (n *LabeledStmtNode) Label() Node {
-	return n.label
+func (r *BaseRewriter) RewriteReceiverIdentNode(n *ReceiverIdentNode) Node {
+	return n
 }
 
-func (n *LabeledStmtNode) SetLabel(v Node) {
-	n.label = v
+func (r *BaseRewriter) RewriteReceiverTypeIdentNode(n *ReceiverTypeIdentNode) Node {
+	return n
 }
 
-func (n *LabeledStmtNode) Stmt() Node {
-	return n.stmt
+func (r *BaseRewriter) RewriteResultGroupDeclNode(n *ResultGroupDeclNode) Node {
+	return n
 }
 
-func (n *LabeledStmtNode) SetStmt(v Node) {
-	n.stmt = v
+func (r *BaseRewriter) RewriteResultIdentNode(n *ResultIdentNode) Node {
+	return n
 }
 
-func (n *LabeledStmtNode) BuildLink() {
-	if !n.Label().IsDummy() {
-		label := n.Label()
-		label.BuildLink()
-		label.SetParent(n)
-		label.SetSelfField("label")
-		label.SetReplaceSelf(func(n Node) {
-			n.Parent().(*LabeledStmtNode).SetLabel(n)
-		})
-	}
-	if !n.Stmt().IsDummy() {
-		stmt := n.Stmt()
-		stmt.BuildLink()
-		stmt.SetParent(n)
-		stmt.SetSelfField("stmt")
-		stmt.SetReplaceSelf(func(n Node) {
-			n.Parent().(*LabeledStmtNode).SetStmt(n)
-		})
-	}
+func (r *BaseRewriter) RewriteResultNameNode(n *ResultNameNode) Node {
+	return n
 }
 
-func (n *LabeledStmtNode) Fields() []string {
-	return []string{
-		"label",
-		"stmt",
-	}
+func (r *BaseRewriter) RewriteResultNameTypeNode(n *ResultNameTypeNode) Node {
+	return n
 }
 
-func (n *LabeledStmtNode) Child(field string) Node {
-	if field == "" {
-		return nil
-	}
-	if field == "label" {
-		return n.Label()
-	}
-	if field == "stmt" {
-		return n.Stmt()
-	}
-	return nil
+func (r *BaseRewriter) RewriteResultOneDeclNode(n *ResultOneDeclNode) Node {
+	return n
 }
 
-func (n *LabeledStmtNode) SetChild(nodes []Node) {
-	if len(nodes) != 2 {
-		return
-	}
-	n.SetLabel(nodes[0])
-	n.SetStmt(nodes[1])
+func (r *BaseRewriter) RewriteResultTypesDeclNode(n *ResultTypesDeclNode) Node {
+	return n
 }
 
-func (n *LabeledStmtNode) Fork() Node {
-	_ret := &LabeledStmtNode{
-		BaseNode: n.BaseNode.fork(),
-		label:    n.label.Fork(),
-		stmt:     n.stmt.Fork(),
-	}
-	_ret.label.SetParent(_ret)
-	_ret.stmt.SetParent(_ret)
-	return _ret
+func (r *BaseRewriter) RewriteSelectStmtNode(n *SelectStmtNode) Node {
+	return n
 }
 
-func (n *LabeledStmtNode) Visit(beforeChildren func(node Node) (visitChildren, exit bool), afterChildren func(node Node) (exit bool)) (exit bool) {
-	vc, e := beforeChildren(n)
-	if e {
-		return true
-	}
-	if !vc {
-		return false
-	}
-	if n.label.Visit(beforeChildren, afterChildren) {
-		return true
-	}
-	if n.stmt.Visit(beforeChildren, afterChildren) {
-		return true
-	}
-	if afterChildren(n) {
-		return true
-	}
-	return false
+func (r *BaseRewriter) RewriteSelectorExprNode(n *SelectorExprNode) Node {
+	return n
 }
 
-func (n *LabeledStmtNode) Dump(hook func(Node, map[string]string) string) map[string]string {
-	ret := make(map[string]string)
-	ret["kind"] = "\"labeled_stmt\""
-	ret["label"] = DumpNode(n.Label(), hook)
-	ret["stmt"] = DumpNode(n.Stmt(), hook)
-	return ret
+func (r *BaseRewriter) RewriteSendStmtNode(n *SendStmtNode) Node {
+	return n
 }
 
-func NewGenericTypeInstantiationNode(filePath string, fileContent []rune, base Node, args Node, start, end Position) Node {
-	if base == nil {
-		base = DummyNode
-	}
-	if args == nil {
-		args = DummyNode
-	}
-	_1 := &GenericTypeInstantiationNode{
-		BaseNode: NewBaseNode(filePath, fileContent, NodeTypeGenericTypeInstantiation, start, end),
-		base:     base,
-		args:     args,
-	}
-	creationHook(_1)
-	return _1
+func (r *BaseRewriter) RewriteShortVarDeclNode(n *ShortVarDeclNode) Node {
+	return n
 }
 
-type GenericTypeInstantiationNode struct {
-	*BaseNode
-	base Node
-	args Node
+func (r *BaseRewriter) RewriteStarExprNode(n *StarExprNode) Node {
+	return n
 }
 
-func (n *GenericTypeInstantiationNode) Base() Node {
-	return n.base
+func (r *BaseRewriter) RewriteStringExprNode(n *StringExprNode) Node {
+	return n
 }
 
-func (n *GenericTypeInstantiationNode) SetBase(v Node) {
-	n.base = v
+func (r *BaseRewriter) RewriteStructTypeNode(n *StructTypeNode) Node {
+	return n
 }
 
-func (n *GenericTypeInstantiationNode) Args() Node {
-	return n.args
+func (r *BaseRewriter) RewriteTypeArgumentDeclNode(n *TypeArgumentDeclNode) Node {
+	return n
 }
 
-func (n *GenericTypeInstantiationNode) SetArgs(v Node) {
-	n.args = v
+func (r *BaseRewriter) RewriteTypeAssertExprNode(n *TypeAssertExprNode) Node {
+	return n
 }
 
-func (n *GenericTypeInstantiationNode) BuildLink() {
-	if !n.Base().IsDummy() {
-		base := n.Base()
-		base.BuildLink()
-		base.SetParent(n)
-		base.SetSelfField("base")
-		base.SetReplaceSelf(func(n Node) {
-			n.Parent().(*GenericTypeInstantiationNode).SetBase(n)
-		})
-	}
-	if !n.Args().IsDummy() {
-		args := n.Args()
-		args.BuildLink()
-		args.SetParent(n)
-		args.SetSelfField("args")
-		args.SetReplaceSelf(func(n Node) {
-			n.Parent().(*GenericTypeInstantiationNode).SetArgs(n)
-		})
-	}
+func (r *BaseRewriter) RewriteTypeDeclNode(n *TypeDeclNode) Node {
+	return n
 }
 
-func (n *GenericTypeInstantiationNode) Fields() []string {
-	return []string{
-		"base",
-		"args",
-	}
+func (r *BaseRewriter) RewriteTypeIdentNode(n *TypeIdentNode) Node {
+	return n
 }
 
-func (n *GenericTypeInstantiationNode) Child(field string) Node {
-	if field == "" {
-		return nil
-	}
-	if field == "base" {
-		return n.Base()
-	}
-	if field == "args" {
-		return n.Args()
-	}
-	return nil
+func (r *BaseRewriter) RewriteTypeSwitchGuardIdentNode(n *TypeSwitchGuardIdentNode) Node {
+	return n
 }
 
-func (n *GenericTypeInstantiationNode) SetChild(nodes []Node) {
-	if len(nodes) != 2 {
-		return
-	}
-	n.SetBase(nodes[0])
-	n.SetArgs(nodes[1])
+func (r *BaseRewriter) RewriteUnaryExprNode(n *UnaryExprNode) Node {
+	return n
 }
 
-func (n *GenericTypeInstantiationNode) Fork() Node {
-	_ret := &GenericTypeInstantiationNode{
-		BaseNode: n.BaseNode.fork(),
-		base:     n.base.Fork(),
-		args:     n.args.Fork(),
-	}
-	_ret.base.SetParent(_ret)
-	_ret.args.SetParent(_ret)
-	return _ret
+func (r *BaseRewriter) RewriteVarDeclNode(n *VarDeclNode) Node {
+	return n
 }
 
-func (n *GenericTypeInstantiationNode) Visit(beforeChildren func(node Node) (visitChildren, exit bool), afterChildren func(node Node) (exit bool)) (exit bool) {
-	vc, e := beforeChildren(n)
-	if e {
-		return true
-	}
-	if !vc {
-		return false
-	}
-	if n.base.Visit(beforeChildren, afterChildren) {
-		return true
-	}
-	if n.args.Visit(beforeChildren, afterChildren) {
-		return true
-	}
-	if afterChildren(n) {
-		return true
-	}
-	return false
+func (r *BaseRewriter) RewriteVarIdentNode(n *VarIdentNode) Node {
+	return n
 }
 
-func (n *GenericTypeInstantiationNode) Dump(hook func(Node, map[string]string) string) map[string]string {
-	ret := make(map[string]string)
-	ret["kind"] = "\"generic_type_instantiation\""
-	ret["base"] = DumpNode(n.Base(), hook)
-	ret["args"] = DumpNode(n.Args(), hook)
-	return ret
+func (r *BaseRewriter) RewriteBreakStmtNode(n *BreakStmtNode) Node {
+	return n
 }
 
-func NewIdentNode(filePath string, fileContent []rune, x Node, start, end Position) Node {
-	if x == nil {
-		x = DummyNode
-	}
-	_1 := &IdentNode{
-		BaseNode: NewBaseNode(filePath, fileContent, NodeTypeIdent, start, end),
-		x:        x,
-	}
-	creationHook(_1)
-	return _1
+func (r *BaseRewriter) RewriteCallExprNode(n *CallExprNode) Node {
+	return n
 }
 
-type IdentNode struct {
-	*BaseNode
-	x Node
+func (r *BaseRewriter) RewriteCompositeLitNode(n *CompositeLitNode) Node {
+	return n
 }
 
-func (n *IdentNode) X() Node {
-	return n.x
+func (r *BaseRewriter) RewriteConstNameTypeValueNode(n *ConstNameTypeValueNode) Node {
+	return n
 }
 
-func (n *IdentNode) SetX(v Node) {
-	n.x = v
+func (r *BaseRewriter) RewriteContinueStmtNode(n *ContinueStmtNode) Node {
+	return n
 }
 
-func (n *IdentNode) BuildLink() {
-	if !n.X().IsDummy() {
-		x := n.X()
-		x.BuildLink()
-		x.SetParent(n)
-		x.SetSelfField("x")
-		x.SetReplaceSelf(func(n Node) {
-			n.Parent().(*IdentNode).SetX(n)
-		})
-	}
+func (r *BaseRewriter) RewriteDefaultClauseNode(n *DefaultClauseNode) Node {
+	return n
 }
 
-func (n *IdentNode) Fields() []string {
-	return []string{
-		"x",
-	}
+func (r *BaseRewriter) RewriteExprCaseClauseNode(n *ExprCaseClauseNode) Node {
+	return n
 }
 
-func (n *IdentNode) Child(field string) Node {
-	if field == "" {
-		return nil
-	}
-	if field == "x" {
-		return n.X()
-	}
-	return nil
+func (r *BaseRewriter) RewriteFileNode(n *FileNode) Node {
+	return n
 }
 
-func (n *IdentNode) SetChild(nodes []Node) {
-	if len(nodes) != 1 {
-		return
-	}
-	n.SetX(nodes[0])
+func (r *BaseRewriter) RewriteForAssignRangeStmtNode(n *ForAssignRangeStmtNode) Node {
+	return n
 }
 
-func (n *IdentNode) Fork() Node {
-	_ret := &IdentNode{
-		BaseNode: n.BaseNode.fork(),
-		x:        n.x.Fork(),
-	}
-	_ret.x.SetParent(_ret)
-	return _ret
+func (r *BaseRewriter) RewriteForDeclRangeStmtNode(n *ForDeclRangeStmtNode) Node {
+	return n
 }
 
-func (n *IdentNode) Visit(beforeChildren func(node Node) (visitChildren, exit bool), afterChildren func(node Node) (exit bool)) (exit bool) {
-	vc, e := beforeChildren(n)
-	if e {
-		return true
-	}
-	if !vc {
-		return false
-	}
-	if n.x.Visit(beforeChildren, afterChildren) {
-		return true
-	}
-	if afterChildren(n) {
-		return true
-	}
-	return false
+func (r *BaseRewriter) RewriteForStmtNode(n *ForStmtNode) Node {
+	return n
 }
 
-func (n *IdentNode) Dump(hook func(Node, map[string]string) string) map[string]string {
-	ret := make(map[string]string)
-	ret["kind"] = "\"ident\""
-	ret["x"] = DumpNode(n.X(), hook)
-	return ret
+func (r *BaseRewriter) RewriteFullSliceExprNode(n *FullSliceExprNode) Node {
+	return n
 }
 
-func NewMakeExprNode(filePath string, fileContent []rune, x Node, start, end Position) Node {
-	if x == nil {
-		x = DummyNode
-	}
-	_1 := &MakeExprNode{
-		BaseNode: NewBaseNode(filePath, fileContent, NodeTypeMakeExpr, start, end),
-		x:        x,
-	}
-	creationHook(_1)
-	return _1
+func (r *BaseRewriter) RewriteFunctionDeclNode(n *FunctionDeclNode) Node {
+	return n
 }
 
-type MakeExprNode struct {
-	*BaseNode
-	x Node
+func (r *BaseRewriter) RewriteFunctionTypeNode(n *FunctionTypeNode) Node {
+	return n
 }
 
-func (n *MakeExprNode) X() Node {
-	return n.x
+func (r *BaseRewriter) RewriteGenericParameterNode(n *GenericParameterNode) Node {
+	return n
 }
 
-func (n *MakeExprNode) SetX(v Node) {
-	n.x = v
+func (r *BaseRewriter) RewriteImportNamePathNode(n *ImportNamePathNode) Node {
+	return n
 }
 
-func (n *MakeExprNode) BuildLink() {
-	if !n.X().IsDummy() {
-		x := n.X()
-		x.BuildLink()
-		x.SetParent(n)
-		x.SetSelfField("x")
-		x.SetReplaceSelf(func(n Node) {
-			n.Parent().(*MakeExprNode).SetX(n)
-		})
-	}
+func (r *BaseRewriter) RewriteMakeChanExprNode(n *MakeChanExprNode) Node {
+	return n
 }
 
-func (n *MakeExprNode) Fields() []string {
-	return []string{
-		"x",
-	}
+func (r *BaseRewriter) RewriteMakeMapExprNode(n *MakeMapExprNode) Node {
+	return n
 }
 
-func (n *MakeExprNode) Child(field string) Node {
-	if field == "" {
-		return nil
-	}
-	if field == "x" {
-		return n.X()
-	}
-	return nil
+func (r *BaseRewriter) RewriteMakeSliceExprNode(n *MakeSliceExprNode) Node {
+	return n
 }
 
-func (n *MakeExprNode) SetChild(nodes []Node) {
-	if len(nodes) != 1 {
-		return
-	}
-	n.SetX(nodes[0])
+func (r *BaseRewriter) RewriteMethodDeclNode(n *MethodDeclNode) Node {
+	return n
 }
 
-func (n *MakeExprNode) Fork() Node {
-	_ret := &MakeExprNode{
-		BaseNode: n.BaseNode.fork(),
-		x:        n.x.Fork(),
-	}
-	_ret.x.SetParent(_ret)
-	return _ret
+func (r *BaseRewriter) RewriteReceiverDeclNode(n *ReceiverDeclNode) Node {
+	return n
 }
 
-func (n *MakeExprNode) Visit(beforeChildren func(node Node) (visitChildren, exit bool), afterChildren func(node Node) (exit bool)) (exit bool) {
-	vc, e := beforeChildren(n)
-	if e {
-		return true
-	}
-	if !vc {
-		return false
-	}
-	if n.x.Visit(beforeChildren, afterChildren) {
-		return true
-	}
-	if afterChildren(n) {
-		return true
-	}
-	return false
+func (r *BaseRewriter) RewriteReceiverTypeNode(n *ReceiverTypeNode) Node {
+	return n
 }
 
-func (n *MakeExprNode) Dump(hook func(Node, map[string]string) string) map[string]string {
-	ret := make(map[string]string)
-	ret["kind"] = "\"make_expr\""
-	ret["x"] = DumpNode(n.X(), hook)
-	return ret
+func (r *BaseRewriter) RewriteReturnStmtNode(n *ReturnStmtNode) Node {
+	return n
 }
 
-func NewNewExprNode(filePath string, fileContent []rune, x Node, start, end Position) Node {
-	if x == nil {
-		x = DummyNode
-	}
-	_1 := &NewExprNode{
-		BaseNode: NewBaseNode(filePath, fileContent, NodeTypeNewExpr, start, end),
-		x:        x,
-	}
-	creationHook(_1)
-	return _1
+func (r *BaseRewriter) RewriteSelectCaseClauseNode(n *SelectCaseClauseNode) Node {
+	return n
 }
 
-type NewExprNode struct {
-	*BaseNode
-	x Node
+func (r *BaseRewriter) RewriteSliceExprNode(n *SliceExprNode) Node {
+	return n
 }
 
-func (n *NewExprNode) X() Node {
-	return n.x
+func (r *BaseRewriter) RewriteStarReceiverTypeNode(n *StarReceiverTypeNode) Node {
+	return n
 }
 
-func (n *NewExprNode) SetX(v Node) {
-	n.x = v
+func (r *BaseRewriter) RewriteSwitchStmtNode(n *SwitchStmtNode) Node {
+	return n
 }
 
-func (n *NewExprNode) BuildLink() {
-	if !n.X().IsDummy() {
-		x := n.X()
-		x.BuildLink()
-		x.SetParent(n)
-		x.SetSelfField("x")
-		x.SetReplaceSelf(func(n Node) {
-			n.Parent().(*NewExprNode).SetX(n)
-		})
-	}
+func (r *BaseRewriter) RewriteTypeCaseClauseNode(n *TypeCaseClauseNode) Node {
+	return n
 }
 
-func (n *NewExprNode) Fields() []string {
-	return []string{
-		"x",
-	}
+func (r *BaseRewriter) RewriteTypeEqSpecNode(n *TypeEqSpecNode) Node {
+	return n
 }
 
-func (n *NewExprNode) Child(field string) Node {
-	if field == "" {
-		return nil
-	}
-	if field == "x" {
-		return n.X()
-	}
-	return nil
+func (r *BaseRewriter) RewriteTypeSpecNode(n *TypeSpecNode) Node {
+	return n
 }
 
-func (n *NewExprNode) SetChild(nodes []Node) {
-	if len(nodes) != 1 {
-		return
-	}
-	n.SetX(nodes[0])
+func (r *BaseRewriter) RewriteTypeSwitchGuardNode(n *TypeSwitchGuardNode) Node {
+	return n
 }
 
-func (n *NewExprNode) Fork() Node {
-	_ret := &NewExprNode{
-		BaseNode: n.BaseNode.fork(),
-		x:        n.x.Fork(),
-	}
-	_ret.x.SetParent(_ret)
-	return _ret
+func (r *BaseRewriter) RewriteTypeSwitchStmtNode(n *TypeSwitchStmtNode) Node {
+	return n
 }
 
-func (n *NewExprNode) Visit(beforeChildren func(node Node) (visitChildren, exit bool), afterChildren func(node Node) (exit bool)) (exit bool) {
-	vc, e := beforeChildren(n)
-	if e {
-		return true
-	}
-	if !vc {
+func (r *BaseRewriter) RewriteVarSpecNode(n *VarSpecNode) Node {
+	return n
+}
+
+// Expr is implemented by expression nodes.
+type Expr interface {
+	Node
+	exprNode()
+}
+
+type ExprVisitor interface {
+	VisitExpr(n Expr) (visitChildren bool)
+}
+
+func WalkExpr(v ExprVisitor, node Node) {
+	node.Visit(func(n Node) (bool, bool) {
+		if x, ok := n.(Expr); ok {
+			return v.VisitExpr(x), false
+		}
+		return true, false
+	}, func(Node) bool {
 		return false
-	}
-	if n.x.Visit(beforeChildren, afterChildren) {
-		return true
-	}
-	if afterChildren(n) {
-		return true
-	}
-	return false
+	})
 }
 
-func (n *NewExprNode) Dump(hook func(Node, map[string]string) string) map[string]string {
-	ret := make(map[string]string)
-	ret["kind"] = "\"new_expr\""
-	ret["x"] = DumpNode(n.X(), hook)
-	return ret
+// Stmt is implemented by statement nodes.
+type Stmt interface {
+	Node
+	stmtNode()
 }
 
-func NewPackageIdentNode(filePath string, fileContent []rune, ident Node, start, end Position) Node {
-	if ident == nil {
-		ident = DummyNode
+type StmtVisitor interface {
+	VisitStmt(n Stmt) (visitChildren bool)
+}
+
+func WalkStmt(v StmtVisitor, node Node) {
+	node.Visit(func(n Node) (bool, bool) {
+		if x, ok := n.(Stmt); ok {
+			return v.VisitStmt(x), false
+		}
+		return true, false
+	}, func(Node) bool {
+		return false
+	})
+}
+
+// Type is implemented by type literal nodes.
+type Type interface {
+	Node
+	typeNode()
+}
+
+type TypeVisitor interface {
+	VisitType(n Type) (visitChildren bool)
+}
+
+func WalkType(v TypeVisitor, node Node) {
+	node.Visit(func(n Node) (bool, bool) {
+		if x, ok := n.(Type); ok {
+			return v.VisitType(x), false
+		}
+		return true, false
+	}, func(Node) bool {
+		return false
+	})
+}
+
+// Decl is implemented by declaration nodes.
+type Decl interface {
+	Node
+	declNode()
+}
+
+type DeclVisitor interface {
+	VisitDecl(n Decl) (visitChildren bool)
+}
+
+func WalkDecl(v DeclVisitor, node Node) {
+	node.Visit(func(n Node) (bool, bool) {
+		if x, ok := n.(Decl); ok {
+			return v.VisitDecl(x), false
+		}
+		return true, false
+	}, func(Node) bool {
+		return false
+	})
+}
+
+var nodeKindCategories = map[string][]string{
+	NodeTypeAddOpExpr: {"expr"},
+	NodeTypeArrayType: {"type"},
+	NodeTypeAssignStmt: {"stmt"},
+	NodeTypeAugAssignStmt: {"stmt"},
+	NodeTypeBasicLit: {"expr"},
+	NodeTypeBlockStmt: {"stmt"},
+	NodeTypeChanType: {"type"},
+	NodeTypeCompareExpr: {"expr"},
+	NodeTypeConstGroupDecl: {"decl"},
+	NodeTypeConstOneDecl: {"decl"},
+	NodeTypeDecStmt: {"stmt"},
+	NodeTypeDeferStmt: {"stmt"},
+	NodeTypeExprStmt: {"stmt"},
+	NodeTypeFallthroughStmt: {"stmt"},
+	NodeTypeFunctionLit: {"expr"},
+	NodeTypeGenericTypeInstantiation: {"expr", "type"},
+	NodeTypeGoStmt: {"stmt"},
+	NodeTypeGotoStmt: {"stmt"},
+	NodeTypeIdent: {"expr"},
+	NodeTypeIfStmt: {"stmt"},
+	NodeTypeImportGroupDecl: {"decl"},
+	NodeTypeImportOneDecl: {"decl"},
+	NodeTypeIncStmt: {"stmt"},
+	NodeTypeIndexExpr: {"expr"},
+	NodeTypeInterfaceType: {"type"},
+	NodeTypeKeyValueExpr: {"expr"},
+	NodeTypeLabeledStmt: {"stmt"},
+	NodeTypeLogicalAndExpr: {"expr"},
+	NodeTypeLogicalOrExpr: {"expr"},
+	NodeTypeMapType: {"type"},
+	NodeTypeMulOpExpr: {"expr"},
+	NodeTypeNewExpr: {"expr"},
+	NodeTypeNumberExpr: {"expr"},
+	NodeTypeParenExpr: {"expr"},
+	NodeTypeSelectStmt: {"stmt"},
+	NodeTypeSelectorExpr: {"expr"},
+	NodeTypeSendStmt: {"stmt"},
+	NodeTypeShortVarDecl: {"stmt"},
+	NodeTypeStarExpr: {"expr"},
+	NodeTypeStringExpr: {"expr"},
+	NodeTypeStructType: {"type"},
+	NodeTypeTypeAssertExpr: {"expr"},
+	NodeTypeTypeDecl: {"decl"},
+	NodeTypeUnaryExpr: {"expr"},
+	NodeTypeVarDecl: {"decl"},
+	NodeTypeBreakStmt: {"stmt"},
+	NodeTypeCallExpr: {"expr"},
+	NodeTypeCompositeLit: {"expr"},
+	NodeTypeContinueStmt: {"stmt"},
+	NodeTypeForAssignRangeStmt: {"stmt"},
+	NodeTypeForDeclRangeStmt: {"stmt"},
+	NodeTypeForStmt: {"stmt"},
+	NodeTypeFullSliceExpr: {"expr"},
+	NodeTypeFunctionDecl: {"decl"},
+	NodeTypeFunctionType: {"type"},
+	NodeTypeMakeChanExpr: {"expr"},
+	NodeTypeMakeMapExpr: {"expr"},
+	NodeTypeMakeSliceExpr: {"expr"},
+	NodeTypeMethodDecl: {"decl"},
+	NodeTypeReturnStmt: {"stmt"},
+	NodeTypeSliceExpr: {"expr"},
+	NodeTypeSwitchStmt: {"stmt"},
+	NodeTypeTypeSwitchStmt: {"stmt"},
+}
+
+func NodeCategory(kind string) []string {
+	return nodeKindCategories[kind]
+}
+
+func NewAddOpExprNode(filePath string, fileContent []rune, lhs Node, op Node, rhs Node, start, end Position) Node {
+	if lhs == nil {
+		lhs = DummyNode
 	}
-	_1 := &PackageIdentNode{
-		BaseNode: NewBaseNode(filePath, fileContent, NodeTypePackageIdent, start, end),
-		ident:    ident,
+	if op == nil {
+		op = DummyNode
+	}
+	if rhs == nil {
+		rhs = DummyNode
+	}
+	_1 := &AddOpExprNode{
+		BaseNode: NewBaseNode(filePath, fileContent, NodeTypeAddOpExpr, start, end),
+		lhs:      lhs,
+		op:       op,
+		rhs:      rhs,
 	}
 	creationHook(_1)
 	return _1
 }
 
-type PackageIdentNode struct {
+type AddOpExprNode struct {
 	*BaseNode
-	ident Node
+	lhs Node
+	op  Node
+	rhs Node
 }
 
-func (n *PackageIdentNode) Ident() Node {
-	return n.ident
+func (n *AddOpExprNode) Lhs() Node {
+	return n.lhs
 }
 
-func (n *PackageIdentNode) SetIdent(v Node) {
-	n.ident = v
+func (n *AddOpExprNode) SetLhs(v Node) {
+	n.lhs = v
 }
 
-func (n *PackageIdentNode) BuildLink() {
-	if !n.Ident().IsDummy() {
-		ident := n.Ident()
-		ident.BuildLink()
-		ident.SetParent(n)
-		ident.SetSelfField("ident")
-		ident.SetReplaceSelf(func(n Node) {
-			n.Parent().(*PackageIdentNode).SetIdent(n)
+func (n *AddOpExprNode) Op() Node {
+	return n.op
+}
+
+func (n *AddOpExprNode) SetOp(v Node) {
+	n.op = v
+}
+
+func (n *AddOpExprNode) Rhs() Node {
+	return n.rhs
+}
+
+func (n *AddOpExprNode) SetRhs(v Node) {
+	n.rhs = v
+}
+
+func (n *AddOpExprNode) BuildLink() {
+	if !n.Lhs().IsDummy() {
+		lhs := n.Lhs()
+		lhs.BuildLink()
+		lhs.SetParent(n)
+		lhs.SetSelfField("lhs")
+		lhs.SetReplaceSelf(func(n Node) {
+			n.Parent().(*AddOpExprNode).SetLhs(n)
+		})
+	}
+	if !n.Op().IsDummy() {
+		op := n.Op()
+		op.BuildLink()
+		op.SetParent(n)
+		op.SetSelfField("op")
+		op.SetReplaceSelf(func(n Node) {
+			n.Parent().(*AddOpExprNode).SetOp(n)
+		})
+	}
+	if !n.Rhs().IsDummy() {
+		rhs := n.Rhs()
+		rhs.BuildLink()
+		rhs.SetParent(n)
+		rhs.SetSelfField("rhs")
+		rhs.SetReplaceSelf(func(n Node) {
+			n.Parent().(*AddOpExprNode).SetRhs(n)
 		})
 	}
 }
 
-func (n *PackageIdentNode) Fields() []string {
+func (n *AddOpExprNode) Fields() []string {
 	return []string{
-		"ident",
+		"lhs",
+		"op",
+		"rhs",
 	}
 }
 
-func (n *PackageIdentNode) Child(field string) Node {
+func (n *AddOpExprNode) Child(field string) Node {
 	if field == "" {
 		return nil
 	}
-	if field == "ident" {
-		return n.Ident()
+	if field == "lhs" {
+		return n.Lhs()
+	}
+	if field == "op" {
+		return n.Op()
+	}
+	if field == "rhs" {
+		return n.Rhs()
 	}
 	return nil
 }
 
-func (n *PackageIdentNode) SetChild(nodes []Node) {
-	if len(nodes) != 1 {
+func (n *AddOpExprNode) SetChild(nodes []Node) {
+	if len(nodes) != 3 {
 		return
 	}
-	n.SetIdent(nodes[0])
+	n.SetLhs(nodes[0])
+	n.SetOp(nodes[1])
+	n.SetRhs(nodes[2])
 }
 
-func (n *PackageIdentNode) Fork() Node {
-	_ret := &PackageIdentNode{
+func (n *AddOpExprNode) Fork() Node {
+	_ret := &AddOpExprNode{
 		BaseNode: n.BaseNode.fork(),
-		ident:    n.ident.Fork(),
+		lhs:      n.lhs.Fork(),
+		op:       n.op.Fork(),
+		rhs:      n.rhs.Fork(),
 	}
-	_ret.ident.SetParent(_ret)
+	_ret.lhs.SetParent(_ret)
+	_ret.op.SetParent(_ret)
+	_ret.rhs.SetParent(_ret)
 	return _ret
 }
 
-func (n *PackageIdentNode) Visit(beforeChildren func(node Node) (visitChildren, exit bool), afterChildren func(node Node) (exit bool)) (exit bool) {
+func (n *AddOpExprNode) Visit(beforeChildren func(node Node) (visitChildren, exit bool), afterChildren func(node Node) (exit bool)) (exit bool) {
 	vc, e := beforeChildren(n)
 	if e {
 		return true
@@ -3322,7 +3322,13 @@ func (n *PackageIdentNode) Visit(beforeChildren func(node Node) (visitChildren,
 	if !vc {
 		return false
 	}
-	if n.ident.Visit(beforeChildren, afterChildren) {
+	if n.lhs.Visit(beforeChildren, afterChildren) {
+		return true
+	}
+	if n.op.Visit(beforeChildren, afterChildren) {
+		return true
+	}
+	if n.rhs.Visit(beforeChildren, afterChildren) {
 		return true
 	}
 	if afterChildren(n) {
@@ -3331,83 +3337,98 @@ func (n *PackageIdentNode) Visit(beforeChildren func(node Node) (visitChildren,
 	return false
 }
 
-func (n *PackageIdentNode) Dump(hook func(Node, map[string]string) string) map[string]string {
+func (n *AddOpExprNode) Accept(v Visitor) bool {
+	return v.VisitAddOpExprNode(n)
+}
+
+func (n *AddOpExprNode) exprNode() {}
+
+func (n *AddOpExprNode) Rewrite(r Rewriter) Node {
+	n.lhs = rewriteChild(r, n.lhs)
+	n.op = rewriteChild(r, n.op)
+	n.rhs = rewriteChild(r, n.rhs)
+	return r.RewriteAddOpExprNode(n)
+}
+
+func (n *AddOpExprNode) Dump(hook func(Node, map[string]string) string) map[string]string {
 	ret := make(map[string]string)
-	ret["kind"] = "\"package_ident\""
-	ret["ident"] = DumpNode(n.Ident(), hook)
+	ret["kind"] = "\"add_op_expr\""
+	ret["lhs"] = DumpNode(n.Lhs(), hook)
+	ret["op"] = DumpNode(n.Op(), hook)
+	ret["rhs"] = DumpNode(n.Rhs(), hook)
 	return ret
 }
 
-func NewImportDotNode(filePath string, fileContent []rune, dot Node, start, end Position) Node {
-	if dot == nil {
-		dot = DummyNode
+func NewArgumentNode(filePath string, fileContent []rune, expr Node, start, end Position) Node {
+	if expr == nil {
+		expr = DummyNode
 	}
-	_1 := &ImportDotNode{
-		BaseNode: NewBaseNode(filePath, fileContent, NodeTypeImportDot, start, end),
-		dot:      dot,
+	_1 := &ArgumentNode{
+		BaseNode: NewBaseNode(filePath, fileContent, NodeTypeArgument, start, end),
+		expr:     expr,
 	}
 	creationHook(_1)
 	return _1
 }
 
-type ImportDotNode struct {
+type ArgumentNode struct {
 	*BaseNode
-	dot Node
+	expr Node
 }
 
-func (n *ImportDotNode) Dot() Node {
-	return n.dot
+func (n *ArgumentNode) Expr() Node {
+	return n.expr
 }
 
-func (n *ImportDotNode) SetDot(v Node) {
-	n.dot = v
+func (n *ArgumentNode) SetExpr(v Node) {
+	n.expr = v
 }
 
-func (n *ImportDotNode) BuildLink() {
-	if !n.Dot().IsDummy() {
-		dot := n.Dot()
-		dot.BuildLink()
-		dot.SetParent(n)
-		dot.SetSelfField("dot")
-		dot.SetReplaceSelf(func(n Node) {
-			n.Parent().(*ImportDotNode).SetDot(n)
+func (n *ArgumentNode) BuildLink() {
+	if !n.Expr().IsDummy() {
+		expr := n.Expr()
+		expr.BuildLink()
+		expr.SetParent(n)
+		expr.SetSelfField("expr")
+		expr.SetReplaceSelf(func(n Node) {
+			n.Parent().(*ArgumentNode).SetExpr(n)
 		})
 	}
 }
 
-func (n *ImportDotNode) Fields() []string {
+func (n *ArgumentNode) Fields() []string {
 	return []string{
-		"dot",
+		"expr",
 	}
 }
 
-func (n *ImportDotNode) Child(field string) Node {
+func (n *ArgumentNode) Child(field string) Node {
 	if field == "" {
 		return nil
 	}
-	if field == "dot" {
-		return n.Dot()
+	if field == "expr" {
+		return n.Expr()
 	}
 	return nil
 }
 
-func (n *ImportDotNode) SetChild(nodes []Node) {
+func (n *ArgumentNode) SetChild(nodes []Node) {
 	if len(nodes) != 1 {
 		return
 	}
-	n.SetDot(nodes[0])
+	n.SetExpr(nodes[0])
 }
 
-func (n *ImportDotNode) Fork() Node {
-	_ret := &ImportDotNode{
+func (n *ArgumentNode) Fork() Node {
+	_ret := &ArgumentNode{
 		BaseNode: n.BaseNode.fork(),
-		dot:      n.dot.Fork(),
+		expr:     n.expr.Fork(),
 	}
-	_ret.dot.SetParent(_ret)
+	_ret.expr.SetParent(_ret)
 	return _ret
 }
 
-func (n *ImportDotNode) Visit(beforeChildren func(node Node) (visitChildren, exit bool), afterChildren func(node Node) (exit bool)) (exit bool) {
+func (n *ArgumentNode) Visit(beforeChildren func(node Node) (visitChildren, exit bool), afterChildren func(node Node) (exit bool)) (exit bool) {
 	vc, e := beforeChildren(n)
 	if e {
 		return true
@@ -3415,7 +3436,7 @@ func (n *ImportDotNode) Visit(beforeChildren func(node Node) (visitChildren, exi
 	if !vc {
 		return false
 	}
-	if n.dot.Visit(beforeChildren, afterChildren) {
+	if n.expr.Visit(beforeChildren, afterChildren) {
 		return true
 	}
 	if afterChildren(n) {
@@ -3424,83 +3445,92 @@ func (n *ImportDotNode) Visit(beforeChildren func(node Node) (visitChildren, exi
 	return false
 }
 
-func (n *ImportDotNode) Dump(hook func(Node, map[string]string) string) map[string]string {
+func (n *ArgumentNode) Accept(v Visitor) bool {
+	return v.VisitArgumentNode(n)
+}
+
+func (n *ArgumentNode) Rewrite(r Rewriter) Node {
+	n.expr = rewriteChild(r, n.expr)
+	return r.RewriteArgumentNode(n)
+}
+
+func (n *ArgumentNode) Dump(hook func(Node, map[string]string) string) map[string]string {
 	ret := make(map[string]string)
-	ret["kind"] = "\"import_dot\""
-	ret["dot"] = DumpNode(n.Dot(), hook)
+	ret["kind"] = "\"argument\""
+	ret["expr"] = DumpNode(n.Expr(), hook)
 	return ret
 }
 
-func NewImportIdentNode(filePath string, fileContent []rune, ident Node, start, end Position) Node {
-	if ident == nil {
-		ident = DummyNode
+func NewArgumentDeclNode(filePath string, fileContent []rune, arguments Node, start, end Position) Node {
+	if arguments == nil {
+		arguments = DummyNode
 	}
-	_1 := &ImportIdentNode{
-		BaseNode: NewBaseNode(filePath, fileContent, NodeTypeImportIdent, start, end),
-		ident:    ident,
+	_1 := &ArgumentDeclNode{
+		BaseNode:  NewBaseNode(filePath, fileContent, NodeTypeArgumentDecl, start, end),
+		arguments: arguments,
 	}
 	creationHook(_1)
 	return _1
 }
 
-type ImportIdentNode struct {
+type ArgumentDeclNode struct {
 	*BaseNode
-	ident Node
+	arguments Node
 }
 
-func (n *ImportIdentNode) Ident() Node {
-	return n.ident
+func (n *ArgumentDeclNode) Arguments() Node {
+	return n.arguments
 }
 
-func (n *ImportIdentNode) SetIdent(v Node) {
-	n.ident = v
+func (n *ArgumentDeclNode) SetArguments(v Node) {
+	n.arguments = v
 }
 
-func (n *ImportIdentNode) BuildLink() {
-	if !n.Ident().IsDummy() {
-		ident := n.Ident()
-		ident.BuildLink()
-		ident.SetParent(n)
-		ident.SetSelfField("ident")
-		ident.SetReplaceSelf(func(n Node) {
-			n.Parent().(*ImportIdentNode).SetIdent(n)
+func (n *ArgumentDeclNode) BuildLink() {
+	if !n.Arguments().IsDummy() {
+		arguments := n.Arguments()
+		arguments.BuildLink()
+		arguments.SetParent(n)
+		arguments.SetSelfField("arguments")
+		arguments.SetReplaceSelf(func(n Node) {
+			n.Parent().(*ArgumentDeclNode).SetArguments(n)
 		})
 	}
 }
 
-func (n *ImportIdentNode) Fields() []string {
+func (n *ArgumentDeclNode) Fields() []string {
 	return []string{
-		"ident",
+		"arguments",
 	}
 }
 
-func (n *ImportIdentNode) Child(field string) Node {
+func (n *ArgumentDeclNode) Child(field string) Node {
 	if field == "" {
 		return nil
 	}
-	if field == "ident" {
-		return n.Ident()
+	if field == "arguments" {
+		return n.Arguments()
 	}
 	return nil
 }
 
-func (n *ImportIdentNode) SetChild(nodes []Node) {
+func (n *ArgumentDeclNode) SetChild(nodes []Node) {
 	if len(nodes) != 1 {
 		return
 	}
-	n.SetIdent(nodes[0])
+	n.SetArguments(nodes[0])
 }
 
-func (n *ImportIdentNode) Fork() Node {
-	_ret := &ImportIdentNode{
-		BaseNode: n.BaseNode.fork(),
-		ident:    n.ident.Fork(),
+func (n *ArgumentDeclNode) Fork() Node {
+	_ret := &ArgumentDeclNode{
+		BaseNode:  n.BaseNode.fork(),
+		arguments: n.arguments.Fork(),
 	}
-	_ret.ident.SetParent(_ret)
+	_ret.arguments.SetParent(_ret)
 	return _ret
 }
 
-func (n *ImportIdentNode) Visit(beforeChildren func(node Node) (visitChildren, exit bool), afterChildren func(node Node) (exit bool)) (exit bool) {
+func (n *ArgumentDeclNode) Visit(beforeChildren func(node Node) (visitChildren, exit bool), afterChildren func(node Node) (exit bool)) (exit bool) {
 	vc, e := beforeChildren(n)
 	if e {
 		return true
@@ -3508,7 +3538,7 @@ func (n *ImportIdentNode) Visit(beforeChildren func(node Node) (visitChildren, e
 	if !vc {
 		return false
 	}
-	if n.ident.Visit(beforeChildren, afterChildren) {
+	if n.arguments.Visit(beforeChildren, afterChildren) {
 		return true
 	}
 	if afterChildren(n) {
@@ -3517,83 +3547,121 @@ func (n *ImportIdentNode) Visit(beforeChildren func(node Node) (visitChildren, e
 	return false
 }
 
-func (n *ImportIdentNode) Dump(hook func(Node, map[string]string) string) map[string]string {
+func (n *ArgumentDeclNode) Accept(v Visitor) bool {
+	return v.VisitArgumentDeclNode(n)
+}
+
+func (n *ArgumentDeclNode) Rewrite(r Rewriter) Node {
+	n.arguments = rewriteChild(r, n.arguments)
+	return r.RewriteArgumentDeclNode(n)
+}
+
+func (n *ArgumentDeclNode) Dump(hook func(Node, map[string]string) string) map[string]string {
 	ret := make(map[string]string)
-	ret["kind"] = "\"import_ident\""
-	ret["ident"] = DumpNode(n.Ident(), hook)
+	ret["kind"] = "\"argument_decl\""
+	ret["arguments"] = DumpNode(n.Arguments(), hook)
 	return ret
 }
 
-func NewImportPathNode(filePath string, fileContent []rune, path Node, start, end Position) Node {
-	if path == nil {
-		path = DummyNode
+func NewArrayTypeNode(filePath string, fileContent []rune, e Node, x Node, start, end Position) Node {
+	if e == nil {
+		e = DummyNode
 	}
-	_1 := &ImportPathNode{
-		BaseNode: NewBaseNode(filePath, fileContent, NodeTypeImportPath, start, end),
-		path:     path,
+	if x == nil {
+		x = DummyNode
+	}
+	_1 := &ArrayTypeNode{
+		BaseNode: NewBaseNode(filePath, fileContent, NodeTypeArrayType, start, end),
+		e:        e,
+		x:        x,
 	}
 	creationHook(_1)
 	return _1
 }
 
-type ImportPathNode struct {
+type ArrayTypeNode struct {
 	*BaseNode
-	path Node
+	e Node
+	x Node
 }
 
-func (n *ImportPathNode) Path() Node {
-	return n.path
+func (n *ArrayTypeNode) E() Node {
+	return n.e
 }
 
-func (n *ImportPathNode) SetPath(v Node) {
-	n.path = v
+func (n *ArrayTypeNode) SetE(v Node) {
+	n.e = v
 }
 
-func (n *ImportPathNode) BuildLink() {
-	if !n.Path().IsDummy() {
-		path := n.Path()
-		path.BuildLink()
-		path.SetParent(n)
-		path.SetSelfField("path")
-		path.SetReplaceSelf(func(n Node) {
-			n.Parent().(*ImportPathNode).SetPath(n)
+func (n *ArrayTypeNode) X() Node {
+	return n.x
+}
+
+func (n *ArrayTypeNode) SetX(v Node) {
+	n.x = v
+}
+
+func (n *ArrayTypeNode) BuildLink() {
+	if !n.E().IsDummy() {
+		e := n.E()
+		e.BuildLink()
+		e.SetParent(n)
+		e.SetSelfField("e")
+		e.SetReplaceSelf(func(n Node) {
+			n.Parent().(*ArrayTypeNode).SetE(n)
+		})
+	}
+	if !n.X().IsDummy() {
+		x := n.X()
+		x.BuildLink()
+		x.SetParent(n)
+		x.SetSelfField("x")
+		x.SetReplaceSelf(func(n Node) {
+			n.Parent().(*ArrayTypeNode).SetX(n)
 		})
 	}
 }
 
-func (n *ImportPathNode) Fields() []string {
+func (n *ArrayTypeNode) Fields() []string {
 	return []string{
-		"path",
+		"e",
+		"x",
 	}
 }
 
-func (n *ImportPathNode) Child(field string) Node {
+func (n *ArrayTypeNode) Child(field string) Node {
 	if field == "" {
 		return nil
 	}
-	if field == "path" {
-		return n.Path()
+	if field == "e" {
+		return n.E()
+	}
+	if field == "x" {
+		return n.X()
 	}
 	return nil
 }
 
-func (n *ImportPathNode) SetChild(nodes []Node) {
-	if len(nodes) != 1 {
+func (n *ArrayTypeNode) SetChild(nodes []Node) {
+	if len(nodes) != 2 {
 		return
 	}
-	n.SetPath(nodes[0])
+	n.SetE(nodes[0])
+	n.SetX(nodes[1])
 }
 
-func (n *ImportPathNode) Fork() Node {
-	_ret := &ImportPathNode{
+func (n *ArrayTypeNode) Fork() Node {
+	_ret := &ArrayTypeNode{
 		BaseNode: n.BaseNode.fork(),
-		path:     n.path.Fork(),
+		e:        n.e.Fork(),
+		x:        n.x.Fork(),
 	}
-	_ret.path.SetParent(_ret)
+	_ret.e.SetParent(_ret)
+	_ret.x.SetParent(_ret)
 	return _ret
 }
 
-func (n *ImportPathNode) Visit(beforeChildren func(node Node) (visitChildren, exit bool), afterChildren func(node Node) (exit bool)) (exit bool) {
+func (n *ArrayTypeNode) Visit(beforeChildren func(node Node) (visitChildren, exit bool), afterChildren func(node Node) (exit bool)) (exit bool) {
 	vc, e := beforeChildren(n)
 	if e {
 		return true
@@ -3601,7 +3669,10 @@ func (n *ImportPathNode) Visit(beforeChildren func(node Node) (visitChildren, ex
 	if !vc {
 		return false
 	}
-	if n.path.Visit(beforeChildren, afterChildren) {
+	if n.e.Visit(beforeChildren, afterChildren) {
+		return true
+	}
+	if n.x.Visit(beforeChildren, afterChildren) {
 		return true
 	}
 	if afterChildren(n) {
@@ -3610,83 +3681,125 @@ func (n *ImportPathNode) Visit(beforeChildren func(node Node) (visitChildren, ex
 	return false
 }
 
-func (n *ImportPathNode) Dump(hook func(Node, map[string]string) string) map[string]string {
+func (n *ArrayTypeNode) Accept(v Visitor) bool {
+	return v.VisitArrayTypeNode(n)
+}
+
+func (n *ArrayTypeNode) typeNode() {}
+
+func (n *ArrayTypeNode) Rewrite(r Rewriter) Node {
+	n.e = rewriteChild(r, n.e)
+	n.x = rewriteChild(r, n.x)
+	return r.RewriteArrayTypeNode(n)
+}
+
+func (n *ArrayTypeNode) Dump(hook func(Node, map[string]string) string) map[string]string {
 	ret := make(map[string]string)
-	ret["kind"] = "\"import_path\""
-	ret["path"] = DumpNode(n.Path(), hook)
+	ret["kind"] = "\"array_type\""
+	ret["e"] = DumpNode(n.E(), hook)
+	ret["x"] = DumpNode(n.X(), hook)
 	return ret
 }
 
-func NewConstIdentNode(filePath string, fileContent []rune, ident Node, start, end Position) Node {
-	if ident == nil {
-		ident = DummyNode
+func NewAssignStmtNode(filePath string, fileContent []rune, l Node, r Node, start, end Position) Node {
+	if l == nil {
+		l = DummyNode
 	}
-	_1 := &ConstIdentNode{
-		BaseNode: NewBaseNode(filePath, fileContent, NodeTypeConstIdent, start, end),
-		ident:    ident,
+	if r == nil {
+		r = DummyNode
+	}
+	_1 := &AssignStmtNode{
+		BaseNode: NewBaseNode(filePath, fileContent, NodeTypeAssignStmt, start, end),
+		l:        l,
+		r:        r,
 	}
 	creationHook(_1)
 	return _1
 }
 
-type ConstIdentNode struct {
+type AssignStmtNode struct {
 	*BaseNode
-	ident Node
+	l Node
+	r Node
 }
 
-func (n *ConstIdentNode) Ident() Node {
-	return n.ident
+func (n *AssignStmtNode) L() Node {
+	return n.l
 }
 
-func (n *ConstIdentNode) SetIdent(v Node) {
-	n.ident = v
+func (n *AssignStmtNode) SetL(v Node) {
+	n.l = v
 }
 
-func (n *ConstIdentNode) BuildLink() {
-	if !n.Ident().IsDummy() {
-		ident := n.Ident()
-		ident.BuildLink()
-		ident.SetParent(n)
-		ident.SetSelfField("ident")
-		ident.SetReplaceSelf(func(n Node) {
-			n.Parent().(*ConstIdentNode).SetIdent(n)
+func (n *AssignStmtNode) R() Node {
+	return n.r
+}
+
+func (n *AssignStmtNode) SetR(v Node) {
+	n.r = v
+}
+
+func (n *AssignStmtNode) BuildLink() {
+	if !n.L().IsDummy() {
+		l := n.L()
+		l.BuildLink()
+		l.SetParent(n)
+		l.SetSelfField("l")
+		l.SetReplaceSelf(func(n Node) {
+			n.Parent().(*AssignStmtNode).SetL(n)
+		})
+	}
+	if !n.R().IsDummy() {
+		r := n.R()
+		r.BuildLink()
+		r.SetParent(n)
+		r.SetSelfField("r")
+		r.SetReplaceSelf(func(n Node) {
+			n.Parent().(*AssignStmtNode).SetR(n)
 		})
 	}
 }
 
-func (n *ConstIdentNode) Fields() []string {
+func (n *AssignStmtNode) Fields() []string {
 	return []string{
-		"ident",
+		"l",
+		"r",
 	}
 }
 
-func (n *ConstIdentNode) Child(field string) Node {
+func (n *AssignStmtNode) Child(field string) Node {
 	if field == "" {
 		return nil
 	}
-	if field == "ident" {
-		return n.Ident()
+	if field == "l" {
+		return n.L()
+	}
+	if field == "r" {
+		return n.R()
 	}
 	return nil
 }
 
-func (n *ConstIdentNode) SetChild(nodes []Node) {
-	if len(nodes) != 1 {
+func (n *AssignStmtNode) SetChild(nodes []Node) {
+	if len(nodes) != 2 {
 		return
 	}
-	n.SetIdent(nodes[0])
+	n.SetL(nodes[0])
+	n.SetR(nodes[1])
 }
 
-func (n *ConstIdentNode) Fork() Node {
-	_ret := &ConstIdentNode{
+func (n *AssignStmtNode) Fork() Node {
+	_ret := &AssignStmtNode{
 		BaseNode: n.BaseNode.fork(),
-		ident:    n.ident.Fork(),
+		l:        n.l.Fork(),
+		r:        n.r.Fork(),
 	}
-	_ret.ident.SetParent(_ret)
+	_ret.l.SetParent(_ret)
+	_ret.r.SetParent(_ret)
 	return _ret
 }
 
-func (n *ConstIdentNode) Visit(beforeChildren func(node Node) (visitChildren, exit bool), afterChildren func(node Node) (exit bool)) (exit bool) {
+func (n *AssignStmtNode) Visit(beforeChildren func(node Node) (visitChildren, exit bool), afterChildren func(node Node) (exit bool)) (exit bool) {
 	vc, e := beforeChildren(n)
 	if e {
 		return true
@@ -3694,7 +3807,10 @@ func (n *ConstIdentNode) Visit(beforeChildren func(node Node) (visitChildren, ex
 	if !vc {
 		return false
 	}
-	if n.ident.Visit(beforeChildren, afterChildren) {
+	if n.l.Visit(beforeChildren, afterChildren) {
+		return true
+	}
+	if n.r.Visit(beforeChildren, afterChildren) {
 		return true
 	}
 	if afterChildren(n) {
@@ -3703,83 +3819,154 @@ func (n *ConstIdentNode) Visit(beforeChildren func(node Node) (visitChildren, ex
 	return false
 }
 
-func (n *ConstIdentNode) Dump(hook func(Node, map[string]string) string) map[string]string {
+func (n *AssignStmtNode) Accept(v Visitor) bool {
+	return v.VisitAssignStmtNode(n)
+}
+
+func (n *AssignStmtNode) stmtNode() {}
+
+func (n *AssignStmtNode) Rewrite(r Rewriter) Node {
+	n.l = rewriteChild(r, n.l)
+	n.r = rewriteChild(r, n.r)
+	return r.RewriteAssignStmtNode(n)
+}
+
+func (n *AssignStmtNode) Dump(hook func(Node, map[string]string) string) map[string]string {
 	ret := make(map[string]string)
-	ret["kind"] = "\"const_ident\""
-	ret["ident"] = DumpNode(n.Ident(), hook)
+	ret["kind"] = "\"assign_stmt\""
+	ret["l"] = DumpNode(n.L(), hook)
+	ret["r"] = DumpNode(n.R(), hook)
 	return ret
 }
 
-func NewVarIdentNode(filePath string, fileContent []rune, ident Node, start, end Position) Node {
-	if ident == nil {
-		ident = DummyNode
+func NewAugAssignStmtNode(filePath string, fileContent []rune, l Node, op Node, r Node, start, end Position) Node {
+	if l == nil {
+		l = DummyNode
 	}
-	_1 := &VarIdentNode{
-		BaseNode: NewBaseNode(filePath, fileContent, NodeTypeVarIdent, start, end),
-		ident:    ident,
+	if op == nil {
+		op = DummyNode
+	}
+	if r == nil {
+		r = DummyNode
+	}
+	_1 := &AugAssignStmtNode{
+		BaseNode: NewBaseNode(filePath, fileContent, NodeTypeAugAssignStmt, start, end),
+		l:        l,
+		op:       op,
+		r:        r,
 	}
 	creationHook(_1)
 	return _1
 }
 
-type VarIdentNode struct {
+type AugAssignStmtNode struct {
 	*BaseNode
-	ident Node
+	l  Node
+	op Node
+	r  Node
 }
 
-func (n *VarIdentNode) Ident() Node {
-	return n.ident
+func (n *AugAssignStmtNode) L() Node {
+	return n.l
 }
 
-func (n *VarIdentNode) SetIdent(v Node) {
-	n.ident = v
+func (n *AugAssignStmtNode) SetL(v Node) {
+	n.l = v
 }
 
-func (n *VarIdentNode) BuildLink() {
-	if !n.Ident().IsDummy() {
-		ident := n.Ident()
-		ident.BuildLink()
-		ident.SetParent(n)
-		ident.SetSelfField("ident")
-		ident.SetReplaceSelf(func(n Node) {
-			n.Parent().(*VarIdentNode).SetIdent(n)
+func (n *AugAssignStmtNode) Op() Node {
+	return n.op
+}
+
+func (n *AugAssignStmtNode) SetOp(v Node) {
+	n.op = v
+}
+
+func (n *AugAssignStmtNode) R() Node {
+	return n.r
+}
+
+func (n *AugAssignStmtNode) SetR(v Node) {
+	n.r = v
+}
+
+func (n *AugAssignStmtNode) BuildLink() {
+	if !n.L().IsDummy() {
+		l := n.L()
+		l.BuildLink()
+		l.SetParent(n)
+		l.SetSelfField("l")
+		l.SetReplaceSelf(func(n Node) {
+			n.Parent().(*AugAssignStmtNode).SetL(n)
+		})
+	}
+	if !n.Op().IsDummy() {
+		op := n.Op()
+		op.BuildLink()
+		op.SetParent(n)
+		op.SetSelfField("op")
+		op.SetReplaceSelf(func(n Node) {
+			n.Parent().(*AugAssignStmtNode).SetOp(n)
+		})
+	}
+	if !n.R().IsDummy() {
+		r := n.R()
+		r.BuildLink()
+		r.SetParent(n)
+		r.SetSelfField("r")
+		r.SetReplaceSelf(func(n Node) {
+			n.Parent().(*AugAssignStmtNode).SetR(n)
 		})
 	}
 }
 
-func (n *VarIdentNode) Fields() []string {
+func (n *AugAssignStmtNode) Fields() []string {
 	return []string{
-		"ident",
+		"l",
+		"op",
+		"r",
 	}
 }
 
-func (n *VarIdentNode) Child(field string) Node {
+func (n *AugAssignStmtNode) Child(field string) Node {
 	if field == "" {
 		return nil
 	}
-	if field == "ident" {
-		return n.Ident()
+	if field == "l" {
+		return n.L()
+	}
+	if field == "op" {
+		return n.Op()
+	}
+	if field == "r" {
+		return n.R()
 	}
 	return nil
 }
 
-func (n *VarIdentNode) SetChild(nodes []Node) {
-	if len(nodes) != 1 {
+func (n *AugAssignStmtNode) SetChild(nodes []Node) {
+	if len(nodes) != 3 {
 		return
 	}
-	n.SetIdent(nodes[0])
+	n.SetL(nodes[0])
+	n.SetOp(nodes[1])
+	n.SetR(nodes[2])
 }
 
-func (n *VarIdentNode) Fork() Node {
-	_ret := &VarIdentNode{
+func (n *AugAssignStmtNode) Fork() Node {
+	_ret := &AugAssignStmtNode{
 		BaseNode: n.BaseNode.fork(),
-		ident:    n.ident.Fork(),
+		l:        n.l.Fork(),
+		op:       n.op.Fork(),
+		r:        n.r.Fork(),
 	}
-	_ret.ident.SetParent(_ret)
+	_ret.l.SetParent(_ret)
+	_ret.op.SetParent(_ret)
+	_ret.r.SetParent(_ret)
 	return _ret
 }
 
-func (n *VarIdentNode) Visit(beforeChildren func(node Node) (visitChildren, exit bool), afterChildren func(node Node) (exit bool)) (exit bool) {
+func (n *AugAssignStmtNode) Visit(beforeChildren func(node Node) (visitChildren, exit bool), afterChildren func(node Node) (exit bool)) (exit bool) {
 	vc, e := beforeChildren(n)
 	if e {
 		return true
@@ -3787,7 +3974,13 @@ func (n *VarIdentNode) Visit(beforeChildren func(node Node) (visitChildren, exit
 	if !vc {
 		return false
 	}
-	if n.ident.Visit(beforeChildren, afterChildren) {
+	if n.l.Visit(beforeChildren, afterChildren) {
+		return true
+	}
+	if n.op.Visit(beforeChildren, afterChildren) {
+		return true
+	}
+	if n.r.Visit(beforeChildren, afterChildren) {
 		return true
 	}
 	if afterChildren(n) {
@@ -3796,83 +3989,98 @@ func (n *VarIdentNode) Visit(beforeChildren func(node Node) (visitChildren, exit
 	return false
 }
 
-func (n *VarIdentNode) Dump(hook func(Node, map[string]string) string) map[string]string {
+func (n *AugAssignStmtNode) Accept(v Visitor) bool {
+	return v.VisitAugAssignStmtNode(n)
+}
+
+func (n *AugAssignStmtNode) stmtNode() {}
+
+func (n *AugAssignStmtNode) Rewrite(r Rewriter) Node {
+	n.l = rewriteChild(r, n.l)
+	n.op = rewriteChild(r, n.op)
+	n.r = rewriteChild(r, n.r)
+	return r.RewriteAugAssignStmtNode(n)
+}
+
+func (n *AugAssignStmtNode) Dump(hook func(Node, map[string]string) string) map[string]string {
 	ret := make(map[string]string)
-	ret["kind"] = "\"var_ident\""
-	ret["ident"] = DumpNode(n.Ident(), hook)
+	ret["kind"] = "\"aug_assign_stmt\""
+	ret["l"] = DumpNode(n.L(), hook)
+	ret["op"] = DumpNode(n.Op(), hook)
+	ret["r"] = DumpNode(n.R(), hook)
 	return ret
 }
 
-func NewTypeIdentNode(filePath string, fileContent []rune, ident Node, start, end Position) Node {
-	if ident == nil {
-		ident = DummyNode
+func NewBasicLitNode(filePath string, fileContent []rune, x Node, start, end Position) Node {
+	if x == nil {
+		x = DummyNode
 	}
-	_1 := &TypeIdentNode{
-		BaseNode: NewBaseNode(filePath, fileContent, NodeTypeTypeIdent, start, end),
-		ident:    ident,
+	_1 := &BasicLitNode{
+		BaseNode: NewBaseNode(filePath, fileContent, NodeTypeBasicLit, start, end),
+		x:        x,
 	}
 	creationHook(_1)
 	return _1
 }
 
-type TypeIdentNode struct {
+type BasicLitNode struct {
 	*BaseNode
-	ident Node
+	x Node
 }
 
-func (n *TypeIdentNode) Ident() Node {
-	return n.ident
+func (n *BasicLitNode) X() Node {
+	return n.x
 }
 
-func (n *TypeIdentNode) SetIdent(v Node) {
-	n.ident = v
+func (n *BasicLitNode) SetX(v Node) {
+	n.x = v
 }
 
-func (n *TypeIdentNode) BuildLink() {
-	if !n.Ident().IsDummy() {
-		ident := n.Ident()
-		ident.BuildLink()
-		ident.SetParent(n)
-		ident.SetSelfField("ident")
-		ident.SetReplaceSelf(func(n Node) {
-			n.Parent().(*TypeIdentNode).SetIdent(n)
+func (n *BasicLitNode) BuildLink() {
+	if !n.X().IsDummy() {
+		x := n.X()
+		x.BuildLink()
+		x.SetParent(n)
+		x.SetSelfField("x")
+		x.SetReplaceSelf(func(n Node) {
+			n.Parent().(*BasicLitNode).SetX(n)
 		})
 	}
 }
 
-func (n *TypeIdentNode) Fields() []string {
+func (n *BasicLitNode) Fields() []string {
 	return []string{
-		"ident",
+		"x",
 	}
 }
 
-func (n *TypeIdentNode) Child(field string) Node {
+func (n *BasicLitNode) Child(field string) Node {
 	if field == "" {
 		return nil
 	}
-	if field == "ident" {
-		return n.Ident()
+	if field == "x" {
+		return n.X()
 	}
 	return nil
 }
 
-func (n *TypeIdentNode) SetChild(nodes []Node) {
+func (n *BasicLitNode) SetChild(nodes []Node) {
 	if len(nodes) != 1 {
 		return
 	}
-	n.SetIdent(nodes[0])
+	n.SetX(nodes[0])
 }
 
-func (n *TypeIdentNode) Fork() Node {
-	_ret := &TypeIdentNode{
+func (n *BasicLitNode) Fork() Node {
+	_ret := &BasicLitNode{
 		BaseNode: n.BaseNode.fork(),
-		ident:    n.ident.Fork(),
+		x:        n.x.Fork(),
 	}
-	_ret.ident.SetParent(_ret)
+	_ret.x.SetParent(_ret)
 	return _ret
 }
 
-func (n *TypeIdentNode) Visit(beforeChildren func(node Node) (visitChildren, exit bool), afterChildren func(node Node) (exit bool)) (exit bool) {
+func (n *BasicLitNode) Visit(beforeChildren func(node Node) (visitChildren, exit bool), afterChildren func(node Node) (exit bool)) (exit bool) {
 	vc, e := beforeChildren(n)
 	if e {
 		return true
@@ -3880,7 +4088,7 @@ func (n *TypeIdentNode) Visit(beforeChildren func(node Node) (visitChildren, exi
 	if !vc {
 		return false
 	}
-	if n.ident.Visit(beforeChildren, afterChildren) {
+	if n.x.Visit(beforeChildren, afterChildren) {
 		return true
 	}
 	if afterChildren(n) {
@@ -3889,83 +4097,94 @@ func (n *TypeIdentNode) Visit(beforeChildren func(node Node) (visitChildren, exi
 	return false
 }
 
-func (n *TypeIdentNode) Dump(hook func(Node, map[string]string) string) map[string]string {
+func (n *BasicLitNode) Accept(v Visitor) bool {
+	return v.VisitBasicLitNode(n)
+}
+
+func (n *BasicLitNode) exprNode() {}
+
+func (n *BasicLitNode) Rewrite(r Rewriter) Node {
+	n.x = rewriteChild(r, n.x)
+	return r.RewriteBasicLitNode(n)
+}
+
+func (n *BasicLitNode) Dump(hook func(Node, map[string]string) string) map[string]string {
 	ret := make(map[string]string)
-	ret["kind"] = "\"type_ident\""
-	ret["ident"] = DumpNode(n.Ident(), hook)
+	ret["kind"] = "\"basic_lit\""
+	ret["x"] = DumpNode(n.X(), hook)
 	return ret
 }
 
-func NewFunctionIdentNode(filePath string, fileContent []rune, ident Node, start, end Position) Node {
-	if ident == nil {
-		ident = DummyNode
+func NewBlockStmtNode(filePath string, fileContent []rune, x Node, start, end Position) Node {
+	if x == nil {
+		x = DummyNode
 	}
-	_1 := &FunctionIdentNode{
-		BaseNode: NewBaseNode(filePath, fileContent, NodeTypeFunctionIdent, start, end),
-		ident:    ident,
+	_1 := &BlockStmtNode{
+		BaseNode: NewBaseNode(filePath, fileContent, NodeTypeBlockStmt, start, end),
+		x:        x,
 	}
 	creationHook(_1)
 	return _1
 }
 
-type FunctionIdentNode struct {
+type BlockStmtNode struct {
 	*BaseNode
-	ident Node
+	x Node
 }
 
-func (n *FunctionIdentNode) Ident() Node {
-	return n.ident
+func (n *BlockStmtNode) X() Node {
+	return n.x
 }
 
-func (n *FunctionIdentNode) SetIdent(v Node) {
-	n.ident = v
+func (n *BlockStmtNode) SetX(v Node) {
+	n.x = v
 }
 
-func (n *FunctionIdentNode) BuildLink() {
-	if !n.Ident().IsDummy() {
-		ident := n.Ident()
-		ident.BuildLink()
-		ident.SetParent(n)
-		ident.SetSelfField("ident")
-		ident.SetReplaceSelf(func(n Node) {
-			n.Parent().(*FunctionIdentNode).SetIdent(n)
+func (n *BlockStmtNode) BuildLink() {
+	if !n.X().IsDummy() {
+		x := n.X()
+		x.BuildLink()
+		x.SetParent(n)
+		x.SetSelfField("x")
+		x.SetReplaceSelf(func(n Node) {
+			n.Parent().(*BlockStmtNode).SetX(n)
 		})
 	}
 }
 
-func (n *FunctionIdentNode) Fields() []string {
+func (n *BlockStmtNode) Fields() []string {
 	return []string{
-		"ident",
+		"x",
 	}
 }
 
-func (n *FunctionIdentNode) Child(field string) Node {
+func (n *BlockStmtNode) Child(field string) Node {
 	if field == "" {
 		return nil
 	}
-	if field == "ident" {
-		return n.Ident()
+	if field == "x" {
+		return n.X()
 	}
 	return nil
 }
 
-func (n *FunctionIdentNode) SetChild(nodes []Node) {
+func (n *BlockStmtNode) SetChild(nodes []Node) {
 	if len(nodes) != 1 {
 		return
 	}
-	n.SetIdent(nodes[0])
+	n.SetX(nodes[0])
 }
 
-func (n *FunctionIdentNode) Fork() Node {
-	_ret := &FunctionIdentNode{
+func (n *BlockStmtNode) Fork() Node {
+	_ret := &BlockStmtNode{
 		BaseNode: n.BaseNode.fork(),
-		ident:    n.ident.Fork(),
+		x:        n.x.Fork(),
 	}
-	_ret.ident.SetParent(_ret)
+	_ret.x.SetParent(_ret)
 	return _ret
 }
 
-func (n *FunctionIdentNode) Visit(beforeChildren func(node Node) (visitChildren, exit bool), afterChildren func(node Node) (exit bool)) (exit bool) {
+func (n *BlockStmtNode) Visit(beforeChildren func(node Node) (visitChildren, exit bool), afterChildren func(node Node) (exit bool)) (exit bool) {
 	vc, e := beforeChildren(n)
 	if e {
 		return true
@@ -3973,7 +4192,7 @@ func (n *FunctionIdentNode) Visit(beforeChildren func(node Node) (visitChildren,
 	if !vc {
 		return false
 	}
-	if n.ident.Visit(beforeChildren, afterChildren) {
+	if n.x.Visit(beforeChildren, afterChildren) {
 		return true
 	}
 	if afterChildren(n) {
@@ -3982,83 +4201,123 @@ func (n *FunctionIdentNode) Visit(beforeChildren func(node Node) (visitChildren,
 	return false
 }
 
-func (n *FunctionIdentNode) Dump(hook func(Node, map[string]string) string) map[string]string {
+func (n *BlockStmtNode) Accept(v Visitor) bool {
+	return v.VisitBlockStmtNode(n)
+}
+
+func (n *BlockStmtNode) stmtNode() {}
+
+func (n *BlockStmtNode) Rewrite(r Rewriter) Node {
+	n.x = rewriteChild(r, n.x)
+	return r.RewriteBlockStmtNode(n)
+}
+
+func (n *BlockStmtNode) Dump(hook func(Node, map[string]string) string) map[string]string {
 	ret := make(map[string]string)
-	ret["kind"] = "\"function_ident\""
-	ret["ident"] = DumpNode(n.Ident(), hook)
+	ret["kind"] = "\"block_stmt\""
+	ret["x"] = DumpNode(n.X(), hook)
 	return ret
 }
 
-func NewMethodIdentNode(filePath string, fileContent []rune, ident Node, start, end Position) Node {
-	if ident == nil {
-		ident = DummyNode
+func NewChanTypeNode(filePath string, fileContent []rune, t Node, x Node, start, end Position) Node {
+	if t == nil {
+		t = DummyNode
 	}
-	_1 := &MethodIdentNode{
-		BaseNode: NewBaseNode(filePath, fileContent, NodeTypeMethodIdent, start, end),
-		ident:    ident,
+	if x == nil {
+		x = DummyNode
+	}
+	_1 := &ChanTypeNode{
+		BaseNode: NewBaseNode(filePath, fileContent, NodeTypeChanType, start, end),
+		t:        t,
+		x:        x,
 	}
 	creationHook(_1)
 	return _1
 }
 
-type MethodIdentNode struct {
+type ChanTypeNode struct {
 	*BaseNode
-	ident Node
+	t Node
+	x Node
 }
 
-func (n *MethodIdentNode) Ident() Node {
-	return n.ident
+func (n *ChanTypeNode) T() Node {
+	return n.t
 }
 
-func (n *MethodIdentNode) SetIdent(v Node) {
-	n.ident = v
+func (n *ChanTypeNode) SetT(v Node) {
+	n.t = v
 }
 
-func (n *MethodIdentNode) BuildLink() {
-	if !n.Ident().IsDummy() {
-		ident := n.Ident()
-		ident.BuildLink()
-		ident.SetParent(n)
-		ident.SetSelfField("ident")
-		ident.SetReplaceSelf(func(n Node) {
-			n.Parent().(*MethodIdentNode).SetIdent(n)
+func (n *ChanTypeNode) X() Node {
+	return n.x
+}
+
+func (n *ChanTypeNode) SetX(v Node) {
+	n.x = v
+}
+
+func (n *ChanTypeNode) BuildLink() {
+	if !n.T().IsDummy() {
+		t := n.T()
+		t.BuildLink()
+		t.SetParent(n)
+		t.SetSelfField("t")
+		t.SetReplaceSelf(func(n Node) {
+			n.Parent().(*ChanTypeNode).SetT(n)
+		})
+	}
+	if !n.X().IsDummy() {
+		x := n.X()
+		x.BuildLink()
+		x.SetParent(n)
+		x.SetSelfField("x")
+		x.SetReplaceSelf(func(n Node) {
+			n.Parent().(*ChanTypeNode).SetX(n)
 		})
 	}
 }
 
-func (n *MethodIdentNode) Fields() []string {
+func (n *ChanTypeNode) Fields() []string {
 	return []string{
-		"ident",
+		"t",
+		"x",
 	}
 }
 
-func (n *MethodIdentNode) Child(field string) Node {
+func (n *ChanTypeNode) Child(field string) Node {
 	if field == "" {
 		return nil
 	}
-	if field == "ident" {
-		return n.Ident()
+	if field == "t" {
+		return n.T()
+	}
+	if field == "x" {
+		return n.X()
 	}
 	return nil
 }
 
-func (n *MethodIdentNode) SetChild(nodes []Node) {
-	if len(nodes) != 1 {
+func (n *ChanTypeNode) SetChild(nodes []Node) {
+	if len(nodes) != 2 {
 		return
 	}
-	n.SetIdent(nodes[0])
+	n.SetT(nodes[0])
+	n.SetX(nodes[1])
 }
 
-func (n *MethodIdentNode) Fork() Node {
-	_ret := &MethodIdentNode{
+func (n *ChanTypeNode) Fork() Node {
+	_ret := &ChanTypeNode{
 		BaseNode: n.BaseNode.fork(),
-		ident:    n.ident.Fork(),
+		t:        n.t.Fork(),
+		x:        n.x.Fork(),
 	}
-	_ret.ident.SetParent(_ret)
+	_ret.t.SetParent(_ret)
+	_ret.x.SetParent(_ret)
 	return _ret
 }
 
-func (n *MethodIdentNode) Visit(beforeChildren func(node Node) (visitChildren, exit bool), afterChildren func(node Node) (exit bool)) (exit bool) {
+func (n *ChanTypeNode) Visit(beforeChildren func(node Node) (visitChildren, exit bool), afterChildren func(node Node) (exit bool)) (exit bool) {
 	vc, e := beforeChildren(n)
 	if e {
 		return true
@@ -4066,7 +4325,10 @@ func (n *MethodIdentNode) Visit(beforeChildren func(node Node) (visitChildren, e
 	if !vc {
 		return false
 	}
-	if n.ident.Visit(beforeChildren, afterChildren) {
+	if n.t.Visit(beforeChildren, afterChildren) {
+		return true
+	}
+	if n.x.Visit(beforeChildren, afterChildren) {
 		return true
 	}
 	if afterChildren(n) {
@@ -4075,112 +4337,154 @@ func (n *MethodIdentNode) Visit(beforeChildren func(node Node) (visitChildren, e
 	return false
 }
 
-func (n *MethodIdentNode) Dump(hook func(Node, map[string]string) string) map[string]string {
+func (n *ChanTypeNode) Accept(v Visitor) bool {
+	return v.VisitChanTypeNode(n)
+}
+
+func (n *ChanTypeNode) typeNode() {}
+
+func (n *ChanTypeNode) Rewrite(r Rewriter) Node {
+	n.t = rewriteChild(r, n.t)
+	n.x = rewriteChild(r, n.x)
+	return r.RewriteChanTypeNode(n)
+}
+
+func (n *ChanTypeNode) Dump(hook func(Node, map[string]string) string) map[string]string {
 	ret := make(map[string]string)
-	ret["kind"] = "\"method_ident\""
-	ret["ident"] = DumpNode(n.Ident(), hook)
+	ret["kind"] = "\"chan_type\""
+	ret["t"] = DumpNode(n.T(), hook)
+	ret["x"] = DumpNode(n.X(), hook)
 	return ret
 }
 
-func NewGenericParameterNode(filePath string, fileContent []rune, name Node, type_ Node, start, end Position) Node {
-	if name == nil {
-		name = DummyNode
+func NewCompareExprNode(filePath string, fileContent []rune, lhs Node, op Node, rhs Node, start, end Position) Node {
+	if lhs == nil {
+		lhs = DummyNode
 	}
-	if type_ == nil {
-		type_ = DummyNode
+	if op == nil {
+		op = DummyNode
 	}
-	_1 := &GenericParameterNode{
-		BaseNode: NewBaseNode(filePath, fileContent, NodeTypeGenericParameter, start, end),
-		name:     name,
-		type_:    type_,
+	if rhs == nil {
+		rhs = DummyNode
+	}
+	_1 := &CompareExprNode{
+		BaseNode: NewBaseNode(filePath, fileContent, NodeTypeCompareExpr, start, end),
+		lhs:      lhs,
+		op:       op,
+		rhs:      rhs,
 	}
 	creationHook(_1)
 	return _1
 }
 
-type GenericParameterNode struct {
+type CompareExprNode struct {
 	*BaseNode
-	name  Node
-	type_ Node
+	lhs Node
+	op  Node
+	rhs Node
 }
 
-func (n *GenericParameterNode) Name() Node {
-	return n.name
+func (n *CompareExprNode) Lhs() Node {
+	return n.lhs
 }
 
-func (n *GenericParameterNode) SetName(v Node) {
-	n.name = v
+func (n *CompareExprNode) SetLhs(v Node) {
+	n.lhs = v
 }
 
-func (n *GenericParameterNode) Type() Node {
-	return n.type_
+func (n *CompareExprNode) Op() Node {
+	return n.op
 }
 
-func (n *GenericParameterNode) SetType(v Node) {
-	n.type_ = v
+func (n *CompareExprNode) SetOp(v Node) {
+	n.op = v
 }
 
-func (n *GenericParameterNode) BuildLink() {
-	if !n.Name().IsDummy() {
-		name := n.Name()
-		name.BuildLink()
-		name.SetParent(n)
-		name.SetSelfField("name")
-		name.SetReplaceSelf(func(n Node) {
-			n.Parent().(*GenericParameterNode).SetName(n)
+func (n *CompareExprNode) Rhs() Node {
+	return n.rhs
+}
+
+func (n *CompareExprNode) SetRhs(v Node) {
+	n.rhs = v
+}
+
+func (n *CompareExprNode) BuildLink() {
+	if !n.Lhs().IsDummy() {
+		lhs := n.Lhs()
+		lhs.BuildLink()
+		lhs.SetParent(n)
+		lhs.SetSelfField("lhs")
+		lhs.SetReplaceSelf(func(n Node) {
+			n.Parent().(*CompareExprNode).SetLhs(n)
 		})
 	}
-	if !n.Type().IsDummy() {
-		type_ := n.Type()
-		type_.BuildLink()
-		type_.SetParent(n)
-		type_.SetSelfField("type_")
-		type_.SetReplaceSelf(func(n Node) {
-			n.Parent().(*GenericParameterNode).SetType(n)
+	if !n.Op().IsDummy() {
+		op := n.Op()
+		op.BuildLink()
+		op.SetParent(n)
+		op.SetSelfField("op")
+		op.SetReplaceSelf(func(n Node) {
+			n.Parent().(*CompareExprNode).SetOp(n)
+		})
+	}
+	if !n.Rhs().IsDummy() {
+		rhs := n.Rhs()
+		rhs.BuildLink()
+		rhs.SetParent(n)
+		rhs.SetSelfField("rhs")
+		rhs.SetReplaceSelf(func(n Node) {
+			n.Parent().(*CompareExprNode).SetRhs(n)
 		})
 	}
 }
 
-func (n *GenericParameterNode) Fields() []string {
+func (n *CompareExprNode) Fields() []string {
 	return []string{
-		"name",
-		"type_",
+		"lhs",
+		"op",
+		"rhs",
 	}
 }
 
-func (n *GenericParameterNode) Child(field string) Node {
+func (n *CompareExprNode) Child(field string) Node {
 	if field == "" {
 		return nil
 	}
-	if field == "name" {
-		return n.Name()
+	if field == "lhs" {
+		return n.Lhs()
 	}
-	if field == "type_" {
-		return n.Type()
+	if field == "op" {
+		return n.Op()
+	}
+	if field == "rhs" {
+		return n.Rhs()
 	}
 	return nil
 }
 
-func (n *GenericParameterNode) SetChild(nodes []Node) {
-	if len(nodes) != 2 {
+func (n *CompareExprNode) SetChild(nodes []Node) {
+	if len(nodes) != 3 {
 		return
 	}
-	n.SetName(nodes[0])
-	n.SetType(nodes[1])
+	n.SetLhs(nodes[0])
+	n.SetOp(nodes[1])
+	n.SetRhs(nodes[2])
 }
 
-func (n *GenericParameterNode) Fork() Node {
-	_ret := &GenericParameterNode{
+func (n *CompareExprNode) Fork() Node {
+	_ret := &CompareExprNode{
 		BaseNode: n.BaseNode.fork(),
-		name:     n.name.Fork(),
-		type_:    n.type_.Fork(),
+		lhs:      n.lhs.Fork(),
+		op:       n.op.Fork(),
+		rhs:      n.rhs.Fork(),
 	}
-	_ret.name.SetParent(_ret)
-	_ret.type_.SetParent(_ret)
+	_ret.lhs.SetParent(_ret)
+	_ret.op.SetParent(_ret)
+	_ret.rhs.SetParent(_ret)
 	return _ret
 }
 
-func (n *GenericParameterNode) Visit(beforeChildren func(node Node) (visitChildren, exit bool), afterChildren func(node Node) (exit bool)) (exit bool) {
+func (n *CompareExprNode) Visit(beforeChildren func(node Node) (visitChildren, exit bool), afterChildren func(node Node) (exit bool)) (exit bool) {
 	vc, e := beforeChildren(n)
 	if e {
 		return true
@@ -4188,10 +4492,13 @@ func (n *GenericParameterNode) Visit(beforeChildren func(node Node) (visitChildr
 	if !vc {
 		return false
 	}
-	if n.name.Visit(beforeChildren, afterChildren) {
+	if n.lhs.Visit(beforeChildren, afterChildren) {
 		return true
 	}
-	if n.type_.Visit(beforeChildren, afterChildren) {
+	if n.op.Visit(beforeChildren, afterChildren) {
+		return true
+	}
+	if n.rhs.Visit(beforeChildren, afterChildren) {
 		return true
 	}
 	if afterChildren(n) {
@@ -4200,84 +4507,98 @@ func (n *GenericParameterNode) Visit(beforeChildren func(node Node) (visitChildr
 	return false
 }
 
-func (n *GenericParameterNode) Dump(hook func(Node, map[string]string) string) map[string]string {
+func (n *CompareExprNode) Accept(v Visitor) bool {
+	return v.VisitCompareExprNode(n)
+}
+
+func (n *CompareExprNode) exprNode() {}
+
+func (n *CompareExprNode) Rewrite(r Rewriter) Node {
+	n.lhs = rewriteChild(r, n.lhs)
+	n.op = rewriteChild(r, n.op)
+	n.rhs = rewriteChild(r, n.rhs)
+	return r.RewriteCompareExprNode(n)
+}
+
+func (n *CompareExprNode) Dump(hook func(Node, map[string]string) string) map[string]string {
 	ret := make(map[string]string)
-	ret["kind"] = "\"generic_parameter\""
-	ret["name"] = DumpNode(n.Name(), hook)
-	ret["type"] = DumpNode(n.Type(), hook)
+	ret["kind"] = "\"compare_expr\""
+	ret["lhs"] = DumpNode(n.Lhs(), hook)
+	ret["op"] = DumpNode(n.Op(), hook)
+	ret["rhs"] = DumpNode(n.Rhs(), hook)
 	return ret
 }
 
-func NewGenericParameterIdentNode(filePath string, fileContent []rune, ident Node, start, end Position) Node {
-	if ident == nil {
-		ident = DummyNode
+func NewConstGroupDeclNode(filePath string, fileContent []rune, constants Node, start, end Position) Node {
+	if constants == nil {
+		constants = DummyNode
 	}
-	_1 := &GenericParameterIdentNode{
-		BaseNode: NewBaseNode(filePath, fileContent, NodeTypeGenericParameterIdent, start, end),
-		ident:    ident,
+	_1 := &ConstGroupDeclNode{
+		BaseNode:  NewBaseNode(filePath, fileContent, NodeTypeConstGroupDecl, start, end),
+		constants: constants,
 	}
 	creationHook(_1)
 	return _1
 }
 
-type GenericParameterIdentNode struct {
+type ConstGroupDeclNode struct {
 	*BaseNode
-	ident Node
+	constants Node
 }
 
-func (n *GenericParameterIdentNode) Ident() Node {
-	return n.ident
+func (n *ConstGroupDeclNode) Constants() Node {
+	return n.constants
 }
 
-func (n *GenericParameterIdentNode) SetIdent(v Node) {
-	n.ident = v
+func (n *ConstGroupDeclNode) SetConstants(v Node) {
+	n.constants = v
 }
 
-func (n *GenericParameterIdentNode) BuildLink() {
-	if !n.Ident().IsDummy() {
-		ident := n.Ident()
-		ident.BuildLink()
-		ident.SetParent(n)
-		ident.SetSelfField("ident")
-		ident.SetReplaceSelf(func(n Node) {
-			n.Parent().(*GenericParameterIdentNode).SetIdent(n)
+func (n *ConstGroupDeclNode) BuildLink() {
+	if !n.Constants().IsDummy() {
+		constants := n.Constants()
+		constants.BuildLink()
+		constants.SetParent(n)
+		constants.SetSelfField("constants")
+		constants.SetReplaceSelf(func(n Node) {
+			n.Parent().(*ConstGroupDeclNode).SetConstants(n)
 		})
 	}
 }
 
-func (n *GenericParameterIdentNode) Fields() []string {
+func (n *ConstGroupDeclNode) Fields() []string {
 	return []string{
-		"ident",
+		"constants",
 	}
 }
 
-func (n *GenericParameterIdentNode) Child(field string) Node {
+func (n *ConstGroupDeclNode) Child(field string) Node {
 	if field == "" {
 		return nil
 	}
-	if field == "ident" {
-		return n.Ident()
+	if field == "constants" {
+		return n.Constants()
 	}
 	return nil
 }
 
-func (n *GenericParameterIdentNode) SetChild(nodes []Node) {
+func (n *ConstGroupDeclNode) SetChild(nodes []Node) {
 	if len(nodes) != 1 {
 		return
 	}
-	n.SetIdent(nodes[0])
+	n.SetConstants(nodes[0])
 }
 
-func (n *GenericParameterIdentNode) Fork() Node {
-	_ret := &GenericParameterIdentNode{
-		BaseNode: n.BaseNode.fork(),
-		ident:    n.ident.Fork(),
+func (n *ConstGroupDeclNode) Fork() Node {
+	_ret := &ConstGroupDeclNode{
+		BaseNode:  n.BaseNode.fork(),
+		constants: n.constants.Fork(),
 	}
-	_ret.ident.SetParent(_ret)
+	_ret.constants.SetParent(_ret)
 	return _ret
 }
 
-func (n *GenericParameterIdentNode) Visit(beforeChildren func(node Node) (visitChildren, exit bool), afterChildren func(node Node) (exit bool)) (exit bool) {
+func (n *ConstGroupDeclNode) Visit(beforeChildren func(node Node) (visitChildren, exit bool), afterChildren func(node Node) (exit bool)) (exit bool) {
 	vc, e := beforeChildren(n)
 	if e {
 		return true
@@ -4285,7 +4606,7 @@ func (n *GenericParameterIdentNode) Visit(beforeChildren func(node Node) (visitC
 	if !vc {
 		return false
 	}
-	if n.ident.Visit(beforeChildren, afterChildren) {
+	if n.constants.Visit(beforeChildren, afterChildren) {
 		return true
 	}
 	if afterChildren(n) {
@@ -4294,83 +4615,94 @@ func (n *GenericParameterIdentNode) Visit(beforeChildren func(node Node) (visitC
 	return false
 }
 
-func (n *GenericParameterIdentNode) Dump(hook func(Node, map[string]string) string) map[string]string {
+func (n *ConstGroupDeclNode) Accept(v Visitor) bool {
+	return v.VisitConstGroupDeclNode(n)
+}
+
+func (n *ConstGroupDeclNode) declNode() {}
+
+func (n *ConstGroupDeclNode) Rewrite(r Rewriter) Node {
+	n.constants = rewriteChild(r, n.constants)
+	return r.RewriteConstGroupDeclNode(n)
+}
+
+func (n *ConstGroupDeclNode) Dump(hook func(Node, map[string]string) string) map[string]string {
 	ret := make(map[string]string)
-	ret["kind"] = "\"generic_parameter_ident\""
-	ret["ident"] = DumpNode(n.Ident(), hook)
+	ret["kind"] = "\"const_group_decl\""
+	ret["constants"] = DumpNode(n.Constants(), hook)
 	return ret
 }
 
-func NewGenericUnionConstraintNode(filePath string, fileContent []rune, list Node, start, end Position) Node {
-	if list == nil {
-		list = DummyNode
+func NewConstIdentNode(filePath string, fileContent []rune, ident Node, start, end Position) Node {
+	if ident == nil {
+		ident = DummyNode
 	}
-	_1 := &GenericUnionConstraintNode{
-		BaseNode: NewBaseNode(filePath, fileContent, NodeTypeGenericUnionConstraint, start, end),
-		list:     list,
+	_1 := &ConstIdentNode{
+		BaseNode: NewBaseNode(filePath, fileContent, NodeTypeConstIdent, start, end),
+		ident:    ident,
 	}
 	creationHook(_1)
 	return _1
 }
 
-type GenericUnionConstraintNode struct {
+type ConstIdentNode struct {
 	*BaseNode
-	list Node
+	ident Node
 }
 
-func (n *GenericUnionConstraintNode) List() Node {
-	return n.list
+func (n *ConstIdentNode) Ident() Node {
+	return n.ident
 }
 
-func (n *GenericUnionConstraintNode) SetList(v Node) {
-	n.list = v
+func (n *ConstIdentNode) SetIdent(v Node) {
+	n.ident = v
 }
 
-func (n *GenericUnionConstraintNode) BuildLink() {
-	if !n.List().IsDummy() {
-		list := n.List()
-		list.BuildLink()
-		list.SetParent(n)
-		list.SetSelfField("list")
-		list.SetReplaceSelf(func(n Node) {
-			n.Parent().(*GenericUnionConstraintNode).SetList(n)
+func (n *ConstIdentNode) BuildLink() {
+	if !n.Ident().IsDummy() {
+		ident := n.Ident()
+		ident.BuildLink()
+		ident.SetParent(n)
+		ident.SetSelfField("ident")
+		ident.SetReplaceSelf(func(n Node) {
+			n.Parent().(*ConstIdentNode).SetIdent(n)
 		})
 	}
 }
 
-func (n *GenericUnionConstraintNode) Fields() []string {
+func (n *ConstIdentNode) Fields() []string {
 	return []string{
-		"list",
+		"ident",
 	}
 }
 
-func (n *GenericUnionConstraintNode) Child(field string) Node {
+func (n *ConstIdentNode) Child(field string) Node {
 	if field == "" {
 		return nil
 	}
-	if field == "list" {
-		return n.List()
+	if field == "ident" {
+		return n.Ident()
 	}
 	return nil
 }
 
-func (n *GenericUnionConstraintNode) SetChild(nodes []Node) {
+func (n *ConstIdentNode) SetChild(nodes []Node) {
 	if len(nodes) != 1 {
 		return
 	}
-	n.SetList(nodes[0])
+	n.SetIdent(nodes[0])
 }
 
-func (n *GenericUnionConstraintNode) Fork() Node {
-	_ret := &GenericUnionConstraintNode{
+func (n *ConstIdentNode) Fork() Node {
+	_ret := &ConstIdentNode{
 		BaseNode: n.BaseNode.fork(),
-		list:     n.list.Fork(),
+		ident:    n.ident.Fork(),
 	}
-	_ret.list.SetParent(_ret)
+	_ret.ident.SetParent(_ret)
 	return _ret
 }
 
-func (n *GenericUnionConstraintNode) Visit(beforeChildren func(node Node) (visitChildren, exit bool), afterChildren func(node Node) (exit bool)) (exit bool) {
+func (n *ConstIdentNode) Visit(beforeChildren func(node Node) (visitChildren, exit bool), afterChildren func(node Node) (exit bool)) (exit bool) {
 	vc, e := beforeChildren(n)
 	if e {
 		return true
@@ -4378,7 +4710,7 @@ func (n *GenericUnionConstraintNode) Visit(beforeChildren func(node Node) (visit
 	if !vc {
 		return false
 	}
-	if n.list.Visit(beforeChildren, afterChildren) {
+	if n.ident.Visit(beforeChildren, afterChildren) {
 		return true
 	}
 	if afterChildren(n) {
@@ -4387,83 +4719,92 @@ func (n *GenericUnionConstraintNode) Visit(beforeChildren func(node Node) (visit
 	return false
 }
 
-func (n *GenericUnionConstraintNode) Dump(hook func(Node, map[string]string) string) map[string]string {
+func (n *ConstIdentNode) Accept(v Visitor) bool {
+	return v.VisitConstIdentNode(n)
+}
+
+func (n *ConstIdentNode) Rewrite(r Rewriter) Node {
+	n.ident = rewriteChild(r, n.ident)
+	return r.RewriteConstIdentNode(n)
+}
+
+func (n *ConstIdentNode) Dump(hook func(Node, map[string]string) string) map[string]string {
 	ret := make(map[string]string)
-	ret["kind"] = "\"generic_union_constraint\""
-	ret["list"] = DumpNode(n.List(), hook)
+	ret["kind"] = "\"const_ident\""
+	ret["ident"] = DumpNode(n.Ident(), hook)
 	return ret
 }
 
-func NewGenericUnderlyingTypeConstraintNode(filePath string, fileContent []rune, type_ Node, start, end Position) Node {
-	if type_ == nil {
-		type_ = DummyNode
+func NewConstOneDeclNode(filePath string, fileContent []rune, constant Node, start, end Position) Node {
+	if constant == nil {
+		constant = DummyNode
 	}
-	_1 := &GenericUnderlyingTypeConstraintNode{
-		BaseNode: NewBaseNode(filePath, fileContent, NodeTypeGenericUnderlyingTypeConstraint, start, end),
-		type_:    type_,
+	_1 := &ConstOneDeclNode{
+		BaseNode: NewBaseNode(filePath, fileContent, NodeTypeConstOneDecl, start, end),
+		constant: constant,
 	}
 	creationHook(_1)
 	return _1
 }
 
-type GenericUnderlyingTypeConstraintNode struct {
+type ConstOneDeclNode struct {
 	*BaseNode
-	type_ Node
+	constant Node
 }
 
-func (n *GenericUnderlyingTypeConstraintNode) Type() Node {
-	return n.type_
+func (n *ConstOneDeclNode) Constant() Node {
+	return n.constant
 }
 
-func (n *GenericUnderlyingTypeConstraintNode) SetType(v Node) {
-	n.type_ = v
+func (n *ConstOneDeclNode) SetConstant(v Node) {
+	n.constant = v
 }
 
-func (n *GenericUnderlyingTypeConstraintNode) BuildLink() {
-	if !n.Type().IsDummy() {
-		type_ := n.Type()
-		type_.BuildLink()
-		type_.SetParent(n)
-		type_.SetSelfField("type_")
-		type_.SetReplaceSelf(func(n Node) {
-			n.Parent().(*GenericUnderlyingTypeConstraintNode).SetType(n)
+func (n *ConstOneDeclNode) BuildLink() {
+	if !n.Constant().IsDummy() {
+		constant := n.Constant()
+		constant.BuildLink()
+		constant.SetParent(n)
+		constant.SetSelfField("constant")
+		constant.SetReplaceSelf(func(n Node) {
+			n.Parent().(*ConstOneDeclNode).SetConstant(n)
 		})
 	}
 }
 
-func (n *GenericUnderlyingTypeConstraintNode) Fields() []string {
+func (n *ConstOneDeclNode) Fields() []string {
 	return []string{
-		"type_",
+		"constant",
 	}
 }
 
-func (n *GenericUnderlyingTypeConstraintNode) Child(field string) Node {
+func (n *ConstOneDeclNode) Child(field string) Node {
 	if field == "" {
 		return nil
 	}
-	if field == "type_" {
-		return n.Type()
+	if field == "constant" {
+		return n.Constant()
 	}
 	return nil
 }
 
-func (n *GenericUnderlyingTypeConstraintNode) SetChild(nodes []Node) {
+func (n *ConstOneDeclNode) SetChild(nodes []Node) {
 	if len(nodes) != 1 {
 		return
 	}
-	n.SetType(nodes[0])
+	n.SetConstant(nodes[0])
 }
 
-func (n *GenericUnderlyingTypeConstraintNode) Fork() Node {
-	_ret := &GenericUnderlyingTypeConstraintNode{
+func (n *ConstOneDeclNode) Fork() Node {
+	_ret := &ConstOneDeclNode{
 		BaseNode: n.BaseNode.fork(),
-		type_:    n.type_.Fork(),
+		constant: n.constant.Fork(),
 	}
-	_ret.type_.SetParent(_ret)
+	_ret.constant.SetParent(_ret)
 	return _ret
 }
 
-func (n *GenericUnderlyingTypeConstraintNode) Visit(beforeChildren func(node Node) (visitChildren, exit bool), afterChildren func(node Node) (exit bool)) (exit bool) {
+func (n *ConstOneDeclNode) Visit(beforeChildren func(node Node) (visitChildren, exit bool), afterChildren func(node Node) (exit bool)) (exit bool) {
 	vc, e := beforeChildren(n)
 	if e {
 		return true
@@ -4471,7 +4812,7 @@ func (n *GenericUnderlyingTypeConstraintNode) Visit(beforeChildren func(node Nod
 	if !vc {
 		return false
 	}
-	if n.type_.Visit(beforeChildren, afterChildren) {
+	if n.constant.Visit(beforeChildren, afterChildren) {
 		return true
 	}
 	if afterChildren(n) {
@@ -4480,83 +4821,94 @@ func (n *GenericUnderlyingTypeConstraintNode) Visit(beforeChildren func(node Nod
 	return false
 }
 
-func (n *GenericUnderlyingTypeConstraintNode) Dump(hook func(Node, map[string]string) string) map[string]string {
+func (n *ConstOneDeclNode) Accept(v Visitor) bool {
+	return v.VisitConstOneDeclNode(n)
+}
+
+func (n *ConstOneDeclNode) declNode() {}
+
+func (n *ConstOneDeclNode) Rewrite(r Rewriter) Node {
+	n.constant = rewriteChild(r, n.constant)
+	return r.RewriteConstOneDeclNode(n)
+}
+
+func (n *ConstOneDeclNode) Dump(hook func(Node, map[string]string) string) map[string]string {
 	ret := make(map[string]string)
-	ret["kind"] = "\"generic_underlying_type_constraint\""
-	ret["type"] = DumpNode(n.Type(), hook)
+	ret["kind"] = "\"const_one_decl\""
+	ret["constant"] = DumpNode(n.Constant(), hook)
 	return ret
 }
 
-func NewGenericTypeConstraintNode(filePath string, fileContent []rune, type_ Node, start, end Position) Node {
-	if type_ == nil {
-		type_ = DummyNode
+func NewDecStmtNode(filePath string, fileContent []rune, x Node, start, end Position) Node {
+	if x == nil {
+		x = DummyNode
 	}
-	_1 := &GenericTypeConstraintNode{
-		BaseNode: NewBaseNode(filePath, fileContent, NodeTypeGenericTypeConstraint, start, end),
-		type_:    type_,
+	_1 := &DecStmtNode{
+		BaseNode: NewBaseNode(filePath, fileContent, NodeTypeDecStmt, start, end),
+		x:        x,
 	}
 	creationHook(_1)
 	return _1
 }
 
-type GenericTypeConstraintNode struct {
+type DecStmtNode struct {
 	*BaseNode
-	type_ Node
+	x Node
 }
 
-func (n *GenericTypeConstraintNode) Type() Node {
-	return n.type_
+func (n *DecStmtNode) X() Node {
+	return n.x
 }
 
-func (n *GenericTypeConstraintNode) SetType(v Node) {
-	n.type_ = v
+func (n *DecStmtNode) SetX(v Node) {
+	n.x = v
 }
 
-func (n *GenericTypeConstraintNode) BuildLink() {
-	if !n.Type().IsDummy() {
-		type_ := n.Type()
-		type_.BuildLink()
-		type_.SetParent(n)
-		type_.SetSelfField("type_")
-		type_.SetReplaceSelf(func(n Node) {
-			n.Parent().(*GenericTypeConstraintNode).SetType(n)
+func (n *DecStmtNode) BuildLink() {
+	if !n.X().IsDummy() {
+		x := n.X()
+		x.BuildLink()
+		x.SetParent(n)
+		x.SetSelfField("x")
+		x.SetReplaceSelf(func(n Node) {
+			n.Parent().(*DecStmtNode).SetX(n)
 		})
 	}
 }
 
-func (n *GenericTypeConstraintNode) Fields() []string {
+func (n *DecStmtNode) Fields() []string {
 	return []string{
-		"type_",
+		"x",
 	}
 }
 
-func (n *GenericTypeConstraintNode) Child(field string) Node {
+func (n *DecStmtNode) Child(field string) Node {
 	if field == "" {
 		return nil
 	}
-	if field == "type_" {
-		return n.Type()
+	if field == "x" {
+		return n.X()
 	}
 	return nil
 }
 
-func (n *GenericTypeConstraintNode) SetChild(nodes []Node) {
+func (n *DecStmtNode) SetChild(nodes []Node) {
 	if len(nodes) != 1 {
 		return
 	}
-	n.SetType(nodes[0])
+	n.SetX(nodes[0])
 }
 
-func (n *GenericTypeConstraintNode) Fork() Node {
-	_ret := &GenericTypeConstraintNode{
+func (n *DecStmtNode) Fork() Node {
+	_ret := &DecStmtNode{
 		BaseNode: n.BaseNode.fork(),
-		type_:    n.type_.Fork(),
+		x:        n.x.Fork(),
 	}
-	_ret.type_.SetParent(_ret)
+	_ret.x.SetParent(_ret)
 	return _ret
 }
 
-func (n *GenericTypeConstraintNode) Visit(beforeChildren func(node Node) (visitChildren, exit bool), afterChildren func(node Node) (exit bool)) (exit bool) {
+func (n *DecStmtNode) Visit(beforeChildren func(node Node) (visitChildren, exit bool), afterChildren func(node Node) (exit bool)) (exit bool) {
 	vc, e := beforeChildren(n)
 	if e {
 		return true
@@ -4564,7 +4916,7 @@ func (n *GenericTypeConstraintNode) Visit(beforeChildren func(node Node) (visitC
 	if !vc {
 		return false
 	}
-	if n.type_.Visit(beforeChildren, afterChildren) {
+	if n.x.Visit(beforeChildren, afterChildren) {
 		return true
 	}
 	if afterChildren(n) {
@@ -4573,112 +4925,94 @@ func (n *GenericTypeConstraintNode) Visit(beforeChildren func(node Node) (visitC
 	return false
 }
 
-func (n *GenericTypeConstraintNode) Dump(hook func(Node, map[string]string) string) map[string]string {
+func (n *DecStmtNode) Accept(v Visitor) bool {
+	return v.VisitDecStmtNode(n)
+}
+
+func (n *DecStmtNode) stmtNode() {}
+
+func (n *DecStmtNode) Rewrite(r Rewriter) Node {
+	n.x = rewriteChild(r, n.x)
+	return r.RewriteDecStmtNode(n)
+}
+
+func (n *DecStmtNode) Dump(hook func(Node, map[string]string) string) map[string]string {
 	ret := make(map[string]string)
-	ret["kind"] = "\"generic_type_constraint\""
-	ret["type"] = DumpNode(n.Type(), hook)
+	ret["kind"] = "\"dec_stmt\""
+	ret["x"] = DumpNode(n.X(), hook)
 	return ret
 }
 
-func NewEllipsisParameterNode(filePath string, fileContent []rune, name Node, type_ Node, start, end Position) Node {
-	if name == nil {
-		name = DummyNode
-	}
-	if type_ == nil {
-		type_ = DummyNode
+func NewDeferStmtNode(filePath string, fileContent []rune, x Node, start, end Position) Node {
+	if x == nil {
+		x = DummyNode
 	}
-	_1 := &EllipsisParameterNode{
-		BaseNode: NewBaseNode(filePath, fileContent, NodeTypeEllipsisParameter, start, end),
-		name:     name,
-		type_:    type_,
+	_1 := &DeferStmtNode{
+		BaseNode: NewBaseNode(filePath, fileContent, NodeTypeDeferStmt, start, end),
+		x:        x,
 	}
 	creationHook(_1)
 	return _1
 }
 
-type EllipsisParameterNode struct {
+type DeferStmtNode struct {
 	*BaseNode
-	name  Node
-	type_ Node
-}
-
-func (n *EllipsisParameterNode) Name() Node {
-	return n.name
-}
-
-func (n *EllipsisParameterNode) SetName(v Node) {
-	n.name = v
+	x Node
 }
 
-func (n *EllipsisParameterNode) Type() Node {
-	return n.type_
+func (n *DeferStmtNode) X() Node {
+	return n.x
 }
 
-func (n *EllipsisParameterNode) SetType(v Node) {
-	n.type_ = v
+func (n *DeferStmtNode) SetX(v Node) {
+	n.x = v
 }
 
-func (n *EllipsisParameterNode) BuildLink() {
-	if !n.Name().IsDummy() {
-		name := n.Name()
-		name.BuildLink()
-		name.SetParent(n)
-		name.SetSelfField("name")
-		name.SetReplaceSelf(func(n Node) {
-			n.Parent().(*EllipsisParameterNode).SetName(n)
-		})
-	}
-	if !n.Type().IsDummy() {
-		type_ := n.Type()
-		type_.BuildLink()
-		type_.SetParent(n)
-		type_.SetSelfField("type_")
-		type_.SetReplaceSelf(func(n Node) {
-			n.Parent().(*EllipsisParameterNode).SetType(n)
+func (n *DeferStmtNode) BuildLink() {
+	if !n.X().IsDummy() {
+		x := n.X()
+		x.BuildLink()
+		x.SetParent(n)
+		x.SetSelfField("x")
+		x.SetReplaceSelf(func(n Node) {
+			n.Parent().(*DeferStmtNode).SetX(n)
 		})
 	}
 }
 
-func (n *EllipsisParameterNode) Fields() []string {
+func (n *DeferStmtNode) Fields() []string {
 	return []string{
-		"name",
-		"type_",
+		"x",
 	}
 }
 
-func (n *EllipsisParameterNode) Child(field string) Node {
+func (n *DeferStmtNode) Child(field string) Node {
 	if field == "" {
 		return nil
 	}
-	if field == "name" {
-		return n.Name()
-	}
-	if field == "type_" {
-		return n.Type()
+	if field == "x" {
+		return n.X()
 	}
 	return nil
 }
 
-func (n *EllipsisParameterNode) SetChild(nodes []Node) {
-	if len(nodes) != 2 {
+func (n *DeferStmtNode) SetChild(nodes []Node) {
+	if len(nodes) != 1 {
 		return
 	}
-	n.SetName(nodes[0])
-	n.SetType(nodes[1])
+	n.SetX(nodes[0])
 }
 
-func (n *EllipsisParameterNode) Fork() Node {
-	_ret := &EllipsisParameterNode{
+func (n *DeferStmtNode) Fork() Node {
+	_ret := &DeferStmtNode{
 		BaseNode: n.BaseNode.fork(),
-		name:     n.name.Fork(),
-		type_:    n.type_.Fork(),
+		x:        n.x.Fork(),
 	}
-	_ret.name.SetParent(_ret)
-	_ret.type_.SetParent(_ret)
+	_ret.x.SetParent(_ret)
 	return _ret
 }
 
-func (n *EllipsisParameterNode) Visit(beforeChildren func(node Node) (visitChildren, exit bool), afterChildren func(node Node) (exit bool)) (exit bool) {
+func (n *DeferStmtNode) Visit(beforeChildren func(node Node) (visitChildren, exit bool), afterChildren func(node Node) (exit bool)) (exit bool) {
 	vc, e := beforeChildren(n)
 	if e {
 		return true
@@ -4686,10 +5020,7 @@ func (n *EllipsisParameterNode) Visit(beforeChildren func(node Node) (visitChild
 	if !vc {
 		return false
 	}
-	if n.name.Visit(beforeChildren, afterChildren) {
-		return true
-	}
-	if n.type_.Visit(beforeChildren, afterChildren) {
+	if n.x.Visit(beforeChildren, afterChildren) {
 		return true
 	}
 	if afterChildren(n) {
@@ -4698,113 +5029,60 @@ func (n *EllipsisParameterNode) Visit(beforeChildren func(node Node) (visitChild
 	return false
 }
 
-func (n *EllipsisParameterNode) Dump(hook func(Node, map[string]string) string) map[string]string {
+func (n *DeferStmtNode) Accept(v Visitor) bool {
+	return v.VisitDeferStmtNode(n)
+}
+
+func (n *DeferStmtNode) stmtNode() {}
+
+func (n *DeferStmtNode) Rewrite(r Rewriter) Node {
+	n.x = rewriteChild(r, n.x)
+	return r.RewriteDeferStmtNode(n)
+}
+
+func (n *DeferStmtNode) Dump(hook func(Node, map[string]string) string) map[string]string {
 	ret := make(map[string]string)
-	ret["kind"] = "\"ellipsis_parameter\""
-	ret["name"] = DumpNode(n.Name(), hook)
-	ret["type"] = DumpNode(n.Type(), hook)
+	ret["kind"] = "\"defer_stmt\""
+	ret["x"] = DumpNode(n.X(), hook)
 	return ret
 }
 
-func NewParameterNode(filePath string, fileContent []rune, name Node, type_ Node, start, end Position) Node {
-	if name == nil {
-		name = DummyNode
-	}
-	if type_ == nil {
-		type_ = DummyNode
-	}
-	_1 := &ParameterNode{
-		BaseNode: NewBaseNode(filePath, fileContent, NodeTypeParameter, start, end),
-		name:     name,
-		type_:    type_,
+func NewEllipsisNode(filePath string, fileContent []rune, start, end Position) Node {
+	_1 := &EllipsisNode{
+		BaseNode: NewBaseNode(filePath, fileContent, NodeTypeEllipsis, start, end),
 	}
 	creationHook(_1)
 	return _1
 }
 
-type ParameterNode struct {
+type EllipsisNode struct {
 	*BaseNode
-	name  Node
-	type_ Node
-}
-
-func (n *ParameterNode) Name() Node {
-	return n.name
-}
-
-func (n *ParameterNode) SetName(v Node) {
-	n.name = v
-}
-
-func (n *ParameterNode) Type() Node {
-	return n.type_
-}
-
-func (n *ParameterNode) SetType(v Node) {
-	n.type_ = v
-}
-
-func (n *ParameterNode) BuildLink() {
-	if !n.Name().IsDummy() {
-		name := n.Name()
-		name.BuildLink()
-		name.SetParent(n)
-		name.SetSelfField("name")
-		name.SetReplaceSelf(func(n Node) {
-			n.Parent().(*ParameterNode).SetName(n)
-		})
-	}
-	if !n.Type().IsDummy() {
-		type_ := n.Type()
-		type_.BuildLink()
-		type_.SetParent(n)
-		type_.SetSelfField("type_")
-		type_.SetReplaceSelf(func(n Node) {
-			n.Parent().(*ParameterNode).SetType(n)
-		})
-	}
 }
 
-func (n *ParameterNode) Fields() []string {
-	return []string{
-		"name",
-		"type_",
-	}
+func (n *EllipsisNode) BuildLink() {
 }
 
-func (n *ParameterNode) Child(field string) Node {
+func (n *EllipsisNode) Child(field string) Node {
 	if field == "" {
 		return nil
 	}
-	if field == "name" {
-		return n.Name()
-	}
-	if field == "type_" {
-		return n.Type()
-	}
 	return nil
 }
 
-func (n *ParameterNode) SetChild(nodes []Node) {
-	if len(nodes) != 2 {
+func (n *EllipsisNode) SetChild(nodes []Node) {
+	if len(nodes) != 0 {
 		return
 	}
-	n.SetName(nodes[0])
-	n.SetType(nodes[1])
 }
 
-func (n *ParameterNode) Fork() Node {
-	_ret := &ParameterNode{
+func (n *EllipsisNode) Fork() Node {
+	_ret := &EllipsisNode{
 		BaseNode: n.BaseNode.fork(),
-		name:     n.name.Fork(),
-		type_:    n.type_.Fork(),
 	}
-	_ret.name.SetParent(_ret)
-	_ret.type_.SetParent(_ret)
 	return _ret
 }
 
-func (n *ParameterNode) Visit(beforeChildren func(node Node) (visitChildren, exit bool), afterChildren func(node Node) (exit bool)) (exit bool) {
+func (n *EllipsisNode) Visit(beforeChildren func(node Node) (visitChildren, exit bool), afterChildren func(node Node) (exit bool)) (exit bool) {
 	vc, e := beforeChildren(n)
 	if e {
 		return true
@@ -4812,96 +5090,96 @@ func (n *ParameterNode) Visit(beforeChildren func(node Node) (visitChildren, exi
 	if !vc {
 		return false
 	}
-	if n.name.Visit(beforeChildren, afterChildren) {
-		return true
-	}
-	if n.type_.Visit(beforeChildren, afterChildren) {
-		return true
-	}
 	if afterChildren(n) {
 		return true
 	}
 	return false
 }
 
-func (n *ParameterNode) Dump(hook func(Node, map[string]string) string) map[string]string {
+func (n *EllipsisNode) Accept(v Visitor) bool {
+	return v.VisitEllipsisNode(n)
+}
+
+func (n *EllipsisNode) Rewrite(r Rewriter) Node {
+	return r.RewriteEllipsisNode(n)
+}
+
+func (n *EllipsisNode) Dump(_ func(Node, map[string]string) string) map[string]string {
 	ret := make(map[string]string)
-	ret["kind"] = "\"parameter\""
-	ret["name"] = DumpNode(n.Name(), hook)
-	ret["type"] = DumpNode(n.Type(), hook)
+	ret["kind"] = "\"ellipsis\""
 	return ret
 }
 
-func NewParameterIdentNode(filePath string, fileContent []rune, ident Node, start, end Position) Node {
-	if ident == nil {
-		ident = DummyNode
+func NewEllipsisArgumentNode(filePath string, fileContent []rune, expr Node, start, end Position) Node {
+	if expr == nil {
+		expr = DummyNode
 	}
-	_1 := &ParameterIdentNode{
-		BaseNode: NewBaseNode(filePath, fileContent, NodeTypeParameterIdent, start, end),
-		ident:    ident,
+	_1 := &EllipsisArgumentNode{
+		BaseNode: NewBaseNode(filePath, fileContent, NodeTypeEllipsisArgument, start, end),
+		expr:     expr,
 	}
 	creationHook(_1)
 	return _1
 }
 
-type ParameterIdentNode struct {
+type EllipsisArgumentNode struct {
 	*BaseNode
-	ident Node
+	expr Node
 }
 
-func (n *ParameterIdentNode) Ident() Node {
-	return n.ident
+func (n *EllipsisArgumentNode) Expr() Node {
+	return n.expr
 }
 
-func (n *ParameterIdentNode) SetIdent(v Node) {
-	n.ident = v
+func (n *EllipsisArgumentNode) SetExpr(v Node) {
+	n.expr = v
 }
 
-func (n *ParameterIdentNode) BuildLink() {
-	if !n.Ident().IsDummy() {
-		ident := n.Ident()
-		ident.BuildLink()
-		ident.SetParent(n)
-		ident.SetSelfField("ident")
-		ident.SetReplaceSelf(func(n Node) {
-			n.Parent().(*ParameterIdentNode).SetIdent(n)
+func (n *EllipsisArgumentNode) BuildLink() {
+	if !n.Expr().IsDummy() {
+		expr := n.Expr()
+		expr.BuildLink()
+		expr.SetParent(n)
+		expr.SetSelfField("expr")
+		expr.SetReplaceSelf(func(n Node) {
+			n.Parent().(*EllipsisArgumentNode).SetExpr(n)
 		})
 	}
 }
 
-func (n *ParameterIdentNode) Fields() []string {
+func (n *EllipsisArgumentNode) Fields() []string {
 	return []string{
-		"ident",
+		"expr",
 	}
 }
 
-func (n *ParameterIdentNode) Child(field string) Node {
+func (n *EllipsisArgumentNode) Child(field string) Node {
 	if field == "" {
 		return nil
 	}
-	if field == "ident" {
-		return n.Ident()
+	if field == "expr" {
+		return n.Expr()
 	}
 	return nil
 }
 
-func (n *ParameterIdentNode) SetChild(nodes []Node) {
+func (n *EllipsisArgumentNode) SetChild(nodes []Node) {
 	if len(nodes) != 1 {
 		return
 	}
-	n.SetIdent(nodes[0])
+	n.SetExpr(nodes[0])
 }
 
-func (n *ParameterIdentNode) Fork() Node {
-	_ret := &ParameterIdentNode{
+func (n *EllipsisArgumentNode) Fork() Node {
+	_ret := &EllipsisArgumentNode{
 		BaseNode: n.BaseNode.fork(),
-		ident:    n.ident.Fork(),
+		expr:     n.expr.Fork(),
 	}
-	_ret.ident.SetParent(_ret)
+	_ret.expr.SetParent(_ret)
 	return _ret
 }
 
-func (n *ParameterIdentNode) Visit(beforeChildren func(node Node) (visitChildren, exit bool), afterChildren func(node Node) (exit bool)) (exit bool) {
+func (n *EllipsisArgumentNode) Visit(beforeChildren func(node Node) (visitChildren, exit bool), afterChildren func(node Node) (exit bool)) (exit bool) {
 	vc, e := beforeChildren(n)
 	if e {
 		return true
@@ -4909,7 +5187,7 @@ func (n *ParameterIdentNode) Visit(beforeChildren func(node Node) (visitChildren
 	if !vc {
 		return false
 	}
-	if n.ident.Visit(beforeChildren, afterChildren) {
+	if n.expr.Visit(beforeChildren, afterChildren) {
 		return true
 	}
 	if afterChildren(n) {
@@ -4918,22 +5196,31 @@ func (n *ParameterIdentNode) Visit(beforeChildren func(node Node) (visitChildren
 	return false
 }
 
-func (n *ParameterIdentNode) Dump(hook func(Node, map[string]string) string) map[string]string {
+func (n *EllipsisArgumentNode) Accept(v Visitor) bool {
+	return v.VisitEllipsisArgumentNode(n)
+}
+
+func (n *EllipsisArgumentNode) Rewrite(r Rewriter) Node {
+	n.expr = rewriteChild(r, n.expr)
+	return r.RewriteEllipsisArgumentNode(n)
+}
+
+func (n *EllipsisArgumentNode) Dump(hook func(Node, map[string]string) string) map[string]string {
 	ret := make(map[string]string)
-	ret["kind"] = "\"parameter_ident\""
-	ret["ident"] = DumpNode(n.Ident(), hook)
+	ret["kind"] = "\"ellipsis_argument\""
+	ret["expr"] = DumpNode(n.Expr(), hook)
 	return ret
 }
 
-func NewFunctionResultNode(filePath string, fileContent []rune, name Node, type_ Node, start, end Position) Node {
+func NewEllipsisParameterNode(filePath string, fileContent []rune, name Node, type_ Node, start, end Position) Node {
 	if name == nil {
 		name = DummyNode
 	}
 	if type_ == nil {
 		type_ = DummyNode
 	}
-	_1 := &FunctionResultNode{
-		BaseNode: NewBaseNode(filePath, fileContent, NodeTypeFunctionResult, start, end),
+	_1 := &EllipsisParameterNode{
+		BaseNode: NewBaseNode(filePath, fileContent, NodeTypeEllipsisParameter, start, end),
 		name:     name,
 		type_:    type_,
 	}
@@ -4941,36 +5228,36 @@ func NewFunctionResultNode(filePath string, fileContent []rune, name Node, type_
 	return _1
 }
 
-type FunctionResultNode struct {
+type EllipsisParameterNode struct {
 	*BaseNode
 	name  Node
 	type_ Node
 }
 
-func (n *FunctionResultNode) Name() Node {
+func (n *EllipsisParameterNode) Name() Node {
 	return n.name
 }
 
-func (n *FunctionResultNode) SetName(v Node) {
+func (n *EllipsisParameterNode) SetName(v Node) {
 	n.name = v
 }
 
-func (n *FunctionResultNode) Type() Node {
+func (n *EllipsisParameterNode) Type() Node {
 	return n.type_
 }
 
-func (n *FunctionResultNode) SetType(v Node) {
+func (n *EllipsisParameterNode) SetType(v Node) {
 	n.type_ = v
 }
 
-func (n *FunctionResultNode) BuildLink() {
+func (n *EllipsisParameterNode) BuildLink() {
 	if !n.Name().IsDummy() {
 		name := n.Name()
 		name.BuildLink()
 		name.SetParent(n)
 		name.SetSelfField("name")
 		name.SetReplaceSelf(func(n Node) {
-			n.Parent().(*FunctionResultNode).SetName(n)
+			n.Parent().(*EllipsisParameterNode).SetName(n)
 		})
 	}
 	if !n.Type().IsDummy() {
@@ -4979,19 +5266,19 @@ func (n *FunctionResultNode) BuildLink() {
 		type_.SetParent(n)
 		type_.SetSelfField("type_")
 		type_.SetReplaceSelf(func(n Node) {
-			n.Parent().(*FunctionResultNode).SetType(n)
+			n.Parent().(*EllipsisParameterNode).SetType(n)
 		})
 	}
 }
 
-func (n *FunctionResultNode) Fields() []string {
+func (n *EllipsisParameterNode) Fields() []string {
 	return []string{
 		"name",
 		"type_",
 	}
 }
 
-func (n *FunctionResultNode) Child(field string) Node {
+func (n *EllipsisParameterNode) Child(field string) Node {
 	if field == "" {
 		return nil
 	}
@@ -5004,7 +5291,7 @@ func (n *FunctionResultNode) Child(field string) Node {
 	return nil
 }
 
-func (n *FunctionResultNode) SetChild(nodes []Node) {
+func (n *EllipsisParameterNode) SetChild(nodes []Node) {
 	if len(nodes) != 2 {
 		return
 	}
@@ -5012,8 +5299,8 @@ func (n *FunctionResultNode) SetChild(nodes []Node) {
 	n.SetType(nodes[1])
 }
 
-func (n *FunctionResultNode) Fork() Node {
-	_ret := &FunctionResultNode{
+func (n *EllipsisParameterNode) Fork() Node {
+	_ret := &EllipsisParameterNode{
 		BaseNode: n.BaseNode.fork(),
 		name:     n.name.Fork(),
 		type_:    n.type_.Fork(),
@@ -5023,7 +5310,7 @@ func (n *FunctionResultNode) Fork() Node {
 	return _ret
 }
 
-func (n *FunctionResultNode) Visit(beforeChildren func(node Node) (visitChildren, exit bool), afterChildren func(node Node) (exit bool)) (exit bool) {
+func (n *EllipsisParameterNode) Visit(beforeChildren func(node Node) (visitChildren, exit bool), afterChildren func(node Node) (exit bool)) (exit bool) {
 	vc, e := beforeChildren(n)
 	if e {
 		return true
@@ -5043,84 +5330,94 @@ func (n *FunctionResultNode) Visit(beforeChildren func(node Node) (visitChildren
 	return false
 }
 
-func (n *FunctionResultNode) Dump(hook func(Node, map[string]string) string) map[string]string {
+func (n *EllipsisParameterNode) Accept(v Visitor) bool {
+	return v.VisitEllipsisParameterNode(n)
+}
+
+func (n *EllipsisParameterNode) Rewrite(r Rewriter) Node {
+	n.name = rewriteChild(r, n.name)
+	n.type_ = rewriteChild(r, n.type_)
+	return r.RewriteEllipsisParameterNode(n)
+}
+
+func (n *EllipsisParameterNode) Dump(hook func(Node, map[string]string) string) map[string]string {
 	ret := make(map[string]string)
-	ret["kind"] = "\"function_result\""
+	ret["kind"] = "\"ellipsis_parameter\""
 	ret["name"] = DumpNode(n.Name(), hook)
 	ret["type"] = DumpNode(n.Type(), hook)
 	return ret
 }
 
-func NewFunctionResultIdentNode(filePath string, fileContent []rune, ident Node, start, end Position) Node {
-	if ident == nil {
-		ident = DummyNode
+func NewExprStmtNode(filePath string, fileContent []rune, x Node, start, end Position) Node {
+	if x == nil {
+		x = DummyNode
 	}
-	_1 := &FunctionResultIdentNode{
-		BaseNode: NewBaseNode(filePath, fileContent, NodeTypeFunctionResultIdent, start, end),
-		ident:    ident,
+	_1 := &ExprStmtNode{
+		BaseNode: NewBaseNode(filePath, fileContent, NodeTypeExprStmt, start, end),
+		x:        x,
 	}
 	creationHook(_1)
 	return _1
 }
 
-type FunctionResultIdentNode struct {
+type ExprStmtNode struct {
 	*BaseNode
-	ident Node
+	x Node
 }
 
-func (n *FunctionResultIdentNode) Ident() Node {
-	return n.ident
+func (n *ExprStmtNode) X() Node {
+	return n.x
 }
 
-func (n *FunctionResultIdentNode) SetIdent(v Node) {
-	n.ident = v
+func (n *ExprStmtNode) SetX(v Node) {
+	n.x = v
 }
 
-func (n *FunctionResultIdentNode) BuildLink() {
-	if !n.Ident().IsDummy() {
-		ident := n.Ident()
-		ident.BuildLink()
-		ident.SetParent(n)
-		ident.SetSelfField("ident")
-		ident.SetReplaceSelf(func(n Node) {
-			n.Parent().(*FunctionResultIdentNode).SetIdent(n)
+func (n *ExprStmtNode) BuildLink() {
+	if !n.X().IsDummy() {
+		x := n.X()
+		x.BuildLink()
+		x.SetParent(n)
+		x.SetSelfField("x")
+		x.SetReplaceSelf(func(n Node) {
+			n.Parent().(*ExprStmtNode).SetX(n)
 		})
 	}
 }
 
-func (n *FunctionResultIdentNode) Fields() []string {
+func (n *ExprStmtNode) Fields() []string {
 	return []string{
-		"ident",
+		"x",
 	}
 }
 
-func (n *FunctionResultIdentNode) Child(field string) Node {
+func (n *ExprStmtNode) Child(field string) Node {
 	if field == "" {
 		return nil
 	}
-	if field == "ident" {
-		return n.Ident()
+	if field == "x" {
+		return n.X()
 	}
 	return nil
 }
 
-func (n *FunctionResultIdentNode) SetChild(nodes []Node) {
+func (n *ExprStmtNode) SetChild(nodes []Node) {
 	if len(nodes) != 1 {
 		return
 	}
-	n.SetIdent(nodes[0])
+	n.SetX(nodes[0])
 }
 
-func (n *FunctionResultIdentNode) Fork() Node {
-	_ret := &FunctionResultIdentNode{
+func (n *ExprStmtNode) Fork() Node {
+	_ret := &ExprStmtNode{
 		BaseNode: n.BaseNode.fork(),
-		ident:    n.ident.Fork(),
+		x:        n.x.Fork(),
 	}
-	_ret.ident.SetParent(_ret)
+	_ret.x.SetParent(_ret)
 	return _ret
 }
 
-func (n *FunctionResultIdentNode) Visit(beforeChildren func(node Node) (visitChildren, exit bool), afterChildren func(node Node) (exit bool)) (exit bool) {
+func (n *ExprStmtNode) Visit(beforeChildren func(node Node) (visitChildren, exit bool), afterChildren func(node Node) (exit bool)) (exit bool) {
 	vc, e := beforeChildren(n)
 	if e {
 		return true
@@ -5128,7 +5425,7 @@ func (n *FunctionResultIdentNode) Visit(beforeChildren func(node Node) (visitChi
 	if !vc {
 		return false
 	}
-	if n.ident.Visit(beforeChildren, afterChildren) {
+	if n.x.Visit(beforeChildren, afterChildren) {
 		return true
 	}
 	if afterChildren(n) {
@@ -5137,199 +5434,60 @@ func (n *FunctionResultIdentNode) Visit(beforeChildren func(node Node) (visitChi
 	return false
 }
 
-func (n *FunctionResultIdentNode) Dump(hook func(Node, map[string]string) string) map[string]string {
+func (n *ExprStmtNode) Accept(v Visitor) bool {
+	return v.VisitExprStmtNode(n)
+}
+
+func (n *ExprStmtNode) stmtNode() {}
+
+func (n *ExprStmtNode) Rewrite(r Rewriter) Node {
+	n.x = rewriteChild(r, n.x)
+	return r.RewriteExprStmtNode(n)
+}
+
+func (n *ExprStmtNode) Dump(hook func(Node, map[string]string) string) map[string]string {
 	ret := make(map[string]string)
-	ret["kind"] = "\"function_result_ident\""
-	ret["ident"] = DumpNode(n.Ident(), hook)
+	ret["kind"] = "\"expr_stmt\""
+	ret["x"] = DumpNode(n.X(), hook)
 	return ret
 }
 
-func NewFunctionDeclNode(filePath string, fileContent []rune, name Node, genericParameters Node, parameters Node, results Node, body Node, start, end Position) Node {
-	if name == nil {
-		name = DummyNode
-	}
-	if genericParameters == nil {
-		genericParameters = DummyNode
-	}
-	if parameters == nil {
-		parameters = DummyNode
-	}
-	if results == nil {
-		results = DummyNode
-	}
-	if body == nil {
-		body = DummyNode
-	}
-	_1 := &FunctionDeclNode{
-		BaseNode:          NewBaseNode(filePath, fileContent, NodeTypeFunctionDecl, start, end),
-		name:              name,
-		genericParameters: genericParameters,
-		parameters:        parameters,
-		results:           results,
-		body:              body,
+func NewFallthroughStmtNode(filePath string, fileContent []rune, start, end Position) Node {
+	_1 := &FallthroughStmtNode{
+		BaseNode: NewBaseNode(filePath, fileContent, NodeTypeFallthroughStmt, start, end),
 	}
 	creationHook(_1)
 	return _1
 }
 
-type FunctionDeclNode struct {
+type FallthroughStmtNode struct {
 	*BaseNode
-	name              Node
-	genericParameters Node
-	parameters        Node
-	results           Node
-	body              Node
-}
-
-func (n *FunctionDeclNode) Name() Node {
-	return n.name
 }
 
-func (n *FunctionDeclNode) SetName(v Node) {
-	n.name = v
-}
-
-func (n *FunctionDeclNode) GenericParameters() Node {
-	return n.genericParameters
-}
-
-func (n *FunctionDeclNode) SetGenericParameters(v Node) {
-	n.genericParameters = v
-}
-
-func (n *FunctionDeclNode) Parameters() Node {
-	return n.parameters
-}
-
-func (n *FunctionDeclNode) SetParameters(v Node) {
-	n.parameters = v
-}
-
-func (n *FunctionDeclNode) Results() Node {
-	return n.results
-}
-
-func (n *FunctionDeclNode) SetResults(v Node) {
-	n.results = v
-}
-
-func (n *FunctionDeclNode) Body() Node {
-	return n.body
-}
-
-func (n *FunctionDeclNode) SetBody(v Node) {
-	n.body = v
-}
-
-func (n *FunctionDeclNode) BuildLink() {
-	if !n.Name().IsDummy() {
-		name := n.Name()
-		name.BuildLink()
-		name.SetParent(n)
-		name.SetSelfField("name")
-		name.SetReplaceSelf(func(n Node) {
-			n.Parent().(*FunctionDeclNode).SetName(n)
-		})
-	}
-	if !n.GenericParameters().IsDummy() {
-		genericParameters := n.GenericParameters()
-		genericParameters.BuildLink()
-		genericParameters.SetParent(n)
-		genericParameters.SetSelfField("generic_parameters")
-		genericParameters.SetReplaceSelf(func(n Node) {
-			n.Parent().(*FunctionDeclNode).SetGenericParameters(n)
-		})
-	}
-	if !n.Parameters().IsDummy() {
-		parameters := n.Parameters()
-		parameters.BuildLink()
-		parameters.SetParent(n)
-		parameters.SetSelfField("parameters")
-		parameters.SetReplaceSelf(func(n Node) {
-			n.Parent().(*FunctionDeclNode).SetParameters(n)
-		})
-	}
-	if !n.Results().IsDummy() {
-		results := n.Results()
-		results.BuildLink()
-		results.SetParent(n)
-		results.SetSelfField("results")
-		results.SetReplaceSelf(func(n Node) {
-			n.Parent().(*FunctionDeclNode).SetResults(n)
-		})
-	}
-	if !n.Body().IsDummy() {
-		body := n.Body()
-		body.BuildLink()
-		body.SetParent(n)
-		body.SetSelfField("body")
-		body.SetReplaceSelf(func(n Node) {
-			n.Parent().(*FunctionDeclNode).SetBody(n)
-		})
-	}
-}
-
-func (n *FunctionDeclNode) Fields() []string {
-	return []string{
-		"name",
-		"generic_parameters",
-		"parameters",
-		"results",
-		"body",
-	}
+func (n *FallthroughStmtNode) BuildLink() {
 }
 
-func (n *FunctionDeclNode) Child(field string) Node {
+func (n *FallthroughStmtNode) Child(field string) Node {
 	if field == "" {
 		return nil
 	}
-	if field == "name" {
-		return n.Name()
-	}
-	if field == "generic_parameters" {
-		return n.GenericParameters()
-	}
-	if field == "parameters" {
-		return n.Parameters()
-	}
-	if field == "results" {
-		return n.Results()
-	}
-	if field == "body" {
-		return n.Body()
-	}
 	return nil
 }
 
-func (n *FunctionDeclNode) SetChild(nodes []Node) {
-	if len(nodes) != 5 {
+func (n *FallthroughStmtNode) SetChild(nodes []Node) {
+	if len(nodes) != 0 {
 		return
 	}
-	n.SetName(nodes[0])
-	n.SetGenericParameters(nodes[1])
-	n.SetParameters(nodes[2])
-	n.SetResults(nodes[3])
-	n.SetBody(nodes[4])
 }
 
-func (n *FunctionDeclNode) Fork() Node {
-	_ret := &FunctionDeclNode{
-		BaseNode:          n.BaseNode.fork(),
-		name:              n.name.Fork(),
-		genericParameters: n.genericParameters.Fork(),
-		parameters:        n.parameters.Fork(),
-		results:           n.results.Fork(),
-		body:              n.body.Fork(),
+func (n *FallthroughStmtNode) Fork() Node {
+	_ret := &FallthroughStmtNode{
+		BaseNode: n.BaseNode.fork(),
 	}
-	_ret.name.SetParent(_ret)
-	_ret.genericParameters.SetParent(_ret)
-	_ret.parameters.SetParent(_ret)
-	_ret.results.SetParent(_ret)
-	_ret.body.SetParent(_ret)
 	return _ret
 }
 
-func (n *FunctionDeclNode) Visit(beforeChildren func(node Node) (visitChildren, exit bool), afterChildren func(node Node) (exit bool)) (exit bool) {
+func (n *FallthroughStmtNode) Visit(beforeChildren func(node Node) (visitChildren, exit bool), afterChildren func(node Node) (exit bool)) (exit bool) {
 	vc, e := beforeChildren(n)
 	if e {
 		return true
@@ -5337,253 +5495,156 @@ func (n *FunctionDeclNode) Visit(beforeChildren func(node Node) (visitChildren,
 	if !vc {
 		return false
 	}
-	if n.name.Visit(beforeChildren, afterChildren) {
-		return true
-	}
-	if n.genericParameters.Visit(beforeChildren, afterChildren) {
-		return true
-	}
-	if n.parameters.Visit(beforeChildren, afterChildren) {
-		return true
-	}
-	if n.results.Visit(beforeChildren, afterChildren) {
-		return true
-	}
-	if n.body.Visit(beforeChildren, afterChildren) {
-		return true
-	}
 	if afterChildren(n) {
 		return true
 	}
 	return false
 }
 
-func (n *FunctionDeclNode) Dump(hook func(Node, map[string]string) string) map[string]string {
-	ret := make(map[string]string)
-	ret["kind"] = "\"function_decl\""
-	ret["name"] = DumpNode(n.Name(), hook)
-	ret["generic_parameters"] = DumpNode(n.GenericParameters(), hook)
-	ret["parameters"] = DumpNode(n.Parameters(), hook)
-	ret["results"] = DumpNode(n.Results(), hook)
-	ret["body"] = DumpNode(n.Body(), hook)
-	return ret
+func (n *FallthroughStmtNode) Accept(v Visitor) bool {
+	return v.VisitFallthroughStmtNode(n)
 }
 
-func NewMethodDeclNode(filePath string, fileContent []rune, receiver Node, name Node, genericParameters Node, parameters Node, results Node, body Node, start, end Position) Node {
-	if receiver == nil {
-		receiver = DummyNode
-	}
-	if name == nil {
-		name = DummyNode
-	}
-	if genericParameters == nil {
-		genericParameters = DummyNode
-	}
-	if parameters == nil {
-		parameters = DummyNode
+func (n *FallthroughStmtNode) stmtNode() {}
+
+func (n *FallthroughStmtNode) Rewrite(r Rewriter) Node {
+	return r.RewriteFallthroughStmtNode(n)
+}
+
+func (n *FallthroughStmtNode) Dump(_ func(Node, map[string]string) string) map[string]string {
+	ret := make(map[string]string)
+	ret["kind"] = "\"fallthrough_stmt\""
+	return ret
+}
+
+func NewFieldNode(filePath string, fileContent []rune, names Node, type_ Node, tag Node, start, end Position) Node {
+	if names == nil {
+		names = DummyNode
 	}
-	if results == nil {
-		results = DummyNode
+	if type_ == nil {
+		type_ = DummyNode
 	}
-	if body == nil {
-		body = DummyNode
+	if tag == nil {
+		tag = DummyNode
 	}
-	_1 := &MethodDeclNode{
-		BaseNode:          NewBaseNode(filePath, fileContent, NodeTypeMethodDecl, start, end),
-		receiver:          receiver,
-		name:              name,
-		genericParameters: genericParameters,
-		parameters:        parameters,
-		results:           results,
-		body:              body,
+	_1 := &FieldNode{
+		BaseNode: NewBaseNode(filePath, fileContent, NodeTypeField, start, end),
+		names:    names,
+		type_:    type_,
+		tag:      tag,
 	}
 	creationHook(_1)
 	return _1
 }
 
-type MethodDeclNode struct {
+type FieldNode struct {
 	*BaseNode
-	receiver          Node
-	name              Node
-	genericParameters Node
-	parameters        Node
-	results           Node
-	body              Node
-}
-
-func (n *MethodDeclNode) Receiver() Node {
-	return n.receiver
-}
-
-func (n *MethodDeclNode) SetReceiver(v Node) {
-	n.receiver = v
-}
-
-func (n *MethodDeclNode) Name() Node {
-	return n.name
-}
-
-func (n *MethodDeclNode) SetName(v Node) {
-	n.name = v
-}
-
-func (n *MethodDeclNode) GenericParameters() Node {
-	return n.genericParameters
-}
-
-func (n *MethodDeclNode) SetGenericParameters(v Node) {
-	n.genericParameters = v
+	names Node
+	type_ Node
+	tag   Node
 }
 
-func (n *MethodDeclNode) Parameters() Node {
-	return n.parameters
+func (n *FieldNode) Names() Node {
+	return n.names
 }
 
-func (n *MethodDeclNode) SetParameters(v Node) {
-	n.parameters = v
+func (n *FieldNode) SetNames(v Node) {
+	n.names = v
 }
 
-func (n *MethodDeclNode) Results() Node {
-	return n.results
+func (n *FieldNode) Type() Node {
+	return n.type_
 }
 
-func (n *MethodDeclNode) SetResults(v Node) {
-	n.results = v
+func (n *FieldNode) SetType(v Node) {
+	n.type_ = v
 }
 
-func (n *MethodDeclNode) Body() Node {
-	return n.body
+func (n *FieldNode) Tag() Node {
+	return n.tag
 }
 
-func (n *MethodDeclNode) SetBody(v Node) {
-	n.body = v
+func (n *FieldNode) SetTag(v Node) {
+	n.tag = v
 }
 
-func (n *MethodDeclNode) BuildLink() {
-	if !n.Receiver().IsDummy() {
-		receiver := n.Receiver()
-		receiver.BuildLink()
-		receiver.SetParent(n)
-		receiver.SetSelfField("receiver")
-		receiver.SetReplaceSelf(func(n Node) {
-			n.Parent().(*MethodDeclNode).SetReceiver(n)
-		})
-	}
-	if !n.Name().IsDummy() {
-		name := n.Name()
-		name.BuildLink()
-		name.SetParent(n)
-		name.SetSelfField("name")
-		name.SetReplaceSelf(func(n Node) {
-			n.Parent().(*MethodDeclNode).SetName(n)
-		})
-	}
-	if !n.GenericParameters().IsDummy() {
-		genericParameters := n.GenericParameters()
-		genericParameters.BuildLink()
-		genericParameters.SetParent(n)
-		genericParameters.SetSelfField("generic_parameters")
-		genericParameters.SetReplaceSelf(func(n Node) {
-			n.Parent().(*MethodDeclNode).SetGenericParameters(n)
-		})
-	}
-	if !n.Parameters().IsDummy() {
-		parameters := n.Parameters()
-		parameters.BuildLink()
-		parameters.SetParent(n)
-		parameters.SetSelfField("parameters")
-		parameters.SetReplaceSelf(func(n Node) {
-			n.Parent().(*MethodDeclNode).SetParameters(n)
+func (n *FieldNode) BuildLink() {
+	if !n.Names().IsDummy() {
+		names := n.Names()
+		names.BuildLink()
+		names.SetParent(n)
+		names.SetSelfField("names")
+		names.SetReplaceSelf(func(n Node) {
+			n.Parent().(*FieldNode).SetNames(n)
 		})
 	}
-	if !n.Results().IsDummy() {
-		results := n.Results()
-		results.BuildLink()
-		results.SetParent(n)
-		results.SetSelfField("results")
-		results.SetReplaceSelf(func(n Node) {
-			n.Parent().(*MethodDeclNode).SetResults(n)
+	if !n.Type().IsDummy() {
+		type_ := n.Type()
+		type_.BuildLink()
+		type_.SetParent(n)
+		type_.SetSelfField("type_")
+		type_.SetReplaceSelf(func(n Node) {
+			n.Parent().(*FieldNode).SetType(n)
 		})
 	}
-	if !n.Body().IsDummy() {
-		body := n.Body()
-		body.BuildLink()
-		body.SetParent(n)
-		body.SetSelfField("body")
-		body.SetReplaceSelf(func(n Node) {
-			n.Parent().(*MethodDeclNode).SetBody(n)
+	if !n.Tag().IsDummy() {
+		tag := n.Tag()
+		tag.BuildLink()
+		tag.SetParent(n)
+		tag.SetSelfField("tag")
+		tag.SetReplaceSelf(func(n Node) {
+			n.Parent().(*FieldNode).SetTag(n)
 		})
 	}
 }
 
-func (n *MethodDeclNode) Fields() []string {
+func (n *FieldNode) Fields() []string {
 	return []string{
-		"receiver",
-		"name",
-		"generic_parameters",
-		"parameters",
-		"results",
-		"body",
+		"names",
+		"type_",
+		"tag",
 	}
 }
 
-func (n *MethodDeclNode) Child(field string) Node {
+func (n *FieldNode) Child(field string) Node {
 	if field == "" {
 		return nil
 	}
-	if field == "receiver" {
-		return n.Receiver()
-	}
-	if field == "name" {
-		return n.Name()
-	}
-	if field == "generic_parameters" {
-		return n.GenericParameters()
-	}
-	if field == "parameters" {
-		return n.Parameters()
+	if field == "names" {
+		return n.Names()
 	}
-	if field == "results" {
-		return n.Results()
+	if field == "type_" {
+		return n.Type()
 	}
-	if field == "body" {
-		return n.Body()
+	if field == "tag" {
+		return n.Tag()
 	}
 	return nil
 }
 
-func (n *MethodDeclNode) SetChild(nodes []Node) {
-	if len(nodes) != 6 {
+func (n *FieldNode) SetChild(nodes []Node) {
+	if len(nodes) != 3 {
 		return
 	}
-	n.SetReceiver(nodes[0])
-	n.SetName(nodes[1])
-	n.SetGenericParameters(nodes[2])
-	n.SetParameters(nodes[3])
-	n.SetResults(nodes[4])
-	n.SetBody(nodes[5])
+	n.SetNames(nodes[0])
+	n.SetType(nodes[1])
+	n.SetTag(nodes[2])
 }
 
-func (n *MethodDeclNode) Fork() Node {
-	_ret := &MethodDeclNode{
-		BaseNode:          n.BaseNode.fork(),
-		receiver:          n.receiver.Fork(),
-		name:              n.name.Fork(),
-		genericParameters: n.genericParameters.Fork(),
-		parameters:        n.parameters.Fork(),
-		results:           n.results.Fork(),
-		body:              n.body.Fork(),
+func (n *FieldNode) Fork() Node {
+	_ret := &FieldNode{
+		BaseNode: n.BaseNode.fork(),
+		names:    n.names.Fork(),
+		type_:    n.type_.Fork(),
+		tag:      n.tag.Fork(),
 	}
-	_ret.receiver.SetParent(_ret)
-	_ret.name.SetParent(_ret)
-	_ret.genericParameters.SetParent(_ret)
-	_ret.parameters.SetParent(_ret)
-	_ret.results.SetParent(_ret)
-	_ret.body.SetParent(_ret)
+	_ret.names.SetParent(_ret)
+	_ret.type_.SetParent(_ret)
+	_ret.tag.SetParent(_ret)
 	return _ret
 }
 
-func (n *MethodDeclNode) Visit(beforeChildren func(node Node) (visitChildren, exit bool), afterChildren func(node Node) (exit bool)) (exit bool) {
+func (n *FieldNode) Visit(beforeChildren func(node Node) (visitChildren, exit bool), afterChildren func(node Node) (exit bool)) (exit bool) {
 	vc, e := beforeChildren(n)
 	if e {
 		return true
@@ -5591,22 +5652,13 @@ func (n *MethodDeclNode) Visit(beforeChildren func(node Node) (visitChildren, ex
 	if !vc {
 		return false
 	}
-	if n.receiver.Visit(beforeChildren, afterChildren) {
-		return true
-	}
-	if n.name.Visit(beforeChildren, afterChildren) {
-		return true
-	}
-	if n.genericParameters.Visit(beforeChildren, afterChildren) {
-		return true
-	}
-	if n.parameters.Visit(beforeChildren, afterChildren) {
+	if n.names.Visit(beforeChildren, afterChildren) {
 		return true
 	}
-	if n.results.Visit(beforeChildren, afterChildren) {
+	if n.type_.Visit(beforeChildren, afterChildren) {
 		return true
 	}
-	if n.body.Visit(beforeChildren, afterChildren) {
+	if n.tag.Visit(beforeChildren, afterChildren) {
 		return true
 	}
 	if afterChildren(n) {
@@ -5615,88 +5667,96 @@ func (n *MethodDeclNode) Visit(beforeChildren func(node Node) (visitChildren, ex
 	return false
 }
 
-func (n *MethodDeclNode) Dump(hook func(Node, map[string]string) string) map[string]string {
+func (n *FieldNode) Accept(v Visitor) bool {
+	return v.VisitFieldNode(n)
+}
+
+func (n *FieldNode) Rewrite(r Rewriter) Node {
+	n.names = rewriteChild(r, n.names)
+	n.type_ = rewriteChild(r, n.type_)
+	n.tag = rewriteChild(r, n.tag)
+	return r.RewriteFieldNode(n)
+}
+
+func (n *FieldNode) Dump(hook func(Node, map[string]string) string) map[string]string {
 	ret := make(map[string]string)
-	ret["kind"] = "\"method_decl\""
-	ret["receiver"] = DumpNode(n.Receiver(), hook)
-	ret["name"] = DumpNode(n.Name(), hook)
-	ret["generic_parameters"] = DumpNode(n.GenericParameters(), hook)
-	ret["parameters"] = DumpNode(n.Parameters(), hook)
-	ret["results"] = DumpNode(n.Results(), hook)
-	ret["body"] = DumpNode(n.Body(), hook)
+	ret["kind"] = "\"field\""
+	ret["names"] = DumpNode(n.Names(), hook)
+	ret["type"] = DumpNode(n.Type(), hook)
+	ret["tag"] = DumpNode(n.Tag(), hook)
 	return ret
 }
 
-func NewReceiverIdentNode(filePath string, fileContent []rune, ident Node, start, end Position) Node {
-	if ident == nil {
-		ident = DummyNode
+func NewFieldListNode(filePath string, fileContent []rune, x Node, start, end Position) Node {
+	if x == nil {
+		x = DummyNode
 	}
-	_1 := &ReceiverIdentNode{
-		BaseNode: NewBaseNode(filePath, fileContent, NodeTypeReceiverIdent, start, end),
-		ident:    ident,
+	_1 := &FieldListNode{
+		BaseNode: NewBaseNode(filePath, fileContent, NodeTypeFieldList, start, end),
+		x:        x,
 	}
 	creationHook(_1)
 	return _1
 }
 
-type ReceiverIdentNode struct {
+type FieldListNode struct {
 	*BaseNode
-	ident Node
+	x Node
 }
 
-func (n *ReceiverIdentNode) Ident() Node {
-	return n.ident
+func (n *FieldListNode) X() Node {
+	return n.x
 }
 
-func (n *ReceiverIdentNode) SetIdent(v Node) {
-	n.ident = v
+func (n *FieldListNode) SetX(v Node) {
+	n.x = v
 }
 
-func (n *ReceiverIdentNode) BuildLink() {
-	if !n.Ident().IsDummy() {
-		ident := n.Ident()
-		ident.BuildLink()
-		ident.SetParent(n)
-		ident.SetSelfField("ident")
-		ident.SetReplaceSelf(func(n Node) {
-			n.Parent().(*ReceiverIdentNode).SetIdent(n)
+func (n *FieldListNode) BuildLink() {
+	if !n.X().IsDummy() {
+		x := n.X()
+		x.BuildLink()
+		x.SetParent(n)
+		x.SetSelfField("x")
+		x.SetReplaceSelf(func(n Node) {
+			n.Parent().(*FieldListNode).SetX(n)
 		})
 	}
 }
 
-func (n *ReceiverIdentNode) Fields() []string {
+func (n *FieldListNode) Fields() []string {
 	return []string{
-		"ident",
+		"x",
 	}
 }
 
-func (n *ReceiverIdentNode) Child(field string) Node {
+func (n *FieldListNode) Child(field string) Node {
 	if field == "" {
 		return nil
 	}
-	if field == "ident" {
-		return n.Ident()
+	if field == "x" {
+		return n.X()
 	}
 	return nil
 }
 
-func (n *ReceiverIdentNode) SetChild(nodes []Node) {
+func (n *FieldListNode) SetChild(nodes []Node) {
 	if len(nodes) != 1 {
 		return
 	}
-	n.SetIdent(nodes[0])
+	n.SetX(nodes[0])
 }
 
-func (n *ReceiverIdentNode) Fork() Node {
-	_ret := &ReceiverIdentNode{
+func (n *FieldListNode) Fork() Node {
+	_ret := &FieldListNode{
 		BaseNode: n.BaseNode.fork(),
-		ident:    n.ident.Fork(),
+		x:        n.x.Fork(),
 	}
-	_ret.ident.SetParent(_ret)
+	_ret.x.SetParent(_ret)
 	return _ret
 }
 
-func (n *ReceiverIdentNode) Visit(beforeChildren func(node Node) (visitChildren, exit bool), afterChildren func(node Node) (exit bool)) (exit bool) {
+func (n *FieldListNode) Visit(beforeChildren func(node Node) (visitChildren, exit bool), afterChildren func(node Node) (exit bool)) (exit bool) {
 	vc, e := beforeChildren(n)
 	if e {
 		return true
@@ -5704,7 +5764,7 @@ func (n *ReceiverIdentNode) Visit(beforeChildren func(node Node) (visitChildren,
 	if !vc {
 		return false
 	}
-	if n.ident.Visit(beforeChildren, afterChildren) {
+	if n.x.Visit(beforeChildren, afterChildren) {
 		return true
 	}
 	if afterChildren(n) {
@@ -5713,57 +5773,66 @@ func (n *ReceiverIdentNode) Visit(beforeChildren func(node Node) (visitChildren,
 	return false
 }
 
-func (n *ReceiverIdentNode) Dump(hook func(Node, map[string]string) string) map[string]string {
+func (n *FieldListNode) Accept(v Visitor) bool {
+	return v.VisitFieldListNode(n)
+}
+
+func (n *FieldListNode) Rewrite(r Rewriter) Node {
+	n.x = rewriteChild(r, n.x)
+	return r.RewriteFieldListNode(n)
+}
+
+func (n *FieldListNode) Dump(hook func(Node, map[string]string) string) map[string]string {
 	ret := make(map[string]string)
-	ret["kind"] = "\"receiver_ident\""
-	ret["ident"] = DumpNode(n.Ident(), hook)
+	ret["kind"] = "\"field_list\""
+	ret["x"] = DumpNode(n.X(), hook)
 	return ret
 }
 
-func NewReceiverTypeIdentNode(filePath string, fileContent []rune, ident Node, start, end Position) Node {
+func NewFunctionIdentNode(filePath string, fileContent []rune, ident Node, start, end Position) Node {
 	if ident == nil {
 		ident = DummyNode
 	}
-	_1 := &ReceiverTypeIdentNode{
-		BaseNode: NewBaseNode(filePath, fileContent, NodeTypeReceiverTypeIdent, start, end),
+	_1 := &FunctionIdentNode{
+		BaseNode: NewBaseNode(filePath, fileContent, NodeTypeFunctionIdent, start, end),
 		ident:    ident,
 	}
 	creationHook(_1)
 	return _1
 }
 
-type ReceiverTypeIdentNode struct {
+type FunctionIdentNode struct {
 	*BaseNode
 	ident Node
 }
 
-func (n *ReceiverTypeIdentNode) Ident() Node {
+func (n *FunctionIdentNode) Ident() Node {
 	return n.ident
 }
 
-func (n *ReceiverTypeIdentNode) SetIdent(v Node) {
+func (n *FunctionIdentNode) SetIdent(v Node) {
 	n.ident = v
 }
 
-func (n *ReceiverTypeIdentNode) BuildLink() {
+func (n *FunctionIdentNode) BuildLink() {
 	if !n.Ident().IsDummy() {
 		ident := n.Ident()
 		ident.BuildLink()
 		ident.SetParent(n)
 		ident.SetSelfField("ident")
 		ident.SetReplaceSelf(func(n Node) {
-			n.Parent().(*ReceiverTypeIdentNode).SetIdent(n)
+			n.Parent().(*FunctionIdentNode).SetIdent(n)
 		})
 	}
 }
 
-func (n *ReceiverTypeIdentNode) Fields() []string {
+func (n *FunctionIdentNode) Fields() []string {
 	return []string{
 		"ident",
 	}
 }
 
-func (n *ReceiverTypeIdentNode) Child(field string) Node {
+func (n *FunctionIdentNode) Child(field string) Node {
 	if field == "" {
 		return nil
 	}
@@ -5773,15 +5842,15 @@ func (n *ReceiverTypeIdentNode) Child(field string) Node {
 	return nil
 }
 
-func (n *ReceiverTypeIdentNode) SetChild(nodes []Node) {
+func (n *FunctionIdentNode) SetChild(nodes []Node) {
 	if len(nodes) != 1 {
 		return
 	}
 	n.SetIdent(nodes[0])
 }
 
-func (n *ReceiverTypeIdentNode) Fork() Node {
-	_ret := &ReceiverTypeIdentNode{
+func (n *FunctionIdentNode) Fork() Node {
+	_ret := &FunctionIdentNode{
 		BaseNode: n.BaseNode.fork(),
 		ident:    n.ident.Fork(),
 	}
@@ -5789,7 +5858,7 @@ func (n *ReceiverTypeIdentNode) Fork() Node {
 	return _ret
 }
 
-func (n *ReceiverTypeIdentNode) Visit(beforeChildren func(node Node) (visitChildren, exit bool), afterChildren func(node Node) (exit bool)) (exit bool) {
+func (n *FunctionIdentNode) Visit(beforeChildren func(node Node) (visitChildren, exit bool), afterChildren func(node Node) (exit bool)) (exit bool) {
 	vc, e := beforeChildren(n)
 	if e {
 		return true
@@ -5806,83 +5875,121 @@ func (n *ReceiverTypeIdentNode) Visit(beforeChildren func(node Node) (visitChild
 	return false
 }
 
-func (n *ReceiverTypeIdentNode) Dump(hook func(Node, map[string]string) string) map[string]string {
+func (n *FunctionIdentNode) Accept(v Visitor) bool {
+	return v.VisitFunctionIdentNode(n)
+}
+
+func (n *FunctionIdentNode) Rewrite(r Rewriter) Node {
+	n.ident = rewriteChild(r, n.ident)
+	return r.RewriteFunctionIdentNode(n)
+}
+
+func (n *FunctionIdentNode) Dump(hook func(Node, map[string]string) string) map[string]string {
 	ret := make(map[string]string)
-	ret["kind"] = "\"receiver_type_ident\""
+	ret["kind"] = "\"function_ident\""
 	ret["ident"] = DumpNode(n.Ident(), hook)
 	return ret
 }
 
-func NewReceiverGenericTypeIdentNode(filePath string, fileContent []rune, ident Node, start, end Position) Node {
-	if ident == nil {
-		ident = DummyNode
+func NewFunctionLitNode(filePath string, fileContent []rune, x Node, y Node, start, end Position) Node {
+	if x == nil {
+		x = DummyNode
 	}
-	_1 := &ReceiverGenericTypeIdentNode{
-		BaseNode: NewBaseNode(filePath, fileContent, NodeTypeReceiverGenericTypeIdent, start, end),
-		ident:    ident,
+	if y == nil {
+		y = DummyNode
+	}
+	_1 := &FunctionLitNode{
+		BaseNode: NewBaseNode(filePath, fileContent, NodeTypeFunctionLit, start, end),
+		x:        x,
+		y:        y,
 	}
 	creationHook(_1)
 	return _1
 }
 
-type ReceiverGenericTypeIdentNode struct {
+type FunctionLitNode struct {
 	*BaseNode
-	ident Node
+	x Node
+	y Node
 }
 
-func (n *ReceiverGenericTypeIdentNode) Ident() Node {
-	return n.ident
+func (n *FunctionLitNode) X() Node {
+	return n.x
 }
 
-func (n *ReceiverGenericTypeIdentNode) SetIdent(v Node) {
-	n.ident = v
+func (n *FunctionLitNode) SetX(v Node) {
+	n.x = v
 }
 
-func (n *ReceiverGenericTypeIdentNode) BuildLink() {
-	if !n.Ident().IsDummy() {
-		ident := n.Ident()
-		ident.BuildLink()
-		ident.SetParent(n)
-		ident.SetSelfField("ident")
-		ident.SetReplaceSelf(func(n Node) {
-			n.Parent().(*ReceiverGenericTypeIdentNode).SetIdent(n)
+func (n *FunctionLitNode) Y() Node {
+	return n.y
+}
+
+func (n *FunctionLitNode) SetY(v Node) {
+	n.y = v
+}
+
+func (n *FunctionLitNode) BuildLink() {
+	if !n.X().IsDummy() {
+		x := n.X()
+		x.BuildLink()
+		x.SetParent(n)
+		x.SetSelfField("x")
+		x.SetReplaceSelf(func(n Node) {
+			n.Parent().(*FunctionLitNode).SetX(n)
+		})
+	}
+	if !n.Y().IsDummy() {
+		y := n.Y()
+		y.BuildLink()
+		y.SetParent(n)
+		y.SetSelfField("y")
+		y.SetReplaceSelf(func(n Node) {
+			n.Parent().(*FunctionLitNode).SetY(n)
 		})
 	}
 }
 
-func (n *ReceiverGenericTypeIdentNode) Fields() []string {
+func (n *FunctionLitNode) Fields() []string {
 	return []string{
-		"ident",
+		"x",
+		"y",
 	}
 }
 
-func (n *ReceiverGenericTypeIdentNode) Child(field string) Node {
+func (n *FunctionLitNode) Child(field string) Node {
 	if field == "" {
 		return nil
 	}
-	if field == "ident" {
-		return n.Ident()
+	if field == "x" {
+		return n.X()
+	}
+	if field == "y" {
+		return n.Y()
 	}
 	return nil
 }
 
-func (n *ReceiverGenericTypeIdentNode) SetChild(nodes []Node) {
-	if len(nodes) != 1 {
+func (n *FunctionLitNode) SetChild(nodes []Node) {
+	if len(nodes) != 2 {
 		return
 	}
-	n.SetIdent(nodes[0])
+	n.SetX(nodes[0])
+	n.SetY(nodes[1])
 }
 
-func (n *ReceiverGenericTypeIdentNode) Fork() Node {
-	_ret := &ReceiverGenericTypeIdentNode{
+func (n *FunctionLitNode) Fork() Node {
+	_ret := &FunctionLitNode{
 		BaseNode: n.BaseNode.fork(),
-		ident:    n.ident.Fork(),
+		x:        n.x.Fork(),
+		y:        n.y.Fork(),
 	}
-	_ret.ident.SetParent(_ret)
+	_ret.x.SetParent(_ret)
+	_ret.y.SetParent(_ret)
 	return _ret
 }
 
-func (n *ReceiverGenericTypeIdentNode) Visit(beforeChildren func(node Node) (visitChildren, exit bool), afterChildren func(node Node) (exit bool)) (exit bool) {
+func (n *FunctionLitNode) Visit(beforeChildren func(node Node) (visitChildren, exit bool), afterChildren func(node Node) (exit bool)) (exit bool) {
 	vc, e := beforeChildren(n)
 	if e {
 		return true
@@ -5890,7 +5997,10 @@ func (n *ReceiverGenericTypeIdentNode) Visit(beforeChildren func(node Node) (vis
 	if !vc {
 		return false
 	}
-	if n.ident.Visit(beforeChildren, afterChildren) {
+	if n.x.Visit(beforeChildren, afterChildren) {
+		return true
+	}
+	if n.y.Visit(beforeChildren, afterChildren) {
 		return true
 	}
 	if afterChildren(n) {
@@ -5899,141 +6009,96 @@ func (n *ReceiverGenericTypeIdentNode) Visit(beforeChildren func(node Node) (vis
 	return false
 }
 
-func (n *ReceiverGenericTypeIdentNode) Dump(hook func(Node, map[string]string) string) map[string]string {
+func (n *FunctionLitNode) Accept(v Visitor) bool {
+	return v.VisitFunctionLitNode(n)
+}
+
+func (n *FunctionLitNode) exprNode() {}
+
+func (n *FunctionLitNode) Rewrite(r Rewriter) Node {
+	n.x = rewriteChild(r, n.x)
+	n.y = rewriteChild(r, n.y)
+	return r.RewriteFunctionLitNode(n)
+}
+
+func (n *FunctionLitNode) Dump(hook func(Node, map[string]string) string) map[string]string {
 	ret := make(map[string]string)
-	ret["kind"] = "\"receiver_generic_type_ident\""
-	ret["ident"] = DumpNode(n.Ident(), hook)
+	ret["kind"] = "\"function_lit\""
+	ret["x"] = DumpNode(n.X(), hook)
+	ret["y"] = DumpNode(n.Y(), hook)
 	return ret
 }
 
-func NewReceiverNode(filePath string, fileContent []rune, name Node, star Node, type_ Node, start, end Position) Node {
-	if name == nil {
-		name = DummyNode
-	}
-	if star == nil {
-		star = DummyNode
-	}
-	if type_ == nil {
-		type_ = DummyNode
+func NewGenericParameterDeclNode(filePath string, fileContent []rune, parameters Node, start, end Position) Node {
+	if parameters == nil {
+		parameters = DummyNode
 	}
-	_1 := &ReceiverNode{
-		BaseNode: NewBaseNode(filePath, fileContent, NodeTypeReceiver, start, end),
-		name:     name,
-		star:     star,
-		type_:    type_,
+	_1 := &GenericParameterDeclNode{
+		BaseNode:   NewBaseNode(filePath, fileContent, NodeTypeGenericParameterDecl, start, end),
+		parameters: parameters,
 	}
 	creationHook(_1)
 	return _1
 }
 
-type ReceiverNode struct {
+type GenericParameterDeclNode struct {
 	*BaseNode
-	name  Node
-	star  Node
-	type_ Node
-}
-
-func (n *ReceiverNode) Name() Node {
-	return n.name
-}
-
-func (n *ReceiverNode) SetName(v Node) {
-	n.name = v
-}
-
-func (n *ReceiverNode) Star() Node {
-	return n.star
+	parameters Node
 }
 
-func (n *ReceiverNode) SetStar(v Node) {
-	n.star = v
-}
-
-func (n *ReceiverNode) Type() Node {
-	return n.type_
+func (n *GenericParameterDeclNode) Parameters() Node {
+	return n.parameters
 }
 
-func (n *ReceiverNode) SetType(v Node) {
-	n.type_ = v
+func (n *GenericParameterDeclNode) SetParameters(v Node) {
+	n.parameters = v
 }
 
-func (n *ReceiverNode) BuildLink() {
-	if !n.Name().IsDummy() {
-		name := n.Name()
-		name.BuildLink()
-		name.SetParent(n)
-		name.SetSelfField("name")
-		name.SetReplaceSelf(func(n Node) {
-			n.Parent().(*ReceiverNode).SetName(n)
-		})
-	}
-	if !n.Star().IsDummy() {
-		star := n.Star()
-		star.BuildLink()
-		star.SetParent(n)
-		star.SetSelfField("star")
-		star.SetReplaceSelf(func(n Node) {
-			n.Parent().(*ReceiverNode).SetStar(n)
-		})
-	}
-	if !n.Type().IsDummy() {
-		type_ := n.Type()
-		type_.BuildLink()
-		type_.SetParent(n)
-		type_.SetSelfField("type_")
-		type_.SetReplaceSelf(func(n Node) {
-			n.Parent().(*ReceiverNode).SetType(n)
+func (n *GenericParameterDeclNode) BuildLink() {
+	if !n.Parameters().IsDummy() {
+		parameters := n.Parameters()
+		parameters.BuildLink()
+		parameters.SetParent(n)
+		parameters.SetSelfField("parameters")
+		parameters.SetReplaceSelf(func(n Node) {
+			n.Parent().(*GenericParameterDeclNode).SetParameters(n)
 		})
 	}
 }
 
-func (n *ReceiverNode) Fields() []string {
+func (n *GenericParameterDeclNode) Fields() []string {
 	return []string{
-		"name",
-		"star",
-		"type_",
+		"parameters",
 	}
 }
 
-func (n *ReceiverNode) Child(field string) Node {
+func (n *GenericParameterDeclNode) Child(field string) Node {
 	if field == "" {
 		return nil
 	}
-	if field == "name" {
-		return n.Name()
-	}
-	if field == "star" {
-		return n.Star()
-	}
-	if field == "type_" {
-		return n.Type()
+	if field == "parameters" {
+		return n.Parameters()
 	}
 	return nil
 }
 
-func (n *ReceiverNode) SetChild(nodes []Node) {
-	if len(nodes) != 3 {
+func (n *GenericParameterDeclNode) SetChild(nodes []Node) {
+	if len(nodes) != 1 {
 		return
 	}
-	n.SetName(nodes[0])
-	n.SetStar(nodes[1])
-	n.SetType(nodes[2])
+	n.SetParameters(nodes[0])
 }
 
-func (n *ReceiverNode) Fork() Node {
-	_ret := &ReceiverNode{
-		BaseNode: n.BaseNode.fork(),
-		name:     n.name.Fork(),
-		star:     n.star.Fork(),
-		type_:    n.type_.Fork(),
+func (n *GenericParameterDeclNode) Fork() Node {
+	_ret := &GenericParameterDeclNode{
+		BaseNode:   n.BaseNode.fork(),
+		parameters: n.parameters.Fork(),
 	}
-	_ret.name.SetParent(_ret)
-	_ret.star.SetParent(_ret)
-	_ret.type_.SetParent(_ret)
+	_ret.parameters.SetParent(_ret)
 	return _ret
 }
 
-func (n *ReceiverNode) Visit(beforeChildren func(node Node) (visitChildren, exit bool), afterChildren func(node Node) (exit bool)) (exit bool) {
+func (n *GenericParameterDeclNode) Visit(beforeChildren func(node Node) (visitChildren, exit bool), afterChildren func(node Node) (exit bool)) (exit bool) {
 	vc, e := beforeChildren(n)
 	if e {
 		return true
@@ -6041,158 +6106,101 @@ func (n *ReceiverNode) Visit(beforeChildren func(node Node) (visitChildren, exit
 	if !vc {
 		return false
 	}
-	if n.name.Visit(beforeChildren, afterChildren) {
+	if n.parameters.Visit(beforeChildren, afterChildren) {
 		return true
 	}
-	if n.star.Visit(beforeChildren, afterChildren) {
-		return true
-	}
-	if n.type_.Visit(beforeChildren, afterChildren) {
-		return true
-	}
-	if afterChildren(n) {
+	if afterChildren(n) {
 		return true
 	}
 	return false
 }
 
-func (n *ReceiverNode) Dump(hook func(Node, map[string]string) string) map[string]string {
+func (n *GenericParameterDeclNode) Accept(v Visitor) bool {
+	return v.VisitGenericParameterDeclNode(n)
+}
+
+func (n *GenericParameterDeclNode) Rewrite(r Rewriter) Node {
+	n.parameters = rewriteChild(r, n.parameters)
+	return r.RewriteGenericParameterDeclNode(n)
+}
+
+func (n *GenericParameterDeclNode) Dump(hook func(Node, map[string]string) string) map[string]string {
 	ret := make(map[string]string)
-	ret["kind"] = "\"receiver\""
-	ret["name"] = DumpNode(n.Name(), hook)
-	ret["star"] = DumpNode(n.Star(), hook)
-	ret["type"] = DumpNode(n.Type(), hook)
+	ret["kind"] = "\"generic_parameter_decl\""
+	ret["parameters"] = DumpNode(n.Parameters(), hook)
 	return ret
 }
 
-func NewBinaryExprNode(filePath string, fileContent []rune, x Node, y Node, op Node, start, end Position) Node {
-	if x == nil {
-		x = DummyNode
-	}
-	if y == nil {
-		y = DummyNode
-	}
-	if op == nil {
-		op = DummyNode
+func NewGenericParameterIdentNode(filePath string, fileContent []rune, ident Node, start, end Position) Node {
+	if ident == nil {
+		ident = DummyNode
 	}
-	_1 := &BinaryExprNode{
-		BaseNode: NewBaseNode(filePath, fileContent, NodeTypeBinaryExpr, start, end),
-		x:        x,
-		y:        y,
-		op:       op,
+	_1 := &GenericParameterIdentNode{
+		BaseNode: NewBaseNode(filePath, fileContent, NodeTypeGenericParameterIdent, start, end),
+		ident:    ident,
 	}
 	creationHook(_1)
 	return _1
 }
 
-type BinaryExprNode struct {
+type GenericParameterIdentNode struct {
 	*BaseNode
-	x  Node
-	y  Node
-	op Node
-}
-
-func (n *BinaryExprNode) X() Node {
-	return n.x
-}
-
-func (n *BinaryExprNode) SetX(v Node) {
-	n.x = v
-}
-
-func (n *BinaryExprNode) Y() Node {
-	return n.y
-}
-
-func (n *BinaryExprNode) SetY(v Node) {
-	n.y = v
+	ident Node
 }
 
-func (n *BinaryExprNode) Op() Node {
-	return n.op
+func (n *GenericParameterIdentNode) Ident() Node {
+	return n.ident
 }
 
-func (n *BinaryExprNode) SetOp(v Node) {
-	n.op = v
+func (n *GenericParameterIdentNode) SetIdent(v Node) {
+	n.ident = v
 }
 
-func (n *BinaryExprNode) BuildLink() {
-	if !n.X().IsDummy() {
-		x := n.X()
-		x.BuildLink()
-		x.SetParent(n)
-		x.SetSelfField("x")
-		x.SetReplaceSelf(func(n Node) {
-			n.Parent().(*BinaryExprNode).SetX(n)
-		})
-	}
-	if !n.Y().IsDummy() {
-		y := n.Y()
-		y.BuildLink()
-		y.SetParent(n)
-		y.SetSelfField("y")
-		y.SetReplaceSelf(func(n Node) {
-			n.Parent().(*BinaryExprNode).SetY(n)
-		})
-	}
-	if !n.Op().IsDummy() {
-		op := n.Op()
-		op.BuildLink()
-		op.SetParent(n)
-		op.SetSelfField("op")
-		op.SetReplaceSelf(func(n Node) {
-			n.Parent().(*BinaryExprNode).SetOp(n)
+func (n *GenericParameterIdentNode) BuildLink() {
+	if !n.Ident().IsDummy() {
+		ident := n.Ident()
+		ident.BuildLink()
+		ident.SetParent(n)
+		ident.SetSelfField("ident")
+		ident.SetReplaceSelf(func(n Node) {
+			n.Parent().(*GenericParameterIdentNode).SetIdent(n)
 		})
 	}
 }
 
-func (n *BinaryExprNode) Fields() []string {
+func (n *GenericParameterIdentNode) Fields() []string {
 	return []string{
-		"x",
-		"y",
-		"op",
+		"ident",
 	}
 }
 
-func (n *BinaryExprNode) Child(field string) Node {
+func (n *GenericParameterIdentNode) Child(field string) Node {
 	if field == "" {
 		return nil
 	}
-	if field == "x" {
-		return n.X()
-	}
-	if field == "y" {
-		return n.Y()
-	}
-	if field == "op" {
-		return n.Op()
+	if field == "ident" {
+		return n.Ident()
 	}
 	return nil
 }
 
-func (n *BinaryExprNode) SetChild(nodes []Node) {
-	if len(nodes) != 3 {
+func (n *GenericParameterIdentNode) SetChild(nodes []Node) {
+	if len(nodes) != 1 {
 		return
 	}
-	n.SetX(nodes[0])
-	n.SetY(nodes[1])
-	n.SetOp(nodes[2])
+	n.SetIdent(nodes[0])
 }
 
-func (n *BinaryExprNode) Fork() Node {
-	_ret := &BinaryExprNode{
+func (n *GenericParameterIdentNode) Fork() Node {
+	_ret := &GenericParameterIdentNode{
 		BaseNode: n.BaseNode.fork(),
-		x:        n.x.Fork(),
-		y:        n.y.Fork(),
-		op:       n.op.Fork(),
+		ident:    n.ident.Fork(),
 	}
-	_ret.x.SetParent(_ret)
-	_ret.y.SetParent(_ret)
-	_ret.op.SetParent(_ret)
+	_ret.ident.SetParent(_ret)
 	return _ret
 }
 
-func (n *BinaryExprNode) Visit(beforeChildren func(node Node) (visitChildren, exit bool), afterChildren func(node Node) (exit bool)) (exit bool) {
+func (n *GenericParameterIdentNode) Visit(beforeChildren func(node Node) (visitChildren, exit bool), afterChildren func(node Node) (exit bool)) (exit bool) {
 	vc, e := beforeChildren(n)
 	if e {
 		return true
@@ -6200,13 +6208,7 @@ func (n *BinaryExprNode) Visit(beforeChildren func(node Node) (visitChildren, ex
 	if !vc {
 		return false
 	}
-	if n.x.Visit(beforeChildren, afterChildren) {
-		return true
-	}
-	if n.y.Visit(beforeChildren, afterChildren) {
-		return true
-	}
-	if n.op.Visit(beforeChildren, afterChildren) {
+	if n.ident.Visit(beforeChildren, afterChildren) {
 		return true
 	}
 	if afterChildren(n) {
@@ -6215,143 +6217,92 @@ func (n *BinaryExprNode) Visit(beforeChildren func(node Node) (visitChildren, ex
 	return false
 }
 
-func (n *BinaryExprNode) Dump(hook func(Node, map[string]string) string) map[string]string {
+func (n *GenericParameterIdentNode) Accept(v Visitor) bool {
+	return v.VisitGenericParameterIdentNode(n)
+}
+
+func (n *GenericParameterIdentNode) Rewrite(r Rewriter) Node {
+	n.ident = rewriteChild(r, n.ident)
+	return r.RewriteGenericParameterIdentNode(n)
+}
+
+func (n *GenericParameterIdentNode) Dump(hook func(Node, map[string]string) string) map[string]string {
 	ret := make(map[string]string)
-	ret["kind"] = "\"binary_expr\""
-	ret["x"] = DumpNode(n.X(), hook)
-	ret["y"] = DumpNode(n.Y(), hook)
-	ret["op"] = DumpNode(n.Op(), hook)
+	ret["kind"] = "\"generic_parameter_ident\""
+	ret["ident"] = DumpNode(n.Ident(), hook)
 	return ret
 }
 
-func NewCallExprNode(filePath string, fileContent []rune, fun Node, typeArgs Node, args Node, start, end Position) Node {
-	if fun == nil {
-		fun = DummyNode
-	}
-	if typeArgs == nil {
-		typeArgs = DummyNode
-	}
-	if args == nil {
-		args = DummyNode
+func NewGenericTypeConstraintNode(filePath string, fileContent []rune, type_ Node, start, end Position) Node {
+	if type_ == nil {
+		type_ = DummyNode
 	}
-	_1 := &CallExprNode{
-		BaseNode: NewBaseNode(filePath, fileContent, NodeTypeCallExpr, start, end),
-		fun:      fun,
-		typeArgs: typeArgs,
-		args:     args,
+	_1 := &GenericTypeConstraintNode{
+		BaseNode: NewBaseNode(filePath, fileContent, NodeTypeGenericTypeConstraint, start, end),
+		type_:    type_,
 	}
 	creationHook(_1)
 	return _1
 }
 
-type CallExprNode struct {
+type GenericTypeConstraintNode struct {
 	*BaseNode
-	fun      Node
-	typeArgs Node
-	args     Node
-}
-
-func (n *CallExprNode) Fun() Node {
-	return n.fun
-}
-
-func (n *CallExprNode) SetFun(v Node) {
-	n.fun = v
-}
-
-func (n *CallExprNode) TypeArgs() Node {
-	return n.typeArgs
-}
-
-func (n *CallExprNode) SetTypeArgs(v Node) {
-	n.typeArgs = v
+	type_ Node
 }
 
-func (n *CallExprNode) Args() Node {
-	return n.args
+func (n *GenericTypeConstraintNode) Type() Node {
+	return n.type_
 }
 
-func (n *CallExprNode) SetArgs(v Node) {
-	n.args = v
+func (n *GenericTypeConstraintNode) SetType(v Node) {
+	n.type_ = v
 }
 
-func (n *CallExprNode) BuildLink() {
-	if !n.Fun().IsDummy() {
-		fun := n.Fun()
-		fun.BuildLink()
-		fun.SetParent(n)
-		fun.SetSelfField("fun")
-		fun.SetReplaceSelf(func(n Node) {
-			n.Parent().(*CallExprNode).SetFun(n)
-		})
-	}
-	if !n.TypeArgs().IsDummy() {
-		typeArgs := n.TypeArgs()
-		typeArgs.BuildLink()
-		typeArgs.SetParent(n)
-		typeArgs.SetSelfField("type_args")
-		typeArgs.SetReplaceSelf(func(n Node) {
-			n.Parent().(*CallExprNode).SetTypeArgs(n)
-		})
-	}
-	if !n.Args().IsDummy() {
-		args := n.Args()
-		args.BuildLink()
-		args.SetParent(n)
-		args.SetSelfField("args")
-		args.SetReplaceSelf(func(n Node) {
-			n.Parent().(*CallExprNode).SetArgs(n)
+func (n *GenericTypeConstraintNode) BuildLink() {
+	if !n.Type().IsDummy() {
+		type_ := n.Type()
+		type_.BuildLink()
+		type_.SetParent(n)
+		type_.SetSelfField("type_")
+		type_.SetReplaceSelf(func(n Node) {
+			n.Parent().(*GenericTypeConstraintNode).SetType(n)
 		})
 	}
 }
 
-func (n *CallExprNode) Fields() []string {
+func (n *GenericTypeConstraintNode) Fields() []string {
 	return []string{
-		"fun",
-		"type_args",
-		"args",
+		"type_",
 	}
 }
 
-func (n *CallExprNode) Child(field string) Node {
+func (n *GenericTypeConstraintNode) Child(field string) Node {
 	if field == "" {
 		return nil
 	}
-	if field == "fun" {
-		return n.Fun()
-	}
-	if field == "type_args" {
-		return n.TypeArgs()
-	}
-	if field == "args" {
-		return n.Args()
+	if field == "type_" {
+		return n.Type()
 	}
 	return nil
 }
 
-func (n *CallExprNode) SetChild(nodes []Node) {
-	if len(nodes) != 3 {
+func (n *GenericTypeConstraintNode) SetChild(nodes []Node) {
+	if len(nodes) != 1 {
 		return
 	}
-	n.SetFun(nodes[0])
-	n.SetTypeArgs(nodes[1])
-	n.SetArgs(nodes[2])
+	n.SetType(nodes[0])
 }
 
-func (n *CallExprNode) Fork() Node {
-	_ret := &CallExprNode{
+func (n *GenericTypeConstraintNode) Fork() Node {
+	_ret := &GenericTypeConstraintNode{
 		BaseNode: n.BaseNode.fork(),
-		fun:      n.fun.Fork(),
-		typeArgs: n.typeArgs.Fork(),
-		args:     n.args.Fork(),
+		type_:    n.type_.Fork(),
 	}
-	_ret.fun.SetParent(_ret)
-	_ret.typeArgs.SetParent(_ret)
-	_ret.args.SetParent(_ret)
+	_ret.type_.SetParent(_ret)
 	return _ret
 }
 
-func (n *CallExprNode) Visit(beforeChildren func(node Node) (visitChildren, exit bool), afterChildren func(node Node) (exit bool)) (exit bool) {
+func (n *GenericTypeConstraintNode) Visit(beforeChildren func(node Node) (visitChildren, exit bool), afterChildren func(node Node) (exit bool)) (exit bool) {
 	vc, e := beforeChildren(n)
 	if e {
 		return true
@@ -6359,13 +6310,7 @@ func (n *CallExprNode) Visit(beforeChildren func(node Node) (visitChildren, exit
 	if !vc {
 		return false
 	}
-	if n.fun.Visit(beforeChildren, afterChildren) {
-		return true
-	}
-	if n.typeArgs.Visit(beforeChildren, afterChildren) {
-		return true
-	}
-	if n.args.Visit(beforeChildren, afterChildren) {
+	if n.type_.Visit(beforeChildren, afterChildren) {
 		return true
 	}
 	if afterChildren(n) {
@@ -6374,114 +6319,121 @@ func (n *CallExprNode) Visit(beforeChildren func(node Node) (visitChildren, exit
 	return false
 }
 
-func (n *CallExprNode) Dump(hook func(Node, map[string]string) string) map[string]string {
+func (n *GenericTypeConstraintNode) Accept(v Visitor) bool {
+	return v.VisitGenericTypeConstraintNode(n)
+}
+
+func (n *GenericTypeConstraintNode) Rewrite(r Rewriter) Node {
+	n.type_ = rewriteChild(r, n.type_)
+	return r.RewriteGenericTypeConstraintNode(n)
+}
+
+func (n *GenericTypeConstraintNode) Dump(hook func(Node, map[string]string) string) map[string]string {
 	ret := make(map[string]string)
-	ret["kind"] = "\"call_expr\""
-	ret["fun"] = DumpNode(n.Fun(), hook)
-	ret["type_args"] = DumpNode(n.TypeArgs(), hook)
-	ret["args"] = DumpNode(n.Args(), hook)
+	ret["kind"] = "\"generic_type_constraint\""
+	ret["type"] = DumpNode(n.Type(), hook)
 	return ret
 }
 
-func NewIndexExprNode(filePath string, fileContent []rune, x Node, index Node, start, end Position) Node {
+func NewGenericTypeInstantiationNode(filePath string, fileContent []rune, x Node, y Node, start, end Position) Node {
 	if x == nil {
 		x = DummyNode
 	}
-	if index == nil {
-		index = DummyNode
+	if y == nil {
+		y = DummyNode
 	}
-	_1 := &IndexExprNode{
-		BaseNode: NewBaseNode(filePath, fileContent, NodeTypeIndexExpr, start, end),
+	_1 := &GenericTypeInstantiationNode{
+		BaseNode: NewBaseNode(filePath, fileContent, NodeTypeGenericTypeInstantiation, start, end),
 		x:        x,
-		index:    index,
+		y:        y,
 	}
 	creationHook(_1)
 	return _1
 }
 
-type IndexExprNode struct {
+type GenericTypeInstantiationNode struct {
 	*BaseNode
-	x     Node
-	index Node
+	x Node
+	y Node
 }
 
-func (n *IndexExprNode) X() Node {
+func (n *GenericTypeInstantiationNode) X() Node {
 	return n.x
 }
 
-func (n *IndexExprNode) SetX(v Node) {
+func (n *GenericTypeInstantiationNode) SetX(v Node) {
 	n.x = v
 }
 
-func (n *IndexExprNode) Index() Node {
-	return n.index
+func (n *GenericTypeInstantiationNode) Y() Node {
+	return n.y
 }
 
-func (n *IndexExprNode) SetIndex(v Node) {
-	n.index = v
+func (n *GenericTypeInstantiationNode) SetY(v Node) {
+	n.y = v
 }
 
-func (n *IndexExprNode) BuildLink() {
+func (n *GenericTypeInstantiationNode) BuildLink() {
 	if !n.X().IsDummy() {
 		x := n.X()
 		x.BuildLink()
 		x.SetParent(n)
 		x.SetSelfField("x")
 		x.SetReplaceSelf(func(n Node) {
-			n.Parent().(*IndexExprNode).SetX(n)
+			n.Parent().(*GenericTypeInstantiationNode).SetX(n)
 		})
 	}
-	if !n.Index().IsDummy() {
-		index := n.Index()
-		index.BuildLink()
-		index.SetParent(n)
-		index.SetSelfField("index")
-		index.SetReplaceSelf(func(n Node) {
-			n.Parent().(*IndexExprNode).SetIndex(n)
+	if !n.Y().IsDummy() {
+		y := n.Y()
+		y.BuildLink()
+		y.SetParent(n)
+		y.SetSelfField("y")
+		y.SetReplaceSelf(func(n Node) {
+			n.Parent().(*GenericTypeInstantiationNode).SetY(n)
 		})
 	}
 }
 
-func (n *IndexExprNode) Fields() []string {
+func (n *GenericTypeInstantiationNode) Fields() []string {
 	return []string{
 		"x",
-		"index",
+		"y",
 	}
 }
 
-func (n *IndexExprNode) Child(field string) Node {
+func (n *GenericTypeInstantiationNode) Child(field string) Node {
 	if field == "" {
 		return nil
 	}
 	if field == "x" {
 		return n.X()
 	}
-	if field == "index" {
-		return n.Index()
+	if field == "y" {
+		return n.Y()
 	}
 	return nil
 }
 
-func (n *IndexExprNode) SetChild(nodes []Node) {
+func (n *GenericTypeInstantiationNode) SetChild(nodes []Node) {
 	if len(nodes) != 2 {
 		return
 	}
 	n.SetX(nodes[0])
-	n.SetIndex(nodes[1])
+	n.SetY(nodes[1])
 }
 
-func (n *IndexExprNode) Fork() Node {
-	_ret := &IndexExprNode{
-		BaseNode: n.BaseNode.fork(),
+func (n *GenericTypeInstantiationNode) Fork() Node {
+	_ret := &GenericTypeInstantiationNode{
+		BaseNode: n.BaseNode.fork(),
 		x:        n.x.Fork(),
-		index:    n.index.Fork(),
+		y:        n.y.Fork(),
 	}
 	_ret.x.SetParent(_ret)
-	_ret.index.SetParent(_ret)
+	_ret.y.SetParent(_ret)
 	return _ret
 }
 
-func (n *IndexExprNode) Visit(beforeChildren func(node Node) (visitChildren, exit bool), afterChildren func(node Node) (exit bool)) (exit bool) {
+func (n *GenericTypeInstantiationNode) Visit(beforeChildren func(node Node) (visitChildren, exit bool), afterChildren func(node Node) (exit bool)) (exit bool) {
 	vc, e := beforeChildren(n)
 	if e {
 		return true
@@ -6492,7 +6444,7 @@ func (n *IndexExprNode) Visit(beforeChildren func(node Node) (visitChildren, exi
 	if n.x.Visit(beforeChildren, afterChildren) {
 		return true
 	}
-	if n.index.Visit(beforeChildren, afterChildren) {
+	if n.y.Visit(beforeChildren, afterChildren) {
 		return true
 	}
 	if afterChildren(n) {
@@ -6501,113 +6453,98 @@ func (n *IndexExprNode) Visit(beforeChildren func(node Node) (visitChildren, exi
 	return false
 }
 
-func (n *IndexExprNode) Dump(hook func(Node, map[string]string) string) map[string]string {
+func (n *GenericTypeInstantiationNode) Accept(v Visitor) bool {
+	return v.VisitGenericTypeInstantiationNode(n)
+}
+
+func (n *GenericTypeInstantiationNode) exprNode() {}
+
+func (n *GenericTypeInstantiationNode) typeNode() {}
+
+func (n *GenericTypeInstantiationNode) Rewrite(r Rewriter) Node {
+	n.x = rewriteChild(r, n.x)
+	n.y = rewriteChild(r, n.y)
+	return r.RewriteGenericTypeInstantiationNode(n)
+}
+
+func (n *GenericTypeInstantiationNode) Dump(hook func(Node, map[string]string) string) map[string]string {
 	ret := make(map[string]string)
-	ret["kind"] = "\"index_expr\""
+	ret["kind"] = "\"generic_type_instantiation\""
 	ret["x"] = DumpNode(n.X(), hook)
-	ret["index"] = DumpNode(n.Index(), hook)
+	ret["y"] = DumpNode(n.Y(), hook)
 	return ret
 }
 
-func NewKeyValueExprNode(filePath string, fileContent []rune, key Node, value Node, start, end Position) Node {
-	if key == nil {
-		key = DummyNode
-	}
-	if value == nil {
-		value = DummyNode
+func NewGenericUnderlyingTypeConstraintNode(filePath string, fileContent []rune, type_ Node, start, end Position) Node {
+	if type_ == nil {
+		type_ = DummyNode
 	}
-	_1 := &KeyValueExprNode{
-		BaseNode: NewBaseNode(filePath, fileContent, NodeTypeKeyValueExpr, start, end),
-		key:      key,
-		value:    value,
+	_1 := &GenericUnderlyingTypeConstraintNode{
+		BaseNode: NewBaseNode(filePath, fileContent, NodeTypeGenericUnderlyingTypeConstraint, start, end),
+		type_:    type_,
 	}
 	creationHook(_1)
 	return _1
 }
 
-type KeyValueExprNode struct {
+type GenericUnderlyingTypeConstraintNode struct {
 	*BaseNode
-	key   Node
-	value Node
-}
-
-func (n *KeyValueExprNode) Key() Node {
-	return n.key
-}
-
-func (n *KeyValueExprNode) SetKey(v Node) {
-	n.key = v
+	type_ Node
 }
 
-func (n *KeyValueExprNode) Value() Node {
-	return n.value
+func (n *GenericUnderlyingTypeConstraintNode) Type() Node {
+	return n.type_
 }
 
-func (n *KeyValueExprNode) SetValue(v Node) {
-	n.value = v
+func (n *GenericUnderlyingTypeConstraintNode) SetType(v Node) {
+	n.type_ = v
 }
 
-func (n *KeyValueExprNode) BuildLink() {
-	if !n.Key().IsDummy() {
-		key := n.Key()
-		key.BuildLink()
-		key.SetParent(n)
-		key.SetSelfField("key")
-		key.SetReplaceSelf(func(n Node) {
-			n.Parent().(*KeyValueExprNode).SetKey(n)
-		})
-	}
-	if !n.Value().IsDummy() {
-		value := n.Value()
-		value.BuildLink()
-		value.SetParent(n)
-		value.SetSelfField("value")
-		value.SetReplaceSelf(func(n Node) {
-			n.Parent().(*KeyValueExprNode).SetValue(n)
+func (n *GenericUnderlyingTypeConstraintNode) BuildLink() {
+	if !n.Type().IsDummy() {
+		type_ := n.Type()
+		type_.BuildLink()
+		type_.SetParent(n)
+		type_.SetSelfField("type_")
+		type_.SetReplaceSelf(func(n Node) {
+			n.Parent().(*GenericUnderlyingTypeConstraintNode).SetType(n)
 		})
 	}
 }
 
-func (n *KeyValueExprNode) Fields() []string {
+func (n *GenericUnderlyingTypeConstraintNode) Fields() []string {
 	return []string{
-		"key",
-		"value",
+		"type_",
 	}
 }
 
-func (n *KeyValueExprNode) Child(field string) Node {
+func (n *GenericUnderlyingTypeConstraintNode) Child(field string) Node {
 	if field == "" {
 		return nil
 	}
-	if field == "key" {
-		return n.Key()
-	}
-	if field == "value" {
-		return n.Value()
+	if field == "type_" {
+		return n.Type()
 	}
 	return nil
 }
 
-func (n *KeyValueExprNode) SetChild(nodes []Node) {
-	if len(nodes) != 2 {
+func (n *GenericUnderlyingTypeConstraintNode) SetChild(nodes []Node) {
+	if len(nodes) != 1 {
 		return
 	}
-	n.SetKey(nodes[0])
-	n.SetValue(nodes[1])
+	n.SetType(nodes[0])
 }
 
-func (n *KeyValueExprNode) Fork() Node {
-	_ret := &KeyValueExprNode{
+func (n *GenericUnderlyingTypeConstraintNode) Fork() Node {
+	_ret := &GenericUnderlyingTypeConstraintNode{
 		BaseNode: n.BaseNode.fork(),
-		key:      n.key.Fork(),
-		value:    n.value.Fork(),
+		type_:    n.type_.Fork(),
 	}
-	_ret.key.SetParent(_ret)
-	_ret.value.SetParent(_ret)
+	_ret.type_.SetParent(_ret)
 	return _ret
 }
 
-func (n *KeyValueExprNode) Visit(beforeChildren func(node Node) (visitChildren, exit bool), afterChildren func(node Node) (exit bool)) (exit bool) {
+func (n *GenericUnderlyingTypeConstraintNode) Visit(beforeChildren func(node Node) (visitChildren, exit bool), afterChildren func(node Node) (exit bool)) (exit bool) {
 	vc, e := beforeChildren(n)
 	if e {
 		return true
@@ -6615,10 +6552,7 @@ func (n *KeyValueExprNode) Visit(beforeChildren func(node Node) (visitChildren,
 	if !vc {
 		return false
 	}
-	if n.key.Visit(beforeChildren, afterChildren) {
-		return true
-	}
-	if n.value.Visit(beforeChildren, afterChildren) {
+	if n.type_.Visit(beforeChildren, afterChildren) {
 		return true
 	}
 	if afterChildren(n) {
@@ -6627,84 +6561,92 @@ func (n *KeyValueExprNode) Visit(beforeChildren func(node Node) (visitChildren,
 	return false
 }
 
-func (n *KeyValueExprNode) Dump(hook func(Node, map[string]string) string) map[string]string {
+func (n *GenericUnderlyingTypeConstraintNode) Accept(v Visitor) bool {
+	return v.VisitGenericUnderlyingTypeConstraintNode(n)
+}
+
+func (n *GenericUnderlyingTypeConstraintNode) Rewrite(r Rewriter) Node {
+	n.type_ = rewriteChild(r, n.type_)
+	return r.RewriteGenericUnderlyingTypeConstraintNode(n)
+}
+
+func (n *GenericUnderlyingTypeConstraintNode) Dump(hook func(Node, map[string]string) string) map[string]string {
 	ret := make(map[string]string)
-	ret["kind"] = "\"key_value_expr\""
-	ret["key"] = DumpNode(n.Key(), hook)
-	ret["value"] = DumpNode(n.Value(), hook)
+	ret["kind"] = "\"generic_underlying_type_constraint\""
+	ret["type"] = DumpNode(n.Type(), hook)
 	return ret
 }
 
-func NewParenExprNode(filePath string, fileContent []rune, x Node, start, end Position) Node {
-	if x == nil {
-		x = DummyNode
+func NewGenericUnionConstraintNode(filePath string, fileContent []rune, types Node, start, end Position) Node {
+	if types == nil {
+		types = DummyNode
 	}
-	_1 := &ParenExprNode{
-		BaseNode: NewBaseNode(filePath, fileContent, NodeTypeParenExpr, start, end),
-		x:        x,
+	_1 := &GenericUnionConstraintNode{
+		BaseNode: NewBaseNode(filePath, fileContent, NodeTypeGenericUnionConstraint, start, end),
+		types:    types,
 	}
 	creationHook(_1)
 	return _1
 }
 
-type ParenExprNode struct {
+type GenericUnionConstraintNode struct {
 	*BaseNode
-	x Node
+	types Node
 }
 
-func (n *ParenExprNode) X() Node {
-	return n.x
+func (n *GenericUnionConstraintNode) Types() Node {
+	return n.types
 }
 
-func (n *ParenExprNode) SetX(v Node) {
-	n.x = v
+func (n *GenericUnionConstraintNode) SetTypes(v Node) {
+	n.types = v
 }
 
-func (n *ParenExprNode) BuildLink() {
-	if !n.X().IsDummy() {
-		x := n.X()
-		x.BuildLink()
-		x.SetParent(n)
-		x.SetSelfField("x")
-		x.SetReplaceSelf(func(n Node) {
-			n.Parent().(*ParenExprNode).SetX(n)
+func (n *GenericUnionConstraintNode) BuildLink() {
+	if !n.Types().IsDummy() {
+		types := n.Types()
+		types.BuildLink()
+		types.SetParent(n)
+		types.SetSelfField("types")
+		types.SetReplaceSelf(func(n Node) {
+			n.Parent().(*GenericUnionConstraintNode).SetTypes(n)
 		})
 	}
 }
 
-func (n *ParenExprNode) Fields() []string {
+func (n *GenericUnionConstraintNode) Fields() []string {
 	return []string{
-		"x",
+		"types",
 	}
 }
 
-func (n *ParenExprNode) Child(field string) Node {
+func (n *GenericUnionConstraintNode) Child(field string) Node {
 	if field == "" {
 		return nil
 	}
-	if field == "x" {
-		return n.X()
+	if field == "types" {
+		return n.Types()
 	}
 	return nil
 }
 
-func (n *ParenExprNode) SetChild(nodes []Node) {
+func (n *GenericUnionConstraintNode) SetChild(nodes []Node) {
 	if len(nodes) != 1 {
 		return
 	}
-	n.SetX(nodes[0])
+	n.SetTypes(nodes[0])
 }
 
-func (n *ParenExprNode) Fork() Node {
-	_ret := &ParenExprNode{
+func (n *GenericUnionConstraintNode) Fork() Node {
+	_ret := &GenericUnionConstraintNode{
 		BaseNode: n.BaseNode.fork(),
-		x:        n.x.Fork(),
+		types:    n.types.Fork(),
 	}
-	_ret.x.SetParent(_ret)
+	_ret.types.SetParent(_ret)
 	return _ret
 }
 
-func (n *ParenExprNode) Visit(beforeChildren func(node Node) (visitChildren, exit bool), afterChildren func(node Node) (exit bool)) (exit bool) {
+func (n *GenericUnionConstraintNode) Visit(beforeChildren func(node Node) (visitChildren, exit bool), afterChildren func(node Node) (exit bool)) (exit bool) {
 	vc, e := beforeChildren(n)
 	if e {
 		return true
@@ -6712,7 +6654,7 @@ func (n *ParenExprNode) Visit(beforeChildren func(node Node) (visitChildren, exi
 	if !vc {
 		return false
 	}
-	if n.x.Visit(beforeChildren, afterChildren) {
+	if n.types.Visit(beforeChildren, afterChildren) {
 		return true
 	}
 	if afterChildren(n) {
@@ -6721,112 +6663,92 @@ func (n *ParenExprNode) Visit(beforeChildren func(node Node) (visitChildren, exi
 	return false
 }
 
-func (n *ParenExprNode) Dump(hook func(Node, map[string]string) string) map[string]string {
+func (n *GenericUnionConstraintNode) Accept(v Visitor) bool {
+	return v.VisitGenericUnionConstraintNode(n)
+}
+
+func (n *GenericUnionConstraintNode) Rewrite(r Rewriter) Node {
+	n.types = rewriteChild(r, n.types)
+	return r.RewriteGenericUnionConstraintNode(n)
+}
+
+func (n *GenericUnionConstraintNode) Dump(hook func(Node, map[string]string) string) map[string]string {
 	ret := make(map[string]string)
-	ret["kind"] = "\"paren_expr\""
-	ret["x"] = DumpNode(n.X(), hook)
+	ret["kind"] = "\"generic_union_constraint\""
+	ret["types"] = DumpNode(n.Types(), hook)
 	return ret
 }
 
-func NewSelectorExprNode(filePath string, fileContent []rune, x Node, sel Node, start, end Position) Node {
+func NewGoStmtNode(filePath string, fileContent []rune, x Node, start, end Position) Node {
 	if x == nil {
 		x = DummyNode
 	}
-	if sel == nil {
-		sel = DummyNode
-	}
-	_1 := &SelectorExprNode{
-		BaseNode: NewBaseNode(filePath, fileContent, NodeTypeSelectorExpr, start, end),
+	_1 := &GoStmtNode{
+		BaseNode: NewBaseNode(filePath, fileContent, NodeTypeGoStmt, start, end),
 		x:        x,
-		sel:      sel,
 	}
 	creationHook(_1)
 	return _1
 }
 
-type SelectorExprNode struct {
+type GoStmtNode struct {
 	*BaseNode
-	x   Node
-	sel Node
+	x Node
 }
 
-func (n *SelectorExprNode) X() Node {
+func (n *GoStmtNode) X() Node {
 	return n.x
 }
 
-func (n *SelectorExprNode) SetX(v Node) {
+func (n *GoStmtNode) SetX(v Node) {
 	n.x = v
 }
 
-func (n *SelectorExprNode) Sel() Node {
-	return n.sel
-}
-
-func (n *SelectorExprNode) SetSel(v Node) {
-	n.sel = v
-}
-
-func (n *SelectorExprNode) BuildLink() {
+func (n *GoStmtNode) BuildLink() {
 	if !n.X().IsDummy() {
 		x := n.X()
 		x.BuildLink()
 		x.SetParent(n)
 		x.SetSelfField("x")
 		x.SetReplaceSelf(func(n Node) {
-			n.Parent().(*SelectorExprNode).SetX(n)
-		})
-	}
-	if !n.Sel().IsDummy() {
-		sel := n.Sel()
-		sel.BuildLink()
-		sel.SetParent(n)
-		sel.SetSelfField("sel")
-		sel.SetReplaceSelf(func(n Node) {
-			n.Parent().(*SelectorExprNode).SetSel(n)
+			n.Parent().(*GoStmtNode).SetX(n)
 		})
 	}
 }
 
-func (n *SelectorExprNode) Fields() []string {
+func (n *GoStmtNode) Fields() []string {
 	return []string{
 		"x",
-		"sel",
 	}
 }
 
-func (n *SelectorExprNode) Child(field string) Node {
+func (n *GoStmtNode) Child(field string) Node {
 	if field == "" {
 		return nil
 	}
 	if field == "x" {
 		return n.X()
 	}
-	if field == "sel" {
-		return n.Sel()
-	}
 	return nil
 }
 
-func (n *SelectorExprNode) SetChild(nodes []Node) {
-	if len(nodes) != 2 {
+func (n *GoStmtNode) SetChild(nodes []Node) {
+	if len(nodes) != 1 {
 		return
 	}
 	n.SetX(nodes[0])
-	n.SetSel(nodes[1])
 }
 
-func (n *SelectorExprNode) Fork() Node {
-	_ret := &SelectorExprNode{
+func (n *GoStmtNode) Fork() Node {
+	_ret := &GoStmtNode{
 		BaseNode: n.BaseNode.fork(),
 		x:        n.x.Fork(),
-		sel:      n.sel.Fork(),
 	}
 	_ret.x.SetParent(_ret)
-	_ret.sel.SetParent(_ret)
 	return _ret
 }
 
-func (n *SelectorExprNode) Visit(beforeChildren func(node Node) (visitChildren, exit bool), afterChildren func(node Node) (exit bool)) (exit bool) {
+func (n *GoStmtNode) Visit(beforeChildren func(node Node) (visitChildren, exit bool), afterChildren func(node Node) (exit bool)) (exit bool) {
 	vc, e := beforeChildren(n)
 	if e {
 		return true
@@ -6837,67 +6759,74 @@ func (n *SelectorExprNode) Visit(beforeChildren func(node Node) (visitChildren,
 	if n.x.Visit(beforeChildren, afterChildren) {
 		return true
 	}
-	if n.sel.Visit(beforeChildren, afterChildren) {
-		return true
-	}
 	if afterChildren(n) {
 		return true
 	}
 	return false
 }
 
-func (n *SelectorExprNode) Dump(hook func(Node, map[string]string) string) map[string]string {
+func (n *GoStmtNode) Accept(v Visitor) bool {
+	return v.VisitGoStmtNode(n)
+}
+
+func (n *GoStmtNode) stmtNode() {}
+
+func (n *GoStmtNode) Rewrite(r Rewriter) Node {
+	n.x = rewriteChild(r, n.x)
+	return r.RewriteGoStmtNode(n)
+}
+
+func (n *GoStmtNode) Dump(hook func(Node, map[string]string) string) map[string]string {
 	ret := make(map[string]string)
-	ret["kind"] = "\"selector_expr\""
+	ret["kind"] = "\"go_stmt\""
 	ret["x"] = DumpNode(n.X(), hook)
-	ret["sel"] = DumpNode(n.Sel(), hook)
 	return ret
 }
 
-func NewStarExprNode(filePath string, fileContent []rune, x Node, start, end Position) Node {
+func NewGotoStmtNode(filePath string, fileContent []rune, x Node, start, end Position) Node {
 	if x == nil {
 		x = DummyNode
 	}
-	_1 := &StarExprNode{
-		BaseNode: NewBaseNode(filePath, fileContent, NodeTypeStarExpr, start, end),
+	_1 := &GotoStmtNode{
+		BaseNode: NewBaseNode(filePath, fileContent, NodeTypeGotoStmt, start, end),
 		x:        x,
 	}
 	creationHook(_1)
 	return _1
 }
 
-type StarExprNode struct {
+type GotoStmtNode struct {
 	*BaseNode
 	x Node
 }
 
-func (n *StarExprNode) X() Node {
+func (n *GotoStmtNode) X() Node {
 	return n.x
 }
 
-func (n *StarExprNode) SetX(v Node) {
+func (n *GotoStmtNode) SetX(v Node) {
 	n.x = v
 }
 
-func (n *StarExprNode) BuildLink() {
+func (n *GotoStmtNode) BuildLink() {
 	if !n.X().IsDummy() {
 		x := n.X()
 		x.BuildLink()
 		x.SetParent(n)
 		x.SetSelfField("x")
 		x.SetReplaceSelf(func(n Node) {
-			n.Parent().(*StarExprNode).SetX(n)
+			n.Parent().(*GotoStmtNode).SetX(n)
 		})
 	}
 }
 
-func (n *StarExprNode) Fields() []string {
+func (n *GotoStmtNode) Fields() []string {
 	return []string{
 		"x",
 	}
 }
 
-func (n *StarExprNode) Child(field string) Node {
+func (n *GotoStmtNode) Child(field string) Node {
 	if field == "" {
 		return nil
 	}
@@ -6907,15 +6836,15 @@ func (n *StarExprNode) Child(field string) Node {
 	return nil
 }
 
-func (n *StarExprNode) SetChild(nodes []Node) {
+func (n *GotoStmtNode) SetChild(nodes []Node) {
 	if len(nodes) != 1 {
 		return
 	}
 	n.SetX(nodes[0])
 }
 
-func (n *StarExprNode) Fork() Node {
-	_ret := &StarExprNode{
+func (n *GotoStmtNode) Fork() Node {
+	_ret := &GotoStmtNode{
 		BaseNode: n.BaseNode.fork(),
 		x:        n.x.Fork(),
 	}
@@ -6923,7 +6852,7 @@ func (n *StarExprNode) Fork() Node {
 	return _ret
 }
 
-func (n *StarExprNode) Visit(beforeChildren func(node Node) (visitChildren, exit bool), afterChildren func(node Node) (exit bool)) (exit bool) {
+func (n *GotoStmtNode) Visit(beforeChildren func(node Node) (visitChildren, exit bool), afterChildren func(node Node) (exit bool)) (exit bool) {
 	vc, e := beforeChildren(n)
 	if e {
 		return true
@@ -6940,112 +6869,94 @@ func (n *StarExprNode) Visit(beforeChildren func(node Node) (visitChildren, exit
 	return false
 }
 
-func (n *StarExprNode) Dump(hook func(Node, map[string]string) string) map[string]string {
+func (n *GotoStmtNode) Accept(v Visitor) bool {
+	return v.VisitGotoStmtNode(n)
+}
+
+func (n *GotoStmtNode) stmtNode() {}
+
+func (n *GotoStmtNode) Rewrite(r Rewriter) Node {
+	n.x = rewriteChild(r, n.x)
+	return r.RewriteGotoStmtNode(n)
+}
+
+func (n *GotoStmtNode) Dump(hook func(Node, map[string]string) string) map[string]string {
 	ret := make(map[string]string)
-	ret["kind"] = "\"star_expr\""
+	ret["kind"] = "\"goto_stmt\""
 	ret["x"] = DumpNode(n.X(), hook)
 	return ret
 }
 
-func NewTypeAssertExprNode(filePath string, fileContent []rune, x Node, type_ Node, start, end Position) Node {
-	if x == nil {
-		x = DummyNode
-	}
-	if type_ == nil {
-		type_ = DummyNode
+func NewIdentNode(filePath string, fileContent []rune, i Node, start, end Position) Node {
+	if i == nil {
+		i = DummyNode
 	}
-	_1 := &TypeAssertExprNode{
-		BaseNode: NewBaseNode(filePath, fileContent, NodeTypeTypeAssertExpr, start, end),
-		x:        x,
-		type_:    type_,
+	_1 := &IdentNode{
+		BaseNode: NewBaseNode(filePath, fileContent, NodeTypeIdent, start, end),
+		i:        i,
 	}
 	creationHook(_1)
 	return _1
 }
 
-type TypeAssertExprNode struct {
+type IdentNode struct {
 	*BaseNode
-	x     Node
-	type_ Node
+	i Node
 }
 
-func (n *TypeAssertExprNode) X() Node {
-	return n.x
+func (n *IdentNode) I() Node {
+	return n.i
 }
 
-func (n *TypeAssertExprNode) SetX(v Node) {
-	n.x = v
+func (n *IdentNode) SetI(v Node) {
+	n.i = v
 }
 
-func (n *TypeAssertExprNode) Type() Node {
-	return n.type_
+func (n *IdentNode) BuildLink() {
+	if !n.I().IsDummy() {
+		i := n.I()
+		i.BuildLink()
+		i.SetParent(n)
+		i.SetSelfField("i")
+		i.SetReplaceSelf(func(n Node) {
+			n.Parent().(*IdentNode).SetI(n)
+		})
+	}
 }
 
-func (n *TypeAssertExprNode) SetType(v Node) {
-	n.type_ = v
-}
-
-func (n *TypeAssertExprNode) BuildLink() {
-	if !n.X().IsDummy() {
-		x := n.X()
-		x.BuildLink()
-		x.SetParent(n)
-		x.SetSelfField("x")
-		x.SetReplaceSelf(func(n Node) {
-			n.Parent().(*TypeAssertExprNode).SetX(n)
-		})
-	}
-	if !n.Type().IsDummy() {
-		type_ := n.Type()
-		type_.BuildLink()
-		type_.SetParent(n)
-		type_.SetSelfField("type_")
-		type_.SetReplaceSelf(func(n Node) {
-			n.Parent().(*TypeAssertExprNode).SetType(n)
-		})
-	}
-}
-
-func (n *TypeAssertExprNode) Fields() []string {
+func (n *IdentNode) Fields() []string {
 	return []string{
-		"x",
-		"type_",
+		"i",
 	}
 }
 
-func (n *TypeAssertExprNode) Child(field string) Node {
+func (n *IdentNode) Child(field string) Node {
 	if field == "" {
 		return nil
 	}
-	if field == "x" {
-		return n.X()
-	}
-	if field == "type_" {
-		return n.Type()
+	if field == "i" {
+		return n.I()
 	}
 	return nil
 }
 
-func (n *TypeAssertExprNode) SetChild(nodes []Node) {
-	if len(nodes) != 2 {
+func (n *IdentNode) SetChild(nodes []Node) {
+	if len(nodes) != 1 {
 		return
 	}
-	n.SetX(nodes[0])
-	n.SetType(nodes[1])
+	n.SetI(nodes[0])
 }
 
-func (n *TypeAssertExprNode) Fork() Node {
-	_ret := &TypeAssertExprNode{
+func (n *IdentNode) Fork() Node {
+	_ret := &IdentNode{
 		BaseNode: n.BaseNode.fork(),
-		x:        n.x.Fork(),
-		type_:    n.type_.Fork(),
+		i:        n.i.Fork(),
 	}
-	_ret.x.SetParent(_ret)
-	_ret.type_.SetParent(_ret)
+	_ret.i.SetParent(_ret)
 	return _ret
 }
 
-func (n *TypeAssertExprNode) Visit(beforeChildren func(node Node) (visitChildren, exit bool), afterChildren func(node Node) (exit bool)) (exit bool) {
+func (n *IdentNode) Visit(beforeChildren func(node Node) (visitChildren, exit bool), afterChildren func(node Node) (exit bool)) (exit bool) {
 	vc, e := beforeChildren(n)
 	if e {
 		return true
@@ -7053,10 +6964,7 @@ func (n *TypeAssertExprNode) Visit(beforeChildren func(node Node) (visitChildren
 	if !vc {
 		return false
 	}
-	if n.x.Visit(beforeChildren, afterChildren) {
-		return true
-	}
-	if n.type_.Visit(beforeChildren, afterChildren) {
+	if n.i.Visit(beforeChildren, afterChildren) {
 		return true
 	}
 	if afterChildren(n) {
@@ -7065,171 +6973,181 @@ func (n *TypeAssertExprNode) Visit(beforeChildren func(node Node) (visitChildren
 	return false
 }
 
-func (n *TypeAssertExprNode) Dump(hook func(Node, map[string]string) string) map[string]string {
+func (n *IdentNode) Accept(v Visitor) bool {
+	return v.VisitIdentNode(n)
+}
+
+func (n *IdentNode) exprNode() {}
+
+func (n *IdentNode) Rewrite(r Rewriter) Node {
+	n.i = rewriteChild(r, n.i)
+	return r.RewriteIdentNode(n)
+}
+
+func (n *IdentNode) Dump(hook func(Node, map[string]string) string) map[string]string {
 	ret := make(map[string]string)
-	ret["kind"] = "\"type_assert_expr\""
-	ret["x"] = DumpNode(n.X(), hook)
-	ret["type"] = DumpNode(n.Type(), hook)
+	ret["kind"] = "\"ident\""
+	ret["i"] = DumpNode(n.I(), hook)
 	return ret
 }
 
-func NewSliceExprNode(filePath string, fileContent []rune, x Node, low Node, high Node, max_ Node, start, end Position) Node {
-	if x == nil {
-		x = DummyNode
+func NewIfStmtNode(filePath string, fileContent []rune, init Node, cond Node, body Node, else_ Node, start, end Position) Node {
+	if init == nil {
+		init = DummyNode
 	}
-	if low == nil {
-		low = DummyNode
+	if cond == nil {
+		cond = DummyNode
 	}
-	if high == nil {
-		high = DummyNode
+	if body == nil {
+		body = DummyNode
 	}
-	if max_ == nil {
-		max_ = DummyNode
+	if else_ == nil {
+		else_ = DummyNode
 	}
-	_1 := &SliceExprNode{
-		BaseNode: NewBaseNode(filePath, fileContent, NodeTypeSliceExpr, start, end),
-		x:        x,
-		low:      low,
-		high:     high,
-		max_:     max_,
+	_1 := &IfStmtNode{
+		BaseNode: NewBaseNode(filePath, fileContent, NodeTypeIfStmt, start, end),
+		init:     init,
+		cond:     cond,
+		body:     body,
+		else_:    else_,
 	}
 	creationHook(_1)
 	return _1
 }
 
-type SliceExprNode struct {
+type IfStmtNode struct {
 	*BaseNode
-	x    Node
-	low  Node
-	high Node
-	max_ Node
+	init  Node
+	cond  Node
+	body  Node
+	else_ Node
 }
 
-func (n *SliceExprNode) X() Node {
-	return n.x
+func (n *IfStmtNode) Init() Node {
+	return n.init
 }
 
-func (n *SliceExprNode) SetX(v Node) {
-	n.x = v
+func (n *IfStmtNode) SetInit(v Node) {
+	n.init = v
 }
 
-func (n *SliceExprNode) Low() Node {
-	return n.low
+func (n *IfStmtNode) Cond() Node {
+	return n.cond
 }
 
-func (n *SliceExprNode) SetLow(v Node) {
-	n.low = v
+func (n *IfStmtNode) SetCond(v Node) {
+	n.cond = v
 }
 
-func (n *SliceExprNode) High() Node {
-	return n.high
+func (n *IfStmtNode) Body() Node {
+	return n.body
 }
 
-func (n *SliceExprNode) SetHigh(v Node) {
-	n.high = v
+func (n *IfStmtNode) SetBody(v Node) {
+	n.body = v
 }
 
-func (n *SliceExprNode) Max() Node {
-	return n.max_
+func (n *IfStmtNode) Else() Node {
+	return n.else_
 }
 
-func (n *SliceExprNode) SetMax(v Node) {
-	n.max_ = v
+func (n *IfStmtNode) SetElse(v Node) {
+	n.else_ = v
 }
 
-func (n *SliceExprNode) BuildLink() {
-	if !n.X().IsDummy() {
-		x := n.X()
-		x.BuildLink()
-		x.SetParent(n)
-		x.SetSelfField("x")
-		x.SetReplaceSelf(func(n Node) {
-			n.Parent().(*SliceExprNode).SetX(n)
+func (n *IfStmtNode) BuildLink() {
+	if !n.Init().IsDummy() {
+		init := n.Init()
+		init.BuildLink()
+		init.SetParent(n)
+		init.SetSelfField("init")
+		init.SetReplaceSelf(func(n Node) {
+			n.Parent().(*IfStmtNode).SetInit(n)
 		})
 	}
-	if !n.Low().IsDummy() {
-		low := n.Low()
-		low.BuildLink()
-		low.SetParent(n)
-		low.SetSelfField("low")
-		low.SetReplaceSelf(func(n Node) {
-			n.Parent().(*SliceExprNode).SetLow(n)
+	if !n.Cond().IsDummy() {
+		cond := n.Cond()
+		cond.BuildLink()
+		cond.SetParent(n)
+		cond.SetSelfField("cond")
+		cond.SetReplaceSelf(func(n Node) {
+			n.Parent().(*IfStmtNode).SetCond(n)
 		})
 	}
-	if !n.High().IsDummy() {
-		high := n.High()
-		high.BuildLink()
-		high.SetParent(n)
-		high.SetSelfField("high")
-		high.SetReplaceSelf(func(n Node) {
-			n.Parent().(*SliceExprNode).SetHigh(n)
+	if !n.Body().IsDummy() {
+		body := n.Body()
+		body.BuildLink()
+		body.SetParent(n)
+		body.SetSelfField("body")
+		body.SetReplaceSelf(func(n Node) {
+			n.Parent().(*IfStmtNode).SetBody(n)
 		})
 	}
-	if !n.Max().IsDummy() {
-		max_ := n.Max()
-		max_.BuildLink()
-		max_.SetParent(n)
-		max_.SetSelfField("max_")
-		max_.SetReplaceSelf(func(n Node) {
-			n.Parent().(*SliceExprNode).SetMax(n)
+	if !n.Else().IsDummy() {
+		else_ := n.Else()
+		else_.BuildLink()
+		else_.SetParent(n)
+		else_.SetSelfField("else_")
+		else_.SetReplaceSelf(func(n Node) {
+			n.Parent().(*IfStmtNode).SetElse(n)
 		})
 	}
 }
 
-func (n *SliceExprNode) Fields() []string {
+func (n *IfStmtNode) Fields() []string {
 	return []string{
-		"x",
-		"low",
-		"high",
-		"max_",
+		"init",
+		"cond",
+		"body",
+		"else_",
 	}
 }
 
-func (n *SliceExprNode) Child(field string) Node {
+func (n *IfStmtNode) Child(field string) Node {
 	if field == "" {
 		return nil
 	}
-	if field == "x" {
-		return n.X()
+	if field == "init" {
+		return n.Init()
 	}
-	if field == "low" {
-		return n.Low()
+	if field == "cond" {
+		return n.Cond()
 	}
-	if field == "high" {
-		return n.High()
+	if field == "body" {
+		return n.Body()
 	}
-	if field == "max_" {
-		return n.Max()
+	if field == "else_" {
+		return n.Else()
 	}
 	return nil
 }
 
-func (n *SliceExprNode) SetChild(nodes []Node) {
+func (n *IfStmtNode) SetChild(nodes []Node) {
 	if len(nodes) != 4 {
 		return
 	}
-	n.SetX(nodes[0])
-	n.SetLow(nodes[1])
-	n.SetHigh(nodes[2])
-	n.SetMax(nodes[3])
+	n.SetInit(nodes[0])
+	n.SetCond(nodes[1])
+	n.SetBody(nodes[2])
+	n.SetElse(nodes[3])
 }
 
-func (n *SliceExprNode) Fork() Node {
-	_ret := &SliceExprNode{
+func (n *IfStmtNode) Fork() Node {
+	_ret := &IfStmtNode{
 		BaseNode: n.BaseNode.fork(),
-		x:        n.x.Fork(),
-		low:      n.low.Fork(),
-		high:     n.high.Fork(),
-		max_:     n.max_.Fork(),
+		init:     n.init.Fork(),
+		cond:     n.cond.Fork(),
+		body:     n.body.Fork(),
+		else_:    n.else_.Fork(),
 	}
-	_ret.x.SetParent(_ret)
-	_ret.low.SetParent(_ret)
-	_ret.high.SetParent(_ret)
-	_ret.max_.SetParent(_ret)
+	_ret.init.SetParent(_ret)
+	_ret.cond.SetParent(_ret)
+	_ret.body.SetParent(_ret)
+	_ret.else_.SetParent(_ret)
 	return _ret
 }
 
-func (n *SliceExprNode) Visit(beforeChildren func(node Node) (visitChildren, exit bool), afterChildren func(node Node) (exit bool)) (exit bool) {
+func (n *IfStmtNode) Visit(beforeChildren func(node Node) (visitChildren, exit bool), afterChildren func(node Node) (exit bool)) (exit bool) {
 	vc, e := beforeChildren(n)
 	if e {
 		return true
@@ -7237,16 +7155,16 @@ func (n *SliceExprNode) Visit(beforeChildren func(node Node) (visitChildren, exi
 	if !vc {
 		return false
 	}
-	if n.x.Visit(beforeChildren, afterChildren) {
+	if n.init.Visit(beforeChildren, afterChildren) {
 		return true
 	}
-	if n.low.Visit(beforeChildren, afterChildren) {
+	if n.cond.Visit(beforeChildren, afterChildren) {
 		return true
 	}
-	if n.high.Visit(beforeChildren, afterChildren) {
+	if n.body.Visit(beforeChildren, afterChildren) {
 		return true
 	}
-	if n.max_.Visit(beforeChildren, afterChildren) {
+	if n.else_.Visit(beforeChildren, afterChildren) {
 		return true
 	}
 	if afterChildren(n) {
@@ -7255,115 +7173,163 @@ func (n *SliceExprNode) Visit(beforeChildren func(node Node) (visitChildren, exi
 	return false
 }
 
-func (n *SliceExprNode) Dump(hook func(Node, map[string]string) string) map[string]string {
+func (n *IfStmtNode) Accept(v Visitor) bool {
+	return v.VisitIfStmtNode(n)
+}
+
+func (n *IfStmtNode) stmtNode() {}
+
+func (n *IfStmtNode) Rewrite(r Rewriter) Node {
+	n.init = rewriteChild(r, n.init)
+	n.cond = rewriteChild(r, n.cond)
+	n.body = rewriteChild(r, n.body)
+	n.else_ = rewriteChild(r, n.else_)
+	return r.RewriteIfStmtNode(n)
+}
+
+func (n *IfStmtNode) Dump(hook func(Node, map[string]string) string) map[string]string {
 	ret := make(map[string]string)
-	ret["kind"] = "\"slice_expr\""
-	ret["x"] = DumpNode(n.X(), hook)
-	ret["low"] = DumpNode(n.Low(), hook)
-	ret["high"] = DumpNode(n.High(), hook)
-	ret["max"] = DumpNode(n.Max(), hook)
+	ret["kind"] = "\"if_stmt\""
+	ret["init"] = DumpNode(n.Init(), hook)
+	ret["cond"] = DumpNode(n.Cond(), hook)
+	ret["body"] = DumpNode(n.Body(), hook)
+	ret["else"] = DumpNode(n.Else(), hook)
 	return ret
 }
 
-func NewUnaryExprNode(filePath string, fileContent []rune, op Node, x Node, start, end Position) Node {
-	if op == nil {
-		op = DummyNode
-	}
-	if x == nil {
-		x = DummyNode
-	}
-	_1 := &UnaryExprNode{
-		BaseNode: NewBaseNode(filePath, fileContent, NodeTypeUnaryExpr, start, end),
-		op:       op,
-		x:        x,
+func NewImportDotNode(filePath string, fileContent []rune, start, end Position) Node {
+	_1 := &ImportDotNode{
+		BaseNode: NewBaseNode(filePath, fileContent, NodeTypeImportDot, start, end),
 	}
 	creationHook(_1)
 	return _1
 }
 
-type UnaryExprNode struct {
+type ImportDotNode struct {
 	*BaseNode
-	op Node
-	x  Node
 }
 
-func (n *UnaryExprNode) Op() Node {
-	return n.op
+func (n *ImportDotNode) BuildLink() {
 }
 
-func (n *UnaryExprNode) SetOp(v Node) {
-	n.op = v
+func (n *ImportDotNode) Child(field string) Node {
+	if field == "" {
+		return nil
+	}
+	return nil
 }
 
-func (n *UnaryExprNode) X() Node {
-	return n.x
+func (n *ImportDotNode) SetChild(nodes []Node) {
+	if len(nodes) != 0 {
+		return
+	}
 }
 
-func (n *UnaryExprNode) SetX(v Node) {
-	n.x = v
+func (n *ImportDotNode) Fork() Node {
+	_ret := &ImportDotNode{
+		BaseNode: n.BaseNode.fork(),
+	}
+	return _ret
 }
 
-func (n *UnaryExprNode) BuildLink() {
-	if !n.Op().IsDummy() {
-		op := n.Op()
-		op.BuildLink()
-		op.SetParent(n)
-		op.SetSelfField("op")
-		op.SetReplaceSelf(func(n Node) {
-			n.Parent().(*UnaryExprNode).SetOp(n)
-		})
+func (n *ImportDotNode) Visit(beforeChildren func(node Node) (visitChildren, exit bool), afterChildren func(node Node) (exit bool)) (exit bool) {
+	vc, e := beforeChildren(n)
+	if e {
+		return true
 	}
-	if !n.X().IsDummy() {
-		x := n.X()
-		x.BuildLink()
-		x.SetParent(n)
-		x.SetSelfField("x")
-		x.SetReplaceSelf(func(n Node) {
-			n.Parent().(*UnaryExprNode).SetX(n)
+	if !vc {
+		return false
+	}
+	if afterChildren(n) {
+		return true
+	}
+	return false
+}
+
+func (n *ImportDotNode) Accept(v Visitor) bool {
+	return v.VisitImportDotNode(n)
+}
+
+func (n *ImportDotNode) Rewrite(r Rewriter) Node {
+	return r.RewriteImportDotNode(n)
+}
+
+func (n *ImportDotNode) Dump(_ func(Node, map[string]string) string) map[string]string {
+	ret := make(map[string]string)
+	ret["kind"] = "\"import_dot\""
+	return ret
+}
+
+func NewImportGroupDeclNode(filePath string, fileContent []rune, targets Node, start, end Position) Node {
+	if targets == nil {
+		targets = DummyNode
+	}
+	_1 := &ImportGroupDeclNode{
+		BaseNode: NewBaseNode(filePath, fileContent, NodeTypeImportGroupDecl, start, end),
+		targets:  targets,
+	}
+	creationHook(_1)
+	return _1
+}
+
+type ImportGroupDeclNode struct {
+	*BaseNode
+	targets Node
+}
+
+func (n *ImportGroupDeclNode) Targets() Node {
+	return n.targets
+}
+
+func (n *ImportGroupDeclNode) SetTargets(v Node) {
+	n.targets = v
+}
+
+func (n *ImportGroupDeclNode) BuildLink() {
+	if !n.Targets().IsDummy() {
+		targets := n.Targets()
+		targets.BuildLink()
+		targets.SetParent(n)
+		targets.SetSelfField("targets")
+		targets.SetReplaceSelf(func(n Node) {
+			n.Parent().(*ImportGroupDeclNode).SetTargets(n)
 		})
 	}
 }
 
-func (n *UnaryExprNode) Fields() []string {
+func (n *ImportGroupDeclNode) Fields() []string {
 	return []string{
-		"op",
-		"x",
+		"targets",
 	}
 }
 
-func (n *UnaryExprNode) Child(field string) Node {
+func (n *ImportGroupDeclNode) Child(field string) Node {
 	if field == "" {
 		return nil
 	}
-	if field == "op" {
-		return n.Op()
-	}
-	if field == "x" {
-		return n.X()
+	if field == "targets" {
+		return n.Targets()
 	}
 	return nil
 }
 
-func (n *UnaryExprNode) SetChild(nodes []Node) {
-	if len(nodes) != 2 {
+func (n *ImportGroupDeclNode) SetChild(nodes []Node) {
+	if len(nodes) != 1 {
 		return
 	}
-	n.SetOp(nodes[0])
-	n.SetX(nodes[1])
+	n.SetTargets(nodes[0])
 }
 
-func (n *UnaryExprNode) Fork() Node {
-	_ret := &UnaryExprNode{
+func (n *ImportGroupDeclNode) Fork() Node {
+	_ret := &ImportGroupDeclNode{
 		BaseNode: n.BaseNode.fork(),
-		op:       n.op.Fork(),
-		x:        n.x.Fork(),
+		targets:  n.targets.Fork(),
 	}
-	_ret.op.SetParent(_ret)
-	_ret.x.SetParent(_ret)
+	_ret.targets.SetParent(_ret)
 	return _ret
 }
 
-func (n *UnaryExprNode) Visit(beforeChildren func(node Node) (visitChildren, exit bool), afterChildren func(node Node) (exit bool)) (exit bool) {
+func (n *ImportGroupDeclNode) Visit(beforeChildren func(node Node) (visitChildren, exit bool), afterChildren func(node Node) (exit bool)) (exit bool) {
 	vc, e := beforeChildren(n)
 	if e {
 		return true
@@ -7371,10 +7337,7 @@ func (n *UnaryExprNode) Visit(beforeChildren func(node Node) (visitChildren, exi
 	if !vc {
 		return false
 	}
-	if n.op.Visit(beforeChildren, afterChildren) {
-		return true
-	}
-	if n.x.Visit(beforeChildren, afterChildren) {
+	if n.targets.Visit(beforeChildren, afterChildren) {
 		return true
 	}
 	if afterChildren(n) {
@@ -7383,113 +7346,94 @@ func (n *UnaryExprNode) Visit(beforeChildren func(node Node) (visitChildren, exi
 	return false
 }
 
-func (n *UnaryExprNode) Dump(hook func(Node, map[string]string) string) map[string]string {
+func (n *ImportGroupDeclNode) Accept(v Visitor) bool {
+	return v.VisitImportGroupDeclNode(n)
+}
+
+func (n *ImportGroupDeclNode) declNode() {}
+
+func (n *ImportGroupDeclNode) Rewrite(r Rewriter) Node {
+	n.targets = rewriteChild(r, n.targets)
+	return r.RewriteImportGroupDeclNode(n)
+}
+
+func (n *ImportGroupDeclNode) Dump(hook func(Node, map[string]string) string) map[string]string {
 	ret := make(map[string]string)
-	ret["kind"] = "\"unary_expr\""
-	ret["op"] = DumpNode(n.Op(), hook)
-	ret["x"] = DumpNode(n.X(), hook)
+	ret["kind"] = "\"import_group_decl\""
+	ret["targets"] = DumpNode(n.Targets(), hook)
 	return ret
 }
 
-func NewAssignStmtNode(filePath string, fileContent []rune, lhs Node, rhs Node, start, end Position) Node {
-	if lhs == nil {
-		lhs = DummyNode
-	}
-	if rhs == nil {
-		rhs = DummyNode
+func NewImportIdentNode(filePath string, fileContent []rune, ident Node, start, end Position) Node {
+	if ident == nil {
+		ident = DummyNode
 	}
-	_1 := &AssignStmtNode{
-		BaseNode: NewBaseNode(filePath, fileContent, NodeTypeAssignStmt, start, end),
-		lhs:      lhs,
-		rhs:      rhs,
+	_1 := &ImportIdentNode{
+		BaseNode: NewBaseNode(filePath, fileContent, NodeTypeImportIdent, start, end),
+		ident:    ident,
 	}
 	creationHook(_1)
 	return _1
 }
 
-type AssignStmtNode struct {
+type ImportIdentNode struct {
 	*BaseNode
-	lhs Node
-	rhs Node
-}
-
-func (n *AssignStmtNode) Lhs() Node {
-	return n.lhs
-}
-
-func (n *AssignStmtNode) SetLhs(v Node) {
-	n.lhs = v
+	ident Node
 }
 
-func (n *AssignStmtNode) Rhs() Node {
-	return n.rhs
+func (n *ImportIdentNode) Ident() Node {
+	return n.ident
 }
 
-func (n *AssignStmtNode) SetRhs(v Node) {
-	n.rhs = v
+func (n *ImportIdentNode) SetIdent(v Node) {
+	n.ident = v
 }
 
-func (n *AssignStmtNode) BuildLink() {
-	if !n.Lhs().IsDummy() {
-		lhs := n.Lhs()
-		lhs.BuildLink()
-		lhs.SetParent(n)
-		lhs.SetSelfField("lhs")
-		lhs.SetReplaceSelf(func(n Node) {
-			n.Parent().(*AssignStmtNode).SetLhs(n)
-		})
-	}
-	if !n.Rhs().IsDummy() {
-		rhs := n.Rhs()
-		rhs.BuildLink()
-		rhs.SetParent(n)
-		rhs.SetSelfField("rhs")
-		rhs.SetReplaceSelf(func(n Node) {
-			n.Parent().(*AssignStmtNode).SetRhs(n)
+func (n *ImportIdentNode) BuildLink() {
+	if !n.Ident().IsDummy() {
+		ident := n.Ident()
+		ident.BuildLink()
+		ident.SetParent(n)
+		ident.SetSelfField("ident")
+		ident.SetReplaceSelf(func(n Node) {
+			n.Parent().(*ImportIdentNode).SetIdent(n)
 		})
 	}
 }
 
-func (n *AssignStmtNode) Fields() []string {
+func (n *ImportIdentNode) Fields() []string {
 	return []string{
-		"lhs",
-		"rhs",
+		"ident",
 	}
 }
 
-func (n *AssignStmtNode) Child(field string) Node {
+func (n *ImportIdentNode) Child(field string) Node {
 	if field == "" {
 		return nil
 	}
-	if field == "lhs" {
-		return n.Lhs()
-	}
-	if field == "rhs" {
-		return n.Rhs()
+	if field == "ident" {
+		return n.Ident()
 	}
 	return nil
 }
 
-func (n *AssignStmtNode) SetChild(nodes []Node) {
-	if len(nodes) != 2 {
+func (n *ImportIdentNode) SetChild(nodes []Node) {
+	if len(nodes) != 1 {
 		return
 	}
-	n.SetLhs(nodes[0])
-	n.SetRhs(nodes[1])
+	n.SetIdent(nodes[0])
 }
 
-func (n *AssignStmtNode) Fork() Node {
-	_ret := &AssignStmtNode{
+func (n *ImportIdentNode) Fork() Node {
+	_ret := &ImportIdentNode{
 		BaseNode: n.BaseNode.fork(),
-		lhs:      n.lhs.Fork(),
-		rhs:      n.rhs.Fork(),
+		ident:    n.ident.Fork(),
 	}
-	_ret.lhs.SetParent(_ret)
-	_ret.rhs.SetParent(_ret)
+	_ret.ident.SetParent(_ret)
 	return _ret
 }
 
-func (n *AssignStmtNode) Visit(beforeChildren func(node Node) (visitChildren, exit bool), afterChildren func(node Node) (exit bool)) (exit bool) {
+func (n *ImportIdentNode) Visit(beforeChildren func(node Node) (visitChildren, exit bool), afterChildren func(node Node) (exit bool)) (exit bool) {
 	vc, e := beforeChildren(n)
 	if e {
 		return true
@@ -7497,10 +7441,7 @@ func (n *AssignStmtNode) Visit(beforeChildren func(node Node) (visitChildren, ex
 	if !vc {
 		return false
 	}
-	if n.lhs.Visit(beforeChildren, afterChildren) {
-		return true
-	}
-	if n.rhs.Visit(beforeChildren, afterChildren) {
+	if n.ident.Visit(beforeChildren, afterChildren) {
 		return true
 	}
 	if afterChildren(n) {
@@ -7509,142 +7450,92 @@ func (n *AssignStmtNode) Visit(beforeChildren func(node Node) (visitChildren, ex
 	return false
 }
 
-func (n *AssignStmtNode) Dump(hook func(Node, map[string]string) string) map[string]string {
+func (n *ImportIdentNode) Accept(v Visitor) bool {
+	return v.VisitImportIdentNode(n)
+}
+
+func (n *ImportIdentNode) Rewrite(r Rewriter) Node {
+	n.ident = rewriteChild(r, n.ident)
+	return r.RewriteImportIdentNode(n)
+}
+
+func (n *ImportIdentNode) Dump(hook func(Node, map[string]string) string) map[string]string {
 	ret := make(map[string]string)
-	ret["kind"] = "\"assign_stmt\""
-	ret["lhs"] = DumpNode(n.Lhs(), hook)
-	ret["rhs"] = DumpNode(n.Rhs(), hook)
+	ret["kind"] = "\"import_ident\""
+	ret["ident"] = DumpNode(n.Ident(), hook)
 	return ret
 }
 
-func NewAugAssignStmtNode(filePath string, fileContent []rune, lhs Node, op Node, rhs Node, start, end Position) Node {
-	if lhs == nil {
-		lhs = DummyNode
-	}
-	if op == nil {
-		op = DummyNode
+func NewImportOneDeclNode(filePath string, fileContent []rune, target Node, start, end Position) Node {
+	if target == nil {
+		target = DummyNode
 	}
-	if rhs == nil {
-		rhs = DummyNode
-	}
-	_1 := &AugAssignStmtNode{
-		BaseNode: NewBaseNode(filePath, fileContent, NodeTypeAugAssignStmt, start, end),
-		lhs:      lhs,
-		op:       op,
-		rhs:      rhs,
+	_1 := &ImportOneDeclNode{
+		BaseNode: NewBaseNode(filePath, fileContent, NodeTypeImportOneDecl, start, end),
+		target:   target,
 	}
 	creationHook(_1)
 	return _1
 }
 
-type AugAssignStmtNode struct {
+type ImportOneDeclNode struct {
 	*BaseNode
-	lhs Node
-	op  Node
-	rhs Node
+	target Node
 }
 
-func (n *AugAssignStmtNode) Lhs() Node {
-	return n.lhs
+func (n *ImportOneDeclNode) Target() Node {
+	return n.target
 }
 
-func (n *AugAssignStmtNode) SetLhs(v Node) {
-	n.lhs = v
+func (n *ImportOneDeclNode) SetTarget(v Node) {
+	n.target = v
 }
 
-func (n *AugAssignStmtNode) Op() Node {
-	return n.op
+func (n *ImportOneDeclNode) BuildLink() {
+	if !n.Target().IsDummy() {
+		target := n.Target()
+		target.BuildLink()
+		target.SetParent(n)
+		target.SetSelfField("target")
+		target.SetReplaceSelf(func(n Node) {
+			n.Parent().(*ImportOneDeclNode).SetTarget(n)
+		})
+	}
 }
 
-func (n *AugAssignStmtNode) SetOp(v Node) {
-	n.op = v
+func (n *ImportOneDeclNode) Fields() []string {
+	return []string{
+		"target",
+	}
 }
 
-func (n *AugAssignStmtNode) Rhs() Node {
-	return n.rhs
+func (n *ImportOneDeclNode) Child(field string) Node {
+	if field == "" {
+		return nil
+	}
+	if field == "target" {
+		return n.Target()
+	}
+	return nil
 }
 
-func (n *AugAssignStmtNode) SetRhs(v Node) {
-	n.rhs = v
+func (n *ImportOneDeclNode) SetChild(nodes []Node) {
+	if len(nodes) != 1 {
+		return
+	}
+	n.SetTarget(nodes[0])
 }
 
-func (n *AugAssignStmtNode) BuildLink() {
-	if !n.Lhs().IsDummy() {
-		lhs := n.Lhs()
-		lhs.BuildLink()
-		lhs.SetParent(n)
-		lhs.SetSelfField("lhs")
-		lhs.SetReplaceSelf(func(n Node) {
-			n.Parent().(*AugAssignStmtNode).SetLhs(n)
-		})
+func (n *ImportOneDeclNode) Fork() Node {
+	_ret := &ImportOneDeclNode{
+		BaseNode: n.BaseNode.fork(),
+		target:   n.target.Fork(),
 	}
-	if !n.Op().IsDummy() {
-		op := n.Op()
-		op.BuildLink()
-		op.SetParent(n)
-		op.SetSelfField("op")
-		op.SetReplaceSelf(func(n Node) {
-			n.Parent().(*AugAssignStmtNode).SetOp(n)
-		})
-	}
-	if !n.Rhs().IsDummy() {
-		rhs := n.Rhs()
-		rhs.BuildLink()
-		rhs.SetParent(n)
-		rhs.SetSelfField("rhs")
-		rhs.SetReplaceSelf(func(n Node) {
-			n.Parent().(*AugAssignStmtNode).SetRhs(n)
-		})
-	}
-}
-
-func (n *AugAssignStmtNode) Fields() []string {
-	return []string{
-		"lhs",
-		"op",
-		"rhs",
-	}
-}
-
-func (n *AugAssignStmtNode) Child(field string) Node {
-	if field == "" {
-		return nil
-	}
-	if field == "lhs" {
-		return n.Lhs()
-	}
-	if field == "op" {
-		return n.Op()
-	}
-	if field == "rhs" {
-		return n.Rhs()
-	}
-	return nil
-}
-
-func (n *AugAssignStmtNode) SetChild(nodes []Node) {
-	if len(nodes) != 3 {
-		return
-	}
-	n.SetLhs(nodes[0])
-	n.SetOp(nodes[1])
-	n.SetRhs(nodes[2])
-}
-
-func (n *AugAssignStmtNode) Fork() Node {
-	_ret := &AugAssignStmtNode{
-		BaseNode: n.BaseNode.fork(),
-		lhs:      n.lhs.Fork(),
-		op:       n.op.Fork(),
-		rhs:      n.rhs.Fork(),
-	}
-	_ret.lhs.SetParent(_ret)
-	_ret.op.SetParent(_ret)
-	_ret.rhs.SetParent(_ret)
+	_ret.target.SetParent(_ret)
 	return _ret
 }
 
-func (n *AugAssignStmtNode) Visit(beforeChildren func(node Node) (visitChildren, exit bool), afterChildren func(node Node) (exit bool)) (exit bool) {
+func (n *ImportOneDeclNode) Visit(beforeChildren func(node Node) (visitChildren, exit bool), afterChildren func(node Node) (exit bool)) (exit bool) {
 	vc, e := beforeChildren(n)
 	if e {
 		return true
@@ -7652,13 +7543,7 @@ func (n *AugAssignStmtNode) Visit(beforeChildren func(node Node) (visitChildren,
 	if !vc {
 		return false
 	}
-	if n.lhs.Visit(beforeChildren, afterChildren) {
-		return true
-	}
-	if n.op.Visit(beforeChildren, afterChildren) {
-		return true
-	}
-	if n.rhs.Visit(beforeChildren, afterChildren) {
+	if n.target.Visit(beforeChildren, afterChildren) {
 		return true
 	}
 	if afterChildren(n) {
@@ -7667,114 +7552,94 @@ func (n *AugAssignStmtNode) Visit(beforeChildren func(node Node) (visitChildren,
 	return false
 }
 
-func (n *AugAssignStmtNode) Dump(hook func(Node, map[string]string) string) map[string]string {
+func (n *ImportOneDeclNode) Accept(v Visitor) bool {
+	return v.VisitImportOneDeclNode(n)
+}
+
+func (n *ImportOneDeclNode) declNode() {}
+
+func (n *ImportOneDeclNode) Rewrite(r Rewriter) Node {
+	n.target = rewriteChild(r, n.target)
+	return r.RewriteImportOneDeclNode(n)
+}
+
+func (n *ImportOneDeclNode) Dump(hook func(Node, map[string]string) string) map[string]string {
 	ret := make(map[string]string)
-	ret["kind"] = "\"aug_assign_stmt\""
-	ret["lhs"] = DumpNode(n.Lhs(), hook)
-	ret["op"] = DumpNode(n.Op(), hook)
-	ret["rhs"] = DumpNode(n.Rhs(), hook)
+	ret["kind"] = "\"import_one_decl\""
+	ret["target"] = DumpNode(n.Target(), hook)
 	return ret
 }
 
-func NewShortVarDeclNode(filePath string, fileContent []rune, lhs Node, rhs Node, start, end Position) Node {
-	if lhs == nil {
-		lhs = DummyNode
-	}
-	if rhs == nil {
-		rhs = DummyNode
+func NewImportPathNode(filePath string, fileContent []rune, path Node, start, end Position) Node {
+	if path == nil {
+		path = DummyNode
 	}
-	_1 := &ShortVarDeclNode{
-		BaseNode: NewBaseNode(filePath, fileContent, NodeTypeShortVarDecl, start, end),
-		lhs:      lhs,
-		rhs:      rhs,
+	_1 := &ImportPathNode{
+		BaseNode: NewBaseNode(filePath, fileContent, NodeTypeImportPath, start, end),
+		path:     path,
 	}
 	creationHook(_1)
 	return _1
 }
 
-type ShortVarDeclNode struct {
+type ImportPathNode struct {
 	*BaseNode
-	lhs Node
-	rhs Node
-}
-
-func (n *ShortVarDeclNode) Lhs() Node {
-	return n.lhs
-}
-
-func (n *ShortVarDeclNode) SetLhs(v Node) {
-	n.lhs = v
+	path Node
 }
 
-func (n *ShortVarDeclNode) Rhs() Node {
-	return n.rhs
+func (n *ImportPathNode) Path() Node {
+	return n.path
 }
 
-func (n *ShortVarDeclNode) SetRhs(v Node) {
-	n.rhs = v
+func (n *ImportPathNode) SetPath(v Node) {
+	n.path = v
 }
 
-func (n *ShortVarDeclNode) BuildLink() {
-	if !n.Lhs().IsDummy() {
-		lhs := n.Lhs()
-		lhs.BuildLink()
-		lhs.SetParent(n)
-		lhs.SetSelfField("lhs")
-		lhs.SetReplaceSelf(func(n Node) {
-			n.Parent().(*ShortVarDeclNode).SetLhs(n)
-		})
-	}
-	if !n.Rhs().IsDummy() {
-		rhs := n.Rhs()
-		rhs.BuildLink()
-		rhs.SetParent(n)
-		rhs.SetSelfField("rhs")
-		rhs.SetReplaceSelf(func(n Node) {
-			n.Parent().(*ShortVarDeclNode).SetRhs(n)
+func (n *ImportPathNode) BuildLink() {
+	if !n.Path().IsDummy() {
+		path := n.Path()
+		path.BuildLink()
+		path.SetParent(n)
+		path.SetSelfField("path")
+		path.SetReplaceSelf(func(n Node) {
+			n.Parent().(*ImportPathNode).SetPath(n)
 		})
 	}
 }
 
-func (n *ShortVarDeclNode) Fields() []string {
+func (n *ImportPathNode) Fields() []string {
 	return []string{
-		"lhs",
-		"rhs",
+		"path",
 	}
 }
 
-func (n *ShortVarDeclNode) Child(field string) Node {
+func (n *ImportPathNode) Child(field string) Node {
 	if field == "" {
 		return nil
 	}
-	if field == "lhs" {
-		return n.Lhs()
-	}
-	if field == "rhs" {
-		return n.Rhs()
+	if field == "path" {
+		return n.Path()
 	}
 	return nil
 }
 
-func (n *ShortVarDeclNode) SetChild(nodes []Node) {
-	if len(nodes) != 2 {
+func (n *ImportPathNode) SetChild(nodes []Node) {
+	if len(nodes) != 1 {
 		return
 	}
-	n.SetLhs(nodes[0])
-	n.SetRhs(nodes[1])
+	n.SetPath(nodes[0])
 }
 
-func (n *ShortVarDeclNode) Fork() Node {
-	_ret := &ShortVarDeclNode{
+func (n *ImportPathNode) Fork() Node {
+	_ret := &ImportPathNode{
 		BaseNode: n.BaseNode.fork(),
-		lhs:      n.lhs.Fork(),
-		rhs:      n.rhs.Fork(),
+		path:     n.path.Fork(),
 	}
-	_ret.lhs.SetParent(_ret)
-	_ret.rhs.SetParent(_ret)
+	_ret.path.SetParent(_ret)
 	return _ret
 }
 
-func (n *ShortVarDeclNode) Visit(beforeChildren func(node Node) (visitChildren, exit bool), afterChildren func(node Node) (exit bool)) (exit bool) {
+func (n *ImportPathNode) Visit(beforeChildren func(node Node) (visitChildren, exit bool), afterChildren func(node Node) (exit bool)) (exit bool) {
 	vc, e := beforeChildren(n)
 	if e {
 		return true
@@ -7782,10 +7647,7 @@ func (n *ShortVarDeclNode) Visit(beforeChildren func(node Node) (visitChildren,
 	if !vc {
 		return false
 	}
-	if n.lhs.Visit(beforeChildren, afterChildren) {
-		return true
-	}
-	if n.rhs.Visit(beforeChildren, afterChildren) {
+	if n.path.Visit(beforeChildren, afterChildren) {
 		return true
 	}
 	if afterChildren(n) {
@@ -7794,84 +7656,92 @@ func (n *ShortVarDeclNode) Visit(beforeChildren func(node Node) (visitChildren,
 	return false
 }
 
-func (n *ShortVarDeclNode) Dump(hook func(Node, map[string]string) string) map[string]string {
+func (n *ImportPathNode) Accept(v Visitor) bool {
+	return v.VisitImportPathNode(n)
+}
+
+func (n *ImportPathNode) Rewrite(r Rewriter) Node {
+	n.path = rewriteChild(r, n.path)
+	return r.RewriteImportPathNode(n)
+}
+
+func (n *ImportPathNode) Dump(hook func(Node, map[string]string) string) map[string]string {
 	ret := make(map[string]string)
-	ret["kind"] = "\"short_var_decl\""
-	ret["lhs"] = DumpNode(n.Lhs(), hook)
-	ret["rhs"] = DumpNode(n.Rhs(), hook)
+	ret["kind"] = "\"import_path\""
+	ret["path"] = DumpNode(n.Path(), hook)
 	return ret
 }
 
-func NewBlockStmtNode(filePath string, fileContent []rune, list Node, start, end Position) Node {
-	if list == nil {
-		list = DummyNode
+func NewIncStmtNode(filePath string, fileContent []rune, x Node, start, end Position) Node {
+	if x == nil {
+		x = DummyNode
 	}
-	_1 := &BlockStmtNode{
-		BaseNode: NewBaseNode(filePath, fileContent, NodeTypeBlockStmt, start, end),
-		list:     list,
+	_1 := &IncStmtNode{
+		BaseNode: NewBaseNode(filePath, fileContent, NodeTypeIncStmt, start, end),
+		x:        x,
 	}
 	creationHook(_1)
 	return _1
 }
 
-type BlockStmtNode struct {
+type IncStmtNode struct {
 	*BaseNode
-	list Node
+	x Node
 }
 
-func (n *BlockStmtNode) List() Node {
-	return n.list
+func (n *IncStmtNode) X() Node {
+	return n.x
 }
 
-func (n *BlockStmtNode) SetList(v Node) {
-	n.list = v
+func (n *IncStmtNode) SetX(v Node) {
+	n.x = v
 }
 
-func (n *BlockStmtNode) BuildLink() {
-	if !n.List().IsDummy() {
-		list := n.List()
-		list.BuildLink()
-		list.SetParent(n)
-		list.SetSelfField("list")
-		list.SetReplaceSelf(func(n Node) {
-			n.Parent().(*BlockStmtNode).SetList(n)
+func (n *IncStmtNode) BuildLink() {
+	if !n.X().IsDummy() {
+		x := n.X()
+		x.BuildLink()
+		x.SetParent(n)
+		x.SetSelfField("x")
+		x.SetReplaceSelf(func(n Node) {
+			n.Parent().(*IncStmtNode).SetX(n)
 		})
 	}
 }
 
-func (n *BlockStmtNode) Fields() []string {
+func (n *IncStmtNode) Fields() []string {
 	return []string{
-		"list",
+		"x",
 	}
 }
 
-func (n *BlockStmtNode) Child(field string) Node {
+func (n *IncStmtNode) Child(field string) Node {
 	if field == "" {
 		return nil
 	}
-	if field == "list" {
-		return n.List()
+	if field == "x" {
+		return n.X()
 	}
 	return nil
 }
 
-func (n *BlockStmtNode) SetChild(nodes []Node) {
+func (n *IncStmtNode) SetChild(nodes []Node) {
 	if len(nodes) != 1 {
 		return
 	}
-	n.SetList(nodes[0])
+	n.SetX(nodes[0])
 }
 
-func (n *BlockStmtNode) Fork() Node {
-	_ret := &BlockStmtNode{
+func (n *IncStmtNode) Fork() Node {
+	_ret := &IncStmtNode{
 		BaseNode: n.BaseNode.fork(),
-		list:     n.list.Fork(),
+		x:        n.x.Fork(),
 	}
-	_ret.list.SetParent(_ret)
+	_ret.x.SetParent(_ret)
 	return _ret
 }
 
-func (n *BlockStmtNode) Visit(beforeChildren func(node Node) (visitChildren, exit bool), afterChildren func(node Node) (exit bool)) (exit bool) {
+func (n *IncStmtNode) Visit(beforeChildren func(node Node) (visitChildren, exit bool), afterChildren func(node Node) (exit bool)) (exit bool) {
 	vc, e := beforeChildren(n)
 	if e {
 		return true
@@ -7879,7 +7749,7 @@ func (n *BlockStmtNode) Visit(beforeChildren func(node Node) (visitChildren, exi
 	if !vc {
 		return false
 	}
-	if n.list.Visit(beforeChildren, afterChildren) {
+	if n.x.Visit(beforeChildren, afterChildren) {
 		return true
 	}
 	if afterChildren(n) {
@@ -7888,112 +7758,123 @@ func (n *BlockStmtNode) Visit(beforeChildren func(node Node) (visitChildren, exi
 	return false
 }
 
-func (n *BlockStmtNode) Dump(hook func(Node, map[string]string) string) map[string]string {
+func (n *IncStmtNode) Accept(v Visitor) bool {
+	return v.VisitIncStmtNode(n)
+}
+
+func (n *IncStmtNode) stmtNode() {}
+
+func (n *IncStmtNode) Rewrite(r Rewriter) Node {
+	n.x = rewriteChild(r, n.x)
+	return r.RewriteIncStmtNode(n)
+}
+
+func (n *IncStmtNode) Dump(hook func(Node, map[string]string) string) map[string]string {
 	ret := make(map[string]string)
-	ret["kind"] = "\"block_stmt\""
-	ret["list"] = DumpNode(n.List(), hook)
+	ret["kind"] = "\"inc_stmt\""
+	ret["x"] = DumpNode(n.X(), hook)
 	return ret
 }
 
-func NewBranchStmtNode(filePath string, fileContent []rune, tok Node, label Node, start, end Position) Node {
-	if tok == nil {
-		tok = DummyNode
+func NewIndexExprNode(filePath string, fileContent []rune, target Node, index Node, start, end Position) Node {
+	if target == nil {
+		target = DummyNode
 	}
-	if label == nil {
-		label = DummyNode
+	if index == nil {
+		index = DummyNode
 	}
-	_1 := &BranchStmtNode{
-		BaseNode: NewBaseNode(filePath, fileContent, NodeTypeBranchStmt, start, end),
-		tok:      tok,
-		label:    label,
+	_1 := &IndexExprNode{
+		BaseNode: NewBaseNode(filePath, fileContent, NodeTypeIndexExpr, start, end),
+		target:   target,
+		index:    index,
 	}
 	creationHook(_1)
 	return _1
 }
 
-type BranchStmtNode struct {
+type IndexExprNode struct {
 	*BaseNode
-	tok   Node
-	label Node
+	target Node
+	index  Node
 }
 
-func (n *BranchStmtNode) Tok() Node {
-	return n.tok
+func (n *IndexExprNode) Target() Node {
+	return n.target
 }
 
-func (n *BranchStmtNode) SetTok(v Node) {
-	n.tok = v
+func (n *IndexExprNode) SetTarget(v Node) {
+	n.target = v
 }
 
-func (n *BranchStmtNode) Label() Node {
-	return n.label
+func (n *IndexExprNode) Index() Node {
+	return n.index
 }
 
-func (n *BranchStmtNode) SetLabel(v Node) {
-	n.label = v
+func (n *IndexExprNode) SetIndex(v Node) {
+	n.index = v
 }
 
-func (n *BranchStmtNode) BuildLink() {
-	if !n.Tok().IsDummy() {
-		tok := n.Tok()
-		tok.BuildLink()
-		tok.SetParent(n)
-		tok.SetSelfField("tok")
-		tok.SetReplaceSelf(func(n Node) {
-			n.Parent().(*BranchStmtNode).SetTok(n)
+func (n *IndexExprNode) BuildLink() {
+	if !n.Target().IsDummy() {
+		target := n.Target()
+		target.BuildLink()
+		target.SetParent(n)
+		target.SetSelfField("target")
+		target.SetReplaceSelf(func(n Node) {
+			n.Parent().(*IndexExprNode).SetTarget(n)
 		})
 	}
-	if !n.Label().IsDummy() {
-		label := n.Label()
-		label.BuildLink()
-		label.SetParent(n)
-		label.SetSelfField("label")
-		label.SetReplaceSelf(func(n Node) {
-			n.Parent().(*BranchStmtNode).SetLabel(n)
+	if !n.Index().IsDummy() {
+		index := n.Index()
+		index.BuildLink()
+		index.SetParent(n)
+		index.SetSelfField("index")
+		index.SetReplaceSelf(func(n Node) {
+			n.Parent().(*IndexExprNode).SetIndex(n)
 		})
 	}
 }
 
-func (n *BranchStmtNode) Fields() []string {
+func (n *IndexExprNode) Fields() []string {
 	return []string{
-		"tok",
-		"label",
+		"target",
+		"index",
 	}
 }
 
-func (n *BranchStmtNode) Child(field string) Node {
+func (n *IndexExprNode) Child(field string) Node {
 	if field == "" {
 		return nil
 	}
-	if field == "tok" {
-		return n.Tok()
+	if field == "target" {
+		return n.Target()
 	}
-	if field == "label" {
-		return n.Label()
+	if field == "index" {
+		return n.Index()
 	}
 	return nil
 }
 
-func (n *BranchStmtNode) SetChild(nodes []Node) {
+func (n *IndexExprNode) SetChild(nodes []Node) {
 	if len(nodes) != 2 {
 		return
 	}
-	n.SetTok(nodes[0])
-	n.SetLabel(nodes[1])
+	n.SetTarget(nodes[0])
+	n.SetIndex(nodes[1])
 }
 
-func (n *BranchStmtNode) Fork() Node {
-	_ret := &BranchStmtNode{
+func (n *IndexExprNode) Fork() Node {
+	_ret := &IndexExprNode{
 		BaseNode: n.BaseNode.fork(),
-		tok:      n.tok.Fork(),
-		label:    n.label.Fork(),
+		target:   n.target.Fork(),
+		index:    n.index.Fork(),
 	}
-	_ret.tok.SetParent(_ret)
-	_ret.label.SetParent(_ret)
+	_ret.target.SetParent(_ret)
+	_ret.index.SetParent(_ret)
 	return _ret
 }
 
-func (n *BranchStmtNode) Visit(beforeChildren func(node Node) (visitChildren, exit bool), afterChildren func(node Node) (exit bool)) (exit bool) {
+func (n *IndexExprNode) Visit(beforeChildren func(node Node) (visitChildren, exit bool), afterChildren func(node Node) (exit bool)) (exit bool) {
 	vc, e := beforeChildren(n)
 	if e {
 		return true
@@ -8001,10 +7882,10 @@ func (n *BranchStmtNode) Visit(beforeChildren func(node Node) (visitChildren, ex
 	if !vc {
 		return false
 	}
-	if n.tok.Visit(beforeChildren, afterChildren) {
+	if n.target.Visit(beforeChildren, afterChildren) {
 		return true
 	}
-	if n.label.Visit(beforeChildren, afterChildren) {
+	if n.index.Visit(beforeChildren, afterChildren) {
 		return true
 	}
 	if afterChildren(n) {
@@ -8013,113 +7894,96 @@ func (n *BranchStmtNode) Visit(beforeChildren func(node Node) (visitChildren, ex
 	return false
 }
 
-func (n *BranchStmtNode) Dump(hook func(Node, map[string]string) string) map[string]string {
+func (n *IndexExprNode) Accept(v Visitor) bool {
+	return v.VisitIndexExprNode(n)
+}
+
+func (n *IndexExprNode) exprNode() {}
+
+func (n *IndexExprNode) Rewrite(r Rewriter) Node {
+	n.target = rewriteChild(r, n.target)
+	n.index = rewriteChild(r, n.index)
+	return r.RewriteIndexExprNode(n)
+}
+
+func (n *IndexExprNode) Dump(hook func(Node, map[string]string) string) map[string]string {
 	ret := make(map[string]string)
-	ret["kind"] = "\"branch_stmt\""
-	ret["tok"] = DumpNode(n.Tok(), hook)
-	ret["label"] = DumpNode(n.Label(), hook)
+	ret["kind"] = "\"index_expr\""
+	ret["target"] = DumpNode(n.Target(), hook)
+	ret["index"] = DumpNode(n.Index(), hook)
 	return ret
 }
 
-func NewSendStmtNode(filePath string, fileContent []rune, chan_ Node, value Node, start, end Position) Node {
-	if chan_ == nil {
-		chan_ = DummyNode
-	}
-	if value == nil {
-		value = DummyNode
+func NewInterfaceTypeNode(filePath string, fileContent []rune, b Node, start, end Position) Node {
+	if b == nil {
+		b = DummyNode
 	}
-	_1 := &SendStmtNode{
-		BaseNode: NewBaseNode(filePath, fileContent, NodeTypeSendStmt, start, end),
-		chan_:    chan_,
-		value:    value,
+	_1 := &InterfaceTypeNode{
+		BaseNode: NewBaseNode(filePath, fileContent, NodeTypeInterfaceType, start, end),
+		b:        b,
 	}
 	creationHook(_1)
 	return _1
 }
 
-type SendStmtNode struct {
+type InterfaceTypeNode struct {
 	*BaseNode
-	chan_ Node
-	value Node
-}
-
-func (n *SendStmtNode) Chan() Node {
-	return n.chan_
-}
-
-func (n *SendStmtNode) SetChan(v Node) {
-	n.chan_ = v
+	b Node
 }
 
-func (n *SendStmtNode) Value() Node {
-	return n.value
+func (n *InterfaceTypeNode) B() Node {
+	return n.b
 }
 
-func (n *SendStmtNode) SetValue(v Node) {
-	n.value = v
+func (n *InterfaceTypeNode) SetB(v Node) {
+	n.b = v
 }
 
-func (n *SendStmtNode) BuildLink() {
-	if !n.Chan().IsDummy() {
-		chan_ := n.Chan()
-		chan_.BuildLink()
-		chan_.SetParent(n)
-		chan_.SetSelfField("chan_")
-		chan_.SetReplaceSelf(func(n Node) {
-			n.Parent().(*SendStmtNode).SetChan(n)
-		})
-	}
-	if !n.Value().IsDummy() {
-		value := n.Value()
-		value.BuildLink()
-		value.SetParent(n)
-		value.SetSelfField("value")
-		value.SetReplaceSelf(func(n Node) {
-			n.Parent().(*SendStmtNode).SetValue(n)
+func (n *InterfaceTypeNode) BuildLink() {
+	if !n.B().IsDummy() {
+		b := n.B()
+		b.BuildLink()
+		b.SetParent(n)
+		b.SetSelfField("b")
+		b.SetReplaceSelf(func(n Node) {
+			n.Parent().(*InterfaceTypeNode).SetB(n)
 		})
 	}
 }
 
-func (n *SendStmtNode) Fields() []string {
+func (n *InterfaceTypeNode) Fields() []string {
 	return []string{
-		"chan_",
-		"value",
+		"b",
 	}
 }
 
-func (n *SendStmtNode) Child(field string) Node {
+func (n *InterfaceTypeNode) Child(field string) Node {
 	if field == "" {
 		return nil
 	}
-	if field == "chan_" {
-		return n.Chan()
-	}
-	if field == "value" {
-		return n.Value()
+	if field == "b" {
+		return n.B()
 	}
 	return nil
 }
 
-func (n *SendStmtNode) SetChild(nodes []Node) {
-	if len(nodes) != 2 {
+func (n *InterfaceTypeNode) SetChild(nodes []Node) {
+	if len(nodes) != 1 {
 		return
 	}
-	n.SetChan(nodes[0])
-	n.SetValue(nodes[1])
+	n.SetB(nodes[0])
 }
 
-func (n *SendStmtNode) Fork() Node {
-	_ret := &SendStmtNode{
+func (n *InterfaceTypeNode) Fork() Node {
+	_ret := &InterfaceTypeNode{
 		BaseNode: n.BaseNode.fork(),
-		chan_:    n.chan_.Fork(),
-		value:    n.value.Fork(),
+		b:        n.b.Fork(),
 	}
-	_ret.chan_.SetParent(_ret)
-	_ret.value.SetParent(_ret)
+	_ret.b.SetParent(_ret)
 	return _ret
 }
 
-func (n *SendStmtNode) Visit(beforeChildren func(node Node) (visitChildren, exit bool), afterChildren func(node Node) (exit bool)) (exit bool) {
+func (n *InterfaceTypeNode) Visit(beforeChildren func(node Node) (visitChildren, exit bool), afterChildren func(node Node) (exit bool)) (exit bool) {
 	vc, e := beforeChildren(n)
 	if e {
 		return true
@@ -8127,10 +7991,7 @@ func (n *SendStmtNode) Visit(beforeChildren func(node Node) (visitChildren, exit
 	if !vc {
 		return false
 	}
-	if n.chan_.Visit(beforeChildren, afterChildren) {
-		return true
-	}
-	if n.value.Visit(beforeChildren, afterChildren) {
+	if n.b.Visit(beforeChildren, afterChildren) {
 		return true
 	}
 	if afterChildren(n) {
@@ -8139,84 +8000,123 @@ func (n *SendStmtNode) Visit(beforeChildren func(node Node) (visitChildren, exit
 	return false
 }
 
-func (n *SendStmtNode) Dump(hook func(Node, map[string]string) string) map[string]string {
+func (n *InterfaceTypeNode) Accept(v Visitor) bool {
+	return v.VisitInterfaceTypeNode(n)
+}
+
+func (n *InterfaceTypeNode) typeNode() {}
+
+func (n *InterfaceTypeNode) Rewrite(r Rewriter) Node {
+	n.b = rewriteChild(r, n.b)
+	return r.RewriteInterfaceTypeNode(n)
+}
+
+func (n *InterfaceTypeNode) Dump(hook func(Node, map[string]string) string) map[string]string {
 	ret := make(map[string]string)
-	ret["kind"] = "\"send_stmt\""
-	ret["chan"] = DumpNode(n.Chan(), hook)
-	ret["value"] = DumpNode(n.Value(), hook)
+	ret["kind"] = "\"interface_type\""
+	ret["b"] = DumpNode(n.B(), hook)
 	return ret
 }
 
-func NewExprStmtNode(filePath string, fileContent []rune, x Node, start, end Position) Node {
+func NewKeyValueExprNode(filePath string, fileContent []rune, x Node, y Node, start, end Position) Node {
 	if x == nil {
 		x = DummyNode
 	}
-	_1 := &ExprStmtNode{
-		BaseNode: NewBaseNode(filePath, fileContent, NodeTypeExprStmt, start, end),
+	if y == nil {
+		y = DummyNode
+	}
+	_1 := &KeyValueExprNode{
+		BaseNode: NewBaseNode(filePath, fileContent, NodeTypeKeyValueExpr, start, end),
 		x:        x,
+		y:        y,
 	}
 	creationHook(_1)
 	return _1
 }
 
-type ExprStmtNode struct {
+type KeyValueExprNode struct {
 	*BaseNode
 	x Node
+	y Node
 }
 
-func (n *ExprStmtNode) X() Node {
+func (n *KeyValueExprNode) X() Node {
 	return n.x
 }
 
-func (n *ExprStmtNode) SetX(v Node) {
+func (n *KeyValueExprNode) SetX(v Node) {
 	n.x = v
 }
 
-func (n *ExprStmtNode) BuildLink() {
+func (n *KeyValueExprNode) Y() Node {
+	return n.y
+}
+
+func (n *KeyValueExprNode) SetY(v Node) {
+	n.y = v
+}
+
+func (n *KeyValueExprNode) BuildLink() {
 	if !n.X().IsDummy() {
 		x := n.X()
 		x.BuildLink()
 		x.SetParent(n)
 		x.SetSelfField("x")
 		x.SetReplaceSelf(func(n Node) {
-			n.Parent().(*ExprStmtNode).SetX(n)
+			n.Parent().(*KeyValueExprNode).SetX(n)
+		})
+	}
+	if !n.Y().IsDummy() {
+		y := n.Y()
+		y.BuildLink()
+		y.SetParent(n)
+		y.SetSelfField("y")
+		y.SetReplaceSelf(func(n Node) {
+			n.Parent().(*KeyValueExprNode).SetY(n)
 		})
 	}
 }
 
-func (n *ExprStmtNode) Fields() []string {
+func (n *KeyValueExprNode) Fields() []string {
 	return []string{
 		"x",
+		"y",
 	}
 }
 
-func (n *ExprStmtNode) Child(field string) Node {
+func (n *KeyValueExprNode) Child(field string) Node {
 	if field == "" {
 		return nil
 	}
 	if field == "x" {
 		return n.X()
 	}
+	if field == "y" {
+		return n.Y()
+	}
 	return nil
 }
 
-func (n *ExprStmtNode) SetChild(nodes []Node) {
-	if len(nodes) != 1 {
+func (n *KeyValueExprNode) SetChild(nodes []Node) {
+	if len(nodes) != 2 {
 		return
 	}
 	n.SetX(nodes[0])
+	n.SetY(nodes[1])
 }
 
-func (n *ExprStmtNode) Fork() Node {
-	_ret := &ExprStmtNode{
+func (n *KeyValueExprNode) Fork() Node {
+	_ret := &KeyValueExprNode{
 		BaseNode: n.BaseNode.fork(),
 		x:        n.x.Fork(),
+		y:        n.y.Fork(),
 	}
 	_ret.x.SetParent(_ret)
+	_ret.y.SetParent(_ret)
 	return _ret
 }
 
-func (n *ExprStmtNode) Visit(beforeChildren func(node Node) (visitChildren, exit bool), afterChildren func(node Node) (exit bool)) (exit bool) {
+func (n *KeyValueExprNode) Visit(beforeChildren func(node Node) (visitChildren, exit bool), afterChildren func(node Node) (exit bool)) (exit bool) {
 	vc, e := beforeChildren(n)
 	if e {
 		return true
@@ -8227,89 +8127,105 @@ func (n *ExprStmtNode) Visit(beforeChildren func(node Node) (visitChildren, exit
 	if n.x.Visit(beforeChildren, afterChildren) {
 		return true
 	}
+	if n.y.Visit(beforeChildren, afterChildren) {
+		return true
+	}
 	if afterChildren(n) {
 		return true
 	}
 	return false
 }
 
-func (n *ExprStmtNode) Dump(hook func(Node, map[string]string) string) map[string]string {
+func (n *KeyValueExprNode) Accept(v Visitor) bool {
+	return v.VisitKeyValueExprNode(n)
+}
+
+func (n *KeyValueExprNode) exprNode() {}
+
+func (n *KeyValueExprNode) Rewrite(r Rewriter) Node {
+	n.x = rewriteChild(r, n.x)
+	n.y = rewriteChild(r, n.y)
+	return r.RewriteKeyValueExprNode(n)
+}
+
+func (n *KeyValueExprNode) Dump(hook func(Node, map[string]string) string) map[string]string {
 	ret := make(map[string]string)
-	ret["kind"] = "\"expr_stmt\""
+	ret["kind"] = "\"key_value_expr\""
 	ret["x"] = DumpNode(n.X(), hook)
+	ret["y"] = DumpNode(n.Y(), hook)
 	return ret
 }
 
-func NewIncStmtNode(filePath string, fileContent []rune, x Node, start, end Position) Node {
-	if x == nil {
-		x = DummyNode
+func NewLabelIdentNode(filePath string, fileContent []rune, i Node, start, end Position) Node {
+	if i == nil {
+		i = DummyNode
 	}
-	_1 := &IncStmtNode{
-		BaseNode: NewBaseNode(filePath, fileContent, NodeTypeIncStmt, start, end),
-		x:        x,
+	_1 := &LabelIdentNode{
+		BaseNode: NewBaseNode(filePath, fileContent, NodeTypeLabelIdent, start, end),
+		i:        i,
 	}
 	creationHook(_1)
 	return _1
 }
 
-type IncStmtNode struct {
+type LabelIdentNode struct {
 	*BaseNode
-	x Node
+	i Node
 }
 
-func (n *IncStmtNode) X() Node {
-	return n.x
+func (n *LabelIdentNode) I() Node {
+	return n.i
 }
 
-func (n *IncStmtNode) SetX(v Node) {
-	n.x = v
+func (n *LabelIdentNode) SetI(v Node) {
+	n.i = v
 }
 
-func (n *IncStmtNode) BuildLink() {
-	if !n.X().IsDummy() {
-		x := n.X()
-		x.BuildLink()
-		x.SetParent(n)
-		x.SetSelfField("x")
-		x.SetReplaceSelf(func(n Node) {
-			n.Parent().(*IncStmtNode).SetX(n)
+func (n *LabelIdentNode) BuildLink() {
+	if !n.I().IsDummy() {
+		i := n.I()
+		i.BuildLink()
+		i.SetParent(n)
+		i.SetSelfField("i")
+		i.SetReplaceSelf(func(n Node) {
+			n.Parent().(*LabelIdentNode).SetI(n)
 		})
 	}
 }
 
-func (n *IncStmtNode) Fields() []string {
+func (n *LabelIdentNode) Fields() []string {
 	return []string{
-		"x",
+		"i",
 	}
 }
 
-func (n *IncStmtNode) Child(field string) Node {
+func (n *LabelIdentNode) Child(field string) Node {
 	if field == "" {
 		return nil
 	}
-	if field == "x" {
-		return n.X()
+	if field == "i" {
+		return n.I()
 	}
 	return nil
 }
 
-func (n *IncStmtNode) SetChild(nodes []Node) {
+func (n *LabelIdentNode) SetChild(nodes []Node) {
 	if len(nodes) != 1 {
 		return
 	}
-	n.SetX(nodes[0])
+	n.SetI(nodes[0])
 }
 
-func (n *IncStmtNode) Fork() Node {
-	_ret := &IncStmtNode{
+func (n *LabelIdentNode) Fork() Node {
+	_ret := &LabelIdentNode{
 		BaseNode: n.BaseNode.fork(),
-		x:        n.x.Fork(),
+		i:        n.i.Fork(),
 	}
-	_ret.x.SetParent(_ret)
+	_ret.i.SetParent(_ret)
 	return _ret
 }
 
-func (n *IncStmtNode) Visit(beforeChildren func(node Node) (visitChildren, exit bool), afterChildren func(node Node) (exit bool)) (exit bool) {
+func (n *LabelIdentNode) Visit(beforeChildren func(node Node) (visitChildren, exit bool), afterChildren func(node Node) (exit bool)) (exit bool) {
 	vc, e := beforeChildren(n)
 	if e {
 		return true
@@ -8317,7 +8233,7 @@ func (n *IncStmtNode) Visit(beforeChildren func(node Node) (visitChildren, exit
 	if !vc {
 		return false
 	}
-	if n.x.Visit(beforeChildren, afterChildren) {
+	if n.i.Visit(beforeChildren, afterChildren) {
 		return true
 	}
 	if afterChildren(n) {
@@ -8326,83 +8242,121 @@ func (n *IncStmtNode) Visit(beforeChildren func(node Node) (visitChildren, exit
 	return false
 }
 
-func (n *IncStmtNode) Dump(hook func(Node, map[string]string) string) map[string]string {
+func (n *LabelIdentNode) Accept(v Visitor) bool {
+	return v.VisitLabelIdentNode(n)
+}
+
+func (n *LabelIdentNode) Rewrite(r Rewriter) Node {
+	n.i = rewriteChild(r, n.i)
+	return r.RewriteLabelIdentNode(n)
+}
+
+func (n *LabelIdentNode) Dump(hook func(Node, map[string]string) string) map[string]string {
 	ret := make(map[string]string)
-	ret["kind"] = "\"inc_stmt\""
-	ret["x"] = DumpNode(n.X(), hook)
+	ret["kind"] = "\"label_ident\""
+	ret["i"] = DumpNode(n.I(), hook)
 	return ret
 }
 
-func NewDecStmtNode(filePath string, fileContent []rune, x Node, start, end Position) Node {
+func NewLabeledStmtNode(filePath string, fileContent []rune, x Node, b Node, start, end Position) Node {
 	if x == nil {
 		x = DummyNode
 	}
-	_1 := &DecStmtNode{
-		BaseNode: NewBaseNode(filePath, fileContent, NodeTypeDecStmt, start, end),
+	if b == nil {
+		b = DummyNode
+	}
+	_1 := &LabeledStmtNode{
+		BaseNode: NewBaseNode(filePath, fileContent, NodeTypeLabeledStmt, start, end),
 		x:        x,
+		b:        b,
 	}
 	creationHook(_1)
 	return _1
 }
 
-type DecStmtNode struct {
+type LabeledStmtNode struct {
 	*BaseNode
 	x Node
+	b Node
 }
 
-func (n *DecStmtNode) X() Node {
+func (n *LabeledStmtNode) X() Node {
 	return n.x
 }
 
-func (n *DecStmtNode) SetX(v Node) {
+func (n *LabeledStmtNode) SetX(v Node) {
 	n.x = v
 }
 
-func (n *DecStmtNode) BuildLink() {
+func (n *LabeledStmtNode) B() Node {
+	return n.b
+}
+
+func (n *LabeledStmtNode) SetB(v Node) {
+	n.b = v
+}
+
+func (n *LabeledStmtNode) BuildLink() {
 	if !n.X().IsDummy() {
 		x := n.X()
 		x.BuildLink()
 		x.SetParent(n)
 		x.SetSelfField("x")
 		x.SetReplaceSelf(func(n Node) {
-			n.Parent().(*DecStmtNode).SetX(n)
+			n.Parent().(*LabeledStmtNode).SetX(n)
+		})
+	}
+	if !n.B().IsDummy() {
+		b := n.B()
+		b.BuildLink()
+		b.SetParent(n)
+		b.SetSelfField("b")
+		b.SetReplaceSelf(func(n Node) {
+			n.Parent().(*LabeledStmtNode).SetB(n)
 		})
 	}
 }
 
-func (n *DecStmtNode) Fields() []string {
+func (n *LabeledStmtNode) Fields() []string {
 	return []string{
 		"x",
+		"b",
 	}
 }
 
-func (n *DecStmtNode) Child(field string) Node {
+func (n *LabeledStmtNode) Child(field string) Node {
 	if field == "" {
 		return nil
 	}
 	if field == "x" {
 		return n.X()
 	}
+	if field == "b" {
+		return n.B()
+	}
 	return nil
 }
 
-func (n *DecStmtNode) SetChild(nodes []Node) {
-	if len(nodes) != 1 {
+func (n *LabeledStmtNode) SetChild(nodes []Node) {
+	if len(nodes) != 2 {
 		return
 	}
 	n.SetX(nodes[0])
+	n.SetB(nodes[1])
 }
 
-func (n *DecStmtNode) Fork() Node {
-	_ret := &DecStmtNode{
+func (n *LabeledStmtNode) Fork() Node {
+	_ret := &LabeledStmtNode{
 		BaseNode: n.BaseNode.fork(),
 		x:        n.x.Fork(),
+		b:        n.b.Fork(),
 	}
 	_ret.x.SetParent(_ret)
+	_ret.b.SetParent(_ret)
 	return _ret
 }
 
-func (n *DecStmtNode) Visit(beforeChildren func(node Node) (visitChildren, exit bool), afterChildren func(node Node) (exit bool)) (exit bool) {
+func (n *LabeledStmtNode) Visit(beforeChildren func(node Node) (visitChildren, exit bool), afterChildren func(node Node) (exit bool)) (exit bool) {
 	vc, e := beforeChildren(n)
 	if e {
 		return true
@@ -8413,176 +8367,134 @@ func (n *DecStmtNode) Visit(beforeChildren func(node Node) (visitChildren, exit
 	if n.x.Visit(beforeChildren, afterChildren) {
 		return true
 	}
+	if n.b.Visit(beforeChildren, afterChildren) {
+		return true
+	}
 	if afterChildren(n) {
 		return true
 	}
 	return false
 }
 
-func (n *DecStmtNode) Dump(hook func(Node, map[string]string) string) map[string]string {
+func (n *LabeledStmtNode) Accept(v Visitor) bool {
+	return v.VisitLabeledStmtNode(n)
+}
+
+func (n *LabeledStmtNode) stmtNode() {}
+
+func (n *LabeledStmtNode) Rewrite(r Rewriter) Node {
+	n.x = rewriteChild(r, n.x)
+	n.b = rewriteChild(r, n.b)
+	return r.RewriteLabeledStmtNode(n)
+}
+
+func (n *LabeledStmtNode) Dump(hook func(Node, map[string]string) string) map[string]string {
 	ret := make(map[string]string)
-	ret["kind"] = "\"dec_stmt\""
+	ret["kind"] = "\"labeled_stmt\""
 	ret["x"] = DumpNode(n.X(), hook)
+	ret["b"] = DumpNode(n.B(), hook)
 	return ret
 }
 
-func NewIfStmtNode(filePath string, fileContent []rune, init Node, condition Node, body Node, else_ Node, start, end Position) Node {
-	if init == nil {
-		init = DummyNode
-	}
-	if condition == nil {
-		condition = DummyNode
-	}
-	if body == nil {
-		body = DummyNode
+func NewLogicalAndExprNode(filePath string, fileContent []rune, lhs Node, rhs Node, start, end Position) Node {
+	if lhs == nil {
+		lhs = DummyNode
 	}
-	if else_ == nil {
-		else_ = DummyNode
+	if rhs == nil {
+		rhs = DummyNode
 	}
-	_1 := &IfStmtNode{
-		BaseNode:  NewBaseNode(filePath, fileContent, NodeTypeIfStmt, start, end),
-		init:      init,
-		condition: condition,
-		body:      body,
-		else_:     else_,
+	_1 := &LogicalAndExprNode{
+		BaseNode: NewBaseNode(filePath, fileContent, NodeTypeLogicalAndExpr, start, end),
+		lhs:      lhs,
+		rhs:      rhs,
 	}
 	creationHook(_1)
 	return _1
 }
 
-type IfStmtNode struct {
+type LogicalAndExprNode struct {
 	*BaseNode
-	init      Node
-	condition Node
-	body      Node
-	else_     Node
-}
-
-func (n *IfStmtNode) Init() Node {
-	return n.init
-}
-
-func (n *IfStmtNode) SetInit(v Node) {
-	n.init = v
-}
-
-func (n *IfStmtNode) Condition() Node {
-	return n.condition
-}
-
-func (n *IfStmtNode) SetCondition(v Node) {
-	n.condition = v
+	lhs Node
+	rhs Node
 }
 
-func (n *IfStmtNode) Body() Node {
-	return n.body
+func (n *LogicalAndExprNode) Lhs() Node {
+	return n.lhs
 }
 
-func (n *IfStmtNode) SetBody(v Node) {
-	n.body = v
+func (n *LogicalAndExprNode) SetLhs(v Node) {
+	n.lhs = v
 }
 
-func (n *IfStmtNode) Else() Node {
-	return n.else_
+func (n *LogicalAndExprNode) Rhs() Node {
+	return n.rhs
 }
 
-func (n *IfStmtNode) SetElse(v Node) {
-	n.else_ = v
+func (n *LogicalAndExprNode) SetRhs(v Node) {
+	n.rhs = v
 }
 
-func (n *IfStmtNode) BuildLink() {
-	if !n.Init().IsDummy() {
-		init := n.Init()
-		init.BuildLink()
-		init.SetParent(n)
-		init.SetSelfField("init")
-		init.SetReplaceSelf(func(n Node) {
-			n.Parent().(*IfStmtNode).SetInit(n)
-		})
-	}
-	if !n.Condition().IsDummy() {
-		condition := n.Condition()
-		condition.BuildLink()
-		condition.SetParent(n)
-		condition.SetSelfField("condition")
-		condition.SetReplaceSelf(func(n Node) {
-			n.Parent().(*IfStmtNode).SetCondition(n)
-		})
-	}
-	if !n.Body().IsDummy() {
-		body := n.Body()
-		body.BuildLink()
-		body.SetParent(n)
-		body.SetSelfField("body")
-		body.SetReplaceSelf(func(n Node) {
-			n.Parent().(*IfStmtNode).SetBody(n)
+func (n *LogicalAndExprNode) BuildLink() {
+	if !n.Lhs().IsDummy() {
+		lhs := n.Lhs()
+		lhs.BuildLink()
+		lhs.SetParent(n)
+		lhs.SetSelfField("lhs")
+		lhs.SetReplaceSelf(func(n Node) {
+			n.Parent().(*LogicalAndExprNode).SetLhs(n)
 		})
 	}
-	if !n.Else().IsDummy() {
-		else_ := n.Else()
-		else_.BuildLink()
-		else_.SetParent(n)
-		else_.SetSelfField("else_")
-		else_.SetReplaceSelf(func(n Node) {
-			n.Parent().(*IfStmtNode).SetElse(n)
+	if !n.Rhs().IsDummy() {
+		rhs := n.Rhs()
+		rhs.BuildLink()
+		rhs.SetParent(n)
+		rhs.SetSelfField("rhs")
+		rhs.SetReplaceSelf(func(n Node) {
+			n.Parent().(*LogicalAndExprNode).SetRhs(n)
 		})
 	}
 }
 
-func (n *IfStmtNode) Fields() []string {
+func (n *LogicalAndExprNode) Fields() []string {
 	return []string{
-		"init",
-		"condition",
-		"body",
-		"else_",
+		"lhs",
+		"rhs",
 	}
 }
 
-func (n *IfStmtNode) Child(field string) Node {
+func (n *LogicalAndExprNode) Child(field string) Node {
 	if field == "" {
 		return nil
 	}
-	if field == "init" {
-		return n.Init()
-	}
-	if field == "condition" {
-		return n.Condition()
-	}
-	if field == "body" {
-		return n.Body()
+	if field == "lhs" {
+		return n.Lhs()
 	}
-	if field == "else_" {
-		return n.Else()
+	if field == "rhs" {
+		return n.Rhs()
 	}
 	return nil
 }
 
-func (n *IfStmtNode) SetChild(nodes []Node) {
-	if len(nodes) != 4 {
+func (n *LogicalAndExprNode) SetChild(nodes []Node) {
+	if len(nodes) != 2 {
 		return
 	}
-	n.SetInit(nodes[0])
-	n.SetCondition(nodes[1])
-	n.SetBody(nodes[2])
-	n.SetElse(nodes[3])
+	n.SetLhs(nodes[0])
+	n.SetRhs(nodes[1])
 }
 
-func (n *IfStmtNode) Fork() Node {
-	_ret := &IfStmtNode{
-		BaseNode:  n.BaseNode.fork(),
-		init:      n.init.Fork(),
-		condition: n.condition.Fork(),
-		body:      n.body.Fork(),
-		else_:     n.else_.Fork(),
+func (n *LogicalAndExprNode) Fork() Node {
+	_ret := &LogicalAndExprNode{
+		BaseNode: n.BaseNode.fork(),
+		lhs:      n.lhs.Fork(),
+		rhs:      n.rhs.Fork(),
 	}
-	_ret.init.SetParent(_ret)
-	_ret.condition.SetParent(_ret)
-	_ret.body.SetParent(_ret)
-	_ret.else_.SetParent(_ret)
+	_ret.lhs.SetParent(_ret)
+	_ret.rhs.SetParent(_ret)
 	return _ret
 }
 
-func (n *IfStmtNode) Visit(beforeChildren func(node Node) (visitChildren, exit bool), afterChildren func(node Node) (exit bool)) (exit bool) {
+func (n *LogicalAndExprNode) Visit(beforeChildren func(node Node) (visitChildren, exit bool), afterChildren func(node Node) (exit bool)) (exit bool) {
 	vc, e := beforeChildren(n)
 	if e {
 		return true
@@ -8590,16 +8502,10 @@ func (n *IfStmtNode) Visit(beforeChildren func(node Node) (visitChildren, exit b
 	if !vc {
 		return false
 	}
-	if n.init.Visit(beforeChildren, afterChildren) {
-		return true
-	}
-	if n.condition.Visit(beforeChildren, afterChildren) {
-		return true
-	}
-	if n.body.Visit(beforeChildren, afterChildren) {
+	if n.lhs.Visit(beforeChildren, afterChildren) {
 		return true
 	}
-	if n.else_.Visit(beforeChildren, afterChildren) {
+	if n.rhs.Visit(beforeChildren, afterChildren) {
 		return true
 	}
 	if afterChildren(n) {
@@ -8608,173 +8514,125 @@ func (n *IfStmtNode) Visit(beforeChildren func(node Node) (visitChildren, exit b
 	return false
 }
 
-func (n *IfStmtNode) Dump(hook func(Node, map[string]string) string) map[string]string {
+func (n *LogicalAndExprNode) Accept(v Visitor) bool {
+	return v.VisitLogicalAndExprNode(n)
+}
+
+func (n *LogicalAndExprNode) exprNode() {}
+
+func (n *LogicalAndExprNode) Rewrite(r Rewriter) Node {
+	n.lhs = rewriteChild(r, n.lhs)
+	n.rhs = rewriteChild(r, n.rhs)
+	return r.RewriteLogicalAndExprNode(n)
+}
+
+func (n *LogicalAndExprNode) Dump(hook func(Node, map[string]string) string) map[string]string {
 	ret := make(map[string]string)
-	ret["kind"] = "\"if_stmt\""
-	ret["init"] = DumpNode(n.Init(), hook)
-	ret["condition"] = DumpNode(n.Condition(), hook)
-	ret["body"] = DumpNode(n.Body(), hook)
-	ret["else"] = DumpNode(n.Else(), hook)
+	ret["kind"] = "\"logical_and_expr\""
+	ret["lhs"] = DumpNode(n.Lhs(), hook)
+	ret["rhs"] = DumpNode(n.Rhs(), hook)
 	return ret
 }
 
-func NewForStmtNode(filePath string, fileContent []rune, init Node, condition Node, update Node, body Node, start, end Position) Node {
-	if init == nil {
-		init = DummyNode
-	}
-	if condition == nil {
-		condition = DummyNode
-	}
-	if update == nil {
-		update = DummyNode
+func NewLogicalOrExprNode(filePath string, fileContent []rune, lhs Node, rhs Node, start, end Position) Node {
+	if lhs == nil {
+		lhs = DummyNode
 	}
-	if body == nil {
-		body = DummyNode
+	if rhs == nil {
+		rhs = DummyNode
 	}
-	_1 := &ForStmtNode{
-		BaseNode:  NewBaseNode(filePath, fileContent, NodeTypeForStmt, start, end),
-		init:      init,
-		condition: condition,
-		update:    update,
-		body:      body,
+	_1 := &LogicalOrExprNode{
+		BaseNode: NewBaseNode(filePath, fileContent, NodeTypeLogicalOrExpr, start, end),
+		lhs:      lhs,
+		rhs:      rhs,
 	}
 	creationHook(_1)
 	return _1
 }
 
-type ForStmtNode struct {
+type LogicalOrExprNode struct {
 	*BaseNode
-	init      Node
-	condition Node
-	update    Node
-	body      Node
-}
-
-func (n *ForStmtNode) Init() Node {
-	return n.init
-}
-
-func (n *ForStmtNode) SetInit(v Node) {
-	n.init = v
-}
-
-func (n *ForStmtNode) Condition() Node {
-	return n.condition
-}
-
-func (n *ForStmtNode) SetCondition(v Node) {
-	n.condition = v
+	lhs Node
+	rhs Node
 }
 
-func (n *ForStmtNode) Update() Node {
-	return n.update
+func (n *LogicalOrExprNode) Lhs() Node {
+	return n.lhs
 }
 
-func (n *ForStmtNode) SetUpdate(v Node) {
-	n.update = v
+func (n *LogicalOrExprNode) SetLhs(v Node) {
+	n.lhs = v
 }
 
-func (n *ForStmtNode) Body() Node {
-	return n.body
+func (n *LogicalOrExprNode) Rhs() Node {
+	return n.rhs
 }
 
-func (n *ForStmtNode) SetBody(v Node) {
-	n.body = v
+func (n *LogicalOrExprNode) SetRhs(v Node) {
+	n.rhs = v
 }
 
-func (n *ForStmtNode) BuildLink() {
-	if !n.Init().IsDummy() {
-		init := n.Init()
-		init.BuildLink()
-		init.SetParent(n)
-		init.SetSelfField("init")
-		init.SetReplaceSelf(func(n Node) {
-			n.Parent().(*ForStmtNode).SetInit(n)
-		})
-	}
-	if !n.Condition().IsDummy() {
-		condition := n.Condition()
-		condition.BuildLink()
-		condition.SetParent(n)
-		condition.SetSelfField("condition")
-		condition.SetReplaceSelf(func(n Node) {
-			n.Parent().(*ForStmtNode).SetCondition(n)
-		})
-	}
-	if !n.Update().IsDummy() {
-		update := n.Update()
-		update.BuildLink()
-		update.SetParent(n)
-		update.SetSelfField("update")
-		update.SetReplaceSelf(func(n Node) {
-			n.Parent().(*ForStmtNode).SetUpdate(n)
+func (n *LogicalOrExprNode) BuildLink() {
+	if !n.Lhs().IsDummy() {
+		lhs := n.Lhs()
+		lhs.BuildLink()
+		lhs.SetParent(n)
+		lhs.SetSelfField("lhs")
+		lhs.SetReplaceSelf(func(n Node) {
+			n.Parent().(*LogicalOrExprNode).SetLhs(n)
 		})
 	}
-	if !n.Body().IsDummy() {
-		body := n.Body()
-		body.BuildLink()
-		body.SetParent(n)
-		body.SetSelfField("body")
-		body.SetReplaceSelf(func(n Node) {
-			n.Parent().(*ForStmtNode).SetBody(n)
+	if !n.Rhs().IsDummy() {
+		rhs := n.Rhs()
+		rhs.BuildLink()
+		rhs.SetParent(n)
+		rhs.SetSelfField("rhs")
+		rhs.SetReplaceSelf(func(n Node) {
+			n.Parent().(*LogicalOrExprNode).SetRhs(n)
 		})
 	}
 }
 
-func (n *ForStmtNode) Fields() []string {
+func (n *LogicalOrExprNode) Fields() []string {
 	return []string{
-		"init",
-		"condition",
-		"update",
-		"body",
+		"lhs",
+		"rhs",
 	}
 }
 
-func (n *ForStmtNode) Child(field string) Node {
+func (n *LogicalOrExprNode) Child(field string) Node {
 	if field == "" {
 		return nil
 	}
-	if field == "init" {
-		return n.Init()
-	}
-	if field == "condition" {
-		return n.Condition()
-	}
-	if field == "update" {
-		return n.Update()
+	if field == "lhs" {
+		return n.Lhs()
 	}
-	if field == "body" {
-		return n.Body()
+	if field == "rhs" {
+		return n.Rhs()
 	}
 	return nil
 }
 
-func (n *ForStmtNode) SetChild(nodes []Node) {
-	if len(nodes) != 4 {
+func (n *LogicalOrExprNode) SetChild(nodes []Node) {
+	if len(nodes) != 2 {
 		return
 	}
-	n.SetInit(nodes[0])
-	n.SetCondition(nodes[1])
-	n.SetUpdate(nodes[2])
-	n.SetBody(nodes[3])
+	n.SetLhs(nodes[0])
+	n.SetRhs(nodes[1])
 }
 
-func (n *ForStmtNode) Fork() Node {
-	_ret := &ForStmtNode{
-		BaseNode:  n.BaseNode.fork(),
-		init:      n.init.Fork(),
-		condition: n.condition.Fork(),
-		update:    n.update.Fork(),
-		body:      n.body.Fork(),
+func (n *LogicalOrExprNode) Fork() Node {
+	_ret := &LogicalOrExprNode{
+		BaseNode: n.BaseNode.fork(),
+		lhs:      n.lhs.Fork(),
+		rhs:      n.rhs.Fork(),
 	}
-	_ret.init.SetParent(_ret)
-	_ret.condition.SetParent(_ret)
-	_ret.update.SetParent(_ret)
-	_ret.body.SetParent(_ret)
+	_ret.lhs.SetParent(_ret)
+	_ret.rhs.SetParent(_ret)
 	return _ret
 }
 
-func (n *ForStmtNode) Visit(beforeChildren func(node Node) (visitChildren, exit bool), afterChildren func(node Node) (exit bool)) (exit bool) {
+func (n *LogicalOrExprNode) Visit(beforeChildren func(node Node) (visitChildren, exit bool), afterChildren func(node Node) (exit bool)) (exit bool) {
 	vc, e := beforeChildren(n)
 	if e {
 		return true
@@ -8782,16 +8640,10 @@ func (n *ForStmtNode) Visit(beforeChildren func(node Node) (visitChildren, exit
 	if !vc {
 		return false
 	}
-	if n.init.Visit(beforeChildren, afterChildren) {
-		return true
-	}
-	if n.condition.Visit(beforeChildren, afterChildren) {
-		return true
-	}
-	if n.update.Visit(beforeChildren, afterChildren) {
+	if n.lhs.Visit(beforeChildren, afterChildren) {
 		return true
 	}
-	if n.body.Visit(beforeChildren, afterChildren) {
+	if n.rhs.Visit(beforeChildren, afterChildren) {
 		return true
 	}
 	if afterChildren(n) {
@@ -8800,173 +8652,125 @@ func (n *ForStmtNode) Visit(beforeChildren func(node Node) (visitChildren, exit
 	return false
 }
 
-func (n *ForStmtNode) Dump(hook func(Node, map[string]string) string) map[string]string {
+func (n *LogicalOrExprNode) Accept(v Visitor) bool {
+	return v.VisitLogicalOrExprNode(n)
+}
+
+func (n *LogicalOrExprNode) exprNode() {}
+
+func (n *LogicalOrExprNode) Rewrite(r Rewriter) Node {
+	n.lhs = rewriteChild(r, n.lhs)
+	n.rhs = rewriteChild(r, n.rhs)
+	return r.RewriteLogicalOrExprNode(n)
+}
+
+func (n *LogicalOrExprNode) Dump(hook func(Node, map[string]string) string) map[string]string {
 	ret := make(map[string]string)
-	ret["kind"] = "\"for_stmt\""
-	ret["init"] = DumpNode(n.Init(), hook)
-	ret["condition"] = DumpNode(n.Condition(), hook)
-	ret["update"] = DumpNode(n.Update(), hook)
-	ret["body"] = DumpNode(n.Body(), hook)
+	ret["kind"] = "\"logical_or_expr\""
+	ret["lhs"] = DumpNode(n.Lhs(), hook)
+	ret["rhs"] = DumpNode(n.Rhs(), hook)
 	return ret
 }
 
-func NewForAssignRangeStmtNode(filePath string, fileContent []rune, key Node, value Node, object Node, body Node, start, end Position) Node {
-	if key == nil {
-		key = DummyNode
-	}
-	if value == nil {
-		value = DummyNode
-	}
-	if object == nil {
-		object = DummyNode
+func NewMapTypeNode(filePath string, fileContent []rune, x Node, y Node, start, end Position) Node {
+	if x == nil {
+		x = DummyNode
 	}
-	if body == nil {
-		body = DummyNode
+	if y == nil {
+		y = DummyNode
 	}
-	_1 := &ForAssignRangeStmtNode{
-		BaseNode: NewBaseNode(filePath, fileContent, NodeTypeForAssignRangeStmt, start, end),
-		key:      key,
-		value:    value,
-		object:   object,
-		body:     body,
+	_1 := &MapTypeNode{
+		BaseNode: NewBaseNode(filePath, fileContent, NodeTypeMapType, start, end),
+		x:        x,
+		y:        y,
 	}
 	creationHook(_1)
 	return _1
 }
 
-type ForAssignRangeStmtNode struct {
+type MapTypeNode struct {
 	*BaseNode
-	key    Node
-	value  Node
-	object Node
-	body   Node
-}
-
-func (n *ForAssignRangeStmtNode) Key() Node {
-	return n.key
-}
-
-func (n *ForAssignRangeStmtNode) SetKey(v Node) {
-	n.key = v
-}
-
-func (n *ForAssignRangeStmtNode) Value() Node {
-	return n.value
-}
-
-func (n *ForAssignRangeStmtNode) SetValue(v Node) {
-	n.value = v
+	x Node
+	y Node
 }
 
-func (n *ForAssignRangeStmtNode) Object() Node {
-	return n.object
+func (n *MapTypeNode) X() Node {
+	return n.x
 }
 
-func (n *ForAssignRangeStmtNode) SetObject(v Node) {
-	n.object = v
+func (n *MapTypeNode) SetX(v Node) {
+	n.x = v
 }
 
-func (n *ForAssignRangeStmtNode) Body() Node {
-	return n.body
+func (n *MapTypeNode) Y() Node {
+	return n.y
 }
 
-func (n *ForAssignRangeStmtNode) SetBody(v Node) {
-	n.body = v
+func (n *MapTypeNode) SetY(v Node) {
+	n.y = v
 }
 
-func (n *ForAssignRangeStmtNode) BuildLink() {
-	if !n.Key().IsDummy() {
-		key := n.Key()
-		key.BuildLink()
-		key.SetParent(n)
-		key.SetSelfField("key")
-		key.SetReplaceSelf(func(n Node) {
-			n.Parent().(*ForAssignRangeStmtNode).SetKey(n)
-		})
-	}
-	if !n.Value().IsDummy() {
-		value := n.Value()
-		value.BuildLink()
-		value.SetParent(n)
-		value.SetSelfField("value")
-		value.SetReplaceSelf(func(n Node) {
-			n.Parent().(*ForAssignRangeStmtNode).SetValue(n)
+func (n *MapTypeNode) BuildLink() {
+	if !n.X().IsDummy() {
+		x := n.X()
+		x.BuildLink()
+		x.SetParent(n)
+		x.SetSelfField("x")
+		x.SetReplaceSelf(func(n Node) {
+			n.Parent().(*MapTypeNode).SetX(n)
 		})
 	}
-	if !n.Object().IsDummy() {
-		object := n.Object()
-		object.BuildLink()
-		object.SetParent(n)
-		object.SetSelfField("object")
-		object.SetReplaceSelf(func(n Node) {
-			n.Parent().(*ForAssignRangeStmtNode).SetObject(n)
-		})
-	}
-	if !n.Body().IsDummy() {
-		body := n.Body()
-		body.BuildLink()
-		body.SetParent(n)
-		body.SetSelfField("body")
-		body.SetReplaceSelf(func(n Node) {
-			n.Parent().(*ForAssignRangeStmtNode).SetBody(n)
+	if !n.Y().IsDummy() {
+		y := n.Y()
+		y.BuildLink()
+		y.SetParent(n)
+		y.SetSelfField("y")
+		y.SetReplaceSelf(func(n Node) {
+			n.Parent().(*MapTypeNode).SetY(n)
 		})
 	}
 }
 
-func (n *ForAssignRangeStmtNode) Fields() []string {
+func (n *MapTypeNode) Fields() []string {
 	return []string{
-		"key",
-		"value",
-		"object",
-		"body",
+		"x",
+		"y",
 	}
 }
 
-func (n *ForAssignRangeStmtNode) Child(field string) Node {
+func (n *MapTypeNode) Child(field string) Node {
 	if field == "" {
 		return nil
 	}
-	if field == "key" {
-		return n.Key()
-	}
-	if field == "value" {
-		return n.Value()
-	}
-	if field == "object" {
-		return n.Object()
+	if field == "x" {
+		return n.X()
 	}
-	if field == "body" {
-		return n.Body()
+	if field == "y" {
+		return n.Y()
 	}
 	return nil
 }
 
-func (n *ForAssignRangeStmtNode) SetChild(nodes []Node) {
-	if len(nodes) != 4 {
+func (n *MapTypeNode) SetChild(nodes []Node) {
+	if len(nodes) != 2 {
 		return
 	}
-	n.SetKey(nodes[0])
-	n.SetValue(nodes[1])
-	n.SetObject(nodes[2])
-	n.SetBody(nodes[3])
+	n.SetX(nodes[0])
+	n.SetY(nodes[1])
 }
 
-func (n *ForAssignRangeStmtNode) Fork() Node {
-	_ret := &ForAssignRangeStmtNode{
+func (n *MapTypeNode) Fork() Node {
+	_ret := &MapTypeNode{
 		BaseNode: n.BaseNode.fork(),
-		key:      n.key.Fork(),
-		value:    n.value.Fork(),
-		object:   n.object.Fork(),
-		body:     n.body.Fork(),
+		x:        n.x.Fork(),
+		y:        n.y.Fork(),
 	}
-	_ret.key.SetParent(_ret)
-	_ret.value.SetParent(_ret)
-	_ret.object.SetParent(_ret)
-	_ret.body.SetParent(_ret)
+	_ret.x.SetParent(_ret)
+	_ret.y.SetParent(_ret)
 	return _ret
 }
 
-func (n *ForAssignRangeStmtNode) Visit(beforeChildren func(node Node) (visitChildren, exit bool), afterChildren func(node Node) (exit bool)) (exit bool) {
+func (n *MapTypeNode) Visit(beforeChildren func(node Node) (visitChildren, exit bool), afterChildren func(node Node) (exit bool)) (exit bool) {
 	vc, e := beforeChildren(n)
 	if e {
 		return true
@@ -8974,16 +8778,10 @@ func (n *ForAssignRangeStmtNode) Visit(beforeChildren func(node Node) (visitChil
 	if !vc {
 		return false
 	}
-	if n.key.Visit(beforeChildren, afterChildren) {
-		return true
-	}
-	if n.value.Visit(beforeChildren, afterChildren) {
-		return true
-	}
-	if n.object.Visit(beforeChildren, afterChildren) {
+	if n.x.Visit(beforeChildren, afterChildren) {
 		return true
 	}
-	if n.body.Visit(beforeChildren, afterChildren) {
+	if n.y.Visit(beforeChildren, afterChildren) {
 		return true
 	}
 	if afterChildren(n) {
@@ -8992,173 +8790,96 @@ func (n *ForAssignRangeStmtNode) Visit(beforeChildren func(node Node) (visitChil
 	return false
 }
 
-func (n *ForAssignRangeStmtNode) Dump(hook func(Node, map[string]string) string) map[string]string {
+func (n *MapTypeNode) Accept(v Visitor) bool {
+	return v.VisitMapTypeNode(n)
+}
+
+func (n *MapTypeNode) typeNode() {}
+
+func (n *MapTypeNode) Rewrite(r Rewriter) Node {
+	n.x = rewriteChild(r, n.x)
+	n.y = rewriteChild(r, n.y)
+	return r.RewriteMapTypeNode(n)
+}
+
+func (n *MapTypeNode) Dump(hook func(Node, map[string]string) string) map[string]string {
 	ret := make(map[string]string)
-	ret["kind"] = "\"for_assign_range_stmt\""
-	ret["key"] = DumpNode(n.Key(), hook)
-	ret["value"] = DumpNode(n.Value(), hook)
-	ret["object"] = DumpNode(n.Object(), hook)
-	ret["body"] = DumpNode(n.Body(), hook)
+	ret["kind"] = "\"map_type\""
+	ret["x"] = DumpNode(n.X(), hook)
+	ret["y"] = DumpNode(n.Y(), hook)
 	return ret
 }
 
-func NewForDeclRangeStmtNode(filePath string, fileContent []rune, key Node, value Node, object Node, body Node, start, end Position) Node {
-	if key == nil {
-		key = DummyNode
-	}
-	if value == nil {
-		value = DummyNode
-	}
-	if object == nil {
-		object = DummyNode
-	}
-	if body == nil {
-		body = DummyNode
+func NewMethodIdentNode(filePath string, fileContent []rune, ident Node, start, end Position) Node {
+	if ident == nil {
+		ident = DummyNode
 	}
-	_1 := &ForDeclRangeStmtNode{
-		BaseNode: NewBaseNode(filePath, fileContent, NodeTypeForDeclRangeStmt, start, end),
-		key:      key,
-		value:    value,
-		object:   object,
-		body:     body,
+	_1 := &MethodIdentNode{
+		BaseNode: NewBaseNode(filePath, fileContent, NodeTypeMethodIdent, start, end),
+		ident:    ident,
 	}
 	creationHook(_1)
 	return _1
 }
 
-type ForDeclRangeStmtNode struct {
+type MethodIdentNode struct {
 	*BaseNode
-	key    Node
-	value  Node
-	object Node
-	body   Node
-}
-
-func (n *ForDeclRangeStmtNode) Key() Node {
-	return n.key
-}
-
-func (n *ForDeclRangeStmtNode) SetKey(v Node) {
-	n.key = v
-}
-
-func (n *ForDeclRangeStmtNode) Value() Node {
-	return n.value
-}
-
-func (n *ForDeclRangeStmtNode) SetValue(v Node) {
-	n.value = v
-}
-
-func (n *ForDeclRangeStmtNode) Object() Node {
-	return n.object
-}
-
-func (n *ForDeclRangeStmtNode) SetObject(v Node) {
-	n.object = v
+	ident Node
 }
 
-func (n *ForDeclRangeStmtNode) Body() Node {
-	return n.body
+func (n *MethodIdentNode) Ident() Node {
+	return n.ident
 }
 
-func (n *ForDeclRangeStmtNode) SetBody(v Node) {
-	n.body = v
+func (n *MethodIdentNode) SetIdent(v Node) {
+	n.ident = v
 }
 
-func (n *ForDeclRangeStmtNode) BuildLink() {
-	if !n.Key().IsDummy() {
-		key := n.Key()
-		key.BuildLink()
-		key.SetParent(n)
-		key.SetSelfField("key")
-		key.SetReplaceSelf(func(n Node) {
-			n.Parent().(*ForDeclRangeStmtNode).SetKey(n)
-		})
-	}
-	if !n.Value().IsDummy() {
-		value := n.Value()
-		value.BuildLink()
-		value.SetParent(n)
-		value.SetSelfField("value")
-		value.SetReplaceSelf(func(n Node) {
-			n.Parent().(*ForDeclRangeStmtNode).SetValue(n)
-		})
-	}
-	if !n.Object().IsDummy() {
-		object := n.Object()
-		object.BuildLink()
-		object.SetParent(n)
-		object.SetSelfField("object")
-		object.SetReplaceSelf(func(n Node) {
-			n.Parent().(*ForDeclRangeStmtNode).SetObject(n)
-		})
-	}
-	if !n.Body().IsDummy() {
-		body := n.Body()
-		body.BuildLink()
-		body.SetParent(n)
-		body.SetSelfField("body")
-		body.SetReplaceSelf(func(n Node) {
-			n.Parent().(*ForDeclRangeStmtNode).SetBody(n)
+func (n *MethodIdentNode) BuildLink() {
+	if !n.Ident().IsDummy() {
+		ident := n.Ident()
+		ident.BuildLink()
+		ident.SetParent(n)
+		ident.SetSelfField("ident")
+		ident.SetReplaceSelf(func(n Node) {
+			n.Parent().(*MethodIdentNode).SetIdent(n)
 		})
 	}
 }
 
-func (n *ForDeclRangeStmtNode) Fields() []string {
+func (n *MethodIdentNode) Fields() []string {
 	return []string{
-		"key",
-		"value",
-		"object",
-		"body",
+		"ident",
 	}
 }
 
-func (n *ForDeclRangeStmtNode) Child(field string) Node {
+func (n *MethodIdentNode) Child(field string) Node {
 	if field == "" {
 		return nil
 	}
-	if field == "key" {
-		return n.Key()
-	}
-	if field == "value" {
-		return n.Value()
-	}
-	if field == "object" {
-		return n.Object()
-	}
-	if field == "body" {
-		return n.Body()
+	if field == "ident" {
+		return n.Ident()
 	}
 	return nil
 }
 
-func (n *ForDeclRangeStmtNode) SetChild(nodes []Node) {
-	if len(nodes) != 4 {
+func (n *MethodIdentNode) SetChild(nodes []Node) {
+	if len(nodes) != 1 {
 		return
 	}
-	n.SetKey(nodes[0])
-	n.SetValue(nodes[1])
-	n.SetObject(nodes[2])
-	n.SetBody(nodes[3])
+	n.SetIdent(nodes[0])
 }
 
-func (n *ForDeclRangeStmtNode) Fork() Node {
-	_ret := &ForDeclRangeStmtNode{
+func (n *MethodIdentNode) Fork() Node {
+	_ret := &MethodIdentNode{
 		BaseNode: n.BaseNode.fork(),
-		key:      n.key.Fork(),
-		value:    n.value.Fork(),
-		object:   n.object.Fork(),
-		body:     n.body.Fork(),
+		ident:    n.ident.Fork(),
 	}
-	_ret.key.SetParent(_ret)
-	_ret.value.SetParent(_ret)
-	_ret.object.SetParent(_ret)
-	_ret.body.SetParent(_ret)
+	_ret.ident.SetParent(_ret)
 	return _ret
 }
 
-func (n *ForDeclRangeStmtNode) Visit(beforeChildren func(node Node) (visitChildren, exit bool), afterChildren func(node Node) (exit bool)) (exit bool) {
+func (n *MethodIdentNode) Visit(beforeChildren func(node Node) (visitChildren, exit bool), afterChildren func(node Node) (exit bool)) (exit bool) {
 	vc, e := beforeChildren(n)
 	if e {
 		return true
@@ -9166,16 +8887,7 @@ func (n *ForDeclRangeStmtNode) Visit(beforeChildren func(node Node) (visitChildr
 	if !vc {
 		return false
 	}
-	if n.key.Visit(beforeChildren, afterChildren) {
-		return true
-	}
-	if n.value.Visit(beforeChildren, afterChildren) {
-		return true
-	}
-	if n.object.Visit(beforeChildren, afterChildren) {
-		return true
-	}
-	if n.body.Visit(beforeChildren, afterChildren) {
+	if n.ident.Visit(beforeChildren, afterChildren) {
 		return true
 	}
 	if afterChildren(n) {
@@ -9184,86 +8896,150 @@ func (n *ForDeclRangeStmtNode) Visit(beforeChildren func(node Node) (visitChildr
 	return false
 }
 
-func (n *ForDeclRangeStmtNode) Dump(hook func(Node, map[string]string) string) map[string]string {
+func (n *MethodIdentNode) Accept(v Visitor) bool {
+	return v.VisitMethodIdentNode(n)
+}
+
+func (n *MethodIdentNode) Rewrite(r Rewriter) Node {
+	n.ident = rewriteChild(r, n.ident)
+	return r.RewriteMethodIdentNode(n)
+}
+
+func (n *MethodIdentNode) Dump(hook func(Node, map[string]string) string) map[string]string {
 	ret := make(map[string]string)
-	ret["kind"] = "\"for_decl_range_stmt\""
-	ret["key"] = DumpNode(n.Key(), hook)
-	ret["value"] = DumpNode(n.Value(), hook)
-	ret["object"] = DumpNode(n.Object(), hook)
-	ret["body"] = DumpNode(n.Body(), hook)
+	ret["kind"] = "\"method_ident\""
+	ret["ident"] = DumpNode(n.Ident(), hook)
 	return ret
 }
 
-func NewSelectStmtNode(filePath string, fileContent []rune, body Node, start, end Position) Node {
-	if body == nil {
-		body = DummyNode
+func NewMulOpExprNode(filePath string, fileContent []rune, lhs Node, op Node, rhs Node, start, end Position) Node {
+	if lhs == nil {
+		lhs = DummyNode
 	}
-	_1 := &SelectStmtNode{
-		BaseNode: NewBaseNode(filePath, fileContent, NodeTypeSelectStmt, start, end),
-		body:     body,
+	if op == nil {
+		op = DummyNode
+	}
+	if rhs == nil {
+		rhs = DummyNode
+	}
+	_1 := &MulOpExprNode{
+		BaseNode: NewBaseNode(filePath, fileContent, NodeTypeMulOpExpr, start, end),
+		lhs:      lhs,
+		op:       op,
+		rhs:      rhs,
 	}
 	creationHook(_1)
 	return _1
 }
 
-type SelectStmtNode struct {
+type MulOpExprNode struct {
 	*BaseNode
-	body Node
+	lhs Node
+	op  Node
+	rhs Node
 }
 
-func (n *SelectStmtNode) Body() Node {
-	return n.body
+func (n *MulOpExprNode) Lhs() Node {
+	return n.lhs
 }
 
-func (n *SelectStmtNode) SetBody(v Node) {
-	n.body = v
+func (n *MulOpExprNode) SetLhs(v Node) {
+	n.lhs = v
 }
 
-func (n *SelectStmtNode) BuildLink() {
-	if !n.Body().IsDummy() {
-		body := n.Body()
-		body.BuildLink()
-		body.SetParent(n)
-		body.SetSelfField("body")
-		body.SetReplaceSelf(func(n Node) {
-			n.Parent().(*SelectStmtNode).SetBody(n)
+func (n *MulOpExprNode) Op() Node {
+	return n.op
+}
+
+func (n *MulOpExprNode) SetOp(v Node) {
+	n.op = v
+}
+
+func (n *MulOpExprNode) Rhs() Node {
+	return n.rhs
+}
+
+func (n *MulOpExprNode) SetRhs(v Node) {
+	n.rhs = v
+}
+
+func (n *MulOpExprNode) BuildLink() {
+	if !n.Lhs().IsDummy() {
+		lhs := n.Lhs()
+		lhs.BuildLink()
+		lhs.SetParent(n)
+		lhs.SetSelfField("lhs")
+		lhs.SetReplaceSelf(func(n Node) {
+			n.Parent().(*MulOpExprNode).SetLhs(n)
+		})
+	}
+	if !n.Op().IsDummy() {
+		op := n.Op()
+		op.BuildLink()
+		op.SetParent(n)
+		op.SetSelfField("op")
+		op.SetReplaceSelf(func(n Node) {
+			n.Parent().(*MulOpExprNode).SetOp(n)
+		})
+	}
+	if !n.Rhs().IsDummy() {
+		rhs := n.Rhs()
+		rhs.BuildLink()
+		rhs.SetParent(n)
+		rhs.SetSelfField("rhs")
+		rhs.SetReplaceSelf(func(n Node) {
+			n.Parent().(*MulOpExprNode).SetRhs(n)
 		})
 	}
 }
 
-func (n *SelectStmtNode) Fields() []string {
+func (n *MulOpExprNode) Fields() []string {
 	return []string{
-		"body",
+		"lhs",
+		"op",
+		"rhs",
 	}
 }
 
-func (n *SelectStmtNode) Child(field string) Node {
+func (n *MulOpExprNode) Child(field string) Node {
 	if field == "" {
 		return nil
 	}
-	if field == "body" {
-		return n.Body()
+	if field == "lhs" {
+		return n.Lhs()
+	}
+	if field == "op" {
+		return n.Op()
+	}
+	if field == "rhs" {
+		return n.Rhs()
 	}
 	return nil
 }
 
-func (n *SelectStmtNode) SetChild(nodes []Node) {
-	if len(nodes) != 1 {
+func (n *MulOpExprNode) SetChild(nodes []Node) {
+	if len(nodes) != 3 {
 		return
 	}
-	n.SetBody(nodes[0])
+	n.SetLhs(nodes[0])
+	n.SetOp(nodes[1])
+	n.SetRhs(nodes[2])
 }
 
-func (n *SelectStmtNode) Fork() Node {
-	_ret := &SelectStmtNode{
+func (n *MulOpExprNode) Fork() Node {
+	_ret := &MulOpExprNode{
 		BaseNode: n.BaseNode.fork(),
-		body:     n.body.Fork(),
+		lhs:      n.lhs.Fork(),
+		op:       n.op.Fork(),
+		rhs:      n.rhs.Fork(),
 	}
-	_ret.body.SetParent(_ret)
+	_ret.lhs.SetParent(_ret)
+	_ret.op.SetParent(_ret)
+	_ret.rhs.SetParent(_ret)
 	return _ret
 }
 
-func (n *SelectStmtNode) Visit(beforeChildren func(node Node) (visitChildren, exit bool), afterChildren func(node Node) (exit bool)) (exit bool) {
+func (n *MulOpExprNode) Visit(beforeChildren func(node Node) (visitChildren, exit bool), afterChildren func(node Node) (exit bool)) (exit bool) {
 	vc, e := beforeChildren(n)
 	if e {
 		return true
@@ -9271,7 +9047,13 @@ func (n *SelectStmtNode) Visit(beforeChildren func(node Node) (visitChildren, ex
 	if !vc {
 		return false
 	}
-	if n.body.Visit(beforeChildren, afterChildren) {
+	if n.lhs.Visit(beforeChildren, afterChildren) {
+		return true
+	}
+	if n.op.Visit(beforeChildren, afterChildren) {
+		return true
+	}
+	if n.rhs.Visit(beforeChildren, afterChildren) {
 		return true
 	}
 	if afterChildren(n) {
@@ -9280,141 +9062,98 @@ func (n *SelectStmtNode) Visit(beforeChildren func(node Node) (visitChildren, ex
 	return false
 }
 
-func (n *SelectStmtNode) Dump(hook func(Node, map[string]string) string) map[string]string {
+func (n *MulOpExprNode) Accept(v Visitor) bool {
+	return v.VisitMulOpExprNode(n)
+}
+
+func (n *MulOpExprNode) exprNode() {}
+
+func (n *MulOpExprNode) Rewrite(r Rewriter) Node {
+	n.lhs = rewriteChild(r, n.lhs)
+	n.op = rewriteChild(r, n.op)
+	n.rhs = rewriteChild(r, n.rhs)
+	return r.RewriteMulOpExprNode(n)
+}
+
+func (n *MulOpExprNode) Dump(hook func(Node, map[string]string) string) map[string]string {
 	ret := make(map[string]string)
-	ret["kind"] = "\"select_stmt\""
-	ret["body"] = DumpNode(n.Body(), hook)
+	ret["kind"] = "\"mul_op_expr\""
+	ret["lhs"] = DumpNode(n.Lhs(), hook)
+	ret["op"] = DumpNode(n.Op(), hook)
+	ret["rhs"] = DumpNode(n.Rhs(), hook)
 	return ret
 }
 
-func NewSwitchStmtNode(filePath string, fileContent []rune, init Node, expression Node, body Node, start, end Position) Node {
-	if init == nil {
-		init = DummyNode
-	}
-	if expression == nil {
-		expression = DummyNode
-	}
-	if body == nil {
-		body = DummyNode
+func NewNameParameterNode(filePath string, fileContent []rune, name Node, start, end Position) Node {
+	if name == nil {
+		name = DummyNode
 	}
-	_1 := &SwitchStmtNode{
-		BaseNode:   NewBaseNode(filePath, fileContent, NodeTypeSwitchStmt, start, end),
-		init:       init,
-		expression: expression,
-		body:       body,
+	_1 := &NameParameterNode{
+		BaseNode: NewBaseNode(filePath, fileContent, NodeTypeNameParameter, start, end),
+		name:     name,
 	}
 	creationHook(_1)
 	return _1
 }
 
-type SwitchStmtNode struct {
+type NameParameterNode struct {
 	*BaseNode
-	init       Node
-	expression Node
-	body       Node
-}
-
-func (n *SwitchStmtNode) Init() Node {
-	return n.init
-}
-
-func (n *SwitchStmtNode) SetInit(v Node) {
-	n.init = v
+	name Node
 }
 
-func (n *SwitchStmtNode) Expression() Node {
-	return n.expression
-}
-
-func (n *SwitchStmtNode) SetExpression(v Node) {
-	n.expression = v
-}
-
-func (n *SwitchStmtNode) Body() Node {
-	return n.body
+func (n *NameParameterNode) Name() Node {
+	return n.name
 }
 
-func (n *SwitchStmtNode) SetBody(v Node) {
-	n.body = v
+func (n *NameParameterNode) SetName(v Node) {
+	n.name = v
 }
 
-func (n *SwitchStmtNode) BuildLink() {
-	if !n.Init().IsDummy() {
-		init := n.Init()
-		init.BuildLink()
-		init.SetParent(n)
-		init.SetSelfField("init")
-		init.SetReplaceSelf(func(n Node) {
-			n.Parent().(*SwitchStmtNode).SetInit(n)
-		})
-	}
-	if !n.Expression().IsDummy() {
-		expression := n.Expression()
-		expression.BuildLink()
-		expression.SetParent(n)
-		expression.SetSelfField("expression")
-		expression.SetReplaceSelf(func(n Node) {
-			n.Parent().(*SwitchStmtNode).SetExpression(n)
-		})
-	}
-	if !n.Body().IsDummy() {
-		body := n.Body()
-		body.BuildLink()
-		body.SetParent(n)
-		body.SetSelfField("body")
-		body.SetReplaceSelf(func(n Node) {
-			n.Parent().(*SwitchStmtNode).SetBody(n)
+func (n *NameParameterNode) BuildLink() {
+	if !n.Name().IsDummy() {
+		name := n.Name()
+		name.BuildLink()
+		name.SetParent(n)
+		name.SetSelfField("name")
+		name.SetReplaceSelf(func(n Node) {
+			n.Parent().(*NameParameterNode).SetName(n)
 		})
 	}
 }
 
-func (n *SwitchStmtNode) Fields() []string {
+func (n *NameParameterNode) Fields() []string {
 	return []string{
-		"init",
-		"expression",
-		"body",
+		"name",
 	}
 }
 
-func (n *SwitchStmtNode) Child(field string) Node {
+func (n *NameParameterNode) Child(field string) Node {
 	if field == "" {
 		return nil
 	}
-	if field == "init" {
-		return n.Init()
-	}
-	if field == "expression" {
-		return n.Expression()
-	}
-	if field == "body" {
-		return n.Body()
+	if field == "name" {
+		return n.Name()
 	}
 	return nil
 }
 
-func (n *SwitchStmtNode) SetChild(nodes []Node) {
-	if len(nodes) != 3 {
+func (n *NameParameterNode) SetChild(nodes []Node) {
+	if len(nodes) != 1 {
 		return
 	}
-	n.SetInit(nodes[0])
-	n.SetExpression(nodes[1])
-	n.SetBody(nodes[2])
+	n.SetName(nodes[0])
 }
 
-func (n *SwitchStmtNode) Fork() Node {
-	_ret := &SwitchStmtNode{
-		BaseNode:   n.BaseNode.fork(),
-		init:       n.init.Fork(),
-		expression: n.expression.Fork(),
-		body:       n.body.Fork(),
+func (n *NameParameterNode) Fork() Node {
+	_ret := &NameParameterNode{
+		BaseNode: n.BaseNode.fork(),
+		name:     n.name.Fork(),
 	}
-	_ret.init.SetParent(_ret)
-	_ret.expression.SetParent(_ret)
-	_ret.body.SetParent(_ret)
+	_ret.name.SetParent(_ret)
 	return _ret
 }
 
-func (n *SwitchStmtNode) Visit(beforeChildren func(node Node) (visitChildren, exit bool), afterChildren func(node Node) (exit bool)) (exit bool) {
+func (n *NameParameterNode) Visit(beforeChildren func(node Node) (visitChildren, exit bool), afterChildren func(node Node) (exit bool)) (exit bool) {
 	vc, e := beforeChildren(n)
 	if e {
 		return true
@@ -9422,13 +9161,7 @@ func (n *SwitchStmtNode) Visit(beforeChildren func(node Node) (visitChildren, ex
 	if !vc {
 		return false
 	}
-	if n.init.Visit(beforeChildren, afterChildren) {
-		return true
-	}
-	if n.expression.Visit(beforeChildren, afterChildren) {
-		return true
-	}
-	if n.body.Visit(beforeChildren, afterChildren) {
+	if n.name.Visit(beforeChildren, afterChildren) {
 		return true
 	}
 	if afterChildren(n) {
@@ -9437,143 +9170,121 @@ func (n *SwitchStmtNode) Visit(beforeChildren func(node Node) (visitChildren, ex
 	return false
 }
 
-func (n *SwitchStmtNode) Dump(hook func(Node, map[string]string) string) map[string]string {
+func (n *NameParameterNode) Accept(v Visitor) bool {
+	return v.VisitNameParameterNode(n)
+}
+
+func (n *NameParameterNode) Rewrite(r Rewriter) Node {
+	n.name = rewriteChild(r, n.name)
+	return r.RewriteNameParameterNode(n)
+}
+
+func (n *NameParameterNode) Dump(hook func(Node, map[string]string) string) map[string]string {
 	ret := make(map[string]string)
-	ret["kind"] = "\"switch_stmt\""
-	ret["init"] = DumpNode(n.Init(), hook)
-	ret["expression"] = DumpNode(n.Expression(), hook)
-	ret["body"] = DumpNode(n.Body(), hook)
+	ret["kind"] = "\"name_parameter\""
+	ret["name"] = DumpNode(n.Name(), hook)
 	return ret
 }
 
-func NewTypeSwitchStmtNode(filePath string, fileContent []rune, init Node, guard Node, body Node, start, end Position) Node {
-	if init == nil {
-		init = DummyNode
-	}
-	if guard == nil {
-		guard = DummyNode
+func NewNameTypeParameterNode(filePath string, fileContent []rune, name Node, type_ Node, start, end Position) Node {
+	if name == nil {
+		name = DummyNode
 	}
-	if body == nil {
-		body = DummyNode
+	if type_ == nil {
+		type_ = DummyNode
 	}
-	_1 := &TypeSwitchStmtNode{
-		BaseNode: NewBaseNode(filePath, fileContent, NodeTypeTypeSwitchStmt, start, end),
-		init:     init,
-		guard:    guard,
-		body:     body,
+	_1 := &NameTypeParameterNode{
+		BaseNode: NewBaseNode(filePath, fileContent, NodeTypeNameTypeParameter, start, end),
+		name:     name,
+		type_:    type_,
 	}
 	creationHook(_1)
 	return _1
 }
 
-type TypeSwitchStmtNode struct {
+type NameTypeParameterNode struct {
 	*BaseNode
-	init  Node
-	guard Node
-	body  Node
-}
-
-func (n *TypeSwitchStmtNode) Init() Node {
-	return n.init
-}
-
-func (n *TypeSwitchStmtNode) SetInit(v Node) {
-	n.init = v
+	name  Node
+	type_ Node
 }
 
-func (n *TypeSwitchStmtNode) Guard() Node {
-	return n.guard
+func (n *NameTypeParameterNode) Name() Node {
+	return n.name
 }
 
-func (n *TypeSwitchStmtNode) SetGuard(v Node) {
-	n.guard = v
+func (n *NameTypeParameterNode) SetName(v Node) {
+	n.name = v
 }
 
-func (n *TypeSwitchStmtNode) Body() Node {
-	return n.body
+func (n *NameTypeParameterNode) Type() Node {
+	return n.type_
 }
 
-func (n *TypeSwitchStmtNode) SetBody(v Node) {
-	n.body = v
+func (n *NameTypeParameterNode) SetType(v Node) {
+	n.type_ = v
 }
 
-func (n *TypeSwitchStmtNode) BuildLink() {
-	if !n.Init().IsDummy() {
-		init := n.Init()
-		init.BuildLink()
-		init.SetParent(n)
-		init.SetSelfField("init")
-		init.SetReplaceSelf(func(n Node) {
-			n.Parent().(*TypeSwitchStmtNode).SetInit(n)
-		})
-	}
-	if !n.Guard().IsDummy() {
-		guard := n.Guard()
-		guard.BuildLink()
-		guard.SetParent(n)
-		guard.SetSelfField("guard")
-		guard.SetReplaceSelf(func(n Node) {
-			n.Parent().(*TypeSwitchStmtNode).SetGuard(n)
+func (n *NameTypeParameterNode) BuildLink() {
+	if !n.Name().IsDummy() {
+		name := n.Name()
+		name.BuildLink()
+		name.SetParent(n)
+		name.SetSelfField("name")
+		name.SetReplaceSelf(func(n Node) {
+			n.Parent().(*NameTypeParameterNode).SetName(n)
 		})
 	}
-	if !n.Body().IsDummy() {
-		body := n.Body()
-		body.BuildLink()
-		body.SetParent(n)
-		body.SetSelfField("body")
-		body.SetReplaceSelf(func(n Node) {
-			n.Parent().(*TypeSwitchStmtNode).SetBody(n)
+	if !n.Type().IsDummy() {
+		type_ := n.Type()
+		type_.BuildLink()
+		type_.SetParent(n)
+		type_.SetSelfField("type_")
+		type_.SetReplaceSelf(func(n Node) {
+			n.Parent().(*NameTypeParameterNode).SetType(n)
 		})
 	}
 }
 
-func (n *TypeSwitchStmtNode) Fields() []string {
+func (n *NameTypeParameterNode) Fields() []string {
 	return []string{
-		"init",
-		"guard",
-		"body",
+		"name",
+		"type_",
 	}
 }
 
-func (n *TypeSwitchStmtNode) Child(field string) Node {
+func (n *NameTypeParameterNode) Child(field string) Node {
 	if field == "" {
 		return nil
 	}
-	if field == "init" {
-		return n.Init()
-	}
-	if field == "guard" {
-		return n.Guard()
+	if field == "name" {
+		return n.Name()
 	}
-	if field == "body" {
-		return n.Body()
+	if field == "type_" {
+		return n.Type()
 	}
 	return nil
 }
 
-func (n *TypeSwitchStmtNode) SetChild(nodes []Node) {
-	if len(nodes) != 3 {
+func (n *NameTypeParameterNode) SetChild(nodes []Node) {
+	if len(nodes) != 2 {
 		return
 	}
-	n.SetInit(nodes[0])
-	n.SetGuard(nodes[1])
-	n.SetBody(nodes[2])
+	n.SetName(nodes[0])
+	n.SetType(nodes[1])
 }
 
-func (n *TypeSwitchStmtNode) Fork() Node {
-	_ret := &TypeSwitchStmtNode{
+func (n *NameTypeParameterNode) Fork() Node {
+	_ret := &NameTypeParameterNode{
 		BaseNode: n.BaseNode.fork(),
-		init:     n.init.Fork(),
-		guard:    n.guard.Fork(),
-		body:     n.body.Fork(),
+		name:     n.name.Fork(),
+		type_:    n.type_.Fork(),
 	}
-	_ret.init.SetParent(_ret)
-	_ret.guard.SetParent(_ret)
-	_ret.body.SetParent(_ret)
+	_ret.name.SetParent(_ret)
+	_ret.type_.SetParent(_ret)
 	return _ret
 }
 
-func (n *TypeSwitchStmtNode) Visit(beforeChildren func(node Node) (visitChildren, exit bool), afterChildren func(node Node) (exit bool)) (exit bool) {
+func (n *NameTypeParameterNode) Visit(beforeChildren func(node Node) (visitChildren, exit bool), afterChildren func(node Node) (exit bool)) (exit bool) {
 	vc, e := beforeChildren(n)
 	if e {
 		return true
@@ -9581,13 +9292,10 @@ func (n *TypeSwitchStmtNode) Visit(beforeChildren func(node Node) (visitChildren
 	if !vc {
 		return false
 	}
-	if n.init.Visit(beforeChildren, afterChildren) {
-		return true
-	}
-	if n.guard.Visit(beforeChildren, afterChildren) {
+	if n.name.Visit(beforeChildren, afterChildren) {
 		return true
 	}
-	if n.body.Visit(beforeChildren, afterChildren) {
+	if n.type_.Visit(beforeChildren, afterChildren) {
 		return true
 	}
 	if afterChildren(n) {
@@ -9596,114 +9304,94 @@ func (n *TypeSwitchStmtNode) Visit(beforeChildren func(node Node) (visitChildren
 	return false
 }
 
-func (n *TypeSwitchStmtNode) Dump(hook func(Node, map[string]string) string) map[string]string {
+func (n *NameTypeParameterNode) Accept(v Visitor) bool {
+	return v.VisitNameTypeParameterNode(n)
+}
+
+func (n *NameTypeParameterNode) Rewrite(r Rewriter) Node {
+	n.name = rewriteChild(r, n.name)
+	n.type_ = rewriteChild(r, n.type_)
+	return r.RewriteNameTypeParameterNode(n)
+}
+
+func (n *NameTypeParameterNode) Dump(hook func(Node, map[string]string) string) map[string]string {
 	ret := make(map[string]string)
-	ret["kind"] = "\"type_switch_stmt\""
-	ret["init"] = DumpNode(n.Init(), hook)
-	ret["guard"] = DumpNode(n.Guard(), hook)
-	ret["body"] = DumpNode(n.Body(), hook)
+	ret["kind"] = "\"name_type_parameter\""
+	ret["name"] = DumpNode(n.Name(), hook)
+	ret["type"] = DumpNode(n.Type(), hook)
 	return ret
 }
 
-func NewTypeSwitchGuardNode(filePath string, fileContent []rune, name Node, expression Node, start, end Position) Node {
-	if name == nil {
-		name = DummyNode
-	}
-	if expression == nil {
-		expression = DummyNode
+func NewNewExprNode(filePath string, fileContent []rune, type_ Node, start, end Position) Node {
+	if type_ == nil {
+		type_ = DummyNode
 	}
-	_1 := &TypeSwitchGuardNode{
-		BaseNode:   NewBaseNode(filePath, fileContent, NodeTypeTypeSwitchGuard, start, end),
-		name:       name,
-		expression: expression,
+	_1 := &NewExprNode{
+		BaseNode: NewBaseNode(filePath, fileContent, NodeTypeNewExpr, start, end),
+		type_:    type_,
 	}
 	creationHook(_1)
 	return _1
 }
 
-type TypeSwitchGuardNode struct {
+type NewExprNode struct {
 	*BaseNode
-	name       Node
-	expression Node
-}
-
-func (n *TypeSwitchGuardNode) Name() Node {
-	return n.name
-}
-
-func (n *TypeSwitchGuardNode) SetName(v Node) {
-	n.name = v
+	type_ Node
 }
 
-func (n *TypeSwitchGuardNode) Expression() Node {
-	return n.expression
+func (n *NewExprNode) Type() Node {
+	return n.type_
 }
 
-func (n *TypeSwitchGuardNode) SetExpression(v Node) {
-	n.expression = v
+func (n *NewExprNode) SetType(v Node) {
+	n.type_ = v
 }
 
-func (n *TypeSwitchGuardNode) BuildLink() {
-	if !n.Name().IsDummy() {
-		name := n.Name()
-		name.BuildLink()
-		name.SetParent(n)
-		name.SetSelfField("name")
-		name.SetReplaceSelf(func(n Node) {
-			n.Parent().(*TypeSwitchGuardNode).SetName(n)
-		})
-	}
-	if !n.Expression().IsDummy() {
-		expression := n.Expression()
-		expression.BuildLink()
-		expression.SetParent(n)
-		expression.SetSelfField("expression")
-		expression.SetReplaceSelf(func(n Node) {
-			n.Parent().(*TypeSwitchGuardNode).SetExpression(n)
+func (n *NewExprNode) BuildLink() {
+	if !n.Type().IsDummy() {
+		type_ := n.Type()
+		type_.BuildLink()
+		type_.SetParent(n)
+		type_.SetSelfField("type_")
+		type_.SetReplaceSelf(func(n Node) {
+			n.Parent().(*NewExprNode).SetType(n)
 		})
 	}
 }
 
-func (n *TypeSwitchGuardNode) Fields() []string {
+func (n *NewExprNode) Fields() []string {
 	return []string{
-		"name",
-		"expression",
+		"type_",
 	}
 }
 
-func (n *TypeSwitchGuardNode) Child(field string) Node {
+func (n *NewExprNode) Child(field string) Node {
 	if field == "" {
 		return nil
 	}
-	if field == "name" {
-		return n.Name()
-	}
-	if field == "expression" {
-		return n.Expression()
+	if field == "type_" {
+		return n.Type()
 	}
 	return nil
 }
 
-func (n *TypeSwitchGuardNode) SetChild(nodes []Node) {
-	if len(nodes) != 2 {
+func (n *NewExprNode) SetChild(nodes []Node) {
+	if len(nodes) != 1 {
 		return
 	}
-	n.SetName(nodes[0])
-	n.SetExpression(nodes[1])
+	n.SetType(nodes[0])
 }
 
-func (n *TypeSwitchGuardNode) Fork() Node {
-	_ret := &TypeSwitchGuardNode{
-		BaseNode:   n.BaseNode.fork(),
-		name:       n.name.Fork(),
-		expression: n.expression.Fork(),
+func (n *NewExprNode) Fork() Node {
+	_ret := &NewExprNode{
+		BaseNode: n.BaseNode.fork(),
+		type_:    n.type_.Fork(),
 	}
-	_ret.name.SetParent(_ret)
-	_ret.expression.SetParent(_ret)
+	_ret.type_.SetParent(_ret)
 	return _ret
 }
 
-func (n *TypeSwitchGuardNode) Visit(beforeChildren func(node Node) (visitChildren, exit bool), afterChildren func(node Node) (exit bool)) (exit bool) {
+func (n *NewExprNode) Visit(beforeChildren func(node Node) (visitChildren, exit bool), afterChildren func(node Node) (exit bool)) (exit bool) {
 	vc, e := beforeChildren(n)
 	if e {
 		return true
@@ -9711,10 +9399,7 @@ func (n *TypeSwitchGuardNode) Visit(beforeChildren func(node Node) (visitChildre
 	if !vc {
 		return false
 	}
-	if n.name.Visit(beforeChildren, afterChildren) {
-		return true
-	}
-	if n.expression.Visit(beforeChildren, afterChildren) {
+	if n.type_.Visit(beforeChildren, afterChildren) {
 		return true
 	}
 	if afterChildren(n) {
@@ -9723,84 +9408,94 @@ func (n *TypeSwitchGuardNode) Visit(beforeChildren func(node Node) (visitChildre
 	return false
 }
 
-func (n *TypeSwitchGuardNode) Dump(hook func(Node, map[string]string) string) map[string]string {
+func (n *NewExprNode) Accept(v Visitor) bool {
+	return v.VisitNewExprNode(n)
+}
+
+func (n *NewExprNode) exprNode() {}
+
+func (n *NewExprNode) Rewrite(r Rewriter) Node {
+	n.type_ = rewriteChild(r, n.type_)
+	return r.RewriteNewExprNode(n)
+}
+
+func (n *NewExprNode) Dump(hook func(Node, map[string]string) string) map[string]string {
 	ret := make(map[string]string)
-	ret["kind"] = "\"type_switch_guard\""
-	ret["name"] = DumpNode(n.Name(), hook)
-	ret["expression"] = DumpNode(n.Expression(), hook)
+	ret["kind"] = "\"new_expr\""
+	ret["type"] = DumpNode(n.Type(), hook)
 	return ret
 }
 
-func NewDefaultClauseNode(filePath string, fileContent []rune, body Node, start, end Position) Node {
-	if body == nil {
-		body = DummyNode
+func NewNumberExprNode(filePath string, fileContent []rune, number Node, start, end Position) Node {
+	if number == nil {
+		number = DummyNode
 	}
-	_1 := &DefaultClauseNode{
-		BaseNode: NewBaseNode(filePath, fileContent, NodeTypeDefaultClause, start, end),
-		body:     body,
+	_1 := &NumberExprNode{
+		BaseNode: NewBaseNode(filePath, fileContent, NodeTypeNumberExpr, start, end),
+		number:   number,
 	}
 	creationHook(_1)
 	return _1
 }
 
-type DefaultClauseNode struct {
+type NumberExprNode struct {
 	*BaseNode
-	body Node
+	number Node
 }
 
-func (n *DefaultClauseNode) Body() Node {
-	return n.body
+func (n *NumberExprNode) Number() Node {
+	return n.number
 }
 
-func (n *DefaultClauseNode) SetBody(v Node) {
-	n.body = v
+func (n *NumberExprNode) SetNumber(v Node) {
+	n.number = v
 }
 
-func (n *DefaultClauseNode) BuildLink() {
-	if !n.Body().IsDummy() {
-		body := n.Body()
-		body.BuildLink()
-		body.SetParent(n)
-		body.SetSelfField("body")
-		body.SetReplaceSelf(func(n Node) {
-			n.Parent().(*DefaultClauseNode).SetBody(n)
+func (n *NumberExprNode) BuildLink() {
+	if !n.Number().IsDummy() {
+		number := n.Number()
+		number.BuildLink()
+		number.SetParent(n)
+		number.SetSelfField("number")
+		number.SetReplaceSelf(func(n Node) {
+			n.Parent().(*NumberExprNode).SetNumber(n)
 		})
 	}
 }
 
-func (n *DefaultClauseNode) Fields() []string {
+func (n *NumberExprNode) Fields() []string {
 	return []string{
-		"body",
+		"number",
 	}
 }
 
-func (n *DefaultClauseNode) Child(field string) Node {
+func (n *NumberExprNode) Child(field string) Node {
 	if field == "" {
 		return nil
 	}
-	if field == "body" {
-		return n.Body()
+	if field == "number" {
+		return n.Number()
 	}
 	return nil
 }
 
-func (n *DefaultClauseNode) SetChild(nodes []Node) {
+func (n *NumberExprNode) SetChild(nodes []Node) {
 	if len(nodes) != 1 {
 		return
 	}
-	n.SetBody(nodes[0])
+	n.SetNumber(nodes[0])
 }
 
-func (n *DefaultClauseNode) Fork() Node {
-	_ret := &DefaultClauseNode{
+func (n *NumberExprNode) Fork() Node {
+	_ret := &NumberExprNode{
 		BaseNode: n.BaseNode.fork(),
-		body:     n.body.Fork(),
+		number:   n.number.Fork(),
 	}
-	_ret.body.SetParent(_ret)
+	_ret.number.SetParent(_ret)
 	return _ret
 }
 
-func (n *DefaultClauseNode) Visit(beforeChildren func(node Node) (visitChildren, exit bool), afterChildren func(node Node) (exit bool)) (exit bool) {
+func (n *NumberExprNode) Visit(beforeChildren func(node Node) (visitChildren, exit bool), afterChildren func(node Node) (exit bool)) (exit bool) {
 	vc, e := beforeChildren(n)
 	if e {
 		return true
@@ -9808,7 +9503,7 @@ func (n *DefaultClauseNode) Visit(beforeChildren func(node Node) (visitChildren,
 	if !vc {
 		return false
 	}
-	if n.body.Visit(beforeChildren, afterChildren) {
+	if n.number.Visit(beforeChildren, afterChildren) {
 		return true
 	}
 	if afterChildren(n) {
@@ -9817,112 +9512,94 @@ func (n *DefaultClauseNode) Visit(beforeChildren func(node Node) (visitChildren,
 	return false
 }
 
-func (n *DefaultClauseNode) Dump(hook func(Node, map[string]string) string) map[string]string {
+func (n *NumberExprNode) Accept(v Visitor) bool {
+	return v.VisitNumberExprNode(n)
+}
+
+func (n *NumberExprNode) exprNode() {}
+
+func (n *NumberExprNode) Rewrite(r Rewriter) Node {
+	n.number = rewriteChild(r, n.number)
+	return r.RewriteNumberExprNode(n)
+}
+
+func (n *NumberExprNode) Dump(hook func(Node, map[string]string) string) map[string]string {
 	ret := make(map[string]string)
-	ret["kind"] = "\"default_clause\""
-	ret["body"] = DumpNode(n.Body(), hook)
+	ret["kind"] = "\"number_expr\""
+	ret["number"] = DumpNode(n.Number(), hook)
 	return ret
 }
 
-func NewExprCaseClauseNode(filePath string, fileContent []rune, condition Node, body Node, start, end Position) Node {
-	if condition == nil {
-		condition = DummyNode
-	}
-	if body == nil {
-		body = DummyNode
+func NewPackageDeclNode(filePath string, fileContent []rune, ident Node, start, end Position) Node {
+	if ident == nil {
+		ident = DummyNode
 	}
-	_1 := &ExprCaseClauseNode{
-		BaseNode:  NewBaseNode(filePath, fileContent, NodeTypeExprCaseClause, start, end),
-		condition: condition,
-		body:      body,
+	_1 := &PackageDeclNode{
+		BaseNode: NewBaseNode(filePath, fileContent, NodeTypePackageDecl, start, end),
+		ident:    ident,
 	}
 	creationHook(_1)
 	return _1
 }
 
-type ExprCaseClauseNode struct {
+type PackageDeclNode struct {
 	*BaseNode
-	condition Node
-	body      Node
-}
-
-func (n *ExprCaseClauseNode) Condition() Node {
-	return n.condition
-}
-
-func (n *ExprCaseClauseNode) SetCondition(v Node) {
-	n.condition = v
+	ident Node
 }
 
-func (n *ExprCaseClauseNode) Body() Node {
-	return n.body
+func (n *PackageDeclNode) Ident() Node {
+	return n.ident
 }
 
-func (n *ExprCaseClauseNode) SetBody(v Node) {
-	n.body = v
+func (n *PackageDeclNode) SetIdent(v Node) {
+	n.ident = v
 }
 
-func (n *ExprCaseClauseNode) BuildLink() {
-	if !n.Condition().IsDummy() {
-		condition := n.Condition()
-		condition.BuildLink()
-		condition.SetParent(n)
-		condition.SetSelfField("condition")
-		condition.SetReplaceSelf(func(n Node) {
-			n.Parent().(*ExprCaseClauseNode).SetCondition(n)
-		})
-	}
-	if !n.Body().IsDummy() {
-		body := n.Body()
-		body.BuildLink()
-		body.SetParent(n)
-		body.SetSelfField("body")
-		body.SetReplaceSelf(func(n Node) {
-			n.Parent().(*ExprCaseClauseNode).SetBody(n)
+func (n *PackageDeclNode) BuildLink() {
+	if !n.Ident().IsDummy() {
+		ident := n.Ident()
+		ident.BuildLink()
+		ident.SetParent(n)
+		ident.SetSelfField("ident")
+		ident.SetReplaceSelf(func(n Node) {
+			n.Parent().(*PackageDeclNode).SetIdent(n)
 		})
 	}
 }
 
-func (n *ExprCaseClauseNode) Fields() []string {
+func (n *PackageDeclNode) Fields() []string {
 	return []string{
-		"condition",
-		"body",
+		"ident",
 	}
 }
 
-func (n *ExprCaseClauseNode) Child(field string) Node {
+func (n *PackageDeclNode) Child(field string) Node {
 	if field == "" {
 		return nil
 	}
-	if field == "condition" {
-		return n.Condition()
-	}
-	if field == "body" {
-		return n.Body()
+	if field == "ident" {
+		return n.Ident()
 	}
 	return nil
 }
 
-func (n *ExprCaseClauseNode) SetChild(nodes []Node) {
-	if len(nodes) != 2 {
+func (n *PackageDeclNode) SetChild(nodes []Node) {
+	if len(nodes) != 1 {
 		return
 	}
-	n.SetCondition(nodes[0])
-	n.SetBody(nodes[1])
+	n.SetIdent(nodes[0])
 }
 
-func (n *ExprCaseClauseNode) Fork() Node {
-	_ret := &ExprCaseClauseNode{
-		BaseNode:  n.BaseNode.fork(),
-		condition: n.condition.Fork(),
-		body:      n.body.Fork(),
+func (n *PackageDeclNode) Fork() Node {
+	_ret := &PackageDeclNode{
+		BaseNode: n.BaseNode.fork(),
+		ident:    n.ident.Fork(),
 	}
-	_ret.condition.SetParent(_ret)
-	_ret.body.SetParent(_ret)
+	_ret.ident.SetParent(_ret)
 	return _ret
 }
 
-func (n *ExprCaseClauseNode) Visit(beforeChildren func(node Node) (visitChildren, exit bool), afterChildren func(node Node) (exit bool)) (exit bool) {
+func (n *PackageDeclNode) Visit(beforeChildren func(node Node) (visitChildren, exit bool), afterChildren func(node Node) (exit bool)) (exit bool) {
 	vc, e := beforeChildren(n)
 	if e {
 		return true
@@ -9930,10 +9607,7 @@ func (n *ExprCaseClauseNode) Visit(beforeChildren func(node Node) (visitChildren
 	if !vc {
 		return false
 	}
-	if n.condition.Visit(beforeChildren, afterChildren) {
-		return true
-	}
-	if n.body.Visit(beforeChildren, afterChildren) {
+	if n.ident.Visit(beforeChildren, afterChildren) {
 		return true
 	}
 	if afterChildren(n) {
@@ -9942,113 +9616,92 @@ func (n *ExprCaseClauseNode) Visit(beforeChildren func(node Node) (visitChildren
 	return false
 }
 
-func (n *ExprCaseClauseNode) Dump(hook func(Node, map[string]string) string) map[string]string {
+func (n *PackageDeclNode) Accept(v Visitor) bool {
+	return v.VisitPackageDeclNode(n)
+}
+
+func (n *PackageDeclNode) Rewrite(r Rewriter) Node {
+	n.ident = rewriteChild(r, n.ident)
+	return r.RewritePackageDeclNode(n)
+}
+
+func (n *PackageDeclNode) Dump(hook func(Node, map[string]string) string) map[string]string {
 	ret := make(map[string]string)
-	ret["kind"] = "\"expr_case_clause\""
-	ret["condition"] = DumpNode(n.Condition(), hook)
-	ret["body"] = DumpNode(n.Body(), hook)
+	ret["kind"] = "\"package_decl\""
+	ret["ident"] = DumpNode(n.Ident(), hook)
 	return ret
 }
 
-func NewSelectCaseClauseNode(filePath string, fileContent []rune, condition Node, body Node, start, end Position) Node {
-	if condition == nil {
-		condition = DummyNode
-	}
-	if body == nil {
-		body = DummyNode
+func NewPackageIdentNode(filePath string, fileContent []rune, ident Node, start, end Position) Node {
+	if ident == nil {
+		ident = DummyNode
 	}
-	_1 := &SelectCaseClauseNode{
-		BaseNode:  NewBaseNode(filePath, fileContent, NodeTypeSelectCaseClause, start, end),
-		condition: condition,
-		body:      body,
+	_1 := &PackageIdentNode{
+		BaseNode: NewBaseNode(filePath, fileContent, NodeTypePackageIdent, start, end),
+		ident:    ident,
 	}
 	creationHook(_1)
 	return _1
 }
 
-type SelectCaseClauseNode struct {
+type PackageIdentNode struct {
 	*BaseNode
-	condition Node
-	body      Node
-}
-
-func (n *SelectCaseClauseNode) Condition() Node {
-	return n.condition
-}
-
-func (n *SelectCaseClauseNode) SetCondition(v Node) {
-	n.condition = v
+	ident Node
 }
 
-func (n *SelectCaseClauseNode) Body() Node {
-	return n.body
+func (n *PackageIdentNode) Ident() Node {
+	return n.ident
 }
 
-func (n *SelectCaseClauseNode) SetBody(v Node) {
-	n.body = v
+func (n *PackageIdentNode) SetIdent(v Node) {
+	n.ident = v
 }
 
-func (n *SelectCaseClauseNode) BuildLink() {
-	if !n.Condition().IsDummy() {
-		condition := n.Condition()
-		condition.BuildLink()
-		condition.SetParent(n)
-		condition.SetSelfField("condition")
-		condition.SetReplaceSelf(func(n Node) {
-			n.Parent().(*SelectCaseClauseNode).SetCondition(n)
-		})
-	}
-	if !n.Body().IsDummy() {
-		body := n.Body()
-		body.BuildLink()
-		body.SetParent(n)
-		body.SetSelfField("body")
-		body.SetReplaceSelf(func(n Node) {
-			n.Parent().(*SelectCaseClauseNode).SetBody(n)
+func (n *PackageIdentNode) BuildLink() {
+	if !n.Ident().IsDummy() {
+		ident := n.Ident()
+		ident.BuildLink()
+		ident.SetParent(n)
+		ident.SetSelfField("ident")
+		ident.SetReplaceSelf(func(n Node) {
+			n.Parent().(*PackageIdentNode).SetIdent(n)
 		})
 	}
 }
 
-func (n *SelectCaseClauseNode) Fields() []string {
+func (n *PackageIdentNode) Fields() []string {
 	return []string{
-		"condition",
-		"body",
+		"ident",
 	}
 }
 
-func (n *SelectCaseClauseNode) Child(field string) Node {
+func (n *PackageIdentNode) Child(field string) Node {
 	if field == "" {
 		return nil
 	}
-	if field == "condition" {
-		return n.Condition()
-	}
-	if field == "body" {
-		return n.Body()
+	if field == "ident" {
+		return n.Ident()
 	}
 	return nil
 }
 
-func (n *SelectCaseClauseNode) SetChild(nodes []Node) {
-	if len(nodes) != 2 {
+func (n *PackageIdentNode) SetChild(nodes []Node) {
+	if len(nodes) != 1 {
 		return
 	}
-	n.SetCondition(nodes[0])
-	n.SetBody(nodes[1])
+	n.SetIdent(nodes[0])
 }
 
-func (n *SelectCaseClauseNode) Fork() Node {
-	_ret := &SelectCaseClauseNode{
-		BaseNode:  n.BaseNode.fork(),
-		condition: n.condition.Fork(),
-		body:      n.body.Fork(),
+func (n *PackageIdentNode) Fork() Node {
+	_ret := &PackageIdentNode{
+		BaseNode: n.BaseNode.fork(),
+		ident:    n.ident.Fork(),
 	}
-	_ret.condition.SetParent(_ret)
-	_ret.body.SetParent(_ret)
+	_ret.ident.SetParent(_ret)
 	return _ret
 }
 
-func (n *SelectCaseClauseNode) Visit(beforeChildren func(node Node) (visitChildren, exit bool), afterChildren func(node Node) (exit bool)) (exit bool) {
+func (n *PackageIdentNode) Visit(beforeChildren func(node Node) (visitChildren, exit bool), afterChildren func(node Node) (exit bool)) (exit bool) {
 	vc, e := beforeChildren(n)
 	if e {
 		return true
@@ -10056,10 +9709,7 @@ func (n *SelectCaseClauseNode) Visit(beforeChildren func(node Node) (visitChildr
 	if !vc {
 		return false
 	}
-	if n.condition.Visit(beforeChildren, afterChildren) {
-		return true
-	}
-	if n.body.Visit(beforeChildren, afterChildren) {
+	if n.ident.Visit(beforeChildren, afterChildren) {
 		return true
 	}
 	if afterChildren(n) {
@@ -10068,113 +9718,92 @@ func (n *SelectCaseClauseNode) Visit(beforeChildren func(node Node) (visitChildr
 	return false
 }
 
-func (n *SelectCaseClauseNode) Dump(hook func(Node, map[string]string) string) map[string]string {
+func (n *PackageIdentNode) Accept(v Visitor) bool {
+	return v.VisitPackageIdentNode(n)
+}
+
+func (n *PackageIdentNode) Rewrite(r Rewriter) Node {
+	n.ident = rewriteChild(r, n.ident)
+	return r.RewritePackageIdentNode(n)
+}
+
+func (n *PackageIdentNode) Dump(hook func(Node, map[string]string) string) map[string]string {
 	ret := make(map[string]string)
-	ret["kind"] = "\"select_case_clause\""
-	ret["condition"] = DumpNode(n.Condition(), hook)
-	ret["body"] = DumpNode(n.Body(), hook)
+	ret["kind"] = "\"package_ident\""
+	ret["ident"] = DumpNode(n.Ident(), hook)
 	return ret
 }
 
-func NewTypeCaseClauseNode(filePath string, fileContent []rune, types Node, body Node, start, end Position) Node {
-	if types == nil {
-		types = DummyNode
-	}
-	if body == nil {
-		body = DummyNode
+func NewParameterDeclNode(filePath string, fileContent []rune, parameters Node, start, end Position) Node {
+	if parameters == nil {
+		parameters = DummyNode
 	}
-	_1 := &TypeCaseClauseNode{
-		BaseNode: NewBaseNode(filePath, fileContent, NodeTypeTypeCaseClause, start, end),
-		types:    types,
-		body:     body,
+	_1 := &ParameterDeclNode{
+		BaseNode:   NewBaseNode(filePath, fileContent, NodeTypeParameterDecl, start, end),
+		parameters: parameters,
 	}
 	creationHook(_1)
 	return _1
 }
 
-type TypeCaseClauseNode struct {
+type ParameterDeclNode struct {
 	*BaseNode
-	types Node
-	body  Node
-}
-
-func (n *TypeCaseClauseNode) Types() Node {
-	return n.types
-}
-
-func (n *TypeCaseClauseNode) SetTypes(v Node) {
-	n.types = v
+	parameters Node
 }
 
-func (n *TypeCaseClauseNode) Body() Node {
-	return n.body
+func (n *ParameterDeclNode) Parameters() Node {
+	return n.parameters
 }
 
-func (n *TypeCaseClauseNode) SetBody(v Node) {
-	n.body = v
+func (n *ParameterDeclNode) SetParameters(v Node) {
+	n.parameters = v
 }
 
-func (n *TypeCaseClauseNode) BuildLink() {
-	if !n.Types().IsDummy() {
-		types := n.Types()
-		types.BuildLink()
-		types.SetParent(n)
-		types.SetSelfField("types")
-		types.SetReplaceSelf(func(n Node) {
-			n.Parent().(*TypeCaseClauseNode).SetTypes(n)
-		})
-	}
-	if !n.Body().IsDummy() {
-		body := n.Body()
-		body.BuildLink()
-		body.SetParent(n)
-		body.SetSelfField("body")
-		body.SetReplaceSelf(func(n Node) {
-			n.Parent().(*TypeCaseClauseNode).SetBody(n)
+func (n *ParameterDeclNode) BuildLink() {
+	if !n.Parameters().IsDummy() {
+		parameters := n.Parameters()
+		parameters.BuildLink()
+		parameters.SetParent(n)
+		parameters.SetSelfField("parameters")
+		parameters.SetReplaceSelf(func(n Node) {
+			n.Parent().(*ParameterDeclNode).SetParameters(n)
 		})
 	}
 }
 
-func (n *TypeCaseClauseNode) Fields() []string {
+func (n *ParameterDeclNode) Fields() []string {
 	return []string{
-		"types",
-		"body",
+		"parameters",
 	}
 }
 
-func (n *TypeCaseClauseNode) Child(field string) Node {
+func (n *ParameterDeclNode) Child(field string) Node {
 	if field == "" {
 		return nil
 	}
-	if field == "types" {
-		return n.Types()
-	}
-	if field == "body" {
-		return n.Body()
+	if field == "parameters" {
+		return n.Parameters()
 	}
 	return nil
 }
 
-func (n *TypeCaseClauseNode) SetChild(nodes []Node) {
-	if len(nodes) != 2 {
+func (n *ParameterDeclNode) SetChild(nodes []Node) {
+	if len(nodes) != 1 {
 		return
 	}
-	n.SetTypes(nodes[0])
-	n.SetBody(nodes[1])
+	n.SetParameters(nodes[0])
 }
 
-func (n *TypeCaseClauseNode) Fork() Node {
-	_ret := &TypeCaseClauseNode{
-		BaseNode: n.BaseNode.fork(),
-		types:    n.types.Fork(),
-		body:     n.body.Fork(),
+func (n *ParameterDeclNode) Fork() Node {
+	_ret := &ParameterDeclNode{
+		BaseNode:   n.BaseNode.fork(),
+		parameters: n.parameters.Fork(),
 	}
-	_ret.types.SetParent(_ret)
-	_ret.body.SetParent(_ret)
+	_ret.parameters.SetParent(_ret)
 	return _ret
 }
 
-func (n *TypeCaseClauseNode) Visit(beforeChildren func(node Node) (visitChildren, exit bool), afterChildren func(node Node) (exit bool)) (exit bool) {
+func (n *ParameterDeclNode) Visit(beforeChildren func(node Node) (visitChildren, exit bool), afterChildren func(node Node) (exit bool)) (exit bool) {
 	vc, e := beforeChildren(n)
 	if e {
 		return true
@@ -10182,10 +9811,7 @@ func (n *TypeCaseClauseNode) Visit(beforeChildren func(node Node) (visitChildren
 	if !vc {
 		return false
 	}
-	if n.types.Visit(beforeChildren, afterChildren) {
-		return true
-	}
-	if n.body.Visit(beforeChildren, afterChildren) {
+	if n.parameters.Visit(beforeChildren, afterChildren) {
 		return true
 	}
 	if afterChildren(n) {
@@ -10194,84 +9820,92 @@ func (n *TypeCaseClauseNode) Visit(beforeChildren func(node Node) (visitChildren
 	return false
 }
 
-func (n *TypeCaseClauseNode) Dump(hook func(Node, map[string]string) string) map[string]string {
+func (n *ParameterDeclNode) Accept(v Visitor) bool {
+	return v.VisitParameterDeclNode(n)
+}
+
+func (n *ParameterDeclNode) Rewrite(r Rewriter) Node {
+	n.parameters = rewriteChild(r, n.parameters)
+	return r.RewriteParameterDeclNode(n)
+}
+
+func (n *ParameterDeclNode) Dump(hook func(Node, map[string]string) string) map[string]string {
 	ret := make(map[string]string)
-	ret["kind"] = "\"type_case_clause\""
-	ret["types"] = DumpNode(n.Types(), hook)
-	ret["body"] = DumpNode(n.Body(), hook)
+	ret["kind"] = "\"parameter_decl\""
+	ret["parameters"] = DumpNode(n.Parameters(), hook)
 	return ret
 }
 
-func NewReturnStmtNode(filePath string, fileContent []rune, results Node, start, end Position) Node {
-	if results == nil {
-		results = DummyNode
+func NewParameterIdentNode(filePath string, fileContent []rune, ident Node, start, end Position) Node {
+	if ident == nil {
+		ident = DummyNode
 	}
-	_1 := &ReturnStmtNode{
-		BaseNode: NewBaseNode(filePath, fileContent, NodeTypeReturnStmt, start, end),
-		results:  results,
+	_1 := &ParameterIdentNode{
+		BaseNode: NewBaseNode(filePath, fileContent, NodeTypeParameterIdent, start, end),
+		ident:    ident,
 	}
 	creationHook(_1)
 	return _1
 }
 
-type ReturnStmtNode struct {
+type ParameterIdentNode struct {
 	*BaseNode
-	results Node
+	ident Node
 }
 
-func (n *ReturnStmtNode) Results() Node {
-	return n.results
+func (n *ParameterIdentNode) Ident() Node {
+	return n.ident
 }
 
-func (n *ReturnStmtNode) SetResults(v Node) {
-	n.results = v
+func (n *ParameterIdentNode) SetIdent(v Node) {
+	n.ident = v
 }
 
-func (n *ReturnStmtNode) BuildLink() {
-	if !n.Results().IsDummy() {
-		results := n.Results()
-		results.BuildLink()
-		results.SetParent(n)
-		results.SetSelfField("results")
-		results.SetReplaceSelf(func(n Node) {
-			n.Parent().(*ReturnStmtNode).SetResults(n)
+func (n *ParameterIdentNode) BuildLink() {
+	if !n.Ident().IsDummy() {
+		ident := n.Ident()
+		ident.BuildLink()
+		ident.SetParent(n)
+		ident.SetSelfField("ident")
+		ident.SetReplaceSelf(func(n Node) {
+			n.Parent().(*ParameterIdentNode).SetIdent(n)
 		})
 	}
 }
 
-func (n *ReturnStmtNode) Fields() []string {
+func (n *ParameterIdentNode) Fields() []string {
 	return []string{
-		"results",
+		"ident",
 	}
 }
 
-func (n *ReturnStmtNode) Child(field string) Node {
+func (n *ParameterIdentNode) Child(field string) Node {
 	if field == "" {
 		return nil
 	}
-	if field == "results" {
-		return n.Results()
+	if field == "ident" {
+		return n.Ident()
 	}
 	return nil
 }
 
-func (n *ReturnStmtNode) SetChild(nodes []Node) {
+func (n *ParameterIdentNode) SetChild(nodes []Node) {
 	if len(nodes) != 1 {
 		return
 	}
-	n.SetResults(nodes[0])
+	n.SetIdent(nodes[0])
 }
 
-func (n *ReturnStmtNode) Fork() Node {
-	_ret := &ReturnStmtNode{
+func (n *ParameterIdentNode) Fork() Node {
+	_ret := &ParameterIdentNode{
 		BaseNode: n.BaseNode.fork(),
-		results:  n.results.Fork(),
+		ident:    n.ident.Fork(),
 	}
-	_ret.results.SetParent(_ret)
+	_ret.ident.SetParent(_ret)
 	return _ret
 }
 
-func (n *ReturnStmtNode) Visit(beforeChildren func(node Node) (visitChildren, exit bool), afterChildren func(node Node) (exit bool)) (exit bool) {
+func (n *ParameterIdentNode) Visit(beforeChildren func(node Node) (visitChildren, exit bool), afterChildren func(node Node) (exit bool)) (exit bool) {
 	vc, e := beforeChildren(n)
 	if e {
 		return true
@@ -10279,7 +9913,7 @@ func (n *ReturnStmtNode) Visit(beforeChildren func(node Node) (visitChildren, ex
 	if !vc {
 		return false
 	}
-	if n.results.Visit(beforeChildren, afterChildren) {
+	if n.ident.Visit(beforeChildren, afterChildren) {
 		return true
 	}
 	if afterChildren(n) {
@@ -10288,83 +9922,92 @@ func (n *ReturnStmtNode) Visit(beforeChildren func(node Node) (visitChildren, ex
 	return false
 }
 
-func (n *ReturnStmtNode) Dump(hook func(Node, map[string]string) string) map[string]string {
+func (n *ParameterIdentNode) Accept(v Visitor) bool {
+	return v.VisitParameterIdentNode(n)
+}
+
+func (n *ParameterIdentNode) Rewrite(r Rewriter) Node {
+	n.ident = rewriteChild(r, n.ident)
+	return r.RewriteParameterIdentNode(n)
+}
+
+func (n *ParameterIdentNode) Dump(hook func(Node, map[string]string) string) map[string]string {
 	ret := make(map[string]string)
-	ret["kind"] = "\"return_stmt\""
-	ret["results"] = DumpNode(n.Results(), hook)
+	ret["kind"] = "\"parameter_ident\""
+	ret["ident"] = DumpNode(n.Ident(), hook)
 	return ret
 }
 
-func NewFallthroughStmtNode(filePath string, fileContent []rune,  Node, start, end Position) Node {
-	if  == nil {
-		 = DummyNode
+func NewParenExprNode(filePath string, fileContent []rune, x Node, start, end Position) Node {
+	if x == nil {
+		x = DummyNode
 	}
-	_1 := &FallthroughStmtNode{
-		BaseNode: NewBaseNode(filePath, fileContent, NodeTypeFallthroughStmt, start, end),
-		:         ,
+	_1 := &ParenExprNode{
+		BaseNode: NewBaseNode(filePath, fileContent, NodeTypeParenExpr, start, end),
+		x:        x,
 	}
 	creationHook(_1)
 	return _1
 }
 
-type FallthroughStmtNode struct {
+type ParenExprNode struct {
 	*BaseNode
-	 Node
+	x Node
 }
 
-func (n *FallthroughStmtNode) () Node {
-	return n.
+func (n *ParenExprNode) X() Node {
+	return n.x
 }
 
-func (n *FallthroughStmtNode) Set(v Node) {
-	n. = v
+func (n *ParenExprNode) SetX(v Node) {
+	n.x = v
 }
 
-func (n *FallthroughStmtNode) BuildLink() {
-	if !n.().IsDummy() {
-		 := n.()
-		.BuildLink()
-		.SetParent(n)
-		.SetSelfField("")
-		.SetReplaceSelf(func(n Node) {
-			n.Parent().(*FallthroughStmtNode).Set(n)
+func (n *ParenExprNode) BuildLink() {
+	if !n.X().IsDummy() {
+		x := n.X()
+		x.BuildLink()
+		x.SetParent(n)
+		x.SetSelfField("x")
+		x.SetReplaceSelf(func(n Node) {
+			n.Parent().(*ParenExprNode).SetX(n)
 		})
 	}
 }
 
-func (n *FallthroughStmtNode) Fields() []string {
+func (n *ParenExprNode) Fields() []string {
 	return []string{
-		"",
+		"x",
 	}
 }
 
-func (n *FallthroughStmtNode) Child(field string) Node {
+func (n *ParenExprNode) Child(field string) Node {
 	if field == "" {
 		return nil
 	}
-	if field == "" {
-		return n.()
+	if field == "x" {
+		return n.X()
 	}
 	return nil
 }
 
-func (n *FallthroughStmtNode) SetChild(nodes []Node) {
+func (n *ParenExprNode) SetChild(nodes []Node) {
 	if len(nodes) != 1 {
 		return
 	}
-	n.Set(nodes[0])
+	n.SetX(nodes[0])
 }
 
-func (n *FallthroughStmtNode) Fork() Node {
-	_ret := &FallthroughStmtNode{
+func (n *ParenExprNode) Fork() Node {
+	_ret := &ParenExprNode{
 		BaseNode: n.BaseNode.fork(),
-		:         n..Fork(),
+		x:        n.x.Fork(),
 	}
-	_ret..SetParent(_ret)
+	_ret.x.SetParent(_ret)
 	return _ret
 }
 
-func (n *FallthroughStmtNode) Visit(beforeChildren func(node Node) (visitChildren, exit bool), afterChildren func(node Node) (exit bool)) (exit bool) {
+func (n *ParenExprNode) Visit(beforeChildren func(node Node) (visitChildren, exit bool), afterChildren func(node Node) (exit bool)) (exit bool) {
 	vc, e := beforeChildren(n)
 	if e {
 		return true
@@ -10372,7 +10015,7 @@ func (n *FallthroughStmtNode) Visit(beforeChildren func(node Node) (visitChildre
 	if !vc {
 		return false
 	}
-	if n..Visit(beforeChildren, afterChildren) {
+	if n.x.Visit(beforeChildren, afterChildren) {
 		return true
 	}
 	if afterChildren(n) {
@@ -10381,83 +10024,94 @@ func (n *FallthroughStmtNode) Visit(beforeChildren func(node Node) (visitChildre
 	return false
 }
 
-func (n *FallthroughStmtNode) Dump(hook func(Node, map[string]string) string) map[string]string {
+func (n *ParenExprNode) Accept(v Visitor) bool {
+	return v.VisitParenExprNode(n)
+}
+
+func (n *ParenExprNode) exprNode() {}
+
+func (n *ParenExprNode) Rewrite(r Rewriter) Node {
+	n.x = rewriteChild(r, n.x)
+	return r.RewriteParenExprNode(n)
+}
+
+func (n *ParenExprNode) Dump(hook func(Node, map[string]string) string) map[string]string {
 	ret := make(map[string]string)
-	ret["kind"] = "\"fallthrough_stmt\""
-	ret[""] = DumpNode(n.(), hook)
+	ret["kind"] = "\"paren_expr\""
+	ret["x"] = DumpNode(n.X(), hook)
 	return ret
 }
 
-func NewContinueStmtNode(filePath string, fileContent []rune, label Node, start, end Position) Node {
-	if label == nil {
-		label = DummyNode
+func NewReceiverGenericTypeDeclNode(filePath string, fileContent []rune, types Node, start, end Position) Node {
+	if types == nil {
+		types = DummyNode
 	}
-	_1 := &ContinueStmtNode{
-		BaseNode: NewBaseNode(filePath, fileContent, NodeTypeContinueStmt, start, end),
-		label:    label,
+	_1 := &ReceiverGenericTypeDeclNode{
+		BaseNode: NewBaseNode(filePath, fileContent, NodeTypeReceiverGenericTypeDecl, start, end),
+		types:    types,
 	}
 	creationHook(_1)
 	return _1
 }
 
-type ContinueStmtNode struct {
+type ReceiverGenericTypeDeclNode struct {
 	*BaseNode
-	label Node
+	types Node
 }
 
-func (n *ContinueStmtNode) Label() Node {
-	return n.label
+func (n *ReceiverGenericTypeDeclNode) Types() Node {
+	return n.types
 }
 
-func (n *ContinueStmtNode) SetLabel(v Node) {
-	n.label = v
+func (n *ReceiverGenericTypeDeclNode) SetTypes(v Node) {
+	n.types = v
 }
 
-func (n *ContinueStmtNode) BuildLink() {
-	if !n.Label().IsDummy() {
-		label := n.Label()
-		label.BuildLink()
-		label.SetParent(n)
-		label.SetSelfField("label")
-		label.SetReplaceSelf(func(n Node) {
-			n.Parent().(*ContinueStmtNode).SetLabel(n)
+func (n *ReceiverGenericTypeDeclNode) BuildLink() {
+	if !n.Types().IsDummy() {
+		types := n.Types()
+		types.BuildLink()
+		types.SetParent(n)
+		types.SetSelfField("types")
+		types.SetReplaceSelf(func(n Node) {
+			n.Parent().(*ReceiverGenericTypeDeclNode).SetTypes(n)
 		})
 	}
 }
 
-func (n *ContinueStmtNode) Fields() []string {
+func (n *ReceiverGenericTypeDeclNode) Fields() []string {
 	return []string{
-		"label",
+		"types",
 	}
 }
 
-func (n *ContinueStmtNode) Child(field string) Node {
+func (n *ReceiverGenericTypeDeclNode) Child(field string) Node {
 	if field == "" {
 		return nil
 	}
-	if field == "label" {
-		return n.Label()
+	if field == "types" {
+		return n.Types()
 	}
 	return nil
 }
 
-func (n *ContinueStmtNode) SetChild(nodes []Node) {
+func (n *ReceiverGenericTypeDeclNode) SetChild(nodes []Node) {
 	if len(nodes) != 1 {
 		return
 	}
-	n.SetLabel(nodes[0])
+	n.SetTypes(nodes[0])
 }
 
-func (n *ContinueStmtNode) Fork() Node {
-	_ret := &ContinueStmtNode{
+func (n *ReceiverGenericTypeDeclNode) Fork() Node {
+	_ret := &ReceiverGenericTypeDeclNode{
 		BaseNode: n.BaseNode.fork(),
-		label:    n.label.Fork(),
+		types:    n.types.Fork(),
 	}
-	_ret.label.SetParent(_ret)
+	_ret.types.SetParent(_ret)
 	return _ret
 }
 
-func (n *ContinueStmtNode) Visit(beforeChildren func(node Node) (visitChildren, exit bool), afterChildren func(node Node) (exit bool)) (exit bool) {
+func (n *ReceiverGenericTypeDeclNode) Visit(beforeChildren func(node Node) (visitChildren, exit bool), afterChildren func(node Node) (exit bool)) (exit bool) {
 	vc, e := beforeChildren(n)
 	if e {
 		return true
@@ -10465,7 +10119,7 @@ func (n *ContinueStmtNode) Visit(beforeChildren func(node Node) (visitChildren,
 	if !vc {
 		return false
 	}
-	if n.label.Visit(beforeChildren, afterChildren) {
+	if n.types.Visit(beforeChildren, afterChildren) {
 		return true
 	}
 	if afterChildren(n) {
@@ -10474,83 +10128,92 @@ func (n *ContinueStmtNode) Visit(beforeChildren func(node Node) (visitChildren,
 	return false
 }
 
-func (n *ContinueStmtNode) Dump(hook func(Node, map[string]string) string) map[string]string {
+func (n *ReceiverGenericTypeDeclNode) Accept(v Visitor) bool {
+	return v.VisitReceiverGenericTypeDeclNode(n)
+}
+
+func (n *ReceiverGenericTypeDeclNode) Rewrite(r Rewriter) Node {
+	n.types = rewriteChild(r, n.types)
+	return r.RewriteReceiverGenericTypeDeclNode(n)
+}
+
+func (n *ReceiverGenericTypeDeclNode) Dump(hook func(Node, map[string]string) string) map[string]string {
 	ret := make(map[string]string)
-	ret["kind"] = "\"continue_stmt\""
-	ret["label"] = DumpNode(n.Label(), hook)
+	ret["kind"] = "\"receiver_generic_type_decl\""
+	ret["types"] = DumpNode(n.Types(), hook)
 	return ret
 }
 
-func NewGotoStmtNode(filePath string, fileContent []rune, label Node, start, end Position) Node {
-	if label == nil {
-		label = DummyNode
+func NewReceiverGenericTypeIdentNode(filePath string, fileContent []rune, ident Node, start, end Position) Node {
+	if ident == nil {
+		ident = DummyNode
 	}
-	_1 := &GotoStmtNode{
-		BaseNode: NewBaseNode(filePath, fileContent, NodeTypeGotoStmt, start, end),
-		label:    label,
+	_1 := &ReceiverGenericTypeIdentNode{
+		BaseNode: NewBaseNode(filePath, fileContent, NodeTypeReceiverGenericTypeIdent, start, end),
+		ident:    ident,
 	}
 	creationHook(_1)
 	return _1
 }
 
-type GotoStmtNode struct {
+type ReceiverGenericTypeIdentNode struct {
 	*BaseNode
-	label Node
-}
-
-func (n *GotoStmtNode) Label() Node {
-	return n.label
+	ident Node
 }
 
-func (n *GotoStmtNode) SetLabel(v Node) {
-	n.label = v
+func (n *ReceiverGenericTypeIdentNode) Ident() Node {
+	return n.ident
 }
 
-func (n *GotoStmtNode) BuildLink() {
-	if !n.Label().IsDummy() {
-		label := n.Label()
-		label.BuildLink()
-		label.SetParent(n)
-		label.SetSelfField("label")
-		label.SetReplaceSelf(func(n Node) {
-			n.Parent().(*GotoStmtNode).SetLabel(n)
-		})
-	}
+func (n *ReceiverGenericTypeIdentNode) SetIdent(v Node) {
+	n.ident = v
 }
 
-func (n *GotoStmtNode) Fields() []string {
-	return []string{
-		"label",
+func (n *ReceiverGenericTypeIdentNode) BuildLink() {
+	if !n.Ident().IsDummy() {
+		ident := n.Ident()
+		ident.BuildLink()
+		ident.SetParent(n)
+		ident.SetSelfField("ident")
+		ident.SetReplaceSelf(func(n Node) {
+			n.Parent().(*ReceiverGenericTypeIdentNode).SetIdent(n)
+		})
 	}
 }
 
-func (n *GotoStmtNode) Child(field string) Node {
+func (n *ReceiverGenericTypeIdentNode) Fields() []string {
+	return []string{
+		"ident",
+	}
+}
+
+func (n *ReceiverGenericTypeIdentNode) Child(field string) Node {
 	if field == "" {
 		return nil
 	}
-	if field == "label" {
-		return n.Label()
+	if field == "ident" {
+		return n.Ident()
 	}
 	return nil
 }
 
-func (n *GotoStmtNode) SetChild(nodes []Node) {
+func (n *ReceiverGenericTypeIdentNode) SetChild(nodes []Node) {
 	if len(nodes) != 1 {
 		return
 	}
-	n.SetLabel(nodes[0])
+	n.SetIdent(nodes[0])
 }
 
-func (n *GotoStmtNode) Fork() Node {
-	_ret := &GotoStmtNode{
+func (n *ReceiverGenericTypeIdentNode) Fork() Node {
+	_ret := &ReceiverGenericTypeIdentNode{
 		BaseNode: n.BaseNode.fork(),
-		label:    n.label.Fork(),
+		ident:    n.ident.Fork(),
 	}
-	_ret.label.SetParent(_ret)
+	_ret.ident.SetParent(_ret)
 	return _ret
 }
 
-func (n *GotoStmtNode) Visit(beforeChildren func(node Node) (visitChildren, exit bool), afterChildren func(node Node) (exit bool)) (exit bool) {
+func (n *ReceiverGenericTypeIdentNode) Visit(beforeChildren func(node Node) (visitChildren, exit bool), afterChildren func(node Node) (exit bool)) (exit bool) {
 	vc, e := beforeChildren(n)
 	if e {
 		return true
@@ -10558,7 +10221,7 @@ func (n *GotoStmtNode) Visit(beforeChildren func(node Node) (visitChildren, exit
 	if !vc {
 		return false
 	}
-	if n.label.Visit(beforeChildren, afterChildren) {
+	if n.ident.Visit(beforeChildren, afterChildren) {
 		return true
 	}
 	if afterChildren(n) {
@@ -10567,83 +10230,92 @@ func (n *GotoStmtNode) Visit(beforeChildren func(node Node) (visitChildren, exit
 	return false
 }
 
-func (n *GotoStmtNode) Dump(hook func(Node, map[string]string) string) map[string]string {
+func (n *ReceiverGenericTypeIdentNode) Accept(v Visitor) bool {
+	return v.VisitReceiverGenericTypeIdentNode(n)
+}
+
+func (n *ReceiverGenericTypeIdentNode) Rewrite(r Rewriter) Node {
+	n.ident = rewriteChild(r, n.ident)
+	return r.RewriteReceiverGenericTypeIdentNode(n)
+}
+
+func (n *ReceiverGenericTypeIdentNode) Dump(hook func(Node, map[string]string) string) map[string]string {
 	ret := make(map[string]string)
-	ret["kind"] = "\"goto_stmt\""
-	ret["label"] = DumpNode(n.Label(), hook)
+	ret["kind"] = "\"receiver_generic_type_ident\""
+	ret["ident"] = DumpNode(n.Ident(), hook)
 	return ret
 }
 
-func NewBreakStmtNode(filePath string, fileContent []rune, label Node, start, end Position) Node {
-	if label == nil {
-		label = DummyNode
+func NewReceiverIdentNode(filePath string, fileContent []rune, ident Node, start, end Position) Node {
+	if ident == nil {
+		ident = DummyNode
 	}
-	_1 := &BreakStmtNode{
-		BaseNode: NewBaseNode(filePath, fileContent, NodeTypeBreakStmt, start, end),
-		label:    label,
+	_1 := &ReceiverIdentNode{
+		BaseNode: NewBaseNode(filePath, fileContent, NodeTypeReceiverIdent, start, end),
+		ident:    ident,
 	}
 	creationHook(_1)
 	return _1
 }
 
-type BreakStmtNode struct {
+type ReceiverIdentNode struct {
 	*BaseNode
-	label Node
+	ident Node
 }
 
-func (n *BreakStmtNode) Label() Node {
-	return n.label
+func (n *ReceiverIdentNode) Ident() Node {
+	return n.ident
 }
 
-func (n *BreakStmtNode) SetLabel(v Node) {
-	n.label = v
+func (n *ReceiverIdentNode) SetIdent(v Node) {
+	n.ident = v
 }
 
-func (n *BreakStmtNode) BuildLink() {
-	if !n.Label().IsDummy() {
-		label := n.Label()
-		label.BuildLink()
-		label.SetParent(n)
-		label.SetSelfField("label")
-		label.SetReplaceSelf(func(n Node) {
-			n.Parent().(*BreakStmtNode).SetLabel(n)
+func (n *ReceiverIdentNode) BuildLink() {
+	if !n.Ident().IsDummy() {
+		ident := n.Ident()
+		ident.BuildLink()
+		ident.SetParent(n)
+		ident.SetSelfField("ident")
+		ident.SetReplaceSelf(func(n Node) {
+			n.Parent().(*ReceiverIdentNode).SetIdent(n)
 		})
 	}
 }
 
-func (n *BreakStmtNode) Fields() []string {
+func (n *ReceiverIdentNode) Fields() []string {
 	return []string{
-		"label",
+		"ident",
 	}
 }
 
-func (n *BreakStmtNode) Child(field string) Node {
+func (n *ReceiverIdentNode) Child(field string) Node {
 	if field == "" {
 		return nil
 	}
-	if field == "label" {
-		return n.Label()
+	if field == "ident" {
+		return n.Ident()
 	}
 	return nil
 }
 
-func (n *BreakStmtNode) SetChild(nodes []Node) {
+func (n *ReceiverIdentNode) SetChild(nodes []Node) {
 	if len(nodes) != 1 {
 		return
 	}
-	n.SetLabel(nodes[0])
+	n.SetIdent(nodes[0])
 }
 
-func (n *BreakStmtNode) Fork() Node {
-	_ret := &BreakStmtNode{
+func (n *ReceiverIdentNode) Fork() Node {
+	_ret := &ReceiverIdentNode{
 		BaseNode: n.BaseNode.fork(),
-		label:    n.label.Fork(),
+		ident:    n.ident.Fork(),
 	}
-	_ret.label.SetParent(_ret)
+	_ret.ident.SetParent(_ret)
 	return _ret
 }
 
-func (n *BreakStmtNode) Visit(beforeChildren func(node Node) (visitChildren, exit bool), afterChildren func(node Node) (exit bool)) (exit bool) {
+func (n *ReceiverIdentNode) Visit(beforeChildren func(node Node) (visitChildren, exit bool), afterChildren func(node Node) (exit bool)) (exit bool) {
 	vc, e := beforeChildren(n)
 	if e {
 		return true
@@ -10651,7 +10323,7 @@ func (n *BreakStmtNode) Visit(beforeChildren func(node Node) (visitChildren, exi
 	if !vc {
 		return false
 	}
-	if n.label.Visit(beforeChildren, afterChildren) {
+	if n.ident.Visit(beforeChildren, afterChildren) {
 		return true
 	}
 	if afterChildren(n) {
@@ -10660,83 +10332,92 @@ func (n *BreakStmtNode) Visit(beforeChildren func(node Node) (visitChildren, exi
 	return false
 }
 
-func (n *BreakStmtNode) Dump(hook func(Node, map[string]string) string) map[string]string {
+func (n *ReceiverIdentNode) Accept(v Visitor) bool {
+	return v.VisitReceiverIdentNode(n)
+}
+
+func (n *ReceiverIdentNode) Rewrite(r Rewriter) Node {
+	n.ident = rewriteChild(r, n.ident)
+	return r.RewriteReceiverIdentNode(n)
+}
+
+func (n *ReceiverIdentNode) Dump(hook func(Node, map[string]string) string) map[string]string {
 	ret := make(map[string]string)
-	ret["kind"] = "\"break_stmt\""
-	ret["label"] = DumpNode(n.Label(), hook)
+	ret["kind"] = "\"receiver_ident\""
+	ret["ident"] = DumpNode(n.Ident(), hook)
 	return ret
 }
 
-func NewDeferStmtNode(filePath string, fileContent []rune, expression Node, start, end Position) Node {
-	if expression == nil {
-		expression = DummyNode
+func NewReceiverTypeIdentNode(filePath string, fileContent []rune, ident Node, start, end Position) Node {
+	if ident == nil {
+		ident = DummyNode
 	}
-	_1 := &DeferStmtNode{
-		BaseNode:   NewBaseNode(filePath, fileContent, NodeTypeDeferStmt, start, end),
-		expression: expression,
+	_1 := &ReceiverTypeIdentNode{
+		BaseNode: NewBaseNode(filePath, fileContent, NodeTypeReceiverTypeIdent, start, end),
+		ident:    ident,
 	}
 	creationHook(_1)
 	return _1
 }
 
-type DeferStmtNode struct {
+type ReceiverTypeIdentNode struct {
 	*BaseNode
-	expression Node
+	ident Node
 }
 
-func (n *DeferStmtNode) Expression() Node {
-	return n.expression
+func (n *ReceiverTypeIdentNode) Ident() Node {
+	return n.ident
 }
 
-func (n *DeferStmtNode) SetExpression(v Node) {
-	n.expression = v
+func (n *ReceiverTypeIdentNode) SetIdent(v Node) {
+	n.ident = v
 }
 
-func (n *DeferStmtNode) BuildLink() {
-	if !n.Expression().IsDummy() {
-		expression := n.Expression()
-		expression.BuildLink()
-		expression.SetParent(n)
-		expression.SetSelfField("expression")
-		expression.SetReplaceSelf(func(n Node) {
-			n.Parent().(*DeferStmtNode).SetExpression(n)
+func (n *ReceiverTypeIdentNode) BuildLink() {
+	if !n.Ident().IsDummy() {
+		ident := n.Ident()
+		ident.BuildLink()
+		ident.SetParent(n)
+		ident.SetSelfField("ident")
+		ident.SetReplaceSelf(func(n Node) {
+			n.Parent().(*ReceiverTypeIdentNode).SetIdent(n)
 		})
 	}
 }
 
-func (n *DeferStmtNode) Fields() []string {
+func (n *ReceiverTypeIdentNode) Fields() []string {
 	return []string{
-		"expression",
+		"ident",
 	}
 }
 
-func (n *DeferStmtNode) Child(field string) Node {
+func (n *ReceiverTypeIdentNode) Child(field string) Node {
 	if field == "" {
 		return nil
 	}
-	if field == "expression" {
-		return n.Expression()
+	if field == "ident" {
+		return n.Ident()
 	}
 	return nil
 }
 
-func (n *DeferStmtNode) SetChild(nodes []Node) {
+func (n *ReceiverTypeIdentNode) SetChild(nodes []Node) {
 	if len(nodes) != 1 {
 		return
 	}
-	n.SetExpression(nodes[0])
+	n.SetIdent(nodes[0])
 }
 
-func (n *DeferStmtNode) Fork() Node {
-	_ret := &DeferStmtNode{
-		BaseNode:   n.BaseNode.fork(),
-		expression: n.expression.Fork(),
+func (n *ReceiverTypeIdentNode) Fork() Node {
+	_ret := &ReceiverTypeIdentNode{
+		BaseNode: n.BaseNode.fork(),
+		ident:    n.ident.Fork(),
 	}
-	_ret.expression.SetParent(_ret)
+	_ret.ident.SetParent(_ret)
 	return _ret
 }
 
-func (n *DeferStmtNode) Visit(beforeChildren func(node Node) (visitChildren, exit bool), afterChildren func(node Node) (exit bool)) (exit bool) {
+func (n *ReceiverTypeIdentNode) Visit(beforeChildren func(node Node) (visitChildren, exit bool), afterChildren func(node Node) (exit bool)) (exit bool) {
 	vc, e := beforeChildren(n)
 	if e {
 		return true
@@ -10744,7 +10425,7 @@ func (n *DeferStmtNode) Visit(beforeChildren func(node Node) (visitChildren, exi
 	if !vc {
 		return false
 	}
-	if n.expression.Visit(beforeChildren, afterChildren) {
+	if n.ident.Visit(beforeChildren, afterChildren) {
 		return true
 	}
 	if afterChildren(n) {
@@ -10753,83 +10434,92 @@ func (n *DeferStmtNode) Visit(beforeChildren func(node Node) (visitChildren, exi
 	return false
 }
 
-func (n *DeferStmtNode) Dump(hook func(Node, map[string]string) string) map[string]string {
+func (n *ReceiverTypeIdentNode) Accept(v Visitor) bool {
+	return v.VisitReceiverTypeIdentNode(n)
+}
+
+func (n *ReceiverTypeIdentNode) Rewrite(r Rewriter) Node {
+	n.ident = rewriteChild(r, n.ident)
+	return r.RewriteReceiverTypeIdentNode(n)
+}
+
+func (n *ReceiverTypeIdentNode) Dump(hook func(Node, map[string]string) string) map[string]string {
 	ret := make(map[string]string)
-	ret["kind"] = "\"defer_stmt\""
-	ret["expression"] = DumpNode(n.Expression(), hook)
+	ret["kind"] = "\"receiver_type_ident\""
+	ret["ident"] = DumpNode(n.Ident(), hook)
 	return ret
 }
 
-func NewGoStmtNode(filePath string, fileContent []rune, expression Node, start, end Position) Node {
-	if expression == nil {
-		expression = DummyNode
+func NewResultGroupDeclNode(filePath string, fileContent []rune, results Node, start, end Position) Node {
+	if results == nil {
+		results = DummyNode
 	}
-	_1 := &GoStmtNode{
-		BaseNode:   NewBaseNode(filePath, fileContent, NodeTypeGoStmt, start, end),
-		expression: expression,
+	_1 := &ResultGroupDeclNode{
+		BaseNode: NewBaseNode(filePath, fileContent, NodeTypeResultGroupDecl, start, end),
+		results:  results,
 	}
 	creationHook(_1)
 	return _1
 }
 
-type GoStmtNode struct {
+type ResultGroupDeclNode struct {
 	*BaseNode
-	expression Node
+	results Node
 }
 
-func (n *GoStmtNode) Expression() Node {
-	return n.expression
+func (n *ResultGroupDeclNode) Results() Node {
+	return n.results
 }
 
-func (n *GoStmtNode) SetExpression(v Node) {
-	n.expression = v
+func (n *ResultGroupDeclNode) SetResults(v Node) {
+	n.results = v
 }
 
-func (n *GoStmtNode) BuildLink() {
-	if !n.Expression().IsDummy() {
-		expression := n.Expression()
-		expression.BuildLink()
-		expression.SetParent(n)
-		expression.SetSelfField("expression")
-		expression.SetReplaceSelf(func(n Node) {
-			n.Parent().(*GoStmtNode).SetExpression(n)
+func (n *ResultGroupDeclNode) BuildLink() {
+	if !n.Results().IsDummy() {
+		results := n.Results()
+		results.BuildLink()
+		results.SetParent(n)
+		results.SetSelfField("results")
+		results.SetReplaceSelf(func(n Node) {
+			n.Parent().(*ResultGroupDeclNode).SetResults(n)
 		})
 	}
 }
 
-func (n *GoStmtNode) Fields() []string {
+func (n *ResultGroupDeclNode) Fields() []string {
 	return []string{
-		"expression",
+		"results",
 	}
 }
 
-func (n *GoStmtNode) Child(field string) Node {
+func (n *ResultGroupDeclNode) Child(field string) Node {
 	if field == "" {
 		return nil
 	}
-	if field == "expression" {
-		return n.Expression()
+	if field == "results" {
+		return n.Results()
 	}
 	return nil
 }
 
-func (n *GoStmtNode) SetChild(nodes []Node) {
+func (n *ResultGroupDeclNode) SetChild(nodes []Node) {
 	if len(nodes) != 1 {
 		return
 	}
-	n.SetExpression(nodes[0])
+	n.SetResults(nodes[0])
 }
 
-func (n *GoStmtNode) Fork() Node {
-	_ret := &GoStmtNode{
-		BaseNode:   n.BaseNode.fork(),
-		expression: n.expression.Fork(),
+func (n *ResultGroupDeclNode) Fork() Node {
+	_ret := &ResultGroupDeclNode{
+		BaseNode: n.BaseNode.fork(),
+		results:  n.results.Fork(),
 	}
-	_ret.expression.SetParent(_ret)
+	_ret.results.SetParent(_ret)
 	return _ret
 }
 
-func (n *GoStmtNode) Visit(beforeChildren func(node Node) (visitChildren, exit bool), afterChildren func(node Node) (exit bool)) (exit bool) {
+func (n *ResultGroupDeclNode) Visit(beforeChildren func(node Node) (visitChildren, exit bool), afterChildren func(node Node) (exit bool)) (exit bool) {
 	vc, e := beforeChildren(n)
 	if e {
 		return true
@@ -10837,7 +10527,7 @@ func (n *GoStmtNode) Visit(beforeChildren func(node Node) (visitChildren, exit b
 	if !vc {
 		return false
 	}
-	if n.expression.Visit(beforeChildren, afterChildren) {
+	if n.results.Visit(beforeChildren, afterChildren) {
 		return true
 	}
 	if afterChildren(n) {
@@ -10846,112 +10536,92 @@ func (n *GoStmtNode) Visit(beforeChildren func(node Node) (visitChildren, exit b
 	return false
 }
 
-func (n *GoStmtNode) Dump(hook func(Node, map[string]string) string) map[string]string {
+func (n *ResultGroupDeclNode) Accept(v Visitor) bool {
+	return v.VisitResultGroupDeclNode(n)
+}
+
+func (n *ResultGroupDeclNode) Rewrite(r Rewriter) Node {
+	n.results = rewriteChild(r, n.results)
+	return r.RewriteResultGroupDeclNode(n)
+}
+
+func (n *ResultGroupDeclNode) Dump(hook func(Node, map[string]string) string) map[string]string {
 	ret := make(map[string]string)
-	ret["kind"] = "\"go_stmt\""
-	ret["expression"] = DumpNode(n.Expression(), hook)
+	ret["kind"] = "\"result_group_decl\""
+	ret["results"] = DumpNode(n.Results(), hook)
 	return ret
 }
 
-func NewArrayTypeNode(filePath string, fileContent []rune, len_ Node, elt Node, start, end Position) Node {
-	if len_ == nil {
-		len_ = DummyNode
-	}
-	if elt == nil {
-		elt = DummyNode
+func NewResultIdentNode(filePath string, fileContent []rune, ident Node, start, end Position) Node {
+	if ident == nil {
+		ident = DummyNode
 	}
-	_1 := &ArrayTypeNode{
-		BaseNode: NewBaseNode(filePath, fileContent, NodeTypeArrayType, start, end),
-		len_:     len_,
-		elt:      elt,
+	_1 := &ResultIdentNode{
+		BaseNode: NewBaseNode(filePath, fileContent, NodeTypeResultIdent, start, end),
+		ident:    ident,
 	}
 	creationHook(_1)
 	return _1
 }
 
-type ArrayTypeNode struct {
+type ResultIdentNode struct {
 	*BaseNode
-	len_ Node
-	elt  Node
-}
-
-func (n *ArrayTypeNode) Len() Node {
-	return n.len_
-}
-
-func (n *ArrayTypeNode) SetLen(v Node) {
-	n.len_ = v
+	ident Node
 }
 
-func (n *ArrayTypeNode) Elt() Node {
-	return n.elt
+func (n *ResultIdentNode) Ident() Node {
+	return n.ident
 }
 
-func (n *ArrayTypeNode) SetElt(v Node) {
-	n.elt = v
+func (n *ResultIdentNode) SetIdent(v Node) {
+	n.ident = v
 }
 
-func (n *ArrayTypeNode) BuildLink() {
-	if !n.Len().IsDummy() {
-		len_ := n.Len()
-		len_.BuildLink()
-		len_.SetParent(n)
-		len_.SetSelfField("len_")
-		len_.SetReplaceSelf(func(n Node) {
-			n.Parent().(*ArrayTypeNode).SetLen(n)
-		})
-	}
-	if !n.Elt().IsDummy() {
-		elt := n.Elt()
-		elt.BuildLink()
-		elt.SetParent(n)
-		elt.SetSelfField("elt")
-		elt.SetReplaceSelf(func(n Node) {
-			n.Parent().(*ArrayTypeNode).SetElt(n)
+func (n *ResultIdentNode) BuildLink() {
+	if !n.Ident().IsDummy() {
+		ident := n.Ident()
+		ident.BuildLink()
+		ident.SetParent(n)
+		ident.SetSelfField("ident")
+		ident.SetReplaceSelf(func(n Node) {
+			n.Parent().(*ResultIdentNode).SetIdent(n)
 		})
 	}
 }
 
-func (n *ArrayTypeNode) Fields() []string {
+func (n *ResultIdentNode) Fields() []string {
 	return []string{
-		"len_",
-		"elt",
+		"ident",
 	}
 }
 
-func (n *ArrayTypeNode) Child(field string) Node {
+func (n *ResultIdentNode) Child(field string) Node {
 	if field == "" {
 		return nil
 	}
-	if field == "len_" {
-		return n.Len()
-	}
-	if field == "elt" {
-		return n.Elt()
+	if field == "ident" {
+		return n.Ident()
 	}
 	return nil
 }
 
-func (n *ArrayTypeNode) SetChild(nodes []Node) {
-	if len(nodes) != 2 {
+func (n *ResultIdentNode) SetChild(nodes []Node) {
+	if len(nodes) != 1 {
 		return
 	}
-	n.SetLen(nodes[0])
-	n.SetElt(nodes[1])
+	n.SetIdent(nodes[0])
 }
 
-func (n *ArrayTypeNode) Fork() Node {
-	_ret := &ArrayTypeNode{
+func (n *ResultIdentNode) Fork() Node {
+	_ret := &ResultIdentNode{
 		BaseNode: n.BaseNode.fork(),
-		len_:     n.len_.Fork(),
-		elt:      n.elt.Fork(),
+		ident:    n.ident.Fork(),
 	}
-	_ret.len_.SetParent(_ret)
-	_ret.elt.SetParent(_ret)
+	_ret.ident.SetParent(_ret)
 	return _ret
 }
 
-func (n *ArrayTypeNode) Visit(beforeChildren func(node Node) (visitChildren, exit bool), afterChildren func(node Node) (exit bool)) (exit bool) {
+func (n *ResultIdentNode) Visit(beforeChildren func(node Node) (visitChildren, exit bool), afterChildren func(node Node) (exit bool)) (exit bool) {
 	vc, e := beforeChildren(n)
 	if e {
 		return true
@@ -10959,10 +10629,7 @@ func (n *ArrayTypeNode) Visit(beforeChildren func(node Node) (visitChildren, exi
 	if !vc {
 		return false
 	}
-	if n.len_.Visit(beforeChildren, afterChildren) {
-		return true
-	}
-	if n.elt.Visit(beforeChildren, afterChildren) {
+	if n.ident.Visit(beforeChildren, afterChildren) {
 		return true
 	}
 	if afterChildren(n) {
@@ -10971,113 +10638,92 @@ func (n *ArrayTypeNode) Visit(beforeChildren func(node Node) (visitChildren, exi
 	return false
 }
 
-func (n *ArrayTypeNode) Dump(hook func(Node, map[string]string) string) map[string]string {
+func (n *ResultIdentNode) Accept(v Visitor) bool {
+	return v.VisitResultIdentNode(n)
+}
+
+func (n *ResultIdentNode) Rewrite(r Rewriter) Node {
+	n.ident = rewriteChild(r, n.ident)
+	return r.RewriteResultIdentNode(n)
+}
+
+func (n *ResultIdentNode) Dump(hook func(Node, map[string]string) string) map[string]string {
 	ret := make(map[string]string)
-	ret["kind"] = "\"array_type\""
-	ret["len"] = DumpNode(n.Len(), hook)
-	ret["elt"] = DumpNode(n.Elt(), hook)
+	ret["kind"] = "\"result_ident\""
+	ret["ident"] = DumpNode(n.Ident(), hook)
 	return ret
 }
 
-func NewChanTypeNode(filePath string, fileContent []rune, dir Node, value Node, start, end Position) Node {
-	if dir == nil {
-		dir = DummyNode
-	}
-	if value == nil {
-		value = DummyNode
+func NewResultNameNode(filePath string, fileContent []rune, name Node, start, end Position) Node {
+	if name == nil {
+		name = DummyNode
 	}
-	_1 := &ChanTypeNode{
-		BaseNode: NewBaseNode(filePath, fileContent, NodeTypeChanType, start, end),
-		dir:      dir,
-		value:    value,
+	_1 := &ResultNameNode{
+		BaseNode: NewBaseNode(filePath, fileContent, NodeTypeResultName, start, end),
+		name:     name,
 	}
 	creationHook(_1)
 	return _1
 }
 
-type ChanTypeNode struct {
+type ResultNameNode struct {
 	*BaseNode
-	dir   Node
-	value Node
+	name Node
 }
 
-func (n *ChanTypeNode) Dir() Node {
-	return n.dir
+func (n *ResultNameNode) Name() Node {
+	return n.name
 }
 
-func (n *ChanTypeNode) SetDir(v Node) {
-	n.dir = v
-}
-
-func (n *ChanTypeNode) Value() Node {
-	return n.value
-}
-
-func (n *ChanTypeNode) SetValue(v Node) {
-	n.value = v
+func (n *ResultNameNode) SetName(v Node) {
+	n.name = v
 }
 
-func (n *ChanTypeNode) BuildLink() {
-	if !n.Dir().IsDummy() {
-		dir := n.Dir()
-		dir.BuildLink()
-		dir.SetParent(n)
-		dir.SetSelfField("dir")
-		dir.SetReplaceSelf(func(n Node) {
-			n.Parent().(*ChanTypeNode).SetDir(n)
-		})
-	}
-	if !n.Value().IsDummy() {
-		value := n.Value()
-		value.BuildLink()
-		value.SetParent(n)
-		value.SetSelfField("value")
-		value.SetReplaceSelf(func(n Node) {
-			n.Parent().(*ChanTypeNode).SetValue(n)
+func (n *ResultNameNode) BuildLink() {
+	if !n.Name().IsDummy() {
+		name := n.Name()
+		name.BuildLink()
+		name.SetParent(n)
+		name.SetSelfField("name")
+		name.SetReplaceSelf(func(n Node) {
+			n.Parent().(*ResultNameNode).SetName(n)
 		})
 	}
 }
 
-func (n *ChanTypeNode) Fields() []string {
+func (n *ResultNameNode) Fields() []string {
 	return []string{
-		"dir",
-		"value",
+		"name",
 	}
 }
 
-func (n *ChanTypeNode) Child(field string) Node {
+func (n *ResultNameNode) Child(field string) Node {
 	if field == "" {
 		return nil
 	}
-	if field == "dir" {
-		return n.Dir()
-	}
-	if field == "value" {
-		return n.Value()
+	if field == "name" {
+		return n.Name()
 	}
 	return nil
 }
 
-func (n *ChanTypeNode) SetChild(nodes []Node) {
-	if len(nodes) != 2 {
+func (n *ResultNameNode) SetChild(nodes []Node) {
+	if len(nodes) != 1 {
 		return
 	}
-	n.SetDir(nodes[0])
-	n.SetValue(nodes[1])
+	n.SetName(nodes[0])
 }
 
-func (n *ChanTypeNode) Fork() Node {
-	_ret := &ChanTypeNode{
+func (n *ResultNameNode) Fork() Node {
+	_ret := &ResultNameNode{
 		BaseNode: n.BaseNode.fork(),
-		dir:      n.dir.Fork(),
-		value:    n.value.Fork(),
+		name:     n.name.Fork(),
 	}
-	_ret.dir.SetParent(_ret)
-	_ret.value.SetParent(_ret)
+	_ret.name.SetParent(_ret)
 	return _ret
 }
 
-func (n *ChanTypeNode) Visit(beforeChildren func(node Node) (visitChildren, exit bool), afterChildren func(node Node) (exit bool)) (exit bool) {
+func (n *ResultNameNode) Visit(beforeChildren func(node Node) (visitChildren, exit bool), afterChildren func(node Node) (exit bool)) (exit bool) {
 	vc, e := beforeChildren(n)
 	if e {
 		return true
@@ -11085,10 +10731,7 @@ func (n *ChanTypeNode) Visit(beforeChildren func(node Node) (visitChildren, exit
 	if !vc {
 		return false
 	}
-	if n.dir.Visit(beforeChildren, afterChildren) {
-		return true
-	}
-	if n.value.Visit(beforeChildren, afterChildren) {
+	if n.name.Visit(beforeChildren, afterChildren) {
 		return true
 	}
 	if afterChildren(n) {
@@ -11097,113 +10740,121 @@ func (n *ChanTypeNode) Visit(beforeChildren func(node Node) (visitChildren, exit
 	return false
 }
 
-func (n *ChanTypeNode) Dump(hook func(Node, map[string]string) string) map[string]string {
+func (n *ResultNameNode) Accept(v Visitor) bool {
+	return v.VisitResultNameNode(n)
+}
+
+func (n *ResultNameNode) Rewrite(r Rewriter) Node {
+	n.name = rewriteChild(r, n.name)
+	return r.RewriteResultNameNode(n)
+}
+
+func (n *ResultNameNode) Dump(hook func(Node, map[string]string) string) map[string]string {
 	ret := make(map[string]string)
-	ret["kind"] = "\"chan_type\""
-	ret["dir"] = DumpNode(n.Dir(), hook)
-	ret["value"] = DumpNode(n.Value(), hook)
+	ret["kind"] = "\"result_name\""
+	ret["name"] = DumpNode(n.Name(), hook)
 	return ret
 }
 
-func NewFunctionTypeNode(filePath string, fileContent []rune, params Node, results Node, start, end Position) Node {
-	if params == nil {
-		params = DummyNode
+func NewResultNameTypeNode(filePath string, fileContent []rune, name Node, type_ Node, start, end Position) Node {
+	if name == nil {
+		name = DummyNode
 	}
-	if results == nil {
-		results = DummyNode
+	if type_ == nil {
+		type_ = DummyNode
 	}
-	_1 := &FunctionTypeNode{
-		BaseNode: NewBaseNode(filePath, fileContent, NodeTypeFunctionType, start, end),
-		params:   params,
-		results:  results,
+	_1 := &ResultNameTypeNode{
+		BaseNode: NewBaseNode(filePath, fileContent, NodeTypeResultNameType, start, end),
+		name:     name,
+		type_:    type_,
 	}
 	creationHook(_1)
 	return _1
 }
 
-type FunctionTypeNode struct {
+type ResultNameTypeNode struct {
 	*BaseNode
-	params  Node
-	results Node
+	name  Node
+	type_ Node
 }
 
-func (n *FunctionTypeNode) Params() Node {
-	return n.params
+func (n *ResultNameTypeNode) Name() Node {
+	return n.name
 }
 
-func (n *FunctionTypeNode) SetParams(v Node) {
-	n.params = v
+func (n *ResultNameTypeNode) SetName(v Node) {
+	n.name = v
 }
 
-func (n *FunctionTypeNode) Results() Node {
-	return n.results
+func (n *ResultNameTypeNode) Type() Node {
+	return n.type_
 }
 
-func (n *FunctionTypeNode) SetResults(v Node) {
-	n.results = v
+func (n *ResultNameTypeNode) SetType(v Node) {
+	n.type_ = v
 }
 
-func (n *FunctionTypeNode) BuildLink() {
-	if !n.Params().IsDummy() {
-		params := n.Params()
-		params.BuildLink()
-		params.SetParent(n)
-		params.SetSelfField("params")
-		params.SetReplaceSelf(func(n Node) {
-			n.Parent().(*FunctionTypeNode).SetParams(n)
+func (n *ResultNameTypeNode) BuildLink() {
+	if !n.Name().IsDummy() {
+		name := n.Name()
+		name.BuildLink()
+		name.SetParent(n)
+		name.SetSelfField("name")
+		name.SetReplaceSelf(func(n Node) {
+			n.Parent().(*ResultNameTypeNode).SetName(n)
 		})
 	}
-	if !n.Results().IsDummy() {
-		results := n.Results()
-		results.BuildLink()
-		results.SetParent(n)
-		results.SetSelfField("results")
-		results.SetReplaceSelf(func(n Node) {
-			n.Parent().(*FunctionTypeNode).SetResults(n)
+	if !n.Type().IsDummy() {
+		type_ := n.Type()
+		type_.BuildLink()
+		type_.SetParent(n)
+		type_.SetSelfField("type_")
+		type_.SetReplaceSelf(func(n Node) {
+			n.Parent().(*ResultNameTypeNode).SetType(n)
 		})
 	}
 }
 
-func (n *FunctionTypeNode) Fields() []string {
+func (n *ResultNameTypeNode) Fields() []string {
 	return []string{
-		"params",
-		"results",
+		"name",
+		"type_",
 	}
 }
 
-func (n *FunctionTypeNode) Child(field string) Node {
+func (n *ResultNameTypeNode) Child(field string) Node {
 	if field == "" {
 		return nil
 	}
-	if field == "params" {
-		return n.Params()
+	if field == "name" {
+		return n.Name()
 	}
-	if field == "results" {
-		return n.Results()
+	if field == "type_" {
+		return n.Type()
 	}
 	return nil
 }
 
-func (n *FunctionTypeNode) SetChild(nodes []Node) {
+func (n *ResultNameTypeNode) SetChild(nodes []Node) {
 	if len(nodes) != 2 {
 		return
 	}
-	n.SetParams(nodes[0])
-	n.SetResults(nodes[1])
+	n.SetName(nodes[0])
+	n.SetType(nodes[1])
 }
 
-func (n *FunctionTypeNode) Fork() Node {
-	_ret := &FunctionTypeNode{
+func (n *ResultNameTypeNode) Fork() Node {
+	_ret := &ResultNameTypeNode{
 		BaseNode: n.BaseNode.fork(),
-		params:   n.params.Fork(),
-		results:  n.results.Fork(),
+		name:     n.name.Fork(),
+		type_:    n.type_.Fork(),
 	}
-	_ret.params.SetParent(_ret)
-	_ret.results.SetParent(_ret)
+	_ret.name.SetParent(_ret)
+	_ret.type_.SetParent(_ret)
 	return _ret
 }
 
-func (n *FunctionTypeNode) Visit(beforeChildren func(node Node) (visitChildren, exit bool), afterChildren func(node Node) (exit bool)) (exit bool) {
+func (n *ResultNameTypeNode) Visit(beforeChildren func(node Node) (visitChildren, exit bool), afterChildren func(node Node) (exit bool)) (exit bool) {
 	vc, e := beforeChildren(n)
 	if e {
 		return true
@@ -11211,10 +10862,10 @@ func (n *FunctionTypeNode) Visit(beforeChildren func(node Node) (visitChildren,
 	if !vc {
 		return false
 	}
-	if n.params.Visit(beforeChildren, afterChildren) {
+	if n.name.Visit(beforeChildren, afterChildren) {
 		return true
 	}
-	if n.results.Visit(beforeChildren, afterChildren) {
+	if n.type_.Visit(beforeChildren, afterChildren) {
 		return true
 	}
 	if afterChildren(n) {
@@ -11223,84 +10874,94 @@ func (n *FunctionTypeNode) Visit(beforeChildren func(node Node) (visitChildren,
 	return false
 }
 
-func (n *FunctionTypeNode) Dump(hook func(Node, map[string]string) string) map[string]string {
+func (n *ResultNameTypeNode) Accept(v Visitor) bool {
+	return v.VisitResultNameTypeNode(n)
+}
+
+func (n *ResultNameTypeNode) Rewrite(r Rewriter) Node {
+	n.name = rewriteChild(r, n.name)
+	n.type_ = rewriteChild(r, n.type_)
+	return r.RewriteResultNameTypeNode(n)
+}
+
+func (n *ResultNameTypeNode) Dump(hook func(Node, map[string]string) string) map[string]string {
 	ret := make(map[string]string)
-	ret["kind"] = "\"function_type\""
-	ret["params"] = DumpNode(n.Params(), hook)
-	ret["results"] = DumpNode(n.Results(), hook)
+	ret["kind"] = "\"result_name_type\""
+	ret["name"] = DumpNode(n.Name(), hook)
+	ret["type"] = DumpNode(n.Type(), hook)
 	return ret
 }
 
-func NewInterfaceTypeNode(filePath string, fileContent []rune, methods Node, start, end Position) Node {
-	if methods == nil {
-		methods = DummyNode
+func NewResultOneDeclNode(filePath string, fileContent []rune, type_ Node, start, end Position) Node {
+	if type_ == nil {
+		type_ = DummyNode
 	}
-	_1 := &InterfaceTypeNode{
-		BaseNode: NewBaseNode(filePath, fileContent, NodeTypeInterfaceType, start, end),
-		methods:  methods,
+	_1 := &ResultOneDeclNode{
+		BaseNode: NewBaseNode(filePath, fileContent, NodeTypeResultOneDecl, start, end),
+		type_:    type_,
 	}
 	creationHook(_1)
 	return _1
 }
 
-type InterfaceTypeNode struct {
+type ResultOneDeclNode struct {
 	*BaseNode
-	methods Node
+	type_ Node
 }
 
-func (n *InterfaceTypeNode) Methods() Node {
-	return n.methods
+func (n *ResultOneDeclNode) Type() Node {
+	return n.type_
 }
 
-func (n *InterfaceTypeNode) SetMethods(v Node) {
-	n.methods = v
+func (n *ResultOneDeclNode) SetType(v Node) {
+	n.type_ = v
 }
 
-func (n *InterfaceTypeNode) BuildLink() {
-	if !n.Methods().IsDummy() {
-		methods := n.Methods()
-		methods.BuildLink()
-		methods.SetParent(n)
-		methods.SetSelfField("methods")
-		methods.SetReplaceSelf(func(n Node) {
-			n.Parent().(*InterfaceTypeNode).SetMethods(n)
+func (n *ResultOneDeclNode) BuildLink() {
+	if !n.Type().IsDummy() {
+		type_ := n.Type()
+		type_.BuildLink()
+		type_.SetParent(n)
+		type_.SetSelfField("type_")
+		type_.SetReplaceSelf(func(n Node) {
+			n.Parent().(*ResultOneDeclNode).SetType(n)
 		})
 	}
 }
 
-func (n *InterfaceTypeNode) Fields() []string {
+func (n *ResultOneDeclNode) Fields() []string {
 	return []string{
-		"methods",
+		"type_",
 	}
 }
 
-func (n *InterfaceTypeNode) Child(field string) Node {
+func (n *ResultOneDeclNode) Child(field string) Node {
 	if field == "" {
 		return nil
 	}
-	if field == "methods" {
-		return n.Methods()
+	if field == "type_" {
+		return n.Type()
 	}
 	return nil
 }
 
-func (n *InterfaceTypeNode) SetChild(nodes []Node) {
+func (n *ResultOneDeclNode) SetChild(nodes []Node) {
 	if len(nodes) != 1 {
 		return
 	}
-	n.SetMethods(nodes[0])
+	n.SetType(nodes[0])
 }
 
-func (n *InterfaceTypeNode) Fork() Node {
-	_ret := &InterfaceTypeNode{
+func (n *ResultOneDeclNode) Fork() Node {
+	_ret := &ResultOneDeclNode{
 		BaseNode: n.BaseNode.fork(),
-		methods:  n.methods.Fork(),
+		type_:    n.type_.Fork(),
 	}
-	_ret.methods.SetParent(_ret)
+	_ret.type_.SetParent(_ret)
 	return _ret
 }
 
-func (n *InterfaceTypeNode) Visit(beforeChildren func(node Node) (visitChildren, exit bool), afterChildren func(node Node) (exit bool)) (exit bool) {
+func (n *ResultOneDeclNode) Visit(beforeChildren func(node Node) (visitChildren, exit bool), afterChildren func(node Node) (exit bool)) (exit bool) {
 	vc, e := beforeChildren(n)
 	if e {
 		return true
@@ -11308,7 +10969,7 @@ func (n *InterfaceTypeNode) Visit(beforeChildren func(node Node) (visitChildren,
 	if !vc {
 		return false
 	}
-	if n.methods.Visit(beforeChildren, afterChildren) {
+	if n.type_.Visit(beforeChildren, afterChildren) {
 		return true
 	}
 	if afterChildren(n) {
@@ -11317,112 +10978,92 @@ func (n *InterfaceTypeNode) Visit(beforeChildren func(node Node) (visitChildren,
 	return false
 }
 
-func (n *InterfaceTypeNode) Dump(hook func(Node, map[string]string) string) map[string]string {
+func (n *ResultOneDeclNode) Accept(v Visitor) bool {
+	return v.VisitResultOneDeclNode(n)
+}
+
+func (n *ResultOneDeclNode) Rewrite(r Rewriter) Node {
+	n.type_ = rewriteChild(r, n.type_)
+	return r.RewriteResultOneDeclNode(n)
+}
+
+func (n *ResultOneDeclNode) Dump(hook func(Node, map[string]string) string) map[string]string {
 	ret := make(map[string]string)
-	ret["kind"] = "\"interface_type\""
-	ret["methods"] = DumpNode(n.Methods(), hook)
+	ret["kind"] = "\"result_one_decl\""
+	ret["type"] = DumpNode(n.Type(), hook)
 	return ret
 }
 
-func NewMapTypeNode(filePath string, fileContent []rune, key Node, value Node, start, end Position) Node {
-	if key == nil {
-		key = DummyNode
-	}
-	if value == nil {
-		value = DummyNode
+func NewResultTypesDeclNode(filePath string, fileContent []rune, types Node, start, end Position) Node {
+	if types == nil {
+		types = DummyNode
 	}
-	_1 := &MapTypeNode{
-		BaseNode: NewBaseNode(filePath, fileContent, NodeTypeMapType, start, end),
-		key:      key,
-		value:    value,
+	_1 := &ResultTypesDeclNode{
+		BaseNode: NewBaseNode(filePath, fileContent, NodeTypeResultTypesDecl, start, end),
+		types:    types,
 	}
 	creationHook(_1)
 	return _1
 }
 
-type MapTypeNode struct {
+type ResultTypesDeclNode struct {
 	*BaseNode
-	key   Node
-	value Node
-}
-
-func (n *MapTypeNode) Key() Node {
-	return n.key
-}
-
-func (n *MapTypeNode) SetKey(v Node) {
-	n.key = v
+	types Node
 }
 
-func (n *MapTypeNode) Value() Node {
-	return n.value
+func (n *ResultTypesDeclNode) Types() Node {
+	return n.types
 }
 
-func (n *MapTypeNode) SetValue(v Node) {
-	n.value = v
+func (n *ResultTypesDeclNode) SetTypes(v Node) {
+	n.types = v
 }
 
-func (n *MapTypeNode) BuildLink() {
-	if !n.Key().IsDummy() {
-		key := n.Key()
-		key.BuildLink()
-		key.SetParent(n)
-		key.SetSelfField("key")
-		key.SetReplaceSelf(func(n Node) {
-			n.Parent().(*MapTypeNode).SetKey(n)
-		})
-	}
-	if !n.Value().IsDummy() {
-		value := n.Value()
-		value.BuildLink()
-		value.SetParent(n)
-		value.SetSelfField("value")
-		value.SetReplaceSelf(func(n Node) {
-			n.Parent().(*MapTypeNode).SetValue(n)
+func (n *ResultTypesDeclNode) BuildLink() {
+	if !n.Types().IsDummy() {
+		types := n.Types()
+		types.BuildLink()
+		types.SetParent(n)
+		types.SetSelfField("types")
+		types.SetReplaceSelf(func(n Node) {
+			n.Parent().(*ResultTypesDeclNode).SetTypes(n)
 		})
 	}
 }
 
-func (n *MapTypeNode) Fields() []string {
+func (n *ResultTypesDeclNode) Fields() []string {
 	return []string{
-		"key",
-		"value",
+		"types",
 	}
 }
 
-func (n *MapTypeNode) Child(field string) Node {
+func (n *ResultTypesDeclNode) Child(field string) Node {
 	if field == "" {
 		return nil
 	}
-	if field == "key" {
-		return n.Key()
-	}
-	if field == "value" {
-		return n.Value()
+	if field == "types" {
+		return n.Types()
 	}
 	return nil
 }
 
-func (n *MapTypeNode) SetChild(nodes []Node) {
-	if len(nodes) != 2 {
+func (n *ResultTypesDeclNode) SetChild(nodes []Node) {
+	if len(nodes) != 1 {
 		return
 	}
-	n.SetKey(nodes[0])
-	n.SetValue(nodes[1])
+	n.SetTypes(nodes[0])
 }
 
-func (n *MapTypeNode) Fork() Node {
-	_ret := &MapTypeNode{
+func (n *ResultTypesDeclNode) Fork() Node {
+	_ret := &ResultTypesDeclNode{
 		BaseNode: n.BaseNode.fork(),
-		key:      n.key.Fork(),
-		value:    n.value.Fork(),
+		types:    n.types.Fork(),
 	}
-	_ret.key.SetParent(_ret)
-	_ret.value.SetParent(_ret)
+	_ret.types.SetParent(_ret)
 	return _ret
 }
 
-func (n *MapTypeNode) Visit(beforeChildren func(node Node) (visitChildren, exit bool), afterChildren func(node Node) (exit bool)) (exit bool) {
+func (n *ResultTypesDeclNode) Visit(beforeChildren func(node Node) (visitChildren, exit bool), afterChildren func(node Node) (exit bool)) (exit bool) {
 	vc, e := beforeChildren(n)
 	if e {
 		return true
@@ -11430,10 +11071,7 @@ func (n *MapTypeNode) Visit(beforeChildren func(node Node) (visitChildren, exit
 	if !vc {
 		return false
 	}
-	if n.key.Visit(beforeChildren, afterChildren) {
-		return true
-	}
-	if n.value.Visit(beforeChildren, afterChildren) {
+	if n.types.Visit(beforeChildren, afterChildren) {
 		return true
 	}
 	if afterChildren(n) {
@@ -11442,84 +11080,92 @@ func (n *MapTypeNode) Visit(beforeChildren func(node Node) (visitChildren, exit
 	return false
 }
 
-func (n *MapTypeNode) Dump(hook func(Node, map[string]string) string) map[string]string {
+func (n *ResultTypesDeclNode) Accept(v Visitor) bool {
+	return v.VisitResultTypesDeclNode(n)
+}
+
+func (n *ResultTypesDeclNode) Rewrite(r Rewriter) Node {
+	n.types = rewriteChild(r, n.types)
+	return r.RewriteResultTypesDeclNode(n)
+}
+
+func (n *ResultTypesDeclNode) Dump(hook func(Node, map[string]string) string) map[string]string {
 	ret := make(map[string]string)
-	ret["kind"] = "\"map_type\""
-	ret["key"] = DumpNode(n.Key(), hook)
-	ret["value"] = DumpNode(n.Value(), hook)
+	ret["kind"] = "\"result_types_decl\""
+	ret["types"] = DumpNode(n.Types(), hook)
 	return ret
 }
 
-func NewStructTypeNode(filePath string, fileContent []rune, x Node, start, end Position) Node {
-	if x == nil {
-		x = DummyNode
+func NewSelectStmtNode(filePath string, fileContent []rune, s Node, start, end Position) Node {
+	if s == nil {
+		s = DummyNode
 	}
-	_1 := &StructTypeNode{
-		BaseNode: NewBaseNode(filePath, fileContent, NodeTypeStructType, start, end),
-		x:        x,
+	_1 := &SelectStmtNode{
+		BaseNode: NewBaseNode(filePath, fileContent, NodeTypeSelectStmt, start, end),
+		s:        s,
 	}
 	creationHook(_1)
 	return _1
 }
 
-type StructTypeNode struct {
+type SelectStmtNode struct {
 	*BaseNode
-	x Node
+	s Node
 }
 
-func (n *StructTypeNode) X() Node {
-	return n.x
+func (n *SelectStmtNode) S() Node {
+	return n.s
 }
 
-func (n *StructTypeNode) SetX(v Node) {
-	n.x = v
+func (n *SelectStmtNode) SetS(v Node) {
+	n.s = v
 }
 
-func (n *StructTypeNode) BuildLink() {
-	if !n.X().IsDummy() {
-		x := n.X()
-		x.BuildLink()
-		x.SetParent(n)
-		x.SetSelfField("x")
-		x.SetReplaceSelf(func(n Node) {
-			n.Parent().(*StructTypeNode).SetX(n)
+func (n *SelectStmtNode) BuildLink() {
+	if !n.S().IsDummy() {
+		s := n.S()
+		s.BuildLink()
+		s.SetParent(n)
+		s.SetSelfField("s")
+		s.SetReplaceSelf(func(n Node) {
+			n.Parent().(*SelectStmtNode).SetS(n)
 		})
 	}
 }
 
-func (n *StructTypeNode) Fields() []string {
+func (n *SelectStmtNode) Fields() []string {
 	return []string{
-		"x",
+		"s",
 	}
 }
 
-func (n *StructTypeNode) Child(field string) Node {
+func (n *SelectStmtNode) Child(field string) Node {
 	if field == "" {
 		return nil
 	}
-	if field == "x" {
-		return n.X()
+	if field == "s" {
+		return n.S()
 	}
 	return nil
 }
 
-func (n *StructTypeNode) SetChild(nodes []Node) {
+func (n *SelectStmtNode) SetChild(nodes []Node) {
 	if len(nodes) != 1 {
 		return
 	}
-	n.SetX(nodes[0])
+	n.SetS(nodes[0])
 }
 
-func (n *StructTypeNode) Fork() Node {
-	_ret := &StructTypeNode{
+func (n *SelectStmtNode) Fork() Node {
+	_ret := &SelectStmtNode{
 		BaseNode: n.BaseNode.fork(),
-		x:        n.x.Fork(),
+		s:        n.s.Fork(),
 	}
-	_ret.x.SetParent(_ret)
+	_ret.s.SetParent(_ret)
 	return _ret
 }
 
-func (n *StructTypeNode) Visit(beforeChildren func(node Node) (visitChildren, exit bool), afterChildren func(node Node) (exit bool)) (exit bool) {
+func (n *SelectStmtNode) Visit(beforeChildren func(node Node) (visitChildren, exit bool), afterChildren func(node Node) (exit bool)) (exit bool) {
 	vc, e := beforeChildren(n)
 	if e {
 		return true
@@ -11527,7 +11173,7 @@ func (n *StructTypeNode) Visit(beforeChildren func(node Node) (visitChildren, ex
 	if !vc {
 		return false
 	}
-	if n.x.Visit(beforeChildren, afterChildren) {
+	if n.s.Visit(beforeChildren, afterChildren) {
 		return true
 	}
 	if afterChildren(n) {
@@ -11536,197 +11182,7080 @@ func (n *StructTypeNode) Visit(beforeChildren func(node Node) (visitChildren, ex
 	return false
 }
 
-func (n *StructTypeNode) Dump(hook func(Node, map[string]string) string) map[string]string {
+func (n *SelectStmtNode) Accept(v Visitor) bool {
+	return v.VisitSelectStmtNode(n)
+}
+
+func (n *SelectStmtNode) stmtNode() {}
+
+func (n *SelectStmtNode) Rewrite(r Rewriter) Node {
+	n.s = rewriteChild(r, n.s)
+	return r.RewriteSelectStmtNode(n)
+}
+
+func (n *SelectStmtNode) Dump(hook func(Node, map[string]string) string) map[string]string {
 	ret := make(map[string]string)
-	ret["kind"] = "\"struct_type\""
-	ret["x"] = DumpNode(n.X(), hook)
+	ret["kind"] = "\"select_stmt\""
+	ret["s"] = DumpNode(n.S(), hook)
 	return ret
 }
 
-func NewTokenizer(filePath string, fileContent []rune) *Tokenizer {
-	tk := &Tokenizer{
-		_filePath:  filePath,
-		_buf:       fileContent,
-		_bufSize:   len(fileContent),
-		_pos:       Position{},
-		_prevPos:   Position{},
-		_lookahead: 0,
+func NewSelectorExprNode(filePath string, fileContent []rune, target Node, selector Node, start, end Position) Node {
+	if target == nil {
+		target = DummyNode
 	}
-	tk._lookahead = tk._safeRead()
-	tk.initKeywords()
-	return tk
+	if selector == nil {
+		selector = DummyNode
+	}
+	_1 := &SelectorExprNode{
+		BaseNode: NewBaseNode(filePath, fileContent, NodeTypeSelectorExpr, start, end),
+		target:   target,
+		selector: selector,
+	}
+	creationHook(_1)
+	return _1
 }
 
-type Tokenizer struct {
-	_filePath  string
-	_buf       []rune
-	_bufSize   int
-	_pos       Position
-	_prevPos   Position
-	_lookahead rune
-	_keywords  map[string]string
+type SelectorExprNode struct {
+	*BaseNode
+	target   Node
+	selector Node
 }
 
-func (tk *Tokenizer) Parse() (tokens []*Token, err error) {
-	tokens = make([]*Token, 0)
-	for {
-		var tok *Token
-		tok, err = tk.next()
-		if err != nil {
-			return nil, err
-		}
-		tokens = append(tokens, tok)
-		if tok.Kind == TokenTypeEndOfFile {
-			break
-		}
-	}
-	return tokens, nil
+func (n *SelectorExprNode) Target() Node {
+	return n.target
 }
 
-func (tk *Tokenizer) _lineEnd(ch rune) bool {
-	return ch == '\n' || (ch == '\r' && tk._pos.Offset < len(tk._buf) && tk._buf[tk._pos.Offset] != '\n')
+func (n *SelectorExprNode) SetTarget(v Node) {
+	n.target = v
 }
 
-func (tk *Tokenizer) _errorMsg(msg string) string {
-	return fmt.Sprintf("fail to tokenize %s\n%s", msg, errorContext(tk._filePath, tk._buf, tk._prevPos.Offset, tk._prevPos.LineIdx, tk._prevPos.CharIdx))
+func (n *SelectorExprNode) Selector() Node {
+	return n.selector
 }
 
-func (tk *Tokenizer) _stepForward(ch rune) {
-	p := &tk._pos
-	p.Offset++
-	p.CharIdx++
-	if tk._lineEnd(ch) {
-		p.LineIdx++
-		p.CharIdx = 0
+func (n *SelectorExprNode) SetSelector(v Node) {
+	n.selector = v
+}
+
+func (n *SelectorExprNode) BuildLink() {
+	if !n.Target().IsDummy() {
+		target := n.Target()
+		target.BuildLink()
+		target.SetParent(n)
+		target.SetSelfField("target")
+		target.SetReplaceSelf(func(n Node) {
+			n.Parent().(*SelectorExprNode).SetTarget(n)
+		})
+	}
+	if !n.Selector().IsDummy() {
+		selector := n.Selector()
+		selector.BuildLink()
+		selector.SetParent(n)
+		selector.SetSelfField("selector")
+		selector.SetReplaceSelf(func(n Node) {
+			n.Parent().(*SelectorExprNode).SetSelector(n)
+		})
 	}
 }
 
-func (tk *Tokenizer) _forward() {
-	tk._stepForward(tk._safeRead())
-	tk._lookahead = tk._safeRead()
+func (n *SelectorExprNode) Fields() []string {
+	return []string{
+		"target",
+		"selector",
+	}
 }
 
-func (tk *Tokenizer) _mark() Position {
-	return tk._pos
+func (n *SelectorExprNode) Child(field string) Node {
+	if field == "" {
+		return nil
+	}
+	if field == "target" {
+		return n.Target()
+	}
+	if field == "selector" {
+		return n.Selector()
+	}
+	return nil
 }
 
-func (tk *Tokenizer) _reset(p Position) {
-	tk._pos = p
-	tk._lookahead = tk._safeRead()
+func (n *SelectorExprNode) SetChild(nodes []Node) {
+	if len(nodes) != 2 {
+		return
+	}
+	n.SetTarget(nodes[0])
+	n.SetSelector(nodes[1])
 }
 
-func (tk *Tokenizer) _safeRead() rune {
-	if tk._pos.Offset >= tk._bufSize {
-		return '\x00'
-	} else {
-		return tk._buf[tk._pos.Offset]
+func (n *SelectorExprNode) Fork() Node {
+	_ret := &SelectorExprNode{
+		BaseNode: n.BaseNode.fork(),
+		target:   n.target.Fork(),
+		selector: n.selector.Fork(),
 	}
+	_ret.target.SetParent(_ret)
+	_ret.selector.SetParent(_ret)
+	return _ret
 }
 
-func (tk *Tokenizer) _expect(r rune) bool {
-	if equalRune(r, tk._lookahead) {
-		tk._forward()
+func (n *SelectorExprNode) Visit(beforeChildren func(node Node) (visitChildren, exit bool), afterChildren func(node Node) (exit bool)) (exit bool) {
+	vc, e := beforeChildren(n)
+	if e {
+		return true
+	}
+	if !vc {
+		return false
+	}
+	if n.target.Visit(beforeChildren, afterChildren) {
+		return true
+	}
+	if n.selector.Visit(beforeChildren, afterChildren) {
+		return true
+	}
+	if afterChildren(n) {
 		return true
 	}
 	return false
 }
 
-func (tk *Tokenizer) _expectS(s string) bool {
-	pos := tk._pos
-	for i := 0; i < len(s); i++ {
-		if equalRune(rune(s[i]), tk._lookahead) {
-			tk._forward()
-		} else {
-			tk._reset(pos)
-			return false
-		}
+func (n *SelectorExprNode) Accept(v Visitor) bool {
+	return v.VisitSelectorExprNode(n)
+}
+
+func (n *SelectorExprNode) exprNode() {}
+
+func (n *SelectorExprNode) Rewrite(r Rewriter) Node {
+	n.target = rewriteChild(r, n.target)
+	n.selector = rewriteChild(r, n.selector)
+	return r.RewriteSelectorExprNode(n)
+}
+
+func (n *SelectorExprNode) Dump(hook func(Node, map[string]string) string) map[string]string {
+	ret := make(map[string]string)
+	ret["kind"] = "\"selector_expr\""
+	ret["target"] = DumpNode(n.Target(), hook)
+	ret["selector"] = DumpNode(n.Selector(), hook)
+	return ret
+}
+
+func NewSendStmtNode(filePath string, fileContent []rune, x Node, y Node, start, end Position) Node {
+	if x == nil {
+		x = DummyNode
 	}
-	return true
+	if y == nil {
+		y = DummyNode
+	}
+	_1 := &SendStmtNode{
+		BaseNode: NewBaseNode(filePath, fileContent, NodeTypeSendStmt, start, end),
+		x:        x,
+		y:        y,
+	}
+	creationHook(_1)
+	return _1
 }
 
-func (tk *Tokenizer) _expectU(s []rune) bool {
-	pos := tk._pos
-	for i := 0; i < len(s); i++ {
-		if equalRune(s[i], tk._lookahead) {
-			tk._forward()
-		} else {
-			tk._reset(pos)
-			return false
-		}
+type SendStmtNode struct {
+	*BaseNode
+	x Node
+	y Node
+}
+
+func (n *SendStmtNode) X() Node {
+	return n.x
+}
+
+func (n *SendStmtNode) SetX(v Node) {
+	n.x = v
+}
+
+func (n *SendStmtNode) Y() Node {
+	return n.y
+}
+
+func (n *SendStmtNode) SetY(v Node) {
+	n.y = v
+}
+
+func (n *SendStmtNode) BuildLink() {
+	if !n.X().IsDummy() {
+		x := n.X()
+		x.BuildLink()
+		x.SetParent(n)
+		x.SetSelfField("x")
+		x.SetReplaceSelf(func(n Node) {
+			n.Parent().(*SendStmtNode).SetX(n)
+		})
+	}
+	if !n.Y().IsDummy() {
+		y := n.Y()
+		y.BuildLink()
+		y.SetParent(n)
+		y.SetSelfField("y")
+		y.SetReplaceSelf(func(n Node) {
+			n.Parent().(*SendStmtNode).SetY(n)
+		})
 	}
-	return true
 }
 
-func (tk *Tokenizer) _expectR(s, e rune) bool {
-	if inRange(tk._lookahead, s, e) {
-		tk._forward()
-		return true
+func (n *SendStmtNode) Fields() []string {
+	return []string{
+		"x",
+		"y",
 	}
-	return false
 }
 
-func (tk *Tokenizer) _anyButEof() bool {
-	if tk._lookahead != 0 {
-		tk._forward()
-		return true
-	} else {
-		return false
+func (n *SendStmtNode) Child(field string) Node {
+	if field == "" {
+		return nil
+	}
+	if field == "x" {
+		return n.X()
 	}
+	if field == "y" {
+		return n.Y()
+	}
+	return nil
 }
 
-func (tk *Tokenizer) _createToken(kind string) *Token {
-	val := tk._buf[tk._prevPos.Offset:tk._pos.Offset]
-	token := NewToken(kind, tk._prevPos, tk._pos, val)
-	tk._prevPos = tk._pos
-	return token
+func (n *SendStmtNode) SetChild(nodes []Node) {
+	if len(nodes) != 2 {
+		return
+	}
+	n.SetX(nodes[0])
+	n.SetY(nodes[1])
 }
 
-// newline:
-//
-//	| '\r\n'
-//	| '\n'
-//	| '\r'
-func (tk *Tokenizer) newline() bool {
-	// '\r\n'
-	if tk._expectS("\r\n") {
+func (n *SendStmtNode) Fork() Node {
+	_ret := &SendStmtNode{
+		BaseNode: n.BaseNode.fork(),
+		x:        n.x.Fork(),
+		y:        n.y.Fork(),
+	}
+	_ret.x.SetParent(_ret)
+	_ret.y.SetParent(_ret)
+	return _ret
+}
+
+func (n *SendStmtNode) Visit(beforeChildren func(node Node) (visitChildren, exit bool), afterChildren func(node Node) (exit bool)) (exit bool) {
+	vc, e := beforeChildren(n)
+	if e {
 		return true
 	}
-	// '\n'
-	if tk._expectS("\n") {
+	if !vc {
+		return false
+	}
+	if n.x.Visit(beforeChildren, afterChildren) {
 		return true
 	}
-	// '\r'
-	if tk._expectS("\r") {
+	if n.y.Visit(beforeChildren, afterChildren) {
+		return true
+	}
+	if afterChildren(n) {
 		return true
 	}
 	return false
 }
 
-// _any_but_eol:
-//
-//	| !newline _any_but_eof
-func (tk *Tokenizer) _anyButEol() bool {
-	// !newline _any_but_eof
-	_p := tk._mark()
-	_ok := false
-	if tk.newline() {
-		_ok = true
-	}
-	tk._reset(_p)
-	if !_ok {
-		if tk._anyButEof() {
-			return true
-		}
-	}
-	return false
+func (n *SendStmtNode) Accept(v Visitor) bool {
+	return v.VisitSendStmtNode(n)
 }
 
-// _whitespace_ch:
-//
-//	| [ \t\f\u1680\u180E\u2000-\u200A\u202F\u205F\u3000\uFEFF\u00A0]
-func (tk *Tokenizer) _whitespaceCh() bool {
-	// [ \t\f\u1680\u180E\u2000-\u200A\u202F\u205F\u3000\uFEFF\u00A0]
-	if tk._expect(0x20) || tk._expect(0x9) || tk._expect(0xC) || tk._expect(0x1680) || tk._expect(0x180E) || tk._expectR(0x2000, 0x200A) || tk._expect(0x202F) || tk._expect(0x205F) || tk._expect(0x3000) || tk._expect(0xFEFF) || tk._expect(0xA0) {
+func (n *SendStmtNode) stmtNode() {}
+
+func (n *SendStmtNode) Rewrite(r Rewriter) Node {
+	n.x = rewriteChild(r, n.x)
+	n.y = rewriteChild(r, n.y)
+	return r.RewriteSendStmtNode(n)
+}
+
+func (n *SendStmtNode) Dump(hook func(Node, map[string]string) string) map[string]string {
+	ret := make(map[string]string)
+	ret["kind"] = "\"send_stmt\""
+	ret["x"] = DumpNode(n.X(), hook)
+	ret["y"] = DumpNode(n.Y(), hook)
+	return ret
+}
+
+func NewShortVarDeclNode(filePath string, fileContent []rune, l Node, r Node, start, end Position) Node {
+	if l == nil {
+		l = DummyNode
+	}
+	if r == nil {
+		r = DummyNode
+	}
+	_1 := &ShortVarDeclNode{
+		BaseNode: NewBaseNode(filePath, fileContent, NodeTypeShortVarDecl, start, end),
+		l:        l,
+		r:        r,
+	}
+	creationHook(_1)
+	return _1
+}
+
+type ShortVarDeclNode struct {
+	*BaseNode
+	l Node
+	r Node
+}
+
+func (n *ShortVarDeclNode) L() Node {
+	return n.l
+}
+
+func (n *ShortVarDeclNode) SetL(v Node) {
+	n.l = v
+}
+
+func (n *ShortVarDeclNode) R() Node {
+	return n.r
+}
+
+func (n *ShortVarDeclNode) SetR(v Node) {
+	n.r = v
+}
+
+func (n *ShortVarDeclNode) BuildLink() {
+	if !n.L().IsDummy() {
+		l := n.L()
+		l.BuildLink()
+		l.SetParent(n)
+		l.SetSelfField("l")
+		l.SetReplaceSelf(func(n Node) {
+			n.Parent().(*ShortVarDeclNode).SetL(n)
+		})
+	}
+	if !n.R().IsDummy() {
+		r := n.R()
+		r.BuildLink()
+		r.SetParent(n)
+		r.SetSelfField("r")
+		r.SetReplaceSelf(func(n Node) {
+			n.Parent().(*ShortVarDeclNode).SetR(n)
+		})
+	}
+}
+
+func (n *ShortVarDeclNode) Fields() []string {
+	return []string{
+		"l",
+		"r",
+	}
+}
+
+func (n *ShortVarDeclNode) Child(field string) Node {
+	if field == "" {
+		return nil
+	}
+	if field == "l" {
+		return n.L()
+	}
+	if field == "r" {
+		return n.R()
+	}
+	return nil
+}
+
+func (n *ShortVarDeclNode) SetChild(nodes []Node) {
+	if len(nodes) != 2 {
+		return
+	}
+	n.SetL(nodes[0])
+	n.SetR(nodes[1])
+}
+
+func (n *ShortVarDeclNode) Fork() Node {
+	_ret := &ShortVarDeclNode{
+		BaseNode: n.BaseNode.fork(),
+		l:        n.l.Fork(),
+		r:        n.r.Fork(),
+	}
+	_ret.l.SetParent(_ret)
+	_ret.r.SetParent(_ret)
+	return _ret
+}
+
+func (n *ShortVarDeclNode) Visit(beforeChildren func(node Node) (visitChildren, exit bool), afterChildren func(node Node) (exit bool)) (exit bool) {
+	vc, e := beforeChildren(n)
+	if e {
+		return true
+	}
+	if !vc {
+		return false
+	}
+	if n.l.Visit(beforeChildren, afterChildren) {
+		return true
+	}
+	if n.r.Visit(beforeChildren, afterChildren) {
+		return true
+	}
+	if afterChildren(n) {
+		return true
+	}
+	return false
+}
+
+func (n *ShortVarDeclNode) Accept(v Visitor) bool {
+	return v.VisitShortVarDeclNode(n)
+}
+
+func (n *ShortVarDeclNode) stmtNode() {}
+
+func (n *ShortVarDeclNode) Rewrite(r Rewriter) Node {
+	n.l = rewriteChild(r, n.l)
+	n.r = rewriteChild(r, n.r)
+	return r.RewriteShortVarDeclNode(n)
+}
+
+func (n *ShortVarDeclNode) Dump(hook func(Node, map[string]string) string) map[string]string {
+	ret := make(map[string]string)
+	ret["kind"] = "\"short_var_decl\""
+	ret["l"] = DumpNode(n.L(), hook)
+	ret["r"] = DumpNode(n.R(), hook)
+	return ret
+}
+
+func NewStarExprNode(filePath string, fileContent []rune, x Node, start, end Position) Node {
+	if x == nil {
+		x = DummyNode
+	}
+	_1 := &StarExprNode{
+		BaseNode: NewBaseNode(filePath, fileContent, NodeTypeStarExpr, start, end),
+		x:        x,
+	}
+	creationHook(_1)
+	return _1
+}
+
+type StarExprNode struct {
+	*BaseNode
+	x Node
+}
+
+func (n *StarExprNode) X() Node {
+	return n.x
+}
+
+func (n *StarExprNode) SetX(v Node) {
+	n.x = v
+}
+
+func (n *StarExprNode) BuildLink() {
+	if !n.X().IsDummy() {
+		x := n.X()
+		x.BuildLink()
+		x.SetParent(n)
+		x.SetSelfField("x")
+		x.SetReplaceSelf(func(n Node) {
+			n.Parent().(*StarExprNode).SetX(n)
+		})
+	}
+}
+
+func (n *StarExprNode) Fields() []string {
+	return []string{
+		"x",
+	}
+}
+
+func (n *StarExprNode) Child(field string) Node {
+	if field == "" {
+		return nil
+	}
+	if field == "x" {
+		return n.X()
+	}
+	return nil
+}
+
+func (n *StarExprNode) SetChild(nodes []Node) {
+	if len(nodes) != 1 {
+		return
+	}
+	n.SetX(nodes[0])
+}
+
+func (n *StarExprNode) Fork() Node {
+	_ret := &StarExprNode{
+		BaseNode: n.BaseNode.fork(),
+		x:        n.x.Fork(),
+	}
+	_ret.x.SetParent(_ret)
+	return _ret
+}
+
+func (n *StarExprNode) Visit(beforeChildren func(node Node) (visitChildren, exit bool), afterChildren func(node Node) (exit bool)) (exit bool) {
+	vc, e := beforeChildren(n)
+	if e {
+		return true
+	}
+	if !vc {
+		return false
+	}
+	if n.x.Visit(beforeChildren, afterChildren) {
+		return true
+	}
+	if afterChildren(n) {
+		return true
+	}
+	return false
+}
+
+func (n *StarExprNode) Accept(v Visitor) bool {
+	return v.VisitStarExprNode(n)
+}
+
+func (n *StarExprNode) exprNode() {}
+
+func (n *StarExprNode) Rewrite(r Rewriter) Node {
+	n.x = rewriteChild(r, n.x)
+	return r.RewriteStarExprNode(n)
+}
+
+func (n *StarExprNode) Dump(hook func(Node, map[string]string) string) map[string]string {
+	ret := make(map[string]string)
+	ret["kind"] = "\"star_expr\""
+	ret["x"] = DumpNode(n.X(), hook)
+	return ret
+}
+
+func NewStringExprNode(filePath string, fileContent []rune, string_ Node, start, end Position) Node {
+	if string_ == nil {
+		string_ = DummyNode
+	}
+	_1 := &StringExprNode{
+		BaseNode: NewBaseNode(filePath, fileContent, NodeTypeStringExpr, start, end),
+		string_:  string_,
+	}
+	creationHook(_1)
+	return _1
+}
+
+type StringExprNode struct {
+	*BaseNode
+	string_ Node
+}
+
+func (n *StringExprNode) String() Node {
+	return n.string_
+}
+
+func (n *StringExprNode) SetString(v Node) {
+	n.string_ = v
+}
+
+func (n *StringExprNode) BuildLink() {
+	if !n.String().IsDummy() {
+		string_ := n.String()
+		string_.BuildLink()
+		string_.SetParent(n)
+		string_.SetSelfField("string_")
+		string_.SetReplaceSelf(func(n Node) {
+			n.Parent().(*StringExprNode).SetString(n)
+		})
+	}
+}
+
+func (n *StringExprNode) Fields() []string {
+	return []string{
+		"string_",
+	}
+}
+
+func (n *StringExprNode) Child(field string) Node {
+	if field == "" {
+		return nil
+	}
+	if field == "string_" {
+		return n.String()
+	}
+	return nil
+}
+
+func (n *StringExprNode) SetChild(nodes []Node) {
+	if len(nodes) != 1 {
+		return
+	}
+	n.SetString(nodes[0])
+}
+
+func (n *StringExprNode) Fork() Node {
+	_ret := &StringExprNode{
+		BaseNode: n.BaseNode.fork(),
+		string_:  n.string_.Fork(),
+	}
+	_ret.string_.SetParent(_ret)
+	return _ret
+}
+
+func (n *StringExprNode) Visit(beforeChildren func(node Node) (visitChildren, exit bool), afterChildren func(node Node) (exit bool)) (exit bool) {
+	vc, e := beforeChildren(n)
+	if e {
+		return true
+	}
+	if !vc {
+		return false
+	}
+	if n.string_.Visit(beforeChildren, afterChildren) {
+		return true
+	}
+	if afterChildren(n) {
+		return true
+	}
+	return false
+}
+
+func (n *StringExprNode) Accept(v Visitor) bool {
+	return v.VisitStringExprNode(n)
+}
+
+func (n *StringExprNode) exprNode() {}
+
+func (n *StringExprNode) Rewrite(r Rewriter) Node {
+	n.string_ = rewriteChild(r, n.string_)
+	return r.RewriteStringExprNode(n)
+}
+
+func (n *StringExprNode) Dump(hook func(Node, map[string]string) string) map[string]string {
+	ret := make(map[string]string)
+	ret["kind"] = "\"string_expr\""
+	ret["string"] = DumpNode(n.String(), hook)
+	return ret
+}
+
+func NewStructTypeNode(filePath string, fileContent []rune, b Node, start, end Position) Node {
+	if b == nil {
+		b = DummyNode
+	}
+	_1 := &StructTypeNode{
+		BaseNode: NewBaseNode(filePath, fileContent, NodeTypeStructType, start, end),
+		b:        b,
+	}
+	creationHook(_1)
+	return _1
+}
+
+type StructTypeNode struct {
+	*BaseNode
+	b Node
+}
+
+func (n *StructTypeNode) B() Node {
+	return n.b
+}
+
+func (n *StructTypeNode) SetB(v Node) {
+	n.b = v
+}
+
+func (n *StructTypeNode) BuildLink() {
+	if !n.B().IsDummy() {
+		b := n.B()
+		b.BuildLink()
+		b.SetParent(n)
+		b.SetSelfField("b")
+		b.SetReplaceSelf(func(n Node) {
+			n.Parent().(*StructTypeNode).SetB(n)
+		})
+	}
+}
+
+func (n *StructTypeNode) Fields() []string {
+	return []string{
+		"b",
+	}
+}
+
+func (n *StructTypeNode) Child(field string) Node {
+	if field == "" {
+		return nil
+	}
+	if field == "b" {
+		return n.B()
+	}
+	return nil
+}
+
+func (n *StructTypeNode) SetChild(nodes []Node) {
+	if len(nodes) != 1 {
+		return
+	}
+	n.SetB(nodes[0])
+}
+
+func (n *StructTypeNode) Fork() Node {
+	_ret := &StructTypeNode{
+		BaseNode: n.BaseNode.fork(),
+		b:        n.b.Fork(),
+	}
+	_ret.b.SetParent(_ret)
+	return _ret
+}
+
+func (n *StructTypeNode) Visit(beforeChildren func(node Node) (visitChildren, exit bool), afterChildren func(node Node) (exit bool)) (exit bool) {
+	vc, e := beforeChildren(n)
+	if e {
+		return true
+	}
+	if !vc {
+		return false
+	}
+	if n.b.Visit(beforeChildren, afterChildren) {
+		return true
+	}
+	if afterChildren(n) {
+		return true
+	}
+	return false
+}
+
+func (n *StructTypeNode) Accept(v Visitor) bool {
+	return v.VisitStructTypeNode(n)
+}
+
+func (n *StructTypeNode) typeNode() {}
+
+func (n *StructTypeNode) Rewrite(r Rewriter) Node {
+	n.b = rewriteChild(r, n.b)
+	return r.RewriteStructTypeNode(n)
+}
+
+func (n *StructTypeNode) Dump(hook func(Node, map[string]string) string) map[string]string {
+	ret := make(map[string]string)
+	ret["kind"] = "\"struct_type\""
+	ret["b"] = DumpNode(n.B(), hook)
+	return ret
+}
+
+func NewTypeArgumentDeclNode(filePath string, fileContent []rune, types Node, start, end Position) Node {
+	if types == nil {
+		types = DummyNode
+	}
+	_1 := &TypeArgumentDeclNode{
+		BaseNode: NewBaseNode(filePath, fileContent, NodeTypeTypeArgumentDecl, start, end),
+		types:    types,
+	}
+	creationHook(_1)
+	return _1
+}
+
+type TypeArgumentDeclNode struct {
+	*BaseNode
+	types Node
+}
+
+func (n *TypeArgumentDeclNode) Types() Node {
+	return n.types
+}
+
+func (n *TypeArgumentDeclNode) SetTypes(v Node) {
+	n.types = v
+}
+
+func (n *TypeArgumentDeclNode) BuildLink() {
+	if !n.Types().IsDummy() {
+		types := n.Types()
+		types.BuildLink()
+		types.SetParent(n)
+		types.SetSelfField("types")
+		types.SetReplaceSelf(func(n Node) {
+			n.Parent().(*TypeArgumentDeclNode).SetTypes(n)
+		})
+	}
+}
+
+func (n *TypeArgumentDeclNode) Fields() []string {
+	return []string{
+		"types",
+	}
+}
+
+func (n *TypeArgumentDeclNode) Child(field string) Node {
+	if field == "" {
+		return nil
+	}
+	if field == "types" {
+		return n.Types()
+	}
+	return nil
+}
+
+func (n *TypeArgumentDeclNode) SetChild(nodes []Node) {
+	if len(nodes) != 1 {
+		return
+	}
+	n.SetTypes(nodes[0])
+}
+
+func (n *TypeArgumentDeclNode) Fork() Node {
+	_ret := &TypeArgumentDeclNode{
+		BaseNode: n.BaseNode.fork(),
+		types:    n.types.Fork(),
+	}
+	_ret.types.SetParent(_ret)
+	return _ret
+}
+
+func (n *TypeArgumentDeclNode) Visit(beforeChildren func(node Node) (visitChildren, exit bool), afterChildren func(node Node) (exit bool)) (exit bool) {
+	vc, e := beforeChildren(n)
+	if e {
+		return true
+	}
+	if !vc {
+		return false
+	}
+	if n.types.Visit(beforeChildren, afterChildren) {
+		return true
+	}
+	if afterChildren(n) {
+		return true
+	}
+	return false
+}
+
+func (n *TypeArgumentDeclNode) Accept(v Visitor) bool {
+	return v.VisitTypeArgumentDeclNode(n)
+}
+
+func (n *TypeArgumentDeclNode) Rewrite(r Rewriter) Node {
+	n.types = rewriteChild(r, n.types)
+	return r.RewriteTypeArgumentDeclNode(n)
+}
+
+func (n *TypeArgumentDeclNode) Dump(hook func(Node, map[string]string) string) map[string]string {
+	ret := make(map[string]string)
+	ret["kind"] = "\"type_argument_decl\""
+	ret["types"] = DumpNode(n.Types(), hook)
+	return ret
+}
+
+func NewTypeAssertExprNode(filePath string, fileContent []rune, expr Node, type_ Node, start, end Position) Node {
+	if expr == nil {
+		expr = DummyNode
+	}
+	if type_ == nil {
+		type_ = DummyNode
+	}
+	_1 := &TypeAssertExprNode{
+		BaseNode: NewBaseNode(filePath, fileContent, NodeTypeTypeAssertExpr, start, end),
+		expr:     expr,
+		type_:    type_,
+	}
+	creationHook(_1)
+	return _1
+}
+
+type TypeAssertExprNode struct {
+	*BaseNode
+	expr  Node
+	type_ Node
+}
+
+func (n *TypeAssertExprNode) Expr() Node {
+	return n.expr
+}
+
+func (n *TypeAssertExprNode) SetExpr(v Node) {
+	n.expr = v
+}
+
+func (n *TypeAssertExprNode) Type() Node {
+	return n.type_
+}
+
+func (n *TypeAssertExprNode) SetType(v Node) {
+	n.type_ = v
+}
+
+func (n *TypeAssertExprNode) BuildLink() {
+	if !n.Expr().IsDummy() {
+		expr := n.Expr()
+		expr.BuildLink()
+		expr.SetParent(n)
+		expr.SetSelfField("expr")
+		expr.SetReplaceSelf(func(n Node) {
+			n.Parent().(*TypeAssertExprNode).SetExpr(n)
+		})
+	}
+	if !n.Type().IsDummy() {
+		type_ := n.Type()
+		type_.BuildLink()
+		type_.SetParent(n)
+		type_.SetSelfField("type_")
+		type_.SetReplaceSelf(func(n Node) {
+			n.Parent().(*TypeAssertExprNode).SetType(n)
+		})
+	}
+}
+
+func (n *TypeAssertExprNode) Fields() []string {
+	return []string{
+		"expr",
+		"type_",
+	}
+}
+
+func (n *TypeAssertExprNode) Child(field string) Node {
+	if field == "" {
+		return nil
+	}
+	if field == "expr" {
+		return n.Expr()
+	}
+	if field == "type_" {
+		return n.Type()
+	}
+	return nil
+}
+
+func (n *TypeAssertExprNode) SetChild(nodes []Node) {
+	if len(nodes) != 2 {
+		return
+	}
+	n.SetExpr(nodes[0])
+	n.SetType(nodes[1])
+}
+
+func (n *TypeAssertExprNode) Fork() Node {
+	_ret := &TypeAssertExprNode{
+		BaseNode: n.BaseNode.fork(),
+		expr:     n.expr.Fork(),
+		type_:    n.type_.Fork(),
+	}
+	_ret.expr.SetParent(_ret)
+	_ret.type_.SetParent(_ret)
+	return _ret
+}
+
+func (n *TypeAssertExprNode) Visit(beforeChildren func(node Node) (visitChildren, exit bool), afterChildren func(node Node) (exit bool)) (exit bool) {
+	vc, e := beforeChildren(n)
+	if e {
+		return true
+	}
+	if !vc {
+		return false
+	}
+	if n.expr.Visit(beforeChildren, afterChildren) {
+		return true
+	}
+	if n.type_.Visit(beforeChildren, afterChildren) {
+		return true
+	}
+	if afterChildren(n) {
+		return true
+	}
+	return false
+}
+
+func (n *TypeAssertExprNode) Accept(v Visitor) bool {
+	return v.VisitTypeAssertExprNode(n)
+}
+
+func (n *TypeAssertExprNode) exprNode() {}
+
+func (n *TypeAssertExprNode) Rewrite(r Rewriter) Node {
+	n.expr = rewriteChild(r, n.expr)
+	n.type_ = rewriteChild(r, n.type_)
+	return r.RewriteTypeAssertExprNode(n)
+}
+
+func (n *TypeAssertExprNode) Dump(hook func(Node, map[string]string) string) map[string]string {
+	ret := make(map[string]string)
+	ret["kind"] = "\"type_assert_expr\""
+	ret["expr"] = DumpNode(n.Expr(), hook)
+	ret["type"] = DumpNode(n.Type(), hook)
+	return ret
+}
+
+func NewTypeDeclNode(filePath string, fileContent []rune, x Node, start, end Position) Node {
+	if x == nil {
+		x = DummyNode
+	}
+	_1 := &TypeDeclNode{
+		BaseNode: NewBaseNode(filePath, fileContent, NodeTypeTypeDecl, start, end),
+		x:        x,
+	}
+	creationHook(_1)
+	return _1
+}
+
+type TypeDeclNode struct {
+	*BaseNode
+	x Node
+}
+
+func (n *TypeDeclNode) X() Node {
+	return n.x
+}
+
+func (n *TypeDeclNode) SetX(v Node) {
+	n.x = v
+}
+
+func (n *TypeDeclNode) BuildLink() {
+	if !n.X().IsDummy() {
+		x := n.X()
+		x.BuildLink()
+		x.SetParent(n)
+		x.SetSelfField("x")
+		x.SetReplaceSelf(func(n Node) {
+			n.Parent().(*TypeDeclNode).SetX(n)
+		})
+	}
+}
+
+func (n *TypeDeclNode) Fields() []string {
+	return []string{
+		"x",
+	}
+}
+
+func (n *TypeDeclNode) Child(field string) Node {
+	if field == "" {
+		return nil
+	}
+	if field == "x" {
+		return n.X()
+	}
+	return nil
+}
+
+func (n *TypeDeclNode) SetChild(nodes []Node) {
+	if len(nodes) != 1 {
+		return
+	}
+	n.SetX(nodes[0])
+}
+
+func (n *TypeDeclNode) Fork() Node {
+	_ret := &TypeDeclNode{
+		BaseNode: n.BaseNode.fork(),
+		x:        n.x.Fork(),
+	}
+	_ret.x.SetParent(_ret)
+	return _ret
+}
+
+func (n *TypeDeclNode) Visit(beforeChildren func(node Node) (visitChildren, exit bool), afterChildren func(node Node) (exit bool)) (exit bool) {
+	vc, e := beforeChildren(n)
+	if e {
+		return true
+	}
+	if !vc {
+		return false
+	}
+	if n.x.Visit(beforeChildren, afterChildren) {
+		return true
+	}
+	if afterChildren(n) {
+		return true
+	}
+	return false
+}
+
+func (n *TypeDeclNode) Accept(v Visitor) bool {
+	return v.VisitTypeDeclNode(n)
+}
+
+func (n *TypeDeclNode) declNode() {}
+
+func (n *TypeDeclNode) Rewrite(r Rewriter) Node {
+	n.x = rewriteChild(r, n.x)
+	return r.RewriteTypeDeclNode(n)
+}
+
+func (n *TypeDeclNode) Dump(hook func(Node, map[string]string) string) map[string]string {
+	ret := make(map[string]string)
+	ret["kind"] = "\"type_decl\""
+	ret["x"] = DumpNode(n.X(), hook)
+	return ret
+}
+
+func NewTypeIdentNode(filePath string, fileContent []rune, n Node, start, end Position) Node {
+	if n == nil {
+		n = DummyNode
+	}
+	_1 := &TypeIdentNode{
+		BaseNode: NewBaseNode(filePath, fileContent, NodeTypeTypeIdent, start, end),
+		n:        n,
+	}
+	creationHook(_1)
+	return _1
+}
+
+type TypeIdentNode struct {
+	*BaseNode
+	n Node
+}
+
+func (n *TypeIdentNode) N() Node {
+	return n.n
+}
+
+func (n *TypeIdentNode) SetN(v Node) {
+	n.n = v
+}
+
+func (n *TypeIdentNode) BuildLink() {
+	if !n.N().IsDummy() {
+		n := n.N()
+		n.BuildLink()
+		n.SetParent(n)
+		n.SetSelfField("n")
+		n.SetReplaceSelf(func(n Node) {
+			n.Parent().(*TypeIdentNode).SetN(n)
+		})
+	}
+}
+
+func (n *TypeIdentNode) Fields() []string {
+	return []string{
+		"n",
+	}
+}
+
+func (n *TypeIdentNode) Child(field string) Node {
+	if field == "" {
+		return nil
+	}
+	if field == "n" {
+		return n.N()
+	}
+	return nil
+}
+
+func (n *TypeIdentNode) SetChild(nodes []Node) {
+	if len(nodes) != 1 {
+		return
+	}
+	n.SetN(nodes[0])
+}
+
+func (n *TypeIdentNode) Fork() Node {
+	_ret := &TypeIdentNode{
+		BaseNode: n.BaseNode.fork(),
+		n:        n.n.Fork(),
+	}
+	_ret.n.SetParent(_ret)
+	return _ret
+}
+
+func (n *TypeIdentNode) Visit(beforeChildren func(node Node) (visitChildren, exit bool), afterChildren func(node Node) (exit bool)) (exit bool) {
+	vc, e := beforeChildren(n)
+	if e {
+		return true
+	}
+	if !vc {
+		return false
+	}
+	if n.n.Visit(beforeChildren, afterChildren) {
+		return true
+	}
+	if afterChildren(n) {
+		return true
+	}
+	return false
+}
+
+func (n *TypeIdentNode) Accept(v Visitor) bool {
+	return v.VisitTypeIdentNode(n)
+}
+
+func (n *TypeIdentNode) Rewrite(r Rewriter) Node {
+	n.n = rewriteChild(r, n.n)
+	return r.RewriteTypeIdentNode(n)
+}
+
+func (n *TypeIdentNode) Dump(hook func(Node, map[string]string) string) map[string]string {
+	ret := make(map[string]string)
+	ret["kind"] = "\"type_ident\""
+	ret["n"] = DumpNode(n.N(), hook)
+	return ret
+}
+
+func NewTypeSwitchGuardIdentNode(filePath string, fileContent []rune, i Node, start, end Position) Node {
+	if i == nil {
+		i = DummyNode
+	}
+	_1 := &TypeSwitchGuardIdentNode{
+		BaseNode: NewBaseNode(filePath, fileContent, NodeTypeTypeSwitchGuardIdent, start, end),
+		i:        i,
+	}
+	creationHook(_1)
+	return _1
+}
+
+type TypeSwitchGuardIdentNode struct {
+	*BaseNode
+	i Node
+}
+
+func (n *TypeSwitchGuardIdentNode) I() Node {
+	return n.i
+}
+
+func (n *TypeSwitchGuardIdentNode) SetI(v Node) {
+	n.i = v
+}
+
+func (n *TypeSwitchGuardIdentNode) BuildLink() {
+	if !n.I().IsDummy() {
+		i := n.I()
+		i.BuildLink()
+		i.SetParent(n)
+		i.SetSelfField("i")
+		i.SetReplaceSelf(func(n Node) {
+			n.Parent().(*TypeSwitchGuardIdentNode).SetI(n)
+		})
+	}
+}
+
+func (n *TypeSwitchGuardIdentNode) Fields() []string {
+	return []string{
+		"i",
+	}
+}
+
+func (n *TypeSwitchGuardIdentNode) Child(field string) Node {
+	if field == "" {
+		return nil
+	}
+	if field == "i" {
+		return n.I()
+	}
+	return nil
+}
+
+func (n *TypeSwitchGuardIdentNode) SetChild(nodes []Node) {
+	if len(nodes) != 1 {
+		return
+	}
+	n.SetI(nodes[0])
+}
+
+func (n *TypeSwitchGuardIdentNode) Fork() Node {
+	_ret := &TypeSwitchGuardIdentNode{
+		BaseNode: n.BaseNode.fork(),
+		i:        n.i.Fork(),
+	}
+	_ret.i.SetParent(_ret)
+	return _ret
+}
+
+func (n *TypeSwitchGuardIdentNode) Visit(beforeChildren func(node Node) (visitChildren, exit bool), afterChildren func(node Node) (exit bool)) (exit bool) {
+	vc, e := beforeChildren(n)
+	if e {
+		return true
+	}
+	if !vc {
+		return false
+	}
+	if n.i.Visit(beforeChildren, afterChildren) {
+		return true
+	}
+	if afterChildren(n) {
+		return true
+	}
+	return false
+}
+
+func (n *TypeSwitchGuardIdentNode) Accept(v Visitor) bool {
+	return v.VisitTypeSwitchGuardIdentNode(n)
+}
+
+func (n *TypeSwitchGuardIdentNode) Rewrite(r Rewriter) Node {
+	n.i = rewriteChild(r, n.i)
+	return r.RewriteTypeSwitchGuardIdentNode(n)
+}
+
+func (n *TypeSwitchGuardIdentNode) Dump(hook func(Node, map[string]string) string) map[string]string {
+	ret := make(map[string]string)
+	ret["kind"] = "\"type_switch_guard_ident\""
+	ret["i"] = DumpNode(n.I(), hook)
+	return ret
+}
+
+func NewUnaryExprNode(filePath string, fileContent []rune, op Node, expr Node, start, end Position) Node {
+	if op == nil {
+		op = DummyNode
+	}
+	if expr == nil {
+		expr = DummyNode
+	}
+	_1 := &UnaryExprNode{
+		BaseNode: NewBaseNode(filePath, fileContent, NodeTypeUnaryExpr, start, end),
+		op:       op,
+		expr:     expr,
+	}
+	creationHook(_1)
+	return _1
+}
+
+type UnaryExprNode struct {
+	*BaseNode
+	op   Node
+	expr Node
+}
+
+func (n *UnaryExprNode) Op() Node {
+	return n.op
+}
+
+func (n *UnaryExprNode) SetOp(v Node) {
+	n.op = v
+}
+
+func (n *UnaryExprNode) Expr() Node {
+	return n.expr
+}
+
+func (n *UnaryExprNode) SetExpr(v Node) {
+	n.expr = v
+}
+
+func (n *UnaryExprNode) BuildLink() {
+	if !n.Op().IsDummy() {
+		op := n.Op()
+		op.BuildLink()
+		op.SetParent(n)
+		op.SetSelfField("op")
+		op.SetReplaceSelf(func(n Node) {
+			n.Parent().(*UnaryExprNode).SetOp(n)
+		})
+	}
+	if !n.Expr().IsDummy() {
+		expr := n.Expr()
+		expr.BuildLink()
+		expr.SetParent(n)
+		expr.SetSelfField("expr")
+		expr.SetReplaceSelf(func(n Node) {
+			n.Parent().(*UnaryExprNode).SetExpr(n)
+		})
+	}
+}
+
+func (n *UnaryExprNode) Fields() []string {
+	return []string{
+		"op",
+		"expr",
+	}
+}
+
+func (n *UnaryExprNode) Child(field string) Node {
+	if field == "" {
+		return nil
+	}
+	if field == "op" {
+		return n.Op()
+	}
+	if field == "expr" {
+		return n.Expr()
+	}
+	return nil
+}
+
+func (n *UnaryExprNode) SetChild(nodes []Node) {
+	if len(nodes) != 2 {
+		return
+	}
+	n.SetOp(nodes[0])
+	n.SetExpr(nodes[1])
+}
+
+func (n *UnaryExprNode) Fork() Node {
+	_ret := &UnaryExprNode{
+		BaseNode: n.BaseNode.fork(),
+		op:       n.op.Fork(),
+		expr:     n.expr.Fork(),
+	}
+	_ret.op.SetParent(_ret)
+	_ret.expr.SetParent(_ret)
+	return _ret
+}
+
+func (n *UnaryExprNode) Visit(beforeChildren func(node Node) (visitChildren, exit bool), afterChildren func(node Node) (exit bool)) (exit bool) {
+	vc, e := beforeChildren(n)
+	if e {
+		return true
+	}
+	if !vc {
+		return false
+	}
+	if n.op.Visit(beforeChildren, afterChildren) {
+		return true
+	}
+	if n.expr.Visit(beforeChildren, afterChildren) {
+		return true
+	}
+	if afterChildren(n) {
+		return true
+	}
+	return false
+}
+
+func (n *UnaryExprNode) Accept(v Visitor) bool {
+	return v.VisitUnaryExprNode(n)
+}
+
+func (n *UnaryExprNode) exprNode() {}
+
+func (n *UnaryExprNode) Rewrite(r Rewriter) Node {
+	n.op = rewriteChild(r, n.op)
+	n.expr = rewriteChild(r, n.expr)
+	return r.RewriteUnaryExprNode(n)
+}
+
+func (n *UnaryExprNode) Dump(hook func(Node, map[string]string) string) map[string]string {
+	ret := make(map[string]string)
+	ret["kind"] = "\"unary_expr\""
+	ret["op"] = DumpNode(n.Op(), hook)
+	ret["expr"] = DumpNode(n.Expr(), hook)
+	return ret
+}
+
+func NewVarDeclNode(filePath string, fileContent []rune, x Node, start, end Position) Node {
+	if x == nil {
+		x = DummyNode
+	}
+	_1 := &VarDeclNode{
+		BaseNode: NewBaseNode(filePath, fileContent, NodeTypeVarDecl, start, end),
+		x:        x,
+	}
+	creationHook(_1)
+	return _1
+}
+
+type VarDeclNode struct {
+	*BaseNode
+	x Node
+}
+
+func (n *VarDeclNode) X() Node {
+	return n.x
+}
+
+func (n *VarDeclNode) SetX(v Node) {
+	n.x = v
+}
+
+func (n *VarDeclNode) BuildLink() {
+	if !n.X().IsDummy() {
+		x := n.X()
+		x.BuildLink()
+		x.SetParent(n)
+		x.SetSelfField("x")
+		x.SetReplaceSelf(func(n Node) {
+			n.Parent().(*VarDeclNode).SetX(n)
+		})
+	}
+}
+
+func (n *VarDeclNode) Fields() []string {
+	return []string{
+		"x",
+	}
+}
+
+func (n *VarDeclNode) Child(field string) Node {
+	if field == "" {
+		return nil
+	}
+	if field == "x" {
+		return n.X()
+	}
+	return nil
+}
+
+func (n *VarDeclNode) SetChild(nodes []Node) {
+	if len(nodes) != 1 {
+		return
+	}
+	n.SetX(nodes[0])
+}
+
+func (n *VarDeclNode) Fork() Node {
+	_ret := &VarDeclNode{
+		BaseNode: n.BaseNode.fork(),
+		x:        n.x.Fork(),
+	}
+	_ret.x.SetParent(_ret)
+	return _ret
+}
+
+func (n *VarDeclNode) Visit(beforeChildren func(node Node) (visitChildren, exit bool), afterChildren func(node Node) (exit bool)) (exit bool) {
+	vc, e := beforeChildren(n)
+	if e {
+		return true
+	}
+	if !vc {
+		return false
+	}
+	if n.x.Visit(beforeChildren, afterChildren) {
+		return true
+	}
+	if afterChildren(n) {
+		return true
+	}
+	return false
+}
+
+func (n *VarDeclNode) Accept(v Visitor) bool {
+	return v.VisitVarDeclNode(n)
+}
+
+func (n *VarDeclNode) declNode() {}
+
+func (n *VarDeclNode) Rewrite(r Rewriter) Node {
+	n.x = rewriteChild(r, n.x)
+	return r.RewriteVarDeclNode(n)
+}
+
+func (n *VarDeclNode) Dump(hook func(Node, map[string]string) string) map[string]string {
+	ret := make(map[string]string)
+	ret["kind"] = "\"var_decl\""
+	ret["x"] = DumpNode(n.X(), hook)
+	return ret
+}
+
+func NewVarIdentNode(filePath string, fileContent []rune, n Node, start, end Position) Node {
+	if n == nil {
+		n = DummyNode
+	}
+	_1 := &VarIdentNode{
+		BaseNode: NewBaseNode(filePath, fileContent, NodeTypeVarIdent, start, end),
+		n:        n,
+	}
+	creationHook(_1)
+	return _1
+}
+
+type VarIdentNode struct {
+	*BaseNode
+	n Node
+}
+
+func (n *VarIdentNode) N() Node {
+	return n.n
+}
+
+func (n *VarIdentNode) SetN(v Node) {
+	n.n = v
+}
+
+func (n *VarIdentNode) BuildLink() {
+	if !n.N().IsDummy() {
+		n := n.N()
+		n.BuildLink()
+		n.SetParent(n)
+		n.SetSelfField("n")
+		n.SetReplaceSelf(func(n Node) {
+			n.Parent().(*VarIdentNode).SetN(n)
+		})
+	}
+}
+
+func (n *VarIdentNode) Fields() []string {
+	return []string{
+		"n",
+	}
+}
+
+func (n *VarIdentNode) Child(field string) Node {
+	if field == "" {
+		return nil
+	}
+	if field == "n" {
+		return n.N()
+	}
+	return nil
+}
+
+func (n *VarIdentNode) SetChild(nodes []Node) {
+	if len(nodes) != 1 {
+		return
+	}
+	n.SetN(nodes[0])
+}
+
+func (n *VarIdentNode) Fork() Node {
+	_ret := &VarIdentNode{
+		BaseNode: n.BaseNode.fork(),
+		n:        n.n.Fork(),
+	}
+	_ret.n.SetParent(_ret)
+	return _ret
+}
+
+func (n *VarIdentNode) Visit(beforeChildren func(node Node) (visitChildren, exit bool), afterChildren func(node Node) (exit bool)) (exit bool) {
+	vc, e := beforeChildren(n)
+	if e {
+		return true
+	}
+	if !vc {
+		return false
+	}
+	if n.n.Visit(beforeChildren, afterChildren) {
+		return true
+	}
+	if afterChildren(n) {
+		return true
+	}
+	return false
+}
+
+func (n *VarIdentNode) Accept(v Visitor) bool {
+	return v.VisitVarIdentNode(n)
+}
+
+func (n *VarIdentNode) Rewrite(r Rewriter) Node {
+	n.n = rewriteChild(r, n.n)
+	return r.RewriteVarIdentNode(n)
+}
+
+func (n *VarIdentNode) Dump(hook func(Node, map[string]string) string) map[string]string {
+	ret := make(map[string]string)
+	ret["kind"] = "\"var_ident\""
+	ret["n"] = DumpNode(n.N(), hook)
+	return ret
+}
+
+func NewBreakStmtNode(filePath string, fileContent []rune, x Node, start, end Position) Node {
+	if x == nil {
+		x = DummyNode
+	}
+	_1 := &BreakStmtNode{
+		BaseNode: NewBaseNode(filePath, fileContent, NodeTypeBreakStmt, start, end),
+		x:        x,
+	}
+	creationHook(_1)
+	return _1
+}
+
+type BreakStmtNode struct {
+	*BaseNode
+	x Node
+}
+
+func (n *BreakStmtNode) X() Node {
+	return n.x
+}
+
+func (n *BreakStmtNode) SetX(v Node) {
+	n.x = v
+}
+
+func (n *BreakStmtNode) BuildLink() {
+	if !n.X().IsDummy() {
+		x := n.X()
+		x.BuildLink()
+		x.SetParent(n)
+		x.SetSelfField("x")
+		x.SetReplaceSelf(func(n Node) {
+			n.Parent().(*BreakStmtNode).SetX(n)
+		})
+	}
+}
+
+func (n *BreakStmtNode) Fields() []string {
+	return []string{
+		"x",
+	}
+}
+
+func (n *BreakStmtNode) Child(field string) Node {
+	if field == "" {
+		return nil
+	}
+	if field == "x" {
+		return n.X()
+	}
+	return nil
+}
+
+func (n *BreakStmtNode) SetChild(nodes []Node) {
+	if len(nodes) != 1 {
+		return
+	}
+	n.SetX(nodes[0])
+}
+
+func (n *BreakStmtNode) Fork() Node {
+	_ret := &BreakStmtNode{
+		BaseNode: n.BaseNode.fork(),
+		x:        n.x.Fork(),
+	}
+	_ret.x.SetParent(_ret)
+	return _ret
+}
+
+func (n *BreakStmtNode) Visit(beforeChildren func(node Node) (visitChildren, exit bool), afterChildren func(node Node) (exit bool)) (exit bool) {
+	vc, e := beforeChildren(n)
+	if e {
+		return true
+	}
+	if !vc {
+		return false
+	}
+	if n.x.Visit(beforeChildren, afterChildren) {
+		return true
+	}
+	if afterChildren(n) {
+		return true
+	}
+	return false
+}
+
+func (n *BreakStmtNode) Accept(v Visitor) bool {
+	return v.VisitBreakStmtNode(n)
+}
+
+func (n *BreakStmtNode) stmtNode() {}
+
+func (n *BreakStmtNode) Rewrite(r Rewriter) Node {
+	n.x = rewriteChild(r, n.x)
+	return r.RewriteBreakStmtNode(n)
+}
+
+func (n *BreakStmtNode) Dump(hook func(Node, map[string]string) string) map[string]string {
+	ret := make(map[string]string)
+	ret["kind"] = "\"break_stmt\""
+	ret["x"] = DumpNode(n.X(), hook)
+	return ret
+}
+
+func NewCallExprNode(filePath string, fileContent []rune, callee Node, typeArgument Node, argument Node, start, end Position) Node {
+	if callee == nil {
+		callee = DummyNode
+	}
+	if typeArgument == nil {
+		typeArgument = DummyNode
+	}
+	if argument == nil {
+		argument = DummyNode
+	}
+	_1 := &CallExprNode{
+		BaseNode:     NewBaseNode(filePath, fileContent, NodeTypeCallExpr, start, end),
+		callee:       callee,
+		typeArgument: typeArgument,
+		argument:     argument,
+	}
+	creationHook(_1)
+	return _1
+}
+
+type CallExprNode struct {
+	*BaseNode
+	callee       Node
+	typeArgument Node
+	argument     Node
+}
+
+func (n *CallExprNode) Callee() Node {
+	return n.callee
+}
+
+func (n *CallExprNode) SetCallee(v Node) {
+	n.callee = v
+}
+
+func (n *CallExprNode) TypeArgument() Node {
+	return n.typeArgument
+}
+
+func (n *CallExprNode) SetTypeArgument(v Node) {
+	n.typeArgument = v
+}
+
+func (n *CallExprNode) Argument() Node {
+	return n.argument
+}
+
+func (n *CallExprNode) SetArgument(v Node) {
+	n.argument = v
+}
+
+func (n *CallExprNode) BuildLink() {
+	if !n.Callee().IsDummy() {
+		callee := n.Callee()
+		callee.BuildLink()
+		callee.SetParent(n)
+		callee.SetSelfField("callee")
+		callee.SetReplaceSelf(func(n Node) {
+			n.Parent().(*CallExprNode).SetCallee(n)
+		})
+	}
+	if !n.TypeArgument().IsDummy() {
+		typeArgument := n.TypeArgument()
+		typeArgument.BuildLink()
+		typeArgument.SetParent(n)
+		typeArgument.SetSelfField("type_argument")
+		typeArgument.SetReplaceSelf(func(n Node) {
+			n.Parent().(*CallExprNode).SetTypeArgument(n)
+		})
+	}
+	if !n.Argument().IsDummy() {
+		argument := n.Argument()
+		argument.BuildLink()
+		argument.SetParent(n)
+		argument.SetSelfField("argument")
+		argument.SetReplaceSelf(func(n Node) {
+			n.Parent().(*CallExprNode).SetArgument(n)
+		})
+	}
+}
+
+func (n *CallExprNode) Fields() []string {
+	return []string{
+		"callee",
+		"type_argument",
+		"argument",
+	}
+}
+
+func (n *CallExprNode) Child(field string) Node {
+	if field == "" {
+		return nil
+	}
+	if field == "callee" {
+		return n.Callee()
+	}
+	if field == "type_argument" {
+		return n.TypeArgument()
+	}
+	if field == "argument" {
+		return n.Argument()
+	}
+	return nil
+}
+
+func (n *CallExprNode) SetChild(nodes []Node) {
+	if len(nodes) != 3 {
+		return
+	}
+	n.SetCallee(nodes[0])
+	n.SetTypeArgument(nodes[1])
+	n.SetArgument(nodes[2])
+}
+
+func (n *CallExprNode) Fork() Node {
+	_ret := &CallExprNode{
+		BaseNode:     n.BaseNode.fork(),
+		callee:       n.callee.Fork(),
+		typeArgument: n.typeArgument.Fork(),
+		argument:     n.argument.Fork(),
+	}
+	_ret.callee.SetParent(_ret)
+	_ret.typeArgument.SetParent(_ret)
+	_ret.argument.SetParent(_ret)
+	return _ret
+}
+
+func (n *CallExprNode) Visit(beforeChildren func(node Node) (visitChildren, exit bool), afterChildren func(node Node) (exit bool)) (exit bool) {
+	vc, e := beforeChildren(n)
+	if e {
+		return true
+	}
+	if !vc {
+		return false
+	}
+	if n.callee.Visit(beforeChildren, afterChildren) {
+		return true
+	}
+	if n.typeArgument.Visit(beforeChildren, afterChildren) {
+		return true
+	}
+	if n.argument.Visit(beforeChildren, afterChildren) {
+		return true
+	}
+	if afterChildren(n) {
+		return true
+	}
+	return false
+}
+
+func (n *CallExprNode) Accept(v Visitor) bool {
+	return v.VisitCallExprNode(n)
+}
+
+func (n *CallExprNode) exprNode() {}
+
+func (n *CallExprNode) Rewrite(r Rewriter) Node {
+	n.callee = rewriteChild(r, n.callee)
+	n.typeArgument = rewriteChild(r, n.typeArgument)
+	n.argument = rewriteChild(r, n.argument)
+	return r.RewriteCallExprNode(n)
+}
+
+func (n *CallExprNode) Dump(hook func(Node, map[string]string) string) map[string]string {
+	ret := make(map[string]string)
+	ret["kind"] = "\"call_expr\""
+	ret["callee"] = DumpNode(n.Callee(), hook)
+	ret["type_argument"] = DumpNode(n.TypeArgument(), hook)
+	ret["argument"] = DumpNode(n.Argument(), hook)
+	return ret
+}
+
+func NewCompositeLitNode(filePath string, fileContent []rune, x Node, y Node, start, end Position) Node {
+	if x == nil {
+		x = DummyNode
+	}
+	if y == nil {
+		y = DummyNode
+	}
+	_1 := &CompositeLitNode{
+		BaseNode: NewBaseNode(filePath, fileContent, NodeTypeCompositeLit, start, end),
+		x:        x,
+		y:        y,
+	}
+	creationHook(_1)
+	return _1
+}
+
+type CompositeLitNode struct {
+	*BaseNode
+	x Node
+	y Node
+}
+
+func (n *CompositeLitNode) X() Node {
+	return n.x
+}
+
+func (n *CompositeLitNode) SetX(v Node) {
+	n.x = v
+}
+
+func (n *CompositeLitNode) Y() Node {
+	return n.y
+}
+
+func (n *CompositeLitNode) SetY(v Node) {
+	n.y = v
+}
+
+func (n *CompositeLitNode) BuildLink() {
+	if !n.X().IsDummy() {
+		x := n.X()
+		x.BuildLink()
+		x.SetParent(n)
+		x.SetSelfField("x")
+		x.SetReplaceSelf(func(n Node) {
+			n.Parent().(*CompositeLitNode).SetX(n)
+		})
+	}
+	if !n.Y().IsDummy() {
+		y := n.Y()
+		y.BuildLink()
+		y.SetParent(n)
+		y.SetSelfField("y")
+		y.SetReplaceSelf(func(n Node) {
+			n.Parent().(*CompositeLitNode).SetY(n)
+		})
+	}
+}
+
+func (n *CompositeLitNode) Fields() []string {
+	return []string{
+		"x",
+		"y",
+	}
+}
+
+func (n *CompositeLitNode) Child(field string) Node {
+	if field == "" {
+		return nil
+	}
+	if field == "x" {
+		return n.X()
+	}
+	if field == "y" {
+		return n.Y()
+	}
+	return nil
+}
+
+func (n *CompositeLitNode) SetChild(nodes []Node) {
+	if len(nodes) != 2 {
+		return
+	}
+	n.SetX(nodes[0])
+	n.SetY(nodes[1])
+}
+
+func (n *CompositeLitNode) Fork() Node {
+	_ret := &CompositeLitNode{
+		BaseNode: n.BaseNode.fork(),
+		x:        n.x.Fork(),
+		y:        n.y.Fork(),
+	}
+	_ret.x.SetParent(_ret)
+	_ret.y.SetParent(_ret)
+	return _ret
+}
+
+func (n *CompositeLitNode) Visit(beforeChildren func(node Node) (visitChildren, exit bool), afterChildren func(node Node) (exit bool)) (exit bool) {
+	vc, e := beforeChildren(n)
+	if e {
+		return true
+	}
+	if !vc {
+		return false
+	}
+	if n.x.Visit(beforeChildren, afterChildren) {
+		return true
+	}
+	if n.y.Visit(beforeChildren, afterChildren) {
+		return true
+	}
+	if afterChildren(n) {
+		return true
+	}
+	return false
+}
+
+func (n *CompositeLitNode) Accept(v Visitor) bool {
+	return v.VisitCompositeLitNode(n)
+}
+
+func (n *CompositeLitNode) exprNode() {}
+
+func (n *CompositeLitNode) Rewrite(r Rewriter) Node {
+	n.x = rewriteChild(r, n.x)
+	n.y = rewriteChild(r, n.y)
+	return r.RewriteCompositeLitNode(n)
+}
+
+func (n *CompositeLitNode) Dump(hook func(Node, map[string]string) string) map[string]string {
+	ret := make(map[string]string)
+	ret["kind"] = "\"composite_lit\""
+	ret["x"] = DumpNode(n.X(), hook)
+	ret["y"] = DumpNode(n.Y(), hook)
+	return ret
+}
+
+func NewConstNameTypeValueNode(filePath string, fileContent []rune, names Node, type_ Node, values Node, start, end Position) Node {
+	if names == nil {
+		names = DummyNode
+	}
+	if type_ == nil {
+		type_ = DummyNode
+	}
+	if values == nil {
+		values = DummyNode
+	}
+	_1 := &ConstNameTypeValueNode{
+		BaseNode: NewBaseNode(filePath, fileContent, NodeTypeConstNameTypeValue, start, end),
+		names:    names,
+		type_:    type_,
+		values:   values,
+	}
+	creationHook(_1)
+	return _1
+}
+
+type ConstNameTypeValueNode struct {
+	*BaseNode
+	names  Node
+	type_  Node
+	values Node
+}
+
+func (n *ConstNameTypeValueNode) Names() Node {
+	return n.names
+}
+
+func (n *ConstNameTypeValueNode) SetNames(v Node) {
+	n.names = v
+}
+
+func (n *ConstNameTypeValueNode) Type() Node {
+	return n.type_
+}
+
+func (n *ConstNameTypeValueNode) SetType(v Node) {
+	n.type_ = v
+}
+
+func (n *ConstNameTypeValueNode) Values() Node {
+	return n.values
+}
+
+func (n *ConstNameTypeValueNode) SetValues(v Node) {
+	n.values = v
+}
+
+func (n *ConstNameTypeValueNode) BuildLink() {
+	if !n.Names().IsDummy() {
+		names := n.Names()
+		names.BuildLink()
+		names.SetParent(n)
+		names.SetSelfField("names")
+		names.SetReplaceSelf(func(n Node) {
+			n.Parent().(*ConstNameTypeValueNode).SetNames(n)
+		})
+	}
+	if !n.Type().IsDummy() {
+		type_ := n.Type()
+		type_.BuildLink()
+		type_.SetParent(n)
+		type_.SetSelfField("type_")
+		type_.SetReplaceSelf(func(n Node) {
+			n.Parent().(*ConstNameTypeValueNode).SetType(n)
+		})
+	}
+	if !n.Values().IsDummy() {
+		values := n.Values()
+		values.BuildLink()
+		values.SetParent(n)
+		values.SetSelfField("values")
+		values.SetReplaceSelf(func(n Node) {
+			n.Parent().(*ConstNameTypeValueNode).SetValues(n)
+		})
+	}
+}
+
+func (n *ConstNameTypeValueNode) Fields() []string {
+	return []string{
+		"names",
+		"type_",
+		"values",
+	}
+}
+
+func (n *ConstNameTypeValueNode) Child(field string) Node {
+	if field == "" {
+		return nil
+	}
+	if field == "names" {
+		return n.Names()
+	}
+	if field == "type_" {
+		return n.Type()
+	}
+	if field == "values" {
+		return n.Values()
+	}
+	return nil
+}
+
+func (n *ConstNameTypeValueNode) SetChild(nodes []Node) {
+	if len(nodes) != 3 {
+		return
+	}
+	n.SetNames(nodes[0])
+	n.SetType(nodes[1])
+	n.SetValues(nodes[2])
+}
+
+func (n *ConstNameTypeValueNode) Fork() Node {
+	_ret := &ConstNameTypeValueNode{
+		BaseNode: n.BaseNode.fork(),
+		names:    n.names.Fork(),
+		type_:    n.type_.Fork(),
+		values:   n.values.Fork(),
+	}
+	_ret.names.SetParent(_ret)
+	_ret.type_.SetParent(_ret)
+	_ret.values.SetParent(_ret)
+	return _ret
+}
+
+func (n *ConstNameTypeValueNode) Visit(beforeChildren func(node Node) (visitChildren, exit bool), afterChildren func(node Node) (exit bool)) (exit bool) {
+	vc, e := beforeChildren(n)
+	if e {
+		return true
+	}
+	if !vc {
+		return false
+	}
+	if n.names.Visit(beforeChildren, afterChildren) {
+		return true
+	}
+	if n.type_.Visit(beforeChildren, afterChildren) {
+		return true
+	}
+	if n.values.Visit(beforeChildren, afterChildren) {
+		return true
+	}
+	if afterChildren(n) {
+		return true
+	}
+	return false
+}
+
+func (n *ConstNameTypeValueNode) Accept(v Visitor) bool {
+	return v.VisitConstNameTypeValueNode(n)
+}
+
+func (n *ConstNameTypeValueNode) Rewrite(r Rewriter) Node {
+	n.names = rewriteChild(r, n.names)
+	n.type_ = rewriteChild(r, n.type_)
+	n.values = rewriteChild(r, n.values)
+	return r.RewriteConstNameTypeValueNode(n)
+}
+
+func (n *ConstNameTypeValueNode) Dump(hook func(Node, map[string]string) string) map[string]string {
+	ret := make(map[string]string)
+	ret["kind"] = "\"const_name_type_value\""
+	ret["names"] = DumpNode(n.Names(), hook)
+	ret["type"] = DumpNode(n.Type(), hook)
+	ret["values"] = DumpNode(n.Values(), hook)
+	return ret
+}
+
+func NewContinueStmtNode(filePath string, fileContent []rune, x Node, start, end Position) Node {
+	if x == nil {
+		x = DummyNode
+	}
+	_1 := &ContinueStmtNode{
+		BaseNode: NewBaseNode(filePath, fileContent, NodeTypeContinueStmt, start, end),
+		x:        x,
+	}
+	creationHook(_1)
+	return _1
+}
+
+type ContinueStmtNode struct {
+	*BaseNode
+	x Node
+}
+
+func (n *ContinueStmtNode) X() Node {
+	return n.x
+}
+
+func (n *ContinueStmtNode) SetX(v Node) {
+	n.x = v
+}
+
+func (n *ContinueStmtNode) BuildLink() {
+	if !n.X().IsDummy() {
+		x := n.X()
+		x.BuildLink()
+		x.SetParent(n)
+		x.SetSelfField("x")
+		x.SetReplaceSelf(func(n Node) {
+			n.Parent().(*ContinueStmtNode).SetX(n)
+		})
+	}
+}
+
+func (n *ContinueStmtNode) Fields() []string {
+	return []string{
+		"x",
+	}
+}
+
+func (n *ContinueStmtNode) Child(field string) Node {
+	if field == "" {
+		return nil
+	}
+	if field == "x" {
+		return n.X()
+	}
+	return nil
+}
+
+func (n *ContinueStmtNode) SetChild(nodes []Node) {
+	if len(nodes) != 1 {
+		return
+	}
+	n.SetX(nodes[0])
+}
+
+func (n *ContinueStmtNode) Fork() Node {
+	_ret := &ContinueStmtNode{
+		BaseNode: n.BaseNode.fork(),
+		x:        n.x.Fork(),
+	}
+	_ret.x.SetParent(_ret)
+	return _ret
+}
+
+func (n *ContinueStmtNode) Visit(beforeChildren func(node Node) (visitChildren, exit bool), afterChildren func(node Node) (exit bool)) (exit bool) {
+	vc, e := beforeChildren(n)
+	if e {
+		return true
+	}
+	if !vc {
+		return false
+	}
+	if n.x.Visit(beforeChildren, afterChildren) {
+		return true
+	}
+	if afterChildren(n) {
+		return true
+	}
+	return false
+}
+
+func (n *ContinueStmtNode) Accept(v Visitor) bool {
+	return v.VisitContinueStmtNode(n)
+}
+
+func (n *ContinueStmtNode) stmtNode() {}
+
+func (n *ContinueStmtNode) Rewrite(r Rewriter) Node {
+	n.x = rewriteChild(r, n.x)
+	return r.RewriteContinueStmtNode(n)
+}
+
+func (n *ContinueStmtNode) Dump(hook func(Node, map[string]string) string) map[string]string {
+	ret := make(map[string]string)
+	ret["kind"] = "\"continue_stmt\""
+	ret["x"] = DumpNode(n.X(), hook)
+	return ret
+}
+
+func NewDefaultClauseNode(filePath string, fileContent []rune, x Node, start, end Position) Node {
+	if x == nil {
+		x = DummyNode
+	}
+	_1 := &DefaultClauseNode{
+		BaseNode: NewBaseNode(filePath, fileContent, NodeTypeDefaultClause, start, end),
+		x:        x,
+	}
+	creationHook(_1)
+	return _1
+}
+
+type DefaultClauseNode struct {
+	*BaseNode
+	x Node
+}
+
+func (n *DefaultClauseNode) X() Node {
+	return n.x
+}
+
+func (n *DefaultClauseNode) SetX(v Node) {
+	n.x = v
+}
+
+func (n *DefaultClauseNode) BuildLink() {
+	if !n.X().IsDummy() {
+		x := n.X()
+		x.BuildLink()
+		x.SetParent(n)
+		x.SetSelfField("x")
+		x.SetReplaceSelf(func(n Node) {
+			n.Parent().(*DefaultClauseNode).SetX(n)
+		})
+	}
+}
+
+func (n *DefaultClauseNode) Fields() []string {
+	return []string{
+		"x",
+	}
+}
+
+func (n *DefaultClauseNode) Child(field string) Node {
+	if field == "" {
+		return nil
+	}
+	if field == "x" {
+		return n.X()
+	}
+	return nil
+}
+
+func (n *DefaultClauseNode) SetChild(nodes []Node) {
+	if len(nodes) != 1 {
+		return
+	}
+	n.SetX(nodes[0])
+}
+
+func (n *DefaultClauseNode) Fork() Node {
+	_ret := &DefaultClauseNode{
+		BaseNode: n.BaseNode.fork(),
+		x:        n.x.Fork(),
+	}
+	_ret.x.SetParent(_ret)
+	return _ret
+}
+
+func (n *DefaultClauseNode) Visit(beforeChildren func(node Node) (visitChildren, exit bool), afterChildren func(node Node) (exit bool)) (exit bool) {
+	vc, e := beforeChildren(n)
+	if e {
+		return true
+	}
+	if !vc {
+		return false
+	}
+	if n.x.Visit(beforeChildren, afterChildren) {
+		return true
+	}
+	if afterChildren(n) {
+		return true
+	}
+	return false
+}
+
+func (n *DefaultClauseNode) Accept(v Visitor) bool {
+	return v.VisitDefaultClauseNode(n)
+}
+
+func (n *DefaultClauseNode) Rewrite(r Rewriter) Node {
+	n.x = rewriteChild(r, n.x)
+	return r.RewriteDefaultClauseNode(n)
+}
+
+func (n *DefaultClauseNode) Dump(hook func(Node, map[string]string) string) map[string]string {
+	ret := make(map[string]string)
+	ret["kind"] = "\"default_clause\""
+	ret["x"] = DumpNode(n.X(), hook)
+	return ret
+}
+
+func NewExprCaseClauseNode(filePath string, fileContent []rune, x Node, y Node, start, end Position) Node {
+	if x == nil {
+		x = DummyNode
+	}
+	if y == nil {
+		y = DummyNode
+	}
+	_1 := &ExprCaseClauseNode{
+		BaseNode: NewBaseNode(filePath, fileContent, NodeTypeExprCaseClause, start, end),
+		x:        x,
+		y:        y,
+	}
+	creationHook(_1)
+	return _1
+}
+
+type ExprCaseClauseNode struct {
+	*BaseNode
+	x Node
+	y Node
+}
+
+func (n *ExprCaseClauseNode) X() Node {
+	return n.x
+}
+
+func (n *ExprCaseClauseNode) SetX(v Node) {
+	n.x = v
+}
+
+func (n *ExprCaseClauseNode) Y() Node {
+	return n.y
+}
+
+func (n *ExprCaseClauseNode) SetY(v Node) {
+	n.y = v
+}
+
+func (n *ExprCaseClauseNode) BuildLink() {
+	if !n.X().IsDummy() {
+		x := n.X()
+		x.BuildLink()
+		x.SetParent(n)
+		x.SetSelfField("x")
+		x.SetReplaceSelf(func(n Node) {
+			n.Parent().(*ExprCaseClauseNode).SetX(n)
+		})
+	}
+	if !n.Y().IsDummy() {
+		y := n.Y()
+		y.BuildLink()
+		y.SetParent(n)
+		y.SetSelfField("y")
+		y.SetReplaceSelf(func(n Node) {
+			n.Parent().(*ExprCaseClauseNode).SetY(n)
+		})
+	}
+}
+
+func (n *ExprCaseClauseNode) Fields() []string {
+	return []string{
+		"x",
+		"y",
+	}
+}
+
+func (n *ExprCaseClauseNode) Child(field string) Node {
+	if field == "" {
+		return nil
+	}
+	if field == "x" {
+		return n.X()
+	}
+	if field == "y" {
+		return n.Y()
+	}
+	return nil
+}
+
+func (n *ExprCaseClauseNode) SetChild(nodes []Node) {
+	if len(nodes) != 2 {
+		return
+	}
+	n.SetX(nodes[0])
+	n.SetY(nodes[1])
+}
+
+func (n *ExprCaseClauseNode) Fork() Node {
+	_ret := &ExprCaseClauseNode{
+		BaseNode: n.BaseNode.fork(),
+		x:        n.x.Fork(),
+		y:        n.y.Fork(),
+	}
+	_ret.x.SetParent(_ret)
+	_ret.y.SetParent(_ret)
+	return _ret
+}
+
+func (n *ExprCaseClauseNode) Visit(beforeChildren func(node Node) (visitChildren, exit bool), afterChildren func(node Node) (exit bool)) (exit bool) {
+	vc, e := beforeChildren(n)
+	if e {
+		return true
+	}
+	if !vc {
+		return false
+	}
+	if n.x.Visit(beforeChildren, afterChildren) {
+		return true
+	}
+	if n.y.Visit(beforeChildren, afterChildren) {
+		return true
+	}
+	if afterChildren(n) {
+		return true
+	}
+	return false
+}
+
+func (n *ExprCaseClauseNode) Accept(v Visitor) bool {
+	return v.VisitExprCaseClauseNode(n)
+}
+
+func (n *ExprCaseClauseNode) Rewrite(r Rewriter) Node {
+	n.x = rewriteChild(r, n.x)
+	n.y = rewriteChild(r, n.y)
+	return r.RewriteExprCaseClauseNode(n)
+}
+
+func (n *ExprCaseClauseNode) Dump(hook func(Node, map[string]string) string) map[string]string {
+	ret := make(map[string]string)
+	ret["kind"] = "\"expr_case_clause\""
+	ret["x"] = DumpNode(n.X(), hook)
+	ret["y"] = DumpNode(n.Y(), hook)
+	return ret
+}
+
+func NewFileNode(filePath string, fileContent []rune, package_ Node, imports Node, decls Node, start, end Position) Node {
+	if package_ == nil {
+		package_ = DummyNode
+	}
+	if imports == nil {
+		imports = DummyNode
+	}
+	if decls == nil {
+		decls = DummyNode
+	}
+	_1 := &FileNode{
+		BaseNode: NewBaseNode(filePath, fileContent, NodeTypeFile, start, end),
+		package_: package_,
+		imports:  imports,
+		decls:    decls,
+	}
+	creationHook(_1)
+	return _1
+}
+
+// A Go source file: the package clause, the imports and the top-level declarations.
+type FileNode struct {
+	*BaseNode
+	package_ Node
+	imports  Node
+	decls    Node
+}
+
+func (n *FileNode) Package() Node {
+	return n.package_
+}
+
+func (n *FileNode) SetPackage(v Node) {
+	n.package_ = v
+}
+
+func (n *FileNode) Imports() Node {
+	return n.imports
+}
+
+func (n *FileNode) SetImports(v Node) {
+	n.imports = v
+}
+
+func (n *FileNode) Decls() Node {
+	return n.decls
+}
+
+func (n *FileNode) SetDecls(v Node) {
+	n.decls = v
+}
+
+func (n *FileNode) BuildLink() {
+	if !n.Package().IsDummy() {
+		package_ := n.Package()
+		package_.BuildLink()
+		package_.SetParent(n)
+		package_.SetSelfField("package_")
+		package_.SetReplaceSelf(func(n Node) {
+			n.Parent().(*FileNode).SetPackage(n)
+		})
+	}
+	if !n.Imports().IsDummy() {
+		imports := n.Imports()
+		imports.BuildLink()
+		imports.SetParent(n)
+		imports.SetSelfField("imports")
+		imports.SetReplaceSelf(func(n Node) {
+			n.Parent().(*FileNode).SetImports(n)
+		})
+	}
+	if !n.Decls().IsDummy() {
+		decls := n.Decls()
+		decls.BuildLink()
+		decls.SetParent(n)
+		decls.SetSelfField("decls")
+		decls.SetReplaceSelf(func(n Node) {
+			n.Parent().(*FileNode).SetDecls(n)
+		})
+	}
+}
+
+func (n *FileNode) Fields() []string {
+	return []string{
+		"package_",
+		"imports",
+		"decls",
+	}
+}
+
+func (n *FileNode) Child(field string) Node {
+	if field == "" {
+		return nil
+	}
+	if field == "package_" {
+		return n.Package()
+	}
+	if field == "imports" {
+		return n.Imports()
+	}
+	if field == "decls" {
+		return n.Decls()
+	}
+	return nil
+}
+
+func (n *FileNode) SetChild(nodes []Node) {
+	if len(nodes) != 3 {
+		return
+	}
+	n.SetPackage(nodes[0])
+	n.SetImports(nodes[1])
+	n.SetDecls(nodes[2])
+}
+
+func (n *FileNode) Fork() Node {
+	_ret := &FileNode{
+		BaseNode: n.BaseNode.fork(),
+		package_: n.package_.Fork(),
+		imports:  n.imports.Fork(),
+		decls:    n.decls.Fork(),
+	}
+	_ret.package_.SetParent(_ret)
+	_ret.imports.SetParent(_ret)
+	_ret.decls.SetParent(_ret)
+	return _ret
+}
+
+func (n *FileNode) Visit(beforeChildren func(node Node) (visitChildren, exit bool), afterChildren func(node Node) (exit bool)) (exit bool) {
+	vc, e := beforeChildren(n)
+	if e {
+		return true
+	}
+	if !vc {
+		return false
+	}
+	if n.package_.Visit(beforeChildren, afterChildren) {
+		return true
+	}
+	if n.imports.Visit(beforeChildren, afterChildren) {
+		return true
+	}
+	if n.decls.Visit(beforeChildren, afterChildren) {
+		return true
+	}
+	if afterChildren(n) {
+		return true
+	}
+	return false
+}
+
+func (n *FileNode) Accept(v Visitor) bool {
+	return v.VisitFileNode(n)
+}
+
+func (n *FileNode) Rewrite(r Rewriter) Node {
+	n.package_ = rewriteChild(r, n.package_)
+	n.imports = rewriteChild(r, n.imports)
+	n.decls = rewriteChild(r, n.decls)
+	return r.RewriteFileNode(n)
+}
+
+func (n *FileNode) Dump(hook func(Node, map[string]string) string) map[string]string {
+	ret := make(map[string]string)
+	ret["kind"] = "\"file\""
+	ret["package"] = DumpNode(n.Package(), hook)
+	ret["imports"] = dumpNodeList(n.Imports(), hook)
+	ret["decls"] = dumpNodeList(n.Decls(), hook)
+	return ret
+}
+
+func NewForAssignRangeStmtNode(filePath string, fileContent []rune, k Node, v Node, x Node, b Node, start, end Position) Node {
+	if k == nil {
+		k = DummyNode
+	}
+	if v == nil {
+		v = DummyNode
+	}
+	if x == nil {
+		x = DummyNode
+	}
+	if b == nil {
+		b = DummyNode
+	}
+	_1 := &ForAssignRangeStmtNode{
+		BaseNode: NewBaseNode(filePath, fileContent, NodeTypeForAssignRangeStmt, start, end),
+		k:        k,
+		v:        v,
+		x:        x,
+		b:        b,
+	}
+	creationHook(_1)
+	return _1
+}
+
+type ForAssignRangeStmtNode struct {
+	*BaseNode
+	k Node
+	v Node
+	x Node
+	b Node
+}
+
+func (n *ForAssignRangeStmtNode) K() Node {
+	return n.k
+}
+
+func (n *ForAssignRangeStmtNode) SetK(v Node) {
+	n.k = v
+}
+
+func (n *ForAssignRangeStmtNode) V() Node {
+	return n.v
+}
+
+func (n *ForAssignRangeStmtNode) SetV(v Node) {
+	n.v = v
+}
+
+func (n *ForAssignRangeStmtNode) X() Node {
+	return n.x
+}
+
+func (n *ForAssignRangeStmtNode) SetX(v Node) {
+	n.x = v
+}
+
+func (n *ForAssignRangeStmtNode) B() Node {
+	return n.b
+}
+
+func (n *ForAssignRangeStmtNode) SetB(v Node) {
+	n.b = v
+}
+
+func (n *ForAssignRangeStmtNode) BuildLink() {
+	if !n.K().IsDummy() {
+		k := n.K()
+		k.BuildLink()
+		k.SetParent(n)
+		k.SetSelfField("k")
+		k.SetReplaceSelf(func(n Node) {
+			n.Parent().(*ForAssignRangeStmtNode).SetK(n)
+		})
+	}
+	if !n.V().IsDummy() {
+		v := n.V()
+		v.BuildLink()
+		v.SetParent(n)
+		v.SetSelfField("v")
+		v.SetReplaceSelf(func(n Node) {
+			n.Parent().(*ForAssignRangeStmtNode).SetV(n)
+		})
+	}
+	if !n.X().IsDummy() {
+		x := n.X()
+		x.BuildLink()
+		x.SetParent(n)
+		x.SetSelfField("x")
+		x.SetReplaceSelf(func(n Node) {
+			n.Parent().(*ForAssignRangeStmtNode).SetX(n)
+		})
+	}
+	if !n.B().IsDummy() {
+		b := n.B()
+		b.BuildLink()
+		b.SetParent(n)
+		b.SetSelfField("b")
+		b.SetReplaceSelf(func(n Node) {
+			n.Parent().(*ForAssignRangeStmtNode).SetB(n)
+		})
+	}
+}
+
+func (n *ForAssignRangeStmtNode) Fields() []string {
+	return []string{
+		"k",
+		"v",
+		"x",
+		"b",
+	}
+}
+
+func (n *ForAssignRangeStmtNode) Child(field string) Node {
+	if field == "" {
+		return nil
+	}
+	if field == "k" {
+		return n.K()
+	}
+	if field == "v" {
+		return n.V()
+	}
+	if field == "x" {
+		return n.X()
+	}
+	if field == "b" {
+		return n.B()
+	}
+	return nil
+}
+
+func (n *ForAssignRangeStmtNode) SetChild(nodes []Node) {
+	if len(nodes) != 4 {
+		return
+	}
+	n.SetK(nodes[0])
+	n.SetV(nodes[1])
+	n.SetX(nodes[2])
+	n.SetB(nodes[3])
+}
+
+func (n *ForAssignRangeStmtNode) Fork() Node {
+	_ret := &ForAssignRangeStmtNode{
+		BaseNode: n.BaseNode.fork(),
+		k:        n.k.Fork(),
+		v:        n.v.Fork(),
+		x:        n.x.Fork(),
+		b:        n.b.Fork(),
+	}
+	_ret.k.SetParent(_ret)
+	_ret.v.SetParent(_ret)
+	_ret.x.SetParent(_ret)
+	_ret.b.SetParent(_ret)
+	return _ret
+}
+
+func (n *ForAssignRangeStmtNode) Visit(beforeChildren func(node Node) (visitChildren, exit bool), afterChildren func(node Node) (exit bool)) (exit bool) {
+	vc, e := beforeChildren(n)
+	if e {
+		return true
+	}
+	if !vc {
+		return false
+	}
+	if n.k.Visit(beforeChildren, afterChildren) {
+		return true
+	}
+	if n.v.Visit(beforeChildren, afterChildren) {
+		return true
+	}
+	if n.x.Visit(beforeChildren, afterChildren) {
+		return true
+	}
+	if n.b.Visit(beforeChildren, afterChildren) {
+		return true
+	}
+	if afterChildren(n) {
+		return true
+	}
+	return false
+}
+
+func (n *ForAssignRangeStmtNode) Accept(v Visitor) bool {
+	return v.VisitForAssignRangeStmtNode(n)
+}
+
+func (n *ForAssignRangeStmtNode) stmtNode() {}
+
+func (n *ForAssignRangeStmtNode) Rewrite(r Rewriter) Node {
+	n.k = rewriteChild(r, n.k)
+	n.v = rewriteChild(r, n.v)
+	n.x = rewriteChild(r, n.x)
+	n.b = rewriteChild(r, n.b)
+	return r.RewriteForAssignRangeStmtNode(n)
+}
+
+func (n *ForAssignRangeStmtNode) Dump(hook func(Node, map[string]string) string) map[string]string {
+	ret := make(map[string]string)
+	ret["kind"] = "\"for_assign_range_stmt\""
+	ret["k"] = DumpNode(n.K(), hook)
+	ret["v"] = DumpNode(n.V(), hook)
+	ret["x"] = DumpNode(n.X(), hook)
+	ret["b"] = DumpNode(n.B(), hook)
+	return ret
+}
+
+func NewForDeclRangeStmtNode(filePath string, fileContent []rune, k Node, v Node, x Node, b Node, start, end Position) Node {
+	if k == nil {
+		k = DummyNode
+	}
+	if v == nil {
+		v = DummyNode
+	}
+	if x == nil {
+		x = DummyNode
+	}
+	if b == nil {
+		b = DummyNode
+	}
+	_1 := &ForDeclRangeStmtNode{
+		BaseNode: NewBaseNode(filePath, fileContent, NodeTypeForDeclRangeStmt, start, end),
+		k:        k,
+		v:        v,
+		x:        x,
+		b:        b,
+	}
+	creationHook(_1)
+	return _1
+}
+
+type ForDeclRangeStmtNode struct {
+	*BaseNode
+	k Node
+	v Node
+	x Node
+	b Node
+}
+
+func (n *ForDeclRangeStmtNode) K() Node {
+	return n.k
+}
+
+func (n *ForDeclRangeStmtNode) SetK(v Node) {
+	n.k = v
+}
+
+func (n *ForDeclRangeStmtNode) V() Node {
+	return n.v
+}
+
+func (n *ForDeclRangeStmtNode) SetV(v Node) {
+	n.v = v
+}
+
+func (n *ForDeclRangeStmtNode) X() Node {
+	return n.x
+}
+
+func (n *ForDeclRangeStmtNode) SetX(v Node) {
+	n.x = v
+}
+
+func (n *ForDeclRangeStmtNode) B() Node {
+	return n.b
+}
+
+func (n *ForDeclRangeStmtNode) SetB(v Node) {
+	n.b = v
+}
+
+func (n *ForDeclRangeStmtNode) BuildLink() {
+	if !n.K().IsDummy() {
+		k := n.K()
+		k.BuildLink()
+		k.SetParent(n)
+		k.SetSelfField("k")
+		k.SetReplaceSelf(func(n Node) {
+			n.Parent().(*ForDeclRangeStmtNode).SetK(n)
+		})
+	}
+	if !n.V().IsDummy() {
+		v := n.V()
+		v.BuildLink()
+		v.SetParent(n)
+		v.SetSelfField("v")
+		v.SetReplaceSelf(func(n Node) {
+			n.Parent().(*ForDeclRangeStmtNode).SetV(n)
+		})
+	}
+	if !n.X().IsDummy() {
+		x := n.X()
+		x.BuildLink()
+		x.SetParent(n)
+		x.SetSelfField("x")
+		x.SetReplaceSelf(func(n Node) {
+			n.Parent().(*ForDeclRangeStmtNode).SetX(n)
+		})
+	}
+	if !n.B().IsDummy() {
+		b := n.B()
+		b.BuildLink()
+		b.SetParent(n)
+		b.SetSelfField("b")
+		b.SetReplaceSelf(func(n Node) {
+			n.Parent().(*ForDeclRangeStmtNode).SetB(n)
+		})
+	}
+}
+
+func (n *ForDeclRangeStmtNode) Fields() []string {
+	return []string{
+		"k",
+		"v",
+		"x",
+		"b",
+	}
+}
+
+func (n *ForDeclRangeStmtNode) Child(field string) Node {
+	if field == "" {
+		return nil
+	}
+	if field == "k" {
+		return n.K()
+	}
+	if field == "v" {
+		return n.V()
+	}
+	if field == "x" {
+		return n.X()
+	}
+	if field == "b" {
+		return n.B()
+	}
+	return nil
+}
+
+func (n *ForDeclRangeStmtNode) SetChild(nodes []Node) {
+	if len(nodes) != 4 {
+		return
+	}
+	n.SetK(nodes[0])
+	n.SetV(nodes[1])
+	n.SetX(nodes[2])
+	n.SetB(nodes[3])
+}
+
+func (n *ForDeclRangeStmtNode) Fork() Node {
+	_ret := &ForDeclRangeStmtNode{
+		BaseNode: n.BaseNode.fork(),
+		k:        n.k.Fork(),
+		v:        n.v.Fork(),
+		x:        n.x.Fork(),
+		b:        n.b.Fork(),
+	}
+	_ret.k.SetParent(_ret)
+	_ret.v.SetParent(_ret)
+	_ret.x.SetParent(_ret)
+	_ret.b.SetParent(_ret)
+	return _ret
+}
+
+func (n *ForDeclRangeStmtNode) Visit(beforeChildren func(node Node) (visitChildren, exit bool), afterChildren func(node Node) (exit bool)) (exit bool) {
+	vc, e := beforeChildren(n)
+	if e {
+		return true
+	}
+	if !vc {
+		return false
+	}
+	if n.k.Visit(beforeChildren, afterChildren) {
+		return true
+	}
+	if n.v.Visit(beforeChildren, afterChildren) {
+		return true
+	}
+	if n.x.Visit(beforeChildren, afterChildren) {
+		return true
+	}
+	if n.b.Visit(beforeChildren, afterChildren) {
+		return true
+	}
+	if afterChildren(n) {
+		return true
+	}
+	return false
+}
+
+func (n *ForDeclRangeStmtNode) Accept(v Visitor) bool {
+	return v.VisitForDeclRangeStmtNode(n)
+}
+
+func (n *ForDeclRangeStmtNode) stmtNode() {}
+
+func (n *ForDeclRangeStmtNode) Rewrite(r Rewriter) Node {
+	n.k = rewriteChild(r, n.k)
+	n.v = rewriteChild(r, n.v)
+	n.x = rewriteChild(r, n.x)
+	n.b = rewriteChild(r, n.b)
+	return r.RewriteForDeclRangeStmtNode(n)
+}
+
+func (n *ForDeclRangeStmtNode) Dump(hook func(Node, map[string]string) string) map[string]string {
+	ret := make(map[string]string)
+	ret["kind"] = "\"for_decl_range_stmt\""
+	ret["k"] = DumpNode(n.K(), hook)
+	ret["v"] = DumpNode(n.V(), hook)
+	ret["x"] = DumpNode(n.X(), hook)
+	ret["b"] = DumpNode(n.B(), hook)
+	return ret
+}
+
+func NewForStmtNode(filePath string, fileContent []rune, i Node, c Node, u Node, b Node, start, end Position) Node {
+	if i == nil {
+		i = DummyNode
+	}
+	if c == nil {
+		c = DummyNode
+	}
+	if u == nil {
+		u = DummyNode
+	}
+	if b == nil {
+		b = DummyNode
+	}
+	_1 := &ForStmtNode{
+		BaseNode: NewBaseNode(filePath, fileContent, NodeTypeForStmt, start, end),
+		i:        i,
+		c:        c,
+		u:        u,
+		b:        b,
+	}
+	creationHook(_1)
+	return _1
+}
+
+type ForStmtNode struct {
+	*BaseNode
+	i Node
+	c Node
+	u Node
+	b Node
+}
+
+func (n *ForStmtNode) I() Node {
+	return n.i
+}
+
+func (n *ForStmtNode) SetI(v Node) {
+	n.i = v
+}
+
+func (n *ForStmtNode) C() Node {
+	return n.c
+}
+
+func (n *ForStmtNode) SetC(v Node) {
+	n.c = v
+}
+
+func (n *ForStmtNode) U() Node {
+	return n.u
+}
+
+func (n *ForStmtNode) SetU(v Node) {
+	n.u = v
+}
+
+func (n *ForStmtNode) B() Node {
+	return n.b
+}
+
+func (n *ForStmtNode) SetB(v Node) {
+	n.b = v
+}
+
+func (n *ForStmtNode) BuildLink() {
+	if !n.I().IsDummy() {
+		i := n.I()
+		i.BuildLink()
+		i.SetParent(n)
+		i.SetSelfField("i")
+		i.SetReplaceSelf(func(n Node) {
+			n.Parent().(*ForStmtNode).SetI(n)
+		})
+	}
+	if !n.C().IsDummy() {
+		c := n.C()
+		c.BuildLink()
+		c.SetParent(n)
+		c.SetSelfField("c")
+		c.SetReplaceSelf(func(n Node) {
+			n.Parent().(*ForStmtNode).SetC(n)
+		})
+	}
+	if !n.U().IsDummy() {
+		u := n.U()
+		u.BuildLink()
+		u.SetParent(n)
+		u.SetSelfField("u")
+		u.SetReplaceSelf(func(n Node) {
+			n.Parent().(*ForStmtNode).SetU(n)
+		})
+	}
+	if !n.B().IsDummy() {
+		b := n.B()
+		b.BuildLink()
+		b.SetParent(n)
+		b.SetSelfField("b")
+		b.SetReplaceSelf(func(n Node) {
+			n.Parent().(*ForStmtNode).SetB(n)
+		})
+	}
+}
+
+func (n *ForStmtNode) Fields() []string {
+	return []string{
+		"i",
+		"c",
+		"u",
+		"b",
+	}
+}
+
+func (n *ForStmtNode) Child(field string) Node {
+	if field == "" {
+		return nil
+	}
+	if field == "i" {
+		return n.I()
+	}
+	if field == "c" {
+		return n.C()
+	}
+	if field == "u" {
+		return n.U()
+	}
+	if field == "b" {
+		return n.B()
+	}
+	return nil
+}
+
+func (n *ForStmtNode) SetChild(nodes []Node) {
+	if len(nodes) != 4 {
+		return
+	}
+	n.SetI(nodes[0])
+	n.SetC(nodes[1])
+	n.SetU(nodes[2])
+	n.SetB(nodes[3])
+}
+
+func (n *ForStmtNode) Fork() Node {
+	_ret := &ForStmtNode{
+		BaseNode: n.BaseNode.fork(),
+		i:        n.i.Fork(),
+		c:        n.c.Fork(),
+		u:        n.u.Fork(),
+		b:        n.b.Fork(),
+	}
+	_ret.i.SetParent(_ret)
+	_ret.c.SetParent(_ret)
+	_ret.u.SetParent(_ret)
+	_ret.b.SetParent(_ret)
+	return _ret
+}
+
+func (n *ForStmtNode) Visit(beforeChildren func(node Node) (visitChildren, exit bool), afterChildren func(node Node) (exit bool)) (exit bool) {
+	vc, e := beforeChildren(n)
+	if e {
+		return true
+	}
+	if !vc {
+		return false
+	}
+	if n.i.Visit(beforeChildren, afterChildren) {
+		return true
+	}
+	if n.c.Visit(beforeChildren, afterChildren) {
+		return true
+	}
+	if n.u.Visit(beforeChildren, afterChildren) {
+		return true
+	}
+	if n.b.Visit(beforeChildren, afterChildren) {
+		return true
+	}
+	if afterChildren(n) {
+		return true
+	}
+	return false
+}
+
+func (n *ForStmtNode) Accept(v Visitor) bool {
+	return v.VisitForStmtNode(n)
+}
+
+func (n *ForStmtNode) stmtNode() {}
+
+func (n *ForStmtNode) Rewrite(r Rewriter) Node {
+	n.i = rewriteChild(r, n.i)
+	n.c = rewriteChild(r, n.c)
+	n.u = rewriteChild(r, n.u)
+	n.b = rewriteChild(r, n.b)
+	return r.RewriteForStmtNode(n)
+}
+
+func (n *ForStmtNode) Dump(hook func(Node, map[string]string) string) map[string]string {
+	ret := make(map[string]string)
+	ret["kind"] = "\"for_stmt\""
+	ret["i"] = DumpNode(n.I(), hook)
+	ret["c"] = DumpNode(n.C(), hook)
+	ret["u"] = DumpNode(n.U(), hook)
+	ret["b"] = DumpNode(n.B(), hook)
+	return ret
+}
+
+func NewFullSliceExprNode(filePath string, fileContent []rune, target Node, low Node, high Node, max_ Node, start, end Position) Node {
+	if target == nil {
+		target = DummyNode
+	}
+	if low == nil {
+		low = DummyNode
+	}
+	if high == nil {
+		high = DummyNode
+	}
+	if max_ == nil {
+		max_ = DummyNode
+	}
+	_1 := &FullSliceExprNode{
+		BaseNode: NewBaseNode(filePath, fileContent, NodeTypeFullSliceExpr, start, end),
+		target:   target,
+		low:      low,
+		high:     high,
+		max_:     max_,
+	}
+	creationHook(_1)
+	return _1
+}
+
+type FullSliceExprNode struct {
+	*BaseNode
+	target Node
+	low    Node
+	high   Node
+	max_   Node
+}
+
+func (n *FullSliceExprNode) Target() Node {
+	return n.target
+}
+
+func (n *FullSliceExprNode) SetTarget(v Node) {
+	n.target = v
+}
+
+func (n *FullSliceExprNode) Low() Node {
+	return n.low
+}
+
+func (n *FullSliceExprNode) SetLow(v Node) {
+	n.low = v
+}
+
+func (n *FullSliceExprNode) High() Node {
+	return n.high
+}
+
+func (n *FullSliceExprNode) SetHigh(v Node) {
+	n.high = v
+}
+
+func (n *FullSliceExprNode) Max() Node {
+	return n.max_
+}
+
+func (n *FullSliceExprNode) SetMax(v Node) {
+	n.max_ = v
+}
+
+func (n *FullSliceExprNode) BuildLink() {
+	if !n.Target().IsDummy() {
+		target := n.Target()
+		target.BuildLink()
+		target.SetParent(n)
+		target.SetSelfField("target")
+		target.SetReplaceSelf(func(n Node) {
+			n.Parent().(*FullSliceExprNode).SetTarget(n)
+		})
+	}
+	if !n.Low().IsDummy() {
+		low := n.Low()
+		low.BuildLink()
+		low.SetParent(n)
+		low.SetSelfField("low")
+		low.SetReplaceSelf(func(n Node) {
+			n.Parent().(*FullSliceExprNode).SetLow(n)
+		})
+	}
+	if !n.High().IsDummy() {
+		high := n.High()
+		high.BuildLink()
+		high.SetParent(n)
+		high.SetSelfField("high")
+		high.SetReplaceSelf(func(n Node) {
+			n.Parent().(*FullSliceExprNode).SetHigh(n)
+		})
+	}
+	if !n.Max().IsDummy() {
+		max_ := n.Max()
+		max_.BuildLink()
+		max_.SetParent(n)
+		max_.SetSelfField("max_")
+		max_.SetReplaceSelf(func(n Node) {
+			n.Parent().(*FullSliceExprNode).SetMax(n)
+		})
+	}
+}
+
+func (n *FullSliceExprNode) Fields() []string {
+	return []string{
+		"target",
+		"low",
+		"high",
+		"max_",
+	}
+}
+
+func (n *FullSliceExprNode) Child(field string) Node {
+	if field == "" {
+		return nil
+	}
+	if field == "target" {
+		return n.Target()
+	}
+	if field == "low" {
+		return n.Low()
+	}
+	if field == "high" {
+		return n.High()
+	}
+	if field == "max_" {
+		return n.Max()
+	}
+	return nil
+}
+
+func (n *FullSliceExprNode) SetChild(nodes []Node) {
+	if len(nodes) != 4 {
+		return
+	}
+	n.SetTarget(nodes[0])
+	n.SetLow(nodes[1])
+	n.SetHigh(nodes[2])
+	n.SetMax(nodes[3])
+}
+
+func (n *FullSliceExprNode) Fork() Node {
+	_ret := &FullSliceExprNode{
+		BaseNode: n.BaseNode.fork(),
+		target:   n.target.Fork(),
+		low:      n.low.Fork(),
+		high:     n.high.Fork(),
+		max_:     n.max_.Fork(),
+	}
+	_ret.target.SetParent(_ret)
+	_ret.low.SetParent(_ret)
+	_ret.high.SetParent(_ret)
+	_ret.max_.SetParent(_ret)
+	return _ret
+}
+
+func (n *FullSliceExprNode) Visit(beforeChildren func(node Node) (visitChildren, exit bool), afterChildren func(node Node) (exit bool)) (exit bool) {
+	vc, e := beforeChildren(n)
+	if e {
+		return true
+	}
+	if !vc {
+		return false
+	}
+	if n.target.Visit(beforeChildren, afterChildren) {
+		return true
+	}
+	if n.low.Visit(beforeChildren, afterChildren) {
+		return true
+	}
+	if n.high.Visit(beforeChildren, afterChildren) {
+		return true
+	}
+	if n.max_.Visit(beforeChildren, afterChildren) {
+		return true
+	}
+	if afterChildren(n) {
+		return true
+	}
+	return false
+}
+
+func (n *FullSliceExprNode) Accept(v Visitor) bool {
+	return v.VisitFullSliceExprNode(n)
+}
+
+func (n *FullSliceExprNode) exprNode() {}
+
+func (n *FullSliceExprNode) Rewrite(r Rewriter) Node {
+	n.target = rewriteChild(r, n.target)
+	n.low = rewriteChild(r, n.low)
+	n.high = rewriteChild(r, n.high)
+	n.max_ = rewriteChild(r, n.max_)
+	return r.RewriteFullSliceExprNode(n)
+}
+
+func (n *FullSliceExprNode) Dump(hook func(Node, map[string]string) string) map[string]string {
+	ret := make(map[string]string)
+	ret["kind"] = "\"full_slice_expr\""
+	ret["target"] = DumpNode(n.Target(), hook)
+	ret["low"] = DumpNode(n.Low(), hook)
+	ret["high"] = DumpNode(n.High(), hook)
+	ret["max"] = DumpNode(n.Max(), hook)
+	return ret
+}
+
+func NewFunctionDeclNode(filePath string, fileContent []rune, name Node, genericParameter Node, parameter Node, result Node, body Node, start, end Position) Node {
+	if name == nil {
+		name = DummyNode
+	}
+	if genericParameter == nil {
+		genericParameter = DummyNode
+	}
+	if parameter == nil {
+		parameter = DummyNode
+	}
+	if result == nil {
+		result = DummyNode
+	}
+	if body == nil {
+		body = DummyNode
+	}
+	_1 := &FunctionDeclNode{
+		BaseNode:         NewBaseNode(filePath, fileContent, NodeTypeFunctionDecl, start, end),
+		name:             name,
+		genericParameter: genericParameter,
+		parameter:        parameter,
+		result:           result,
+		body:             body,
+	}
+	creationHook(_1)
+	return _1
+}
+
+type FunctionDeclNode struct {
+	*BaseNode
+	name             Node
+	genericParameter Node
+	parameter        Node
+	result           Node
+	body             Node
+}
+
+func (n *FunctionDeclNode) Name() Node {
+	return n.name
+}
+
+func (n *FunctionDeclNode) SetName(v Node) {
+	n.name = v
+}
+
+func (n *FunctionDeclNode) GenericParameter() Node {
+	return n.genericParameter
+}
+
+func (n *FunctionDeclNode) SetGenericParameter(v Node) {
+	n.genericParameter = v
+}
+
+func (n *FunctionDeclNode) Parameter() Node {
+	return n.parameter
+}
+
+func (n *FunctionDeclNode) SetParameter(v Node) {
+	n.parameter = v
+}
+
+func (n *FunctionDeclNode) Result() Node {
+	return n.result
+}
+
+func (n *FunctionDeclNode) SetResult(v Node) {
+	n.result = v
+}
+
+func (n *FunctionDeclNode) Body() Node {
+	return n.body
+}
+
+func (n *FunctionDeclNode) SetBody(v Node) {
+	n.body = v
+}
+
+func (n *FunctionDeclNode) BuildLink() {
+	if !n.Name().IsDummy() {
+		name := n.Name()
+		name.BuildLink()
+		name.SetParent(n)
+		name.SetSelfField("name")
+		name.SetReplaceSelf(func(n Node) {
+			n.Parent().(*FunctionDeclNode).SetName(n)
+		})
+	}
+	if !n.GenericParameter().IsDummy() {
+		genericParameter := n.GenericParameter()
+		genericParameter.BuildLink()
+		genericParameter.SetParent(n)
+		genericParameter.SetSelfField("generic_parameter")
+		genericParameter.SetReplaceSelf(func(n Node) {
+			n.Parent().(*FunctionDeclNode).SetGenericParameter(n)
+		})
+	}
+	if !n.Parameter().IsDummy() {
+		parameter := n.Parameter()
+		parameter.BuildLink()
+		parameter.SetParent(n)
+		parameter.SetSelfField("parameter")
+		parameter.SetReplaceSelf(func(n Node) {
+			n.Parent().(*FunctionDeclNode).SetParameter(n)
+		})
+	}
+	if !n.Result().IsDummy() {
+		result := n.Result()
+		result.BuildLink()
+		result.SetParent(n)
+		result.SetSelfField("result")
+		result.SetReplaceSelf(func(n Node) {
+			n.Parent().(*FunctionDeclNode).SetResult(n)
+		})
+	}
+	if !n.Body().IsDummy() {
+		body := n.Body()
+		body.BuildLink()
+		body.SetParent(n)
+		body.SetSelfField("body")
+		body.SetReplaceSelf(func(n Node) {
+			n.Parent().(*FunctionDeclNode).SetBody(n)
+		})
+	}
+}
+
+func (n *FunctionDeclNode) Fields() []string {
+	return []string{
+		"name",
+		"generic_parameter",
+		"parameter",
+		"result",
+		"body",
+	}
+}
+
+func (n *FunctionDeclNode) Child(field string) Node {
+	if field == "" {
+		return nil
+	}
+	if field == "name" {
+		return n.Name()
+	}
+	if field == "generic_parameter" {
+		return n.GenericParameter()
+	}
+	if field == "parameter" {
+		return n.Parameter()
+	}
+	if field == "result" {
+		return n.Result()
+	}
+	if field == "body" {
+		return n.Body()
+	}
+	return nil
+}
+
+func (n *FunctionDeclNode) SetChild(nodes []Node) {
+	if len(nodes) != 5 {
+		return
+	}
+	n.SetName(nodes[0])
+	n.SetGenericParameter(nodes[1])
+	n.SetParameter(nodes[2])
+	n.SetResult(nodes[3])
+	n.SetBody(nodes[4])
+}
+
+func (n *FunctionDeclNode) Fork() Node {
+	_ret := &FunctionDeclNode{
+		BaseNode:         n.BaseNode.fork(),
+		name:             n.name.Fork(),
+		genericParameter: n.genericParameter.Fork(),
+		parameter:        n.parameter.Fork(),
+		result:           n.result.Fork(),
+		body:             n.body.Fork(),
+	}
+	_ret.name.SetParent(_ret)
+	_ret.genericParameter.SetParent(_ret)
+	_ret.parameter.SetParent(_ret)
+	_ret.result.SetParent(_ret)
+	_ret.body.SetParent(_ret)
+	return _ret
+}
+
+func (n *FunctionDeclNode) Visit(beforeChildren func(node Node) (visitChildren, exit bool), afterChildren func(node Node) (exit bool)) (exit bool) {
+	vc, e := beforeChildren(n)
+	if e {
+		return true
+	}
+	if !vc {
+		return false
+	}
+	if n.name.Visit(beforeChildren, afterChildren) {
+		return true
+	}
+	if n.genericParameter.Visit(beforeChildren, afterChildren) {
+		return true
+	}
+	if n.parameter.Visit(beforeChildren, afterChildren) {
+		return true
+	}
+	if n.result.Visit(beforeChildren, afterChildren) {
+		return true
+	}
+	if n.body.Visit(beforeChildren, afterChildren) {
+		return true
+	}
+	if afterChildren(n) {
+		return true
+	}
+	return false
+}
+
+func (n *FunctionDeclNode) Accept(v Visitor) bool {
+	return v.VisitFunctionDeclNode(n)
+}
+
+func (n *FunctionDeclNode) declNode() {}
+
+func (n *FunctionDeclNode) Rewrite(r Rewriter) Node {
+	n.name = rewriteChild(r, n.name)
+	n.genericParameter = rewriteChild(r, n.genericParameter)
+	n.parameter = rewriteChild(r, n.parameter)
+	n.result = rewriteChild(r, n.result)
+	n.body = rewriteChild(r, n.body)
+	return r.RewriteFunctionDeclNode(n)
+}
+
+func (n *FunctionDeclNode) Dump(hook func(Node, map[string]string) string) map[string]string {
+	ret := make(map[string]string)
+	ret["kind"] = "\"function_decl\""
+	ret["name"] = DumpNode(n.Name(), hook)
+	ret["generic_parameter"] = DumpNode(n.GenericParameter(), hook)
+	ret["parameter"] = DumpNode(n.Parameter(), hook)
+	ret["result"] = DumpNode(n.Result(), hook)
+	ret["body"] = DumpNode(n.Body(), hook)
+	return ret
+}
+
+func NewFunctionTypeNode(filePath string, fileContent []rune, parameter Node, result Node, start, end Position) Node {
+	if parameter == nil {
+		parameter = DummyNode
+	}
+	if result == nil {
+		result = DummyNode
+	}
+	_1 := &FunctionTypeNode{
+		BaseNode:  NewBaseNode(filePath, fileContent, NodeTypeFunctionType, start, end),
+		parameter: parameter,
+		result:    result,
+	}
+	creationHook(_1)
+	return _1
+}
+
+type FunctionTypeNode struct {
+	*BaseNode
+	parameter Node
+	result    Node
+}
+
+func (n *FunctionTypeNode) Parameter() Node {
+	return n.parameter
+}
+
+func (n *FunctionTypeNode) SetParameter(v Node) {
+	n.parameter = v
+}
+
+func (n *FunctionTypeNode) Result() Node {
+	return n.result
+}
+
+func (n *FunctionTypeNode) SetResult(v Node) {
+	n.result = v
+}
+
+func (n *FunctionTypeNode) BuildLink() {
+	if !n.Parameter().IsDummy() {
+		parameter := n.Parameter()
+		parameter.BuildLink()
+		parameter.SetParent(n)
+		parameter.SetSelfField("parameter")
+		parameter.SetReplaceSelf(func(n Node) {
+			n.Parent().(*FunctionTypeNode).SetParameter(n)
+		})
+	}
+	if !n.Result().IsDummy() {
+		result := n.Result()
+		result.BuildLink()
+		result.SetParent(n)
+		result.SetSelfField("result")
+		result.SetReplaceSelf(func(n Node) {
+			n.Parent().(*FunctionTypeNode).SetResult(n)
+		})
+	}
+}
+
+func (n *FunctionTypeNode) Fields() []string {
+	return []string{
+		"parameter",
+		"result",
+	}
+}
+
+func (n *FunctionTypeNode) Child(field string) Node {
+	if field == "" {
+		return nil
+	}
+	if field == "parameter" {
+		return n.Parameter()
+	}
+	if field == "result" {
+		return n.Result()
+	}
+	return nil
+}
+
+func (n *FunctionTypeNode) SetChild(nodes []Node) {
+	if len(nodes) != 2 {
+		return
+	}
+	n.SetParameter(nodes[0])
+	n.SetResult(nodes[1])
+}
+
+func (n *FunctionTypeNode) Fork() Node {
+	_ret := &FunctionTypeNode{
+		BaseNode:  n.BaseNode.fork(),
+		parameter: n.parameter.Fork(),
+		result:    n.result.Fork(),
+	}
+	_ret.parameter.SetParent(_ret)
+	_ret.result.SetParent(_ret)
+	return _ret
+}
+
+func (n *FunctionTypeNode) Visit(beforeChildren func(node Node) (visitChildren, exit bool), afterChildren func(node Node) (exit bool)) (exit bool) {
+	vc, e := beforeChildren(n)
+	if e {
+		return true
+	}
+	if !vc {
+		return false
+	}
+	if n.parameter.Visit(beforeChildren, afterChildren) {
+		return true
+	}
+	if n.result.Visit(beforeChildren, afterChildren) {
+		return true
+	}
+	if afterChildren(n) {
+		return true
+	}
+	return false
+}
+
+func (n *FunctionTypeNode) Accept(v Visitor) bool {
+	return v.VisitFunctionTypeNode(n)
+}
+
+func (n *FunctionTypeNode) typeNode() {}
+
+func (n *FunctionTypeNode) Rewrite(r Rewriter) Node {
+	n.parameter = rewriteChild(r, n.parameter)
+	n.result = rewriteChild(r, n.result)
+	return r.RewriteFunctionTypeNode(n)
+}
+
+func (n *FunctionTypeNode) Dump(hook func(Node, map[string]string) string) map[string]string {
+	ret := make(map[string]string)
+	ret["kind"] = "\"function_type\""
+	ret["parameter"] = DumpNode(n.Parameter(), hook)
+	ret["result"] = DumpNode(n.Result(), hook)
+	return ret
+}
+
+func NewGenericParameterNode(filePath string, fileContent []rune, ident Node, constraint Node, start, end Position) Node {
+	if ident == nil {
+		ident = DummyNode
+	}
+	if constraint == nil {
+		constraint = DummyNode
+	}
+	_1 := &GenericParameterNode{
+		BaseNode:   NewBaseNode(filePath, fileContent, NodeTypeGenericParameter, start, end),
+		ident:      ident,
+		constraint: constraint,
+	}
+	creationHook(_1)
+	return _1
+}
+
+type GenericParameterNode struct {
+	*BaseNode
+	ident      Node
+	constraint Node
+}
+
+func (n *GenericParameterNode) Ident() Node {
+	return n.ident
+}
+
+func (n *GenericParameterNode) SetIdent(v Node) {
+	n.ident = v
+}
+
+func (n *GenericParameterNode) Constraint() Node {
+	return n.constraint
+}
+
+func (n *GenericParameterNode) SetConstraint(v Node) {
+	n.constraint = v
+}
+
+func (n *GenericParameterNode) BuildLink() {
+	if !n.Ident().IsDummy() {
+		ident := n.Ident()
+		ident.BuildLink()
+		ident.SetParent(n)
+		ident.SetSelfField("ident")
+		ident.SetReplaceSelf(func(n Node) {
+			n.Parent().(*GenericParameterNode).SetIdent(n)
+		})
+	}
+	if !n.Constraint().IsDummy() {
+		constraint := n.Constraint()
+		constraint.BuildLink()
+		constraint.SetParent(n)
+		constraint.SetSelfField("constraint")
+		constraint.SetReplaceSelf(func(n Node) {
+			n.Parent().(*GenericParameterNode).SetConstraint(n)
+		})
+	}
+}
+
+func (n *GenericParameterNode) Fields() []string {
+	return []string{
+		"ident",
+		"constraint",
+	}
+}
+
+func (n *GenericParameterNode) Child(field string) Node {
+	if field == "" {
+		return nil
+	}
+	if field == "ident" {
+		return n.Ident()
+	}
+	if field == "constraint" {
+		return n.Constraint()
+	}
+	return nil
+}
+
+func (n *GenericParameterNode) SetChild(nodes []Node) {
+	if len(nodes) != 2 {
+		return
+	}
+	n.SetIdent(nodes[0])
+	n.SetConstraint(nodes[1])
+}
+
+func (n *GenericParameterNode) Fork() Node {
+	_ret := &GenericParameterNode{
+		BaseNode:   n.BaseNode.fork(),
+		ident:      n.ident.Fork(),
+		constraint: n.constraint.Fork(),
+	}
+	_ret.ident.SetParent(_ret)
+	_ret.constraint.SetParent(_ret)
+	return _ret
+}
+
+func (n *GenericParameterNode) Visit(beforeChildren func(node Node) (visitChildren, exit bool), afterChildren func(node Node) (exit bool)) (exit bool) {
+	vc, e := beforeChildren(n)
+	if e {
+		return true
+	}
+	if !vc {
+		return false
+	}
+	if n.ident.Visit(beforeChildren, afterChildren) {
+		return true
+	}
+	if n.constraint.Visit(beforeChildren, afterChildren) {
+		return true
+	}
+	if afterChildren(n) {
+		return true
+	}
+	return false
+}
+
+func (n *GenericParameterNode) Accept(v Visitor) bool {
+	return v.VisitGenericParameterNode(n)
+}
+
+func (n *GenericParameterNode) Rewrite(r Rewriter) Node {
+	n.ident = rewriteChild(r, n.ident)
+	n.constraint = rewriteChild(r, n.constraint)
+	return r.RewriteGenericParameterNode(n)
+}
+
+func (n *GenericParameterNode) Dump(hook func(Node, map[string]string) string) map[string]string {
+	ret := make(map[string]string)
+	ret["kind"] = "\"generic_parameter\""
+	ret["ident"] = DumpNode(n.Ident(), hook)
+	ret["constraint"] = DumpNode(n.Constraint(), hook)
+	return ret
+}
+
+func NewImportNamePathNode(filePath string, fileContent []rune, name Node, path Node, start, end Position) Node {
+	if name == nil {
+		name = DummyNode
+	}
+	if path == nil {
+		path = DummyNode
+	}
+	_1 := &ImportNamePathNode{
+		BaseNode: NewBaseNode(filePath, fileContent, NodeTypeImportNamePath, start, end),
+		name:     name,
+		path:     path,
+	}
+	creationHook(_1)
+	return _1
+}
+
+type ImportNamePathNode struct {
+	*BaseNode
+	name Node
+	path Node
+}
+
+func (n *ImportNamePathNode) Name() Node {
+	return n.name
+}
+
+func (n *ImportNamePathNode) SetName(v Node) {
+	n.name = v
+}
+
+func (n *ImportNamePathNode) Path() Node {
+	return n.path
+}
+
+func (n *ImportNamePathNode) SetPath(v Node) {
+	n.path = v
+}
+
+func (n *ImportNamePathNode) BuildLink() {
+	if !n.Name().IsDummy() {
+		name := n.Name()
+		name.BuildLink()
+		name.SetParent(n)
+		name.SetSelfField("name")
+		name.SetReplaceSelf(func(n Node) {
+			n.Parent().(*ImportNamePathNode).SetName(n)
+		})
+	}
+	if !n.Path().IsDummy() {
+		path := n.Path()
+		path.BuildLink()
+		path.SetParent(n)
+		path.SetSelfField("path")
+		path.SetReplaceSelf(func(n Node) {
+			n.Parent().(*ImportNamePathNode).SetPath(n)
+		})
+	}
+}
+
+func (n *ImportNamePathNode) Fields() []string {
+	return []string{
+		"name",
+		"path",
+	}
+}
+
+func (n *ImportNamePathNode) Child(field string) Node {
+	if field == "" {
+		return nil
+	}
+	if field == "name" {
+		return n.Name()
+	}
+	if field == "path" {
+		return n.Path()
+	}
+	return nil
+}
+
+func (n *ImportNamePathNode) SetChild(nodes []Node) {
+	if len(nodes) != 2 {
+		return
+	}
+	n.SetName(nodes[0])
+	n.SetPath(nodes[1])
+}
+
+func (n *ImportNamePathNode) Fork() Node {
+	_ret := &ImportNamePathNode{
+		BaseNode: n.BaseNode.fork(),
+		name:     n.name.Fork(),
+		path:     n.path.Fork(),
+	}
+	_ret.name.SetParent(_ret)
+	_ret.path.SetParent(_ret)
+	return _ret
+}
+
+func (n *ImportNamePathNode) Visit(beforeChildren func(node Node) (visitChildren, exit bool), afterChildren func(node Node) (exit bool)) (exit bool) {
+	vc, e := beforeChildren(n)
+	if e {
+		return true
+	}
+	if !vc {
+		return false
+	}
+	if n.name.Visit(beforeChildren, afterChildren) {
+		return true
+	}
+	if n.path.Visit(beforeChildren, afterChildren) {
+		return true
+	}
+	if afterChildren(n) {
+		return true
+	}
+	return false
+}
+
+func (n *ImportNamePathNode) Accept(v Visitor) bool {
+	return v.VisitImportNamePathNode(n)
+}
+
+func (n *ImportNamePathNode) Rewrite(r Rewriter) Node {
+	n.name = rewriteChild(r, n.name)
+	n.path = rewriteChild(r, n.path)
+	return r.RewriteImportNamePathNode(n)
+}
+
+func (n *ImportNamePathNode) Dump(hook func(Node, map[string]string) string) map[string]string {
+	ret := make(map[string]string)
+	ret["kind"] = "\"import_name_path\""
+	ret["name"] = DumpNode(n.Name(), hook)
+	ret["path"] = DumpNode(n.Path(), hook)
+	return ret
+}
+
+func NewMakeChanExprNode(filePath string, fileContent []rune, type_ Node, buffer Node, start, end Position) Node {
+	if type_ == nil {
+		type_ = DummyNode
+	}
+	if buffer == nil {
+		buffer = DummyNode
+	}
+	_1 := &MakeChanExprNode{
+		BaseNode: NewBaseNode(filePath, fileContent, NodeTypeMakeChanExpr, start, end),
+		type_:    type_,
+		buffer:   buffer,
+	}
+	creationHook(_1)
+	return _1
+}
+
+type MakeChanExprNode struct {
+	*BaseNode
+	type_  Node
+	buffer Node
+}
+
+func (n *MakeChanExprNode) Type() Node {
+	return n.type_
+}
+
+func (n *MakeChanExprNode) SetType(v Node) {
+	n.type_ = v
+}
+
+func (n *MakeChanExprNode) Buffer() Node {
+	return n.buffer
+}
+
+func (n *MakeChanExprNode) SetBuffer(v Node) {
+	n.buffer = v
+}
+
+func (n *MakeChanExprNode) BuildLink() {
+	if !n.Type().IsDummy() {
+		type_ := n.Type()
+		type_.BuildLink()
+		type_.SetParent(n)
+		type_.SetSelfField("type_")
+		type_.SetReplaceSelf(func(n Node) {
+			n.Parent().(*MakeChanExprNode).SetType(n)
+		})
+	}
+	if !n.Buffer().IsDummy() {
+		buffer := n.Buffer()
+		buffer.BuildLink()
+		buffer.SetParent(n)
+		buffer.SetSelfField("buffer")
+		buffer.SetReplaceSelf(func(n Node) {
+			n.Parent().(*MakeChanExprNode).SetBuffer(n)
+		})
+	}
+}
+
+func (n *MakeChanExprNode) Fields() []string {
+	return []string{
+		"type_",
+		"buffer",
+	}
+}
+
+func (n *MakeChanExprNode) Child(field string) Node {
+	if field == "" {
+		return nil
+	}
+	if field == "type_" {
+		return n.Type()
+	}
+	if field == "buffer" {
+		return n.Buffer()
+	}
+	return nil
+}
+
+func (n *MakeChanExprNode) SetChild(nodes []Node) {
+	if len(nodes) != 2 {
+		return
+	}
+	n.SetType(nodes[0])
+	n.SetBuffer(nodes[1])
+}
+
+func (n *MakeChanExprNode) Fork() Node {
+	_ret := &MakeChanExprNode{
+		BaseNode: n.BaseNode.fork(),
+		type_:    n.type_.Fork(),
+		buffer:   n.buffer.Fork(),
+	}
+	_ret.type_.SetParent(_ret)
+	_ret.buffer.SetParent(_ret)
+	return _ret
+}
+
+func (n *MakeChanExprNode) Visit(beforeChildren func(node Node) (visitChildren, exit bool), afterChildren func(node Node) (exit bool)) (exit bool) {
+	vc, e := beforeChildren(n)
+	if e {
+		return true
+	}
+	if !vc {
+		return false
+	}
+	if n.type_.Visit(beforeChildren, afterChildren) {
+		return true
+	}
+	if n.buffer.Visit(beforeChildren, afterChildren) {
+		return true
+	}
+	if afterChildren(n) {
+		return true
+	}
+	return false
+}
+
+func (n *MakeChanExprNode) Accept(v Visitor) bool {
+	return v.VisitMakeChanExprNode(n)
+}
+
+func (n *MakeChanExprNode) exprNode() {}
+
+func (n *MakeChanExprNode) Rewrite(r Rewriter) Node {
+	n.type_ = rewriteChild(r, n.type_)
+	n.buffer = rewriteChild(r, n.buffer)
+	return r.RewriteMakeChanExprNode(n)
+}
+
+func (n *MakeChanExprNode) Dump(hook func(Node, map[string]string) string) map[string]string {
+	ret := make(map[string]string)
+	ret["kind"] = "\"make_chan_expr\""
+	ret["type"] = DumpNode(n.Type(), hook)
+	ret["buffer"] = DumpNode(n.Buffer(), hook)
+	return ret
+}
+
+func NewMakeMapExprNode(filePath string, fileContent []rune, k Node, v Node, hint Node, start, end Position) Node {
+	if k == nil {
+		k = DummyNode
+	}
+	if v == nil {
+		v = DummyNode
+	}
+	if hint == nil {
+		hint = DummyNode
+	}
+	_1 := &MakeMapExprNode{
+		BaseNode: NewBaseNode(filePath, fileContent, NodeTypeMakeMapExpr, start, end),
+		k:        k,
+		v:        v,
+		hint:     hint,
+	}
+	creationHook(_1)
+	return _1
+}
+
+type MakeMapExprNode struct {
+	*BaseNode
+	k    Node
+	v    Node
+	hint Node
+}
+
+func (n *MakeMapExprNode) K() Node {
+	return n.k
+}
+
+func (n *MakeMapExprNode) SetK(v Node) {
+	n.k = v
+}
+
+func (n *MakeMapExprNode) V() Node {
+	return n.v
+}
+
+func (n *MakeMapExprNode) SetV(v Node) {
+	n.v = v
+}
+
+func (n *MakeMapExprNode) Hint() Node {
+	return n.hint
+}
+
+func (n *MakeMapExprNode) SetHint(v Node) {
+	n.hint = v
+}
+
+func (n *MakeMapExprNode) BuildLink() {
+	if !n.K().IsDummy() {
+		k := n.K()
+		k.BuildLink()
+		k.SetParent(n)
+		k.SetSelfField("k")
+		k.SetReplaceSelf(func(n Node) {
+			n.Parent().(*MakeMapExprNode).SetK(n)
+		})
+	}
+	if !n.V().IsDummy() {
+		v := n.V()
+		v.BuildLink()
+		v.SetParent(n)
+		v.SetSelfField("v")
+		v.SetReplaceSelf(func(n Node) {
+			n.Parent().(*MakeMapExprNode).SetV(n)
+		})
+	}
+	if !n.Hint().IsDummy() {
+		hint := n.Hint()
+		hint.BuildLink()
+		hint.SetParent(n)
+		hint.SetSelfField("hint")
+		hint.SetReplaceSelf(func(n Node) {
+			n.Parent().(*MakeMapExprNode).SetHint(n)
+		})
+	}
+}
+
+func (n *MakeMapExprNode) Fields() []string {
+	return []string{
+		"k",
+		"v",
+		"hint",
+	}
+}
+
+func (n *MakeMapExprNode) Child(field string) Node {
+	if field == "" {
+		return nil
+	}
+	if field == "k" {
+		return n.K()
+	}
+	if field == "v" {
+		return n.V()
+	}
+	if field == "hint" {
+		return n.Hint()
+	}
+	return nil
+}
+
+func (n *MakeMapExprNode) SetChild(nodes []Node) {
+	if len(nodes) != 3 {
+		return
+	}
+	n.SetK(nodes[0])
+	n.SetV(nodes[1])
+	n.SetHint(nodes[2])
+}
+
+func (n *MakeMapExprNode) Fork() Node {
+	_ret := &MakeMapExprNode{
+		BaseNode: n.BaseNode.fork(),
+		k:        n.k.Fork(),
+		v:        n.v.Fork(),
+		hint:     n.hint.Fork(),
+	}
+	_ret.k.SetParent(_ret)
+	_ret.v.SetParent(_ret)
+	_ret.hint.SetParent(_ret)
+	return _ret
+}
+
+func (n *MakeMapExprNode) Visit(beforeChildren func(node Node) (visitChildren, exit bool), afterChildren func(node Node) (exit bool)) (exit bool) {
+	vc, e := beforeChildren(n)
+	if e {
+		return true
+	}
+	if !vc {
+		return false
+	}
+	if n.k.Visit(beforeChildren, afterChildren) {
+		return true
+	}
+	if n.v.Visit(beforeChildren, afterChildren) {
+		return true
+	}
+	if n.hint.Visit(beforeChildren, afterChildren) {
+		return true
+	}
+	if afterChildren(n) {
+		return true
+	}
+	return false
+}
+
+func (n *MakeMapExprNode) Accept(v Visitor) bool {
+	return v.VisitMakeMapExprNode(n)
+}
+
+func (n *MakeMapExprNode) exprNode() {}
+
+func (n *MakeMapExprNode) Rewrite(r Rewriter) Node {
+	n.k = rewriteChild(r, n.k)
+	n.v = rewriteChild(r, n.v)
+	n.hint = rewriteChild(r, n.hint)
+	return r.RewriteMakeMapExprNode(n)
+}
+
+func (n *MakeMapExprNode) Dump(hook func(Node, map[string]string) string) map[string]string {
+	ret := make(map[string]string)
+	ret["kind"] = "\"make_map_expr\""
+	ret["k"] = DumpNode(n.K(), hook)
+	ret["v"] = DumpNode(n.V(), hook)
+	ret["hint"] = DumpNode(n.Hint(), hook)
+	return ret
+}
+
+func NewMakeSliceExprNode(filePath string, fileContent []rune, type_ Node, len_ Node, cap Node, start, end Position) Node {
+	if type_ == nil {
+		type_ = DummyNode
+	}
+	if len_ == nil {
+		len_ = DummyNode
+	}
+	if cap == nil {
+		cap = DummyNode
+	}
+	_1 := &MakeSliceExprNode{
+		BaseNode: NewBaseNode(filePath, fileContent, NodeTypeMakeSliceExpr, start, end),
+		type_:    type_,
+		len_:     len_,
+		cap:      cap,
+	}
+	creationHook(_1)
+	return _1
+}
+
+type MakeSliceExprNode struct {
+	*BaseNode
+	type_ Node
+	len_  Node
+	cap   Node
+}
+
+func (n *MakeSliceExprNode) Type() Node {
+	return n.type_
+}
+
+func (n *MakeSliceExprNode) SetType(v Node) {
+	n.type_ = v
+}
+
+func (n *MakeSliceExprNode) Len() Node {
+	return n.len_
+}
+
+func (n *MakeSliceExprNode) SetLen(v Node) {
+	n.len_ = v
+}
+
+func (n *MakeSliceExprNode) Cap() Node {
+	return n.cap
+}
+
+func (n *MakeSliceExprNode) SetCap(v Node) {
+	n.cap = v
+}
+
+func (n *MakeSliceExprNode) BuildLink() {
+	if !n.Type().IsDummy() {
+		type_ := n.Type()
+		type_.BuildLink()
+		type_.SetParent(n)
+		type_.SetSelfField("type_")
+		type_.SetReplaceSelf(func(n Node) {
+			n.Parent().(*MakeSliceExprNode).SetType(n)
+		})
+	}
+	if !n.Len().IsDummy() {
+		len_ := n.Len()
+		len_.BuildLink()
+		len_.SetParent(n)
+		len_.SetSelfField("len_")
+		len_.SetReplaceSelf(func(n Node) {
+			n.Parent().(*MakeSliceExprNode).SetLen(n)
+		})
+	}
+	if !n.Cap().IsDummy() {
+		cap := n.Cap()
+		cap.BuildLink()
+		cap.SetParent(n)
+		cap.SetSelfField("cap")
+		cap.SetReplaceSelf(func(n Node) {
+			n.Parent().(*MakeSliceExprNode).SetCap(n)
+		})
+	}
+}
+
+func (n *MakeSliceExprNode) Fields() []string {
+	return []string{
+		"type_",
+		"len_",
+		"cap",
+	}
+}
+
+func (n *MakeSliceExprNode) Child(field string) Node {
+	if field == "" {
+		return nil
+	}
+	if field == "type_" {
+		return n.Type()
+	}
+	if field == "len_" {
+		return n.Len()
+	}
+	if field == "cap" {
+		return n.Cap()
+	}
+	return nil
+}
+
+func (n *MakeSliceExprNode) SetChild(nodes []Node) {
+	if len(nodes) != 3 {
+		return
+	}
+	n.SetType(nodes[0])
+	n.SetLen(nodes[1])
+	n.SetCap(nodes[2])
+}
+
+func (n *MakeSliceExprNode) Fork() Node {
+	_ret := &MakeSliceExprNode{
+		BaseNode: n.BaseNode.fork(),
+		type_:    n.type_.Fork(),
+		len_:     n.len_.Fork(),
+		cap:      n.cap.Fork(),
+	}
+	_ret.type_.SetParent(_ret)
+	_ret.len_.SetParent(_ret)
+	_ret.cap.SetParent(_ret)
+	return _ret
+}
+
+func (n *MakeSliceExprNode) Visit(beforeChildren func(node Node) (visitChildren, exit bool), afterChildren func(node Node) (exit bool)) (exit bool) {
+	vc, e := beforeChildren(n)
+	if e {
+		return true
+	}
+	if !vc {
+		return false
+	}
+	if n.type_.Visit(beforeChildren, afterChildren) {
+		return true
+	}
+	if n.len_.Visit(beforeChildren, afterChildren) {
+		return true
+	}
+	if n.cap.Visit(beforeChildren, afterChildren) {
+		return true
+	}
+	if afterChildren(n) {
+		return true
+	}
+	return false
+}
+
+func (n *MakeSliceExprNode) Accept(v Visitor) bool {
+	return v.VisitMakeSliceExprNode(n)
+}
+
+func (n *MakeSliceExprNode) exprNode() {}
+
+func (n *MakeSliceExprNode) Rewrite(r Rewriter) Node {
+	n.type_ = rewriteChild(r, n.type_)
+	n.len_ = rewriteChild(r, n.len_)
+	n.cap = rewriteChild(r, n.cap)
+	return r.RewriteMakeSliceExprNode(n)
+}
+
+func (n *MakeSliceExprNode) Dump(hook func(Node, map[string]string) string) map[string]string {
+	ret := make(map[string]string)
+	ret["kind"] = "\"make_slice_expr\""
+	ret["type"] = DumpNode(n.Type(), hook)
+	ret["len"] = DumpNode(n.Len(), hook)
+	ret["cap"] = DumpNode(n.Cap(), hook)
+	return ret
+}
+
+func NewMethodDeclNode(filePath string, fileContent []rune, receiver Node, name Node, parameter Node, result Node, body Node, start, end Position) Node {
+	if receiver == nil {
+		receiver = DummyNode
+	}
+	if name == nil {
+		name = DummyNode
+	}
+	if parameter == nil {
+		parameter = DummyNode
+	}
+	if result == nil {
+		result = DummyNode
+	}
+	if body == nil {
+		body = DummyNode
+	}
+	_1 := &MethodDeclNode{
+		BaseNode:  NewBaseNode(filePath, fileContent, NodeTypeMethodDecl, start, end),
+		receiver:  receiver,
+		name:      name,
+		parameter: parameter,
+		result:    result,
+		body:      body,
+	}
+	creationHook(_1)
+	return _1
+}
+
+type MethodDeclNode struct {
+	*BaseNode
+	receiver  Node
+	name      Node
+	parameter Node
+	result    Node
+	body      Node
+}
+
+func (n *MethodDeclNode) Receiver() Node {
+	return n.receiver
+}
+
+func (n *MethodDeclNode) SetReceiver(v Node) {
+	n.receiver = v
+}
+
+func (n *MethodDeclNode) Name() Node {
+	return n.name
+}
+
+func (n *MethodDeclNode) SetName(v Node) {
+	n.name = v
+}
+
+func (n *MethodDeclNode) Parameter() Node {
+	return n.parameter
+}
+
+func (n *MethodDeclNode) SetParameter(v Node) {
+	n.parameter = v
+}
+
+func (n *MethodDeclNode) Result() Node {
+	return n.result
+}
+
+func (n *MethodDeclNode) SetResult(v Node) {
+	n.result = v
+}
+
+func (n *MethodDeclNode) Body() Node {
+	return n.body
+}
+
+func (n *MethodDeclNode) SetBody(v Node) {
+	n.body = v
+}
+
+func (n *MethodDeclNode) BuildLink() {
+	if !n.Receiver().IsDummy() {
+		receiver := n.Receiver()
+		receiver.BuildLink()
+		receiver.SetParent(n)
+		receiver.SetSelfField("receiver")
+		receiver.SetReplaceSelf(func(n Node) {
+			n.Parent().(*MethodDeclNode).SetReceiver(n)
+		})
+	}
+	if !n.Name().IsDummy() {
+		name := n.Name()
+		name.BuildLink()
+		name.SetParent(n)
+		name.SetSelfField("name")
+		name.SetReplaceSelf(func(n Node) {
+			n.Parent().(*MethodDeclNode).SetName(n)
+		})
+	}
+	if !n.Parameter().IsDummy() {
+		parameter := n.Parameter()
+		parameter.BuildLink()
+		parameter.SetParent(n)
+		parameter.SetSelfField("parameter")
+		parameter.SetReplaceSelf(func(n Node) {
+			n.Parent().(*MethodDeclNode).SetParameter(n)
+		})
+	}
+	if !n.Result().IsDummy() {
+		result := n.Result()
+		result.BuildLink()
+		result.SetParent(n)
+		result.SetSelfField("result")
+		result.SetReplaceSelf(func(n Node) {
+			n.Parent().(*MethodDeclNode).SetResult(n)
+		})
+	}
+	if !n.Body().IsDummy() {
+		body := n.Body()
+		body.BuildLink()
+		body.SetParent(n)
+		body.SetSelfField("body")
+		body.SetReplaceSelf(func(n Node) {
+			n.Parent().(*MethodDeclNode).SetBody(n)
+		})
+	}
+}
+
+func (n *MethodDeclNode) Fields() []string {
+	return []string{
+		"receiver",
+		"name",
+		"parameter",
+		"result",
+		"body",
+	}
+}
+
+func (n *MethodDeclNode) Child(field string) Node {
+	if field == "" {
+		return nil
+	}
+	if field == "receiver" {
+		return n.Receiver()
+	}
+	if field == "name" {
+		return n.Name()
+	}
+	if field == "parameter" {
+		return n.Parameter()
+	}
+	if field == "result" {
+		return n.Result()
+	}
+	if field == "body" {
+		return n.Body()
+	}
+	return nil
+}
+
+func (n *MethodDeclNode) SetChild(nodes []Node) {
+	if len(nodes) != 5 {
+		return
+	}
+	n.SetReceiver(nodes[0])
+	n.SetName(nodes[1])
+	n.SetParameter(nodes[2])
+	n.SetResult(nodes[3])
+	n.SetBody(nodes[4])
+}
+
+func (n *MethodDeclNode) Fork() Node {
+	_ret := &MethodDeclNode{
+		BaseNode:  n.BaseNode.fork(),
+		receiver:  n.receiver.Fork(),
+		name:      n.name.Fork(),
+		parameter: n.parameter.Fork(),
+		result:    n.result.Fork(),
+		body:      n.body.Fork(),
+	}
+	_ret.receiver.SetParent(_ret)
+	_ret.name.SetParent(_ret)
+	_ret.parameter.SetParent(_ret)
+	_ret.result.SetParent(_ret)
+	_ret.body.SetParent(_ret)
+	return _ret
+}
+
+func (n *MethodDeclNode) Visit(beforeChildren func(node Node) (visitChildren, exit bool), afterChildren func(node Node) (exit bool)) (exit bool) {
+	vc, e := beforeChildren(n)
+	if e {
+		return true
+	}
+	if !vc {
+		return false
+	}
+	if n.receiver.Visit(beforeChildren, afterChildren) {
+		return true
+	}
+	if n.name.Visit(beforeChildren, afterChildren) {
+		return true
+	}
+	if n.parameter.Visit(beforeChildren, afterChildren) {
+		return true
+	}
+	if n.result.Visit(beforeChildren, afterChildren) {
+		return true
+	}
+	if n.body.Visit(beforeChildren, afterChildren) {
+		return true
+	}
+	if afterChildren(n) {
+		return true
+	}
+	return false
+}
+
+func (n *MethodDeclNode) Accept(v Visitor) bool {
+	return v.VisitMethodDeclNode(n)
+}
+
+func (n *MethodDeclNode) declNode() {}
+
+func (n *MethodDeclNode) Rewrite(r Rewriter) Node {
+	n.receiver = rewriteChild(r, n.receiver)
+	n.name = rewriteChild(r, n.name)
+	n.parameter = rewriteChild(r, n.parameter)
+	n.result = rewriteChild(r, n.result)
+	n.body = rewriteChild(r, n.body)
+	return r.RewriteMethodDeclNode(n)
+}
+
+func (n *MethodDeclNode) Dump(hook func(Node, map[string]string) string) map[string]string {
+	ret := make(map[string]string)
+	ret["kind"] = "\"method_decl\""
+	ret["receiver"] = DumpNode(n.Receiver(), hook)
+	ret["name"] = DumpNode(n.Name(), hook)
+	ret["parameter"] = DumpNode(n.Parameter(), hook)
+	ret["result"] = DumpNode(n.Result(), hook)
+	ret["body"] = DumpNode(n.Body(), hook)
+	return ret
+}
+
+func NewReceiverDeclNode(filePath string, fileContent []rune, name Node, type_ Node, start, end Position) Node {
+	if name == nil {
+		name = DummyNode
+	}
+	if type_ == nil {
+		type_ = DummyNode
+	}
+	_1 := &ReceiverDeclNode{
+		BaseNode: NewBaseNode(filePath, fileContent, NodeTypeReceiverDecl, start, end),
+		name:     name,
+		type_:    type_,
+	}
+	creationHook(_1)
+	return _1
+}
+
+type ReceiverDeclNode struct {
+	*BaseNode
+	name  Node
+	type_ Node
+}
+
+func (n *ReceiverDeclNode) Name() Node {
+	return n.name
+}
+
+func (n *ReceiverDeclNode) SetName(v Node) {
+	n.name = v
+}
+
+func (n *ReceiverDeclNode) Type() Node {
+	return n.type_
+}
+
+func (n *ReceiverDeclNode) SetType(v Node) {
+	n.type_ = v
+}
+
+func (n *ReceiverDeclNode) BuildLink() {
+	if !n.Name().IsDummy() {
+		name := n.Name()
+		name.BuildLink()
+		name.SetParent(n)
+		name.SetSelfField("name")
+		name.SetReplaceSelf(func(n Node) {
+			n.Parent().(*ReceiverDeclNode).SetName(n)
+		})
+	}
+	if !n.Type().IsDummy() {
+		type_ := n.Type()
+		type_.BuildLink()
+		type_.SetParent(n)
+		type_.SetSelfField("type_")
+		type_.SetReplaceSelf(func(n Node) {
+			n.Parent().(*ReceiverDeclNode).SetType(n)
+		})
+	}
+}
+
+func (n *ReceiverDeclNode) Fields() []string {
+	return []string{
+		"name",
+		"type_",
+	}
+}
+
+func (n *ReceiverDeclNode) Child(field string) Node {
+	if field == "" {
+		return nil
+	}
+	if field == "name" {
+		return n.Name()
+	}
+	if field == "type_" {
+		return n.Type()
+	}
+	return nil
+}
+
+func (n *ReceiverDeclNode) SetChild(nodes []Node) {
+	if len(nodes) != 2 {
+		return
+	}
+	n.SetName(nodes[0])
+	n.SetType(nodes[1])
+}
+
+func (n *ReceiverDeclNode) Fork() Node {
+	_ret := &ReceiverDeclNode{
+		BaseNode: n.BaseNode.fork(),
+		name:     n.name.Fork(),
+		type_:    n.type_.Fork(),
+	}
+	_ret.name.SetParent(_ret)
+	_ret.type_.SetParent(_ret)
+	return _ret
+}
+
+func (n *ReceiverDeclNode) Visit(beforeChildren func(node Node) (visitChildren, exit bool), afterChildren func(node Node) (exit bool)) (exit bool) {
+	vc, e := beforeChildren(n)
+	if e {
+		return true
+	}
+	if !vc {
+		return false
+	}
+	if n.name.Visit(beforeChildren, afterChildren) {
+		return true
+	}
+	if n.type_.Visit(beforeChildren, afterChildren) {
+		return true
+	}
+	if afterChildren(n) {
+		return true
+	}
+	return false
+}
+
+func (n *ReceiverDeclNode) Accept(v Visitor) bool {
+	return v.VisitReceiverDeclNode(n)
+}
+
+func (n *ReceiverDeclNode) Rewrite(r Rewriter) Node {
+	n.name = rewriteChild(r, n.name)
+	n.type_ = rewriteChild(r, n.type_)
+	return r.RewriteReceiverDeclNode(n)
+}
+
+func (n *ReceiverDeclNode) Dump(hook func(Node, map[string]string) string) map[string]string {
+	ret := make(map[string]string)
+	ret["kind"] = "\"receiver_decl\""
+	ret["name"] = DumpNode(n.Name(), hook)
+	ret["type"] = DumpNode(n.Type(), hook)
+	return ret
+}
+
+func NewReceiverTypeNode(filePath string, fileContent []rune, type_ Node, genericType Node, start, end Position) Node {
+	if type_ == nil {
+		type_ = DummyNode
+	}
+	if genericType == nil {
+		genericType = DummyNode
+	}
+	_1 := &ReceiverTypeNode{
+		BaseNode:    NewBaseNode(filePath, fileContent, NodeTypeReceiverType, start, end),
+		type_:       type_,
+		genericType: genericType,
+	}
+	creationHook(_1)
+	return _1
+}
+
+type ReceiverTypeNode struct {
+	*BaseNode
+	type_       Node
+	genericType Node
+}
+
+func (n *ReceiverTypeNode) Type() Node {
+	return n.type_
+}
+
+func (n *ReceiverTypeNode) SetType(v Node) {
+	n.type_ = v
+}
+
+func (n *ReceiverTypeNode) GenericType() Node {
+	return n.genericType
+}
+
+func (n *ReceiverTypeNode) SetGenericType(v Node) {
+	n.genericType = v
+}
+
+func (n *ReceiverTypeNode) BuildLink() {
+	if !n.Type().IsDummy() {
+		type_ := n.Type()
+		type_.BuildLink()
+		type_.SetParent(n)
+		type_.SetSelfField("type_")
+		type_.SetReplaceSelf(func(n Node) {
+			n.Parent().(*ReceiverTypeNode).SetType(n)
+		})
+	}
+	if !n.GenericType().IsDummy() {
+		genericType := n.GenericType()
+		genericType.BuildLink()
+		genericType.SetParent(n)
+		genericType.SetSelfField("generic_type")
+		genericType.SetReplaceSelf(func(n Node) {
+			n.Parent().(*ReceiverTypeNode).SetGenericType(n)
+		})
+	}
+}
+
+func (n *ReceiverTypeNode) Fields() []string {
+	return []string{
+		"type_",
+		"generic_type",
+	}
+}
+
+func (n *ReceiverTypeNode) Child(field string) Node {
+	if field == "" {
+		return nil
+	}
+	if field == "type_" {
+		return n.Type()
+	}
+	if field == "generic_type" {
+		return n.GenericType()
+	}
+	return nil
+}
+
+func (n *ReceiverTypeNode) SetChild(nodes []Node) {
+	if len(nodes) != 2 {
+		return
+	}
+	n.SetType(nodes[0])
+	n.SetGenericType(nodes[1])
+}
+
+func (n *ReceiverTypeNode) Fork() Node {
+	_ret := &ReceiverTypeNode{
+		BaseNode:    n.BaseNode.fork(),
+		type_:       n.type_.Fork(),
+		genericType: n.genericType.Fork(),
+	}
+	_ret.type_.SetParent(_ret)
+	_ret.genericType.SetParent(_ret)
+	return _ret
+}
+
+func (n *ReceiverTypeNode) Visit(beforeChildren func(node Node) (visitChildren, exit bool), afterChildren func(node Node) (exit bool)) (exit bool) {
+	vc, e := beforeChildren(n)
+	if e {
+		return true
+	}
+	if !vc {
+		return false
+	}
+	if n.type_.Visit(beforeChildren, afterChildren) {
+		return true
+	}
+	if n.genericType.Visit(beforeChildren, afterChildren) {
+		return true
+	}
+	if afterChildren(n) {
+		return true
+	}
+	return false
+}
+
+func (n *ReceiverTypeNode) Accept(v Visitor) bool {
+	return v.VisitReceiverTypeNode(n)
+}
+
+func (n *ReceiverTypeNode) Rewrite(r Rewriter) Node {
+	n.type_ = rewriteChild(r, n.type_)
+	n.genericType = rewriteChild(r, n.genericType)
+	return r.RewriteReceiverTypeNode(n)
+}
+
+func (n *ReceiverTypeNode) Dump(hook func(Node, map[string]string) string) map[string]string {
+	ret := make(map[string]string)
+	ret["kind"] = "\"receiver_type\""
+	ret["type"] = DumpNode(n.Type(), hook)
+	ret["generic_type"] = DumpNode(n.GenericType(), hook)
+	return ret
+}
+
+func NewReturnStmtNode(filePath string, fileContent []rune, x Node, start, end Position) Node {
+	if x == nil {
+		x = DummyNode
+	}
+	_1 := &ReturnStmtNode{
+		BaseNode: NewBaseNode(filePath, fileContent, NodeTypeReturnStmt, start, end),
+		x:        x,
+	}
+	creationHook(_1)
+	return _1
+}
+
+type ReturnStmtNode struct {
+	*BaseNode
+	x Node
+}
+
+func (n *ReturnStmtNode) X() Node {
+	return n.x
+}
+
+func (n *ReturnStmtNode) SetX(v Node) {
+	n.x = v
+}
+
+func (n *ReturnStmtNode) BuildLink() {
+	if !n.X().IsDummy() {
+		x := n.X()
+		x.BuildLink()
+		x.SetParent(n)
+		x.SetSelfField("x")
+		x.SetReplaceSelf(func(n Node) {
+			n.Parent().(*ReturnStmtNode).SetX(n)
+		})
+	}
+}
+
+func (n *ReturnStmtNode) Fields() []string {
+	return []string{
+		"x",
+	}
+}
+
+func (n *ReturnStmtNode) Child(field string) Node {
+	if field == "" {
+		return nil
+	}
+	if field == "x" {
+		return n.X()
+	}
+	return nil
+}
+
+func (n *ReturnStmtNode) SetChild(nodes []Node) {
+	if len(nodes) != 1 {
+		return
+	}
+	n.SetX(nodes[0])
+}
+
+func (n *ReturnStmtNode) Fork() Node {
+	_ret := &ReturnStmtNode{
+		BaseNode: n.BaseNode.fork(),
+		x:        n.x.Fork(),
+	}
+	_ret.x.SetParent(_ret)
+	return _ret
+}
+
+func (n *ReturnStmtNode) Visit(beforeChildren func(node Node) (visitChildren, exit bool), afterChildren func(node Node) (exit bool)) (exit bool) {
+	vc, e := beforeChildren(n)
+	if e {
+		return true
+	}
+	if !vc {
+		return false
+	}
+	if n.x.Visit(beforeChildren, afterChildren) {
+		return true
+	}
+	if afterChildren(n) {
+		return true
+	}
+	return false
+}
+
+func (n *ReturnStmtNode) Accept(v Visitor) bool {
+	return v.VisitReturnStmtNode(n)
+}
+
+func (n *ReturnStmtNode) stmtNode() {}
+
+func (n *ReturnStmtNode) Rewrite(r Rewriter) Node {
+	n.x = rewriteChild(r, n.x)
+	return r.RewriteReturnStmtNode(n)
+}
+
+func (n *ReturnStmtNode) Dump(hook func(Node, map[string]string) string) map[string]string {
+	ret := make(map[string]string)
+	ret["kind"] = "\"return_stmt\""
+	ret["x"] = DumpNode(n.X(), hook)
+	return ret
+}
+
+func NewSelectCaseClauseNode(filePath string, fileContent []rune, x Node, y Node, start, end Position) Node {
+	if x == nil {
+		x = DummyNode
+	}
+	if y == nil {
+		y = DummyNode
+	}
+	_1 := &SelectCaseClauseNode{
+		BaseNode: NewBaseNode(filePath, fileContent, NodeTypeSelectCaseClause, start, end),
+		x:        x,
+		y:        y,
+	}
+	creationHook(_1)
+	return _1
+}
+
+type SelectCaseClauseNode struct {
+	*BaseNode
+	x Node
+	y Node
+}
+
+func (n *SelectCaseClauseNode) X() Node {
+	return n.x
+}
+
+func (n *SelectCaseClauseNode) SetX(v Node) {
+	n.x = v
+}
+
+func (n *SelectCaseClauseNode) Y() Node {
+	return n.y
+}
+
+func (n *SelectCaseClauseNode) SetY(v Node) {
+	n.y = v
+}
+
+func (n *SelectCaseClauseNode) BuildLink() {
+	if !n.X().IsDummy() {
+		x := n.X()
+		x.BuildLink()
+		x.SetParent(n)
+		x.SetSelfField("x")
+		x.SetReplaceSelf(func(n Node) {
+			n.Parent().(*SelectCaseClauseNode).SetX(n)
+		})
+	}
+	if !n.Y().IsDummy() {
+		y := n.Y()
+		y.BuildLink()
+		y.SetParent(n)
+		y.SetSelfField("y")
+		y.SetReplaceSelf(func(n Node) {
+			n.Parent().(*SelectCaseClauseNode).SetY(n)
+		})
+	}
+}
+
+func (n *SelectCaseClauseNode) Fields() []string {
+	return []string{
+		"x",
+		"y",
+	}
+}
+
+func (n *SelectCaseClauseNode) Child(field string) Node {
+	if field == "" {
+		return nil
+	}
+	if field == "x" {
+		return n.X()
+	}
+	if field == "y" {
+		return n.Y()
+	}
+	return nil
+}
+
+func (n *SelectCaseClauseNode) SetChild(nodes []Node) {
+	if len(nodes) != 2 {
+		return
+	}
+	n.SetX(nodes[0])
+	n.SetY(nodes[1])
+}
+
+func (n *SelectCaseClauseNode) Fork() Node {
+	_ret := &SelectCaseClauseNode{
+		BaseNode: n.BaseNode.fork(),
+		x:        n.x.Fork(),
+		y:        n.y.Fork(),
+	}
+	_ret.x.SetParent(_ret)
+	_ret.y.SetParent(_ret)
+	return _ret
+}
+
+func (n *SelectCaseClauseNode) Visit(beforeChildren func(node Node) (visitChildren, exit bool), afterChildren func(node Node) (exit bool)) (exit bool) {
+	vc, e := beforeChildren(n)
+	if e {
+		return true
+	}
+	if !vc {
+		return false
+	}
+	if n.x.Visit(beforeChildren, afterChildren) {
+		return true
+	}
+	if n.y.Visit(beforeChildren, afterChildren) {
+		return true
+	}
+	if afterChildren(n) {
+		return true
+	}
+	return false
+}
+
+func (n *SelectCaseClauseNode) Accept(v Visitor) bool {
+	return v.VisitSelectCaseClauseNode(n)
+}
+
+func (n *SelectCaseClauseNode) Rewrite(r Rewriter) Node {
+	n.x = rewriteChild(r, n.x)
+	n.y = rewriteChild(r, n.y)
+	return r.RewriteSelectCaseClauseNode(n)
+}
+
+func (n *SelectCaseClauseNode) Dump(hook func(Node, map[string]string) string) map[string]string {
+	ret := make(map[string]string)
+	ret["kind"] = "\"select_case_clause\""
+	ret["x"] = DumpNode(n.X(), hook)
+	ret["y"] = DumpNode(n.Y(), hook)
+	return ret
+}
+
+func NewSliceExprNode(filePath string, fileContent []rune, target Node, low Node, high Node, start, end Position) Node {
+	if target == nil {
+		target = DummyNode
+	}
+	if low == nil {
+		low = DummyNode
+	}
+	if high == nil {
+		high = DummyNode
+	}
+	_1 := &SliceExprNode{
+		BaseNode: NewBaseNode(filePath, fileContent, NodeTypeSliceExpr, start, end),
+		target:   target,
+		low:      low,
+		high:     high,
+	}
+	creationHook(_1)
+	return _1
+}
+
+type SliceExprNode struct {
+	*BaseNode
+	target Node
+	low    Node
+	high   Node
+}
+
+func (n *SliceExprNode) Target() Node {
+	return n.target
+}
+
+func (n *SliceExprNode) SetTarget(v Node) {
+	n.target = v
+}
+
+func (n *SliceExprNode) Low() Node {
+	return n.low
+}
+
+func (n *SliceExprNode) SetLow(v Node) {
+	n.low = v
+}
+
+func (n *SliceExprNode) High() Node {
+	return n.high
+}
+
+func (n *SliceExprNode) SetHigh(v Node) {
+	n.high = v
+}
+
+func (n *SliceExprNode) BuildLink() {
+	if !n.Target().IsDummy() {
+		target := n.Target()
+		target.BuildLink()
+		target.SetParent(n)
+		target.SetSelfField("target")
+		target.SetReplaceSelf(func(n Node) {
+			n.Parent().(*SliceExprNode).SetTarget(n)
+		})
+	}
+	if !n.Low().IsDummy() {
+		low := n.Low()
+		low.BuildLink()
+		low.SetParent(n)
+		low.SetSelfField("low")
+		low.SetReplaceSelf(func(n Node) {
+			n.Parent().(*SliceExprNode).SetLow(n)
+		})
+	}
+	if !n.High().IsDummy() {
+		high := n.High()
+		high.BuildLink()
+		high.SetParent(n)
+		high.SetSelfField("high")
+		high.SetReplaceSelf(func(n Node) {
+			n.Parent().(*SliceExprNode).SetHigh(n)
+		})
+	}
+}
+
+func (n *SliceExprNode) Fields() []string {
+	return []string{
+		"target",
+		"low",
+		"high",
+	}
+}
+
+func (n *SliceExprNode) Child(field string) Node {
+	if field == "" {
+		return nil
+	}
+	if field == "target" {
+		return n.Target()
+	}
+	if field == "low" {
+		return n.Low()
+	}
+	if field == "high" {
+		return n.High()
+	}
+	return nil
+}
+
+func (n *SliceExprNode) SetChild(nodes []Node) {
+	if len(nodes) != 3 {
+		return
+	}
+	n.SetTarget(nodes[0])
+	n.SetLow(nodes[1])
+	n.SetHigh(nodes[2])
+}
+
+func (n *SliceExprNode) Fork() Node {
+	_ret := &SliceExprNode{
+		BaseNode: n.BaseNode.fork(),
+		target:   n.target.Fork(),
+		low:      n.low.Fork(),
+		high:     n.high.Fork(),
+	}
+	_ret.target.SetParent(_ret)
+	_ret.low.SetParent(_ret)
+	_ret.high.SetParent(_ret)
+	return _ret
+}
+
+func (n *SliceExprNode) Visit(beforeChildren func(node Node) (visitChildren, exit bool), afterChildren func(node Node) (exit bool)) (exit bool) {
+	vc, e := beforeChildren(n)
+	if e {
+		return true
+	}
+	if !vc {
+		return false
+	}
+	if n.target.Visit(beforeChildren, afterChildren) {
+		return true
+	}
+	if n.low.Visit(beforeChildren, afterChildren) {
+		return true
+	}
+	if n.high.Visit(beforeChildren, afterChildren) {
+		return true
+	}
+	if afterChildren(n) {
+		return true
+	}
+	return false
+}
+
+func (n *SliceExprNode) Accept(v Visitor) bool {
+	return v.VisitSliceExprNode(n)
+}
+
+func (n *SliceExprNode) exprNode() {}
+
+func (n *SliceExprNode) Rewrite(r Rewriter) Node {
+	n.target = rewriteChild(r, n.target)
+	n.low = rewriteChild(r, n.low)
+	n.high = rewriteChild(r, n.high)
+	return r.RewriteSliceExprNode(n)
+}
+
+func (n *SliceExprNode) Dump(hook func(Node, map[string]string) string) map[string]string {
+	ret := make(map[string]string)
+	ret["kind"] = "\"slice_expr\""
+	ret["target"] = DumpNode(n.Target(), hook)
+	ret["low"] = DumpNode(n.Low(), hook)
+	ret["high"] = DumpNode(n.High(), hook)
+	return ret
+}
+
+func NewStarReceiverTypeNode(filePath string, fileContent []rune, type_ Node, genericType Node, start, end Position) Node {
+	if type_ == nil {
+		type_ = DummyNode
+	}
+	if genericType == nil {
+		genericType = DummyNode
+	}
+	_1 := &StarReceiverTypeNode{
+		BaseNode:    NewBaseNode(filePath, fileContent, NodeTypeStarReceiverType, start, end),
+		type_:       type_,
+		genericType: genericType,
+	}
+	creationHook(_1)
+	return _1
+}
+
+type StarReceiverTypeNode struct {
+	*BaseNode
+	type_       Node
+	genericType Node
+}
+
+func (n *StarReceiverTypeNode) Type() Node {
+	return n.type_
+}
+
+func (n *StarReceiverTypeNode) SetType(v Node) {
+	n.type_ = v
+}
+
+func (n *StarReceiverTypeNode) GenericType() Node {
+	return n.genericType
+}
+
+func (n *StarReceiverTypeNode) SetGenericType(v Node) {
+	n.genericType = v
+}
+
+func (n *StarReceiverTypeNode) BuildLink() {
+	if !n.Type().IsDummy() {
+		type_ := n.Type()
+		type_.BuildLink()
+		type_.SetParent(n)
+		type_.SetSelfField("type_")
+		type_.SetReplaceSelf(func(n Node) {
+			n.Parent().(*StarReceiverTypeNode).SetType(n)
+		})
+	}
+	if !n.GenericType().IsDummy() {
+		genericType := n.GenericType()
+		genericType.BuildLink()
+		genericType.SetParent(n)
+		genericType.SetSelfField("generic_type")
+		genericType.SetReplaceSelf(func(n Node) {
+			n.Parent().(*StarReceiverTypeNode).SetGenericType(n)
+		})
+	}
+}
+
+func (n *StarReceiverTypeNode) Fields() []string {
+	return []string{
+		"type_",
+		"generic_type",
+	}
+}
+
+func (n *StarReceiverTypeNode) Child(field string) Node {
+	if field == "" {
+		return nil
+	}
+	if field == "type_" {
+		return n.Type()
+	}
+	if field == "generic_type" {
+		return n.GenericType()
+	}
+	return nil
+}
+
+func (n *StarReceiverTypeNode) SetChild(nodes []Node) {
+	if len(nodes) != 2 {
+		return
+	}
+	n.SetType(nodes[0])
+	n.SetGenericType(nodes[1])
+}
+
+func (n *StarReceiverTypeNode) Fork() Node {
+	_ret := &StarReceiverTypeNode{
+		BaseNode:    n.BaseNode.fork(),
+		type_:       n.type_.Fork(),
+		genericType: n.genericType.Fork(),
+	}
+	_ret.type_.SetParent(_ret)
+	_ret.genericType.SetParent(_ret)
+	return _ret
+}
+
+func (n *StarReceiverTypeNode) Visit(beforeChildren func(node Node) (visitChildren, exit bool), afterChildren func(node Node) (exit bool)) (exit bool) {
+	vc, e := beforeChildren(n)
+	if e {
+		return true
+	}
+	if !vc {
+		return false
+	}
+	if n.type_.Visit(beforeChildren, afterChildren) {
+		return true
+	}
+	if n.genericType.Visit(beforeChildren, afterChildren) {
+		return true
+	}
+	if afterChildren(n) {
+		return true
+	}
+	return false
+}
+
+func (n *StarReceiverTypeNode) Accept(v Visitor) bool {
+	return v.VisitStarReceiverTypeNode(n)
+}
+
+func (n *StarReceiverTypeNode) Rewrite(r Rewriter) Node {
+	n.type_ = rewriteChild(r, n.type_)
+	n.genericType = rewriteChild(r, n.genericType)
+	return r.RewriteStarReceiverTypeNode(n)
+}
+
+func (n *StarReceiverTypeNode) Dump(hook func(Node, map[string]string) string) map[string]string {
+	ret := make(map[string]string)
+	ret["kind"] = "\"star_receiver_type\""
+	ret["type"] = DumpNode(n.Type(), hook)
+	ret["generic_type"] = DumpNode(n.GenericType(), hook)
+	return ret
+}
+
+func NewSwitchStmtNode(filePath string, fileContent []rune, init Node, tag Node, s Node, start, end Position) Node {
+	if init == nil {
+		init = DummyNode
+	}
+	if tag == nil {
+		tag = DummyNode
+	}
+	if s == nil {
+		s = DummyNode
+	}
+	_1 := &SwitchStmtNode{
+		BaseNode: NewBaseNode(filePath, fileContent, NodeTypeSwitchStmt, start, end),
+		init:     init,
+		tag:      tag,
+		s:        s,
+	}
+	creationHook(_1)
+	return _1
+}
+
+type SwitchStmtNode struct {
+	*BaseNode
+	init Node
+	tag  Node
+	s    Node
+}
+
+func (n *SwitchStmtNode) Init() Node {
+	return n.init
+}
+
+func (n *SwitchStmtNode) SetInit(v Node) {
+	n.init = v
+}
+
+func (n *SwitchStmtNode) Tag() Node {
+	return n.tag
+}
+
+func (n *SwitchStmtNode) SetTag(v Node) {
+	n.tag = v
+}
+
+func (n *SwitchStmtNode) S() Node {
+	return n.s
+}
+
+func (n *SwitchStmtNode) SetS(v Node) {
+	n.s = v
+}
+
+func (n *SwitchStmtNode) BuildLink() {
+	if !n.Init().IsDummy() {
+		init := n.Init()
+		init.BuildLink()
+		init.SetParent(n)
+		init.SetSelfField("init")
+		init.SetReplaceSelf(func(n Node) {
+			n.Parent().(*SwitchStmtNode).SetInit(n)
+		})
+	}
+	if !n.Tag().IsDummy() {
+		tag := n.Tag()
+		tag.BuildLink()
+		tag.SetParent(n)
+		tag.SetSelfField("tag")
+		tag.SetReplaceSelf(func(n Node) {
+			n.Parent().(*SwitchStmtNode).SetTag(n)
+		})
+	}
+	if !n.S().IsDummy() {
+		s := n.S()
+		s.BuildLink()
+		s.SetParent(n)
+		s.SetSelfField("s")
+		s.SetReplaceSelf(func(n Node) {
+			n.Parent().(*SwitchStmtNode).SetS(n)
+		})
+	}
+}
+
+func (n *SwitchStmtNode) Fields() []string {
+	return []string{
+		"init",
+		"tag",
+		"s",
+	}
+}
+
+func (n *SwitchStmtNode) Child(field string) Node {
+	if field == "" {
+		return nil
+	}
+	if field == "init" {
+		return n.Init()
+	}
+	if field == "tag" {
+		return n.Tag()
+	}
+	if field == "s" {
+		return n.S()
+	}
+	return nil
+}
+
+func (n *SwitchStmtNode) SetChild(nodes []Node) {
+	if len(nodes) != 3 {
+		return
+	}
+	n.SetInit(nodes[0])
+	n.SetTag(nodes[1])
+	n.SetS(nodes[2])
+}
+
+func (n *SwitchStmtNode) Fork() Node {
+	_ret := &SwitchStmtNode{
+		BaseNode: n.BaseNode.fork(),
+		init:     n.init.Fork(),
+		tag:      n.tag.Fork(),
+		s:        n.s.Fork(),
+	}
+	_ret.init.SetParent(_ret)
+	_ret.tag.SetParent(_ret)
+	_ret.s.SetParent(_ret)
+	return _ret
+}
+
+func (n *SwitchStmtNode) Visit(beforeChildren func(node Node) (visitChildren, exit bool), afterChildren func(node Node) (exit bool)) (exit bool) {
+	vc, e := beforeChildren(n)
+	if e {
+		return true
+	}
+	if !vc {
+		return false
+	}
+	if n.init.Visit(beforeChildren, afterChildren) {
+		return true
+	}
+	if n.tag.Visit(beforeChildren, afterChildren) {
+		return true
+	}
+	if n.s.Visit(beforeChildren, afterChildren) {
+		return true
+	}
+	if afterChildren(n) {
+		return true
+	}
+	return false
+}
+
+func (n *SwitchStmtNode) Accept(v Visitor) bool {
+	return v.VisitSwitchStmtNode(n)
+}
+
+func (n *SwitchStmtNode) stmtNode() {}
+
+func (n *SwitchStmtNode) Rewrite(r Rewriter) Node {
+	n.init = rewriteChild(r, n.init)
+	n.tag = rewriteChild(r, n.tag)
+	n.s = rewriteChild(r, n.s)
+	return r.RewriteSwitchStmtNode(n)
+}
+
+func (n *SwitchStmtNode) Dump(hook func(Node, map[string]string) string) map[string]string {
+	ret := make(map[string]string)
+	ret["kind"] = "\"switch_stmt\""
+	ret["init"] = DumpNode(n.Init(), hook)
+	ret["tag"] = DumpNode(n.Tag(), hook)
+	ret["s"] = DumpNode(n.S(), hook)
+	return ret
+}
+
+func NewTypeCaseClauseNode(filePath string, fileContent []rune, x Node, y Node, start, end Position) Node {
+	if x == nil {
+		x = DummyNode
+	}
+	if y == nil {
+		y = DummyNode
+	}
+	_1 := &TypeCaseClauseNode{
+		BaseNode: NewBaseNode(filePath, fileContent, NodeTypeTypeCaseClause, start, end),
+		x:        x,
+		y:        y,
+	}
+	creationHook(_1)
+	return _1
+}
+
+type TypeCaseClauseNode struct {
+	*BaseNode
+	x Node
+	y Node
+}
+
+func (n *TypeCaseClauseNode) X() Node {
+	return n.x
+}
+
+func (n *TypeCaseClauseNode) SetX(v Node) {
+	n.x = v
+}
+
+func (n *TypeCaseClauseNode) Y() Node {
+	return n.y
+}
+
+func (n *TypeCaseClauseNode) SetY(v Node) {
+	n.y = v
+}
+
+func (n *TypeCaseClauseNode) BuildLink() {
+	if !n.X().IsDummy() {
+		x := n.X()
+		x.BuildLink()
+		x.SetParent(n)
+		x.SetSelfField("x")
+		x.SetReplaceSelf(func(n Node) {
+			n.Parent().(*TypeCaseClauseNode).SetX(n)
+		})
+	}
+	if !n.Y().IsDummy() {
+		y := n.Y()
+		y.BuildLink()
+		y.SetParent(n)
+		y.SetSelfField("y")
+		y.SetReplaceSelf(func(n Node) {
+			n.Parent().(*TypeCaseClauseNode).SetY(n)
+		})
+	}
+}
+
+func (n *TypeCaseClauseNode) Fields() []string {
+	return []string{
+		"x",
+		"y",
+	}
+}
+
+func (n *TypeCaseClauseNode) Child(field string) Node {
+	if field == "" {
+		return nil
+	}
+	if field == "x" {
+		return n.X()
+	}
+	if field == "y" {
+		return n.Y()
+	}
+	return nil
+}
+
+func (n *TypeCaseClauseNode) SetChild(nodes []Node) {
+	if len(nodes) != 2 {
+		return
+	}
+	n.SetX(nodes[0])
+	n.SetY(nodes[1])
+}
+
+func (n *TypeCaseClauseNode) Fork() Node {
+	_ret := &TypeCaseClauseNode{
+		BaseNode: n.BaseNode.fork(),
+		x:        n.x.Fork(),
+		y:        n.y.Fork(),
+	}
+	_ret.x.SetParent(_ret)
+	_ret.y.SetParent(_ret)
+	return _ret
+}
+
+func (n *TypeCaseClauseNode) Visit(beforeChildren func(node Node) (visitChildren, exit bool), afterChildren func(node Node) (exit bool)) (exit bool) {
+	vc, e := beforeChildren(n)
+	if e {
+		return true
+	}
+	if !vc {
+		return false
+	}
+	if n.x.Visit(beforeChildren, afterChildren) {
+		return true
+	}
+	if n.y.Visit(beforeChildren, afterChildren) {
+		return true
+	}
+	if afterChildren(n) {
+		return true
+	}
+	return false
+}
+
+func (n *TypeCaseClauseNode) Accept(v Visitor) bool {
+	return v.VisitTypeCaseClauseNode(n)
+}
+
+func (n *TypeCaseClauseNode) Rewrite(r Rewriter) Node {
+	n.x = rewriteChild(r, n.x)
+	n.y = rewriteChild(r, n.y)
+	return r.RewriteTypeCaseClauseNode(n)
+}
+
+func (n *TypeCaseClauseNode) Dump(hook func(Node, map[string]string) string) map[string]string {
+	ret := make(map[string]string)
+	ret["kind"] = "\"type_case_clause\""
+	ret["x"] = DumpNode(n.X(), hook)
+	ret["y"] = DumpNode(n.Y(), hook)
+	return ret
+}
+
+func NewTypeEqSpecNode(filePath string, fileContent []rune, x Node, t Node, y Node, start, end Position) Node {
+	if x == nil {
+		x = DummyNode
+	}
+	if t == nil {
+		t = DummyNode
+	}
+	if y == nil {
+		y = DummyNode
+	}
+	_1 := &TypeEqSpecNode{
+		BaseNode: NewBaseNode(filePath, fileContent, NodeTypeTypeEqSpec, start, end),
+		x:        x,
+		t:        t,
+		y:        y,
+	}
+	creationHook(_1)
+	return _1
+}
+
+type TypeEqSpecNode struct {
+	*BaseNode
+	x Node
+	t Node
+	y Node
+}
+
+func (n *TypeEqSpecNode) X() Node {
+	return n.x
+}
+
+func (n *TypeEqSpecNode) SetX(v Node) {
+	n.x = v
+}
+
+func (n *TypeEqSpecNode) T() Node {
+	return n.t
+}
+
+func (n *TypeEqSpecNode) SetT(v Node) {
+	n.t = v
+}
+
+func (n *TypeEqSpecNode) Y() Node {
+	return n.y
+}
+
+func (n *TypeEqSpecNode) SetY(v Node) {
+	n.y = v
+}
+
+func (n *TypeEqSpecNode) BuildLink() {
+	if !n.X().IsDummy() {
+		x := n.X()
+		x.BuildLink()
+		x.SetParent(n)
+		x.SetSelfField("x")
+		x.SetReplaceSelf(func(n Node) {
+			n.Parent().(*TypeEqSpecNode).SetX(n)
+		})
+	}
+	if !n.T().IsDummy() {
+		t := n.T()
+		t.BuildLink()
+		t.SetParent(n)
+		t.SetSelfField("t")
+		t.SetReplaceSelf(func(n Node) {
+			n.Parent().(*TypeEqSpecNode).SetT(n)
+		})
+	}
+	if !n.Y().IsDummy() {
+		y := n.Y()
+		y.BuildLink()
+		y.SetParent(n)
+		y.SetSelfField("y")
+		y.SetReplaceSelf(func(n Node) {
+			n.Parent().(*TypeEqSpecNode).SetY(n)
+		})
+	}
+}
+
+func (n *TypeEqSpecNode) Fields() []string {
+	return []string{
+		"x",
+		"t",
+		"y",
+	}
+}
+
+func (n *TypeEqSpecNode) Child(field string) Node {
+	if field == "" {
+		return nil
+	}
+	if field == "x" {
+		return n.X()
+	}
+	if field == "t" {
+		return n.T()
+	}
+	if field == "y" {
+		return n.Y()
+	}
+	return nil
+}
+
+func (n *TypeEqSpecNode) SetChild(nodes []Node) {
+	if len(nodes) != 3 {
+		return
+	}
+	n.SetX(nodes[0])
+	n.SetT(nodes[1])
+	n.SetY(nodes[2])
+}
+
+func (n *TypeEqSpecNode) Fork() Node {
+	_ret := &TypeEqSpecNode{
+		BaseNode: n.BaseNode.fork(),
+		x:        n.x.Fork(),
+		t:        n.t.Fork(),
+		y:        n.y.Fork(),
+	}
+	_ret.x.SetParent(_ret)
+	_ret.t.SetParent(_ret)
+	_ret.y.SetParent(_ret)
+	return _ret
+}
+
+func (n *TypeEqSpecNode) Visit(beforeChildren func(node Node) (visitChildren, exit bool), afterChildren func(node Node) (exit bool)) (exit bool) {
+	vc, e := beforeChildren(n)
+	if e {
+		return true
+	}
+	if !vc {
+		return false
+	}
+	if n.x.Visit(beforeChildren, afterChildren) {
+		return true
+	}
+	if n.t.Visit(beforeChildren, afterChildren) {
+		return true
+	}
+	if n.y.Visit(beforeChildren, afterChildren) {
+		return true
+	}
+	if afterChildren(n) {
+		return true
+	}
+	return false
+}
+
+func (n *TypeEqSpecNode) Accept(v Visitor) bool {
+	return v.VisitTypeEqSpecNode(n)
+}
+
+func (n *TypeEqSpecNode) Rewrite(r Rewriter) Node {
+	n.x = rewriteChild(r, n.x)
+	n.t = rewriteChild(r, n.t)
+	n.y = rewriteChild(r, n.y)
+	return r.RewriteTypeEqSpecNode(n)
+}
+
+func (n *TypeEqSpecNode) Dump(hook func(Node, map[string]string) string) map[string]string {
+	ret := make(map[string]string)
+	ret["kind"] = "\"type_eq_spec\""
+	ret["x"] = DumpNode(n.X(), hook)
+	ret["t"] = DumpNode(n.T(), hook)
+	ret["y"] = DumpNode(n.Y(), hook)
+	return ret
+}
+
+func NewTypeSpecNode(filePath string, fileContent []rune, x Node, t Node, y Node, start, end Position) Node {
+	if x == nil {
+		x = DummyNode
+	}
+	if t == nil {
+		t = DummyNode
+	}
+	if y == nil {
+		y = DummyNode
+	}
+	_1 := &TypeSpecNode{
+		BaseNode: NewBaseNode(filePath, fileContent, NodeTypeTypeSpec, start, end),
+		x:        x,
+		t:        t,
+		y:        y,
+	}
+	creationHook(_1)
+	return _1
+}
+
+type TypeSpecNode struct {
+	*BaseNode
+	x Node
+	t Node
+	y Node
+}
+
+func (n *TypeSpecNode) X() Node {
+	return n.x
+}
+
+func (n *TypeSpecNode) SetX(v Node) {
+	n.x = v
+}
+
+func (n *TypeSpecNode) T() Node {
+	return n.t
+}
+
+func (n *TypeSpecNode) SetT(v Node) {
+	n.t = v
+}
+
+func (n *TypeSpecNode) Y() Node {
+	return n.y
+}
+
+func (n *TypeSpecNode) SetY(v Node) {
+	n.y = v
+}
+
+func (n *TypeSpecNode) BuildLink() {
+	if !n.X().IsDummy() {
+		x := n.X()
+		x.BuildLink()
+		x.SetParent(n)
+		x.SetSelfField("x")
+		x.SetReplaceSelf(func(n Node) {
+			n.Parent().(*TypeSpecNode).SetX(n)
+		})
+	}
+	if !n.T().IsDummy() {
+		t := n.T()
+		t.BuildLink()
+		t.SetParent(n)
+		t.SetSelfField("t")
+		t.SetReplaceSelf(func(n Node) {
+			n.Parent().(*TypeSpecNode).SetT(n)
+		})
+	}
+	if !n.Y().IsDummy() {
+		y := n.Y()
+		y.BuildLink()
+		y.SetParent(n)
+		y.SetSelfField("y")
+		y.SetReplaceSelf(func(n Node) {
+			n.Parent().(*TypeSpecNode).SetY(n)
+		})
+	}
+}
+
+func (n *TypeSpecNode) Fields() []string {
+	return []string{
+		"x",
+		"t",
+		"y",
+	}
+}
+
+func (n *TypeSpecNode) Child(field string) Node {
+	if field == "" {
+		return nil
+	}
+	if field == "x" {
+		return n.X()
+	}
+	if field == "t" {
+		return n.T()
+	}
+	if field == "y" {
+		return n.Y()
+	}
+	return nil
+}
+
+func (n *TypeSpecNode) SetChild(nodes []Node) {
+	if len(nodes) != 3 {
+		return
+	}
+	n.SetX(nodes[0])
+	n.SetT(nodes[1])
+	n.SetY(nodes[2])
+}
+
+func (n *TypeSpecNode) Fork() Node {
+	_ret := &TypeSpecNode{
+		BaseNode: n.BaseNode.fork(),
+		x:        n.x.Fork(),
+		t:        n.t.Fork(),
+		y:        n.y.Fork(),
+	}
+	_ret.x.SetParent(_ret)
+	_ret.t.SetParent(_ret)
+	_ret.y.SetParent(_ret)
+	return _ret
+}
+
+func (n *TypeSpecNode) Visit(beforeChildren func(node Node) (visitChildren, exit bool), afterChildren func(node Node) (exit bool)) (exit bool) {
+	vc, e := beforeChildren(n)
+	if e {
+		return true
+	}
+	if !vc {
+		return false
+	}
+	if n.x.Visit(beforeChildren, afterChildren) {
+		return true
+	}
+	if n.t.Visit(beforeChildren, afterChildren) {
+		return true
+	}
+	if n.y.Visit(beforeChildren, afterChildren) {
+		return true
+	}
+	if afterChildren(n) {
+		return true
+	}
+	return false
+}
+
+func (n *TypeSpecNode) Accept(v Visitor) bool {
+	return v.VisitTypeSpecNode(n)
+}
+
+func (n *TypeSpecNode) Rewrite(r Rewriter) Node {
+	n.x = rewriteChild(r, n.x)
+	n.t = rewriteChild(r, n.t)
+	n.y = rewriteChild(r, n.y)
+	return r.RewriteTypeSpecNode(n)
+}
+
+func (n *TypeSpecNode) Dump(hook func(Node, map[string]string) string) map[string]string {
+	ret := make(map[string]string)
+	ret["kind"] = "\"type_spec\""
+	ret["x"] = DumpNode(n.X(), hook)
+	ret["t"] = DumpNode(n.T(), hook)
+	ret["y"] = DumpNode(n.Y(), hook)
+	return ret
+}
+
+func NewTypeSwitchGuardNode(filePath string, fileContent []rune, i Node, r Node, start, end Position) Node {
+	if i == nil {
+		i = DummyNode
+	}
+	if r == nil {
+		r = DummyNode
+	}
+	_1 := &TypeSwitchGuardNode{
+		BaseNode: NewBaseNode(filePath, fileContent, NodeTypeTypeSwitchGuard, start, end),
+		i:        i,
+		r:        r,
+	}
+	creationHook(_1)
+	return _1
+}
+
+type TypeSwitchGuardNode struct {
+	*BaseNode
+	i Node
+	r Node
+}
+
+func (n *TypeSwitchGuardNode) I() Node {
+	return n.i
+}
+
+func (n *TypeSwitchGuardNode) SetI(v Node) {
+	n.i = v
+}
+
+func (n *TypeSwitchGuardNode) R() Node {
+	return n.r
+}
+
+func (n *TypeSwitchGuardNode) SetR(v Node) {
+	n.r = v
+}
+
+func (n *TypeSwitchGuardNode) BuildLink() {
+	if !n.I().IsDummy() {
+		i := n.I()
+		i.BuildLink()
+		i.SetParent(n)
+		i.SetSelfField("i")
+		i.SetReplaceSelf(func(n Node) {
+			n.Parent().(*TypeSwitchGuardNode).SetI(n)
+		})
+	}
+	if !n.R().IsDummy() {
+		r := n.R()
+		r.BuildLink()
+		r.SetParent(n)
+		r.SetSelfField("r")
+		r.SetReplaceSelf(func(n Node) {
+			n.Parent().(*TypeSwitchGuardNode).SetR(n)
+		})
+	}
+}
+
+func (n *TypeSwitchGuardNode) Fields() []string {
+	return []string{
+		"i",
+		"r",
+	}
+}
+
+func (n *TypeSwitchGuardNode) Child(field string) Node {
+	if field == "" {
+		return nil
+	}
+	if field == "i" {
+		return n.I()
+	}
+	if field == "r" {
+		return n.R()
+	}
+	return nil
+}
+
+func (n *TypeSwitchGuardNode) SetChild(nodes []Node) {
+	if len(nodes) != 2 {
+		return
+	}
+	n.SetI(nodes[0])
+	n.SetR(nodes[1])
+}
+
+func (n *TypeSwitchGuardNode) Fork() Node {
+	_ret := &TypeSwitchGuardNode{
+		BaseNode: n.BaseNode.fork(),
+		i:        n.i.Fork(),
+		r:        n.r.Fork(),
+	}
+	_ret.i.SetParent(_ret)
+	_ret.r.SetParent(_ret)
+	return _ret
+}
+
+func (n *TypeSwitchGuardNode) Visit(beforeChildren func(node Node) (visitChildren, exit bool), afterChildren func(node Node) (exit bool)) (exit bool) {
+	vc, e := beforeChildren(n)
+	if e {
+		return true
+	}
+	if !vc {
+		return false
+	}
+	if n.i.Visit(beforeChildren, afterChildren) {
+		return true
+	}
+	if n.r.Visit(beforeChildren, afterChildren) {
+		return true
+	}
+	if afterChildren(n) {
+		return true
+	}
+	return false
+}
+
+func (n *TypeSwitchGuardNode) Accept(v Visitor) bool {
+	return v.VisitTypeSwitchGuardNode(n)
+}
+
+func (n *TypeSwitchGuardNode) Rewrite(r Rewriter) Node {
+	n.i = rewriteChild(r, n.i)
+	n.r = rewriteChild(r, n.r)
+	return r.RewriteTypeSwitchGuardNode(n)
+}
+
+func (n *TypeSwitchGuardNode) Dump(hook func(Node, map[string]string) string) map[string]string {
+	ret := make(map[string]string)
+	ret["kind"] = "\"type_switch_guard\""
+	ret["i"] = DumpNode(n.I(), hook)
+	ret["r"] = DumpNode(n.R(), hook)
+	return ret
+}
+
+func NewTypeSwitchStmtNode(filePath string, fileContent []rune, init Node, assign Node, s Node, start, end Position) Node {
+	if init == nil {
+		init = DummyNode
+	}
+	if assign == nil {
+		assign = DummyNode
+	}
+	if s == nil {
+		s = DummyNode
+	}
+	_1 := &TypeSwitchStmtNode{
+		BaseNode: NewBaseNode(filePath, fileContent, NodeTypeTypeSwitchStmt, start, end),
+		init:     init,
+		assign:   assign,
+		s:        s,
+	}
+	creationHook(_1)
+	return _1
+}
+
+type TypeSwitchStmtNode struct {
+	*BaseNode
+	init   Node
+	assign Node
+	s      Node
+}
+
+func (n *TypeSwitchStmtNode) Init() Node {
+	return n.init
+}
+
+func (n *TypeSwitchStmtNode) SetInit(v Node) {
+	n.init = v
+}
+
+func (n *TypeSwitchStmtNode) Assign() Node {
+	return n.assign
+}
+
+func (n *TypeSwitchStmtNode) SetAssign(v Node) {
+	n.assign = v
+}
+
+func (n *TypeSwitchStmtNode) S() Node {
+	return n.s
+}
+
+func (n *TypeSwitchStmtNode) SetS(v Node) {
+	n.s = v
+}
+
+func (n *TypeSwitchStmtNode) BuildLink() {
+	if !n.Init().IsDummy() {
+		init := n.Init()
+		init.BuildLink()
+		init.SetParent(n)
+		init.SetSelfField("init")
+		init.SetReplaceSelf(func(n Node) {
+			n.Parent().(*TypeSwitchStmtNode).SetInit(n)
+		})
+	}
+	if !n.Assign().IsDummy() {
+		assign := n.Assign()
+		assign.BuildLink()
+		assign.SetParent(n)
+		assign.SetSelfField("assign")
+		assign.SetReplaceSelf(func(n Node) {
+			n.Parent().(*TypeSwitchStmtNode).SetAssign(n)
+		})
+	}
+	if !n.S().IsDummy() {
+		s := n.S()
+		s.BuildLink()
+		s.SetParent(n)
+		s.SetSelfField("s")
+		s.SetReplaceSelf(func(n Node) {
+			n.Parent().(*TypeSwitchStmtNode).SetS(n)
+		})
+	}
+}
+
+func (n *TypeSwitchStmtNode) Fields() []string {
+	return []string{
+		"init",
+		"assign",
+		"s",
+	}
+}
+
+func (n *TypeSwitchStmtNode) Child(field string) Node {
+	if field == "" {
+		return nil
+	}
+	if field == "init" {
+		return n.Init()
+	}
+	if field == "assign" {
+		return n.Assign()
+	}
+	if field == "s" {
+		return n.S()
+	}
+	return nil
+}
+
+func (n *TypeSwitchStmtNode) SetChild(nodes []Node) {
+	if len(nodes) != 3 {
+		return
+	}
+	n.SetInit(nodes[0])
+	n.SetAssign(nodes[1])
+	n.SetS(nodes[2])
+}
+
+func (n *TypeSwitchStmtNode) Fork() Node {
+	_ret := &TypeSwitchStmtNode{
+		BaseNode: n.BaseNode.fork(),
+		init:     n.init.Fork(),
+		assign:   n.assign.Fork(),
+		s:        n.s.Fork(),
+	}
+	_ret.init.SetParent(_ret)
+	_ret.assign.SetParent(_ret)
+	_ret.s.SetParent(_ret)
+	return _ret
+}
+
+func (n *TypeSwitchStmtNode) Visit(beforeChildren func(node Node) (visitChildren, exit bool), afterChildren func(node Node) (exit bool)) (exit bool) {
+	vc, e := beforeChildren(n)
+	if e {
+		return true
+	}
+	if !vc {
+		return false
+	}
+	if n.init.Visit(beforeChildren, afterChildren) {
+		return true
+	}
+	if n.assign.Visit(beforeChildren, afterChildren) {
+		return true
+	}
+	if n.s.Visit(beforeChildren, afterChildren) {
+		return true
+	}
+	if afterChildren(n) {
+		return true
+	}
+	return false
+}
+
+func (n *TypeSwitchStmtNode) Accept(v Visitor) bool {
+	return v.VisitTypeSwitchStmtNode(n)
+}
+
+func (n *TypeSwitchStmtNode) stmtNode() {}
+
+func (n *TypeSwitchStmtNode) Rewrite(r Rewriter) Node {
+	n.init = rewriteChild(r, n.init)
+	n.assign = rewriteChild(r, n.assign)
+	n.s = rewriteChild(r, n.s)
+	return r.RewriteTypeSwitchStmtNode(n)
+}
+
+func (n *TypeSwitchStmtNode) Dump(hook func(Node, map[string]string) string) map[string]string {
+	ret := make(map[string]string)
+	ret["kind"] = "\"type_switch_stmt\""
+	ret["init"] = DumpNode(n.Init(), hook)
+	ret["assign"] = DumpNode(n.Assign(), hook)
+	ret["s"] = DumpNode(n.S(), hook)
+	return ret
+}
+
+func NewVarSpecNode(filePath string, fileContent []rune, i Node, t Node, e Node, start, end Position) Node {
+	if i == nil {
+		i = DummyNode
+	}
+	if t == nil {
+		t = DummyNode
+	}
+	if e == nil {
+		e = DummyNode
+	}
+	_1 := &VarSpecNode{
+		BaseNode: NewBaseNode(filePath, fileContent, NodeTypeVarSpec, start, end),
+		i:        i,
+		t:        t,
+		e:        e,
+	}
+	creationHook(_1)
+	return _1
+}
+
+type VarSpecNode struct {
+	*BaseNode
+	i Node
+	t Node
+	e Node
+}
+
+func (n *VarSpecNode) I() Node {
+	return n.i
+}
+
+func (n *VarSpecNode) SetI(v Node) {
+	n.i = v
+}
+
+func (n *VarSpecNode) T() Node {
+	return n.t
+}
+
+func (n *VarSpecNode) SetT(v Node) {
+	n.t = v
+}
+
+func (n *VarSpecNode) E() Node {
+	return n.e
+}
+
+func (n *VarSpecNode) SetE(v Node) {
+	n.e = v
+}
+
+func (n *VarSpecNode) BuildLink() {
+	if !n.I().IsDummy() {
+		i := n.I()
+		i.BuildLink()
+		i.SetParent(n)
+		i.SetSelfField("i")
+		i.SetReplaceSelf(func(n Node) {
+			n.Parent().(*VarSpecNode).SetI(n)
+		})
+	}
+	if !n.T().IsDummy() {
+		t := n.T()
+		t.BuildLink()
+		t.SetParent(n)
+		t.SetSelfField("t")
+		t.SetReplaceSelf(func(n Node) {
+			n.Parent().(*VarSpecNode).SetT(n)
+		})
+	}
+	if !n.E().IsDummy() {
+		e := n.E()
+		e.BuildLink()
+		e.SetParent(n)
+		e.SetSelfField("e")
+		e.SetReplaceSelf(func(n Node) {
+			n.Parent().(*VarSpecNode).SetE(n)
+		})
+	}
+}
+
+func (n *VarSpecNode) Fields() []string {
+	return []string{
+		"i",
+		"t",
+		"e",
+	}
+}
+
+func (n *VarSpecNode) Child(field string) Node {
+	if field == "" {
+		return nil
+	}
+	if field == "i" {
+		return n.I()
+	}
+	if field == "t" {
+		return n.T()
+	}
+	if field == "e" {
+		return n.E()
+	}
+	return nil
+}
+
+func (n *VarSpecNode) SetChild(nodes []Node) {
+	if len(nodes) != 3 {
+		return
+	}
+	n.SetI(nodes[0])
+	n.SetT(nodes[1])
+	n.SetE(nodes[2])
+}
+
+func (n *VarSpecNode) Fork() Node {
+	_ret := &VarSpecNode{
+		BaseNode: n.BaseNode.fork(),
+		i:        n.i.Fork(),
+		t:        n.t.Fork(),
+		e:        n.e.Fork(),
+	}
+	_ret.i.SetParent(_ret)
+	_ret.t.SetParent(_ret)
+	_ret.e.SetParent(_ret)
+	return _ret
+}
+
+func (n *VarSpecNode) Visit(beforeChildren func(node Node) (visitChildren, exit bool), afterChildren func(node Node) (exit bool)) (exit bool) {
+	vc, e := beforeChildren(n)
+	if e {
+		return true
+	}
+	if !vc {
+		return false
+	}
+	if n.i.Visit(beforeChildren, afterChildren) {
+		return true
+	}
+	if n.t.Visit(beforeChildren, afterChildren) {
+		return true
+	}
+	if n.e.Visit(beforeChildren, afterChildren) {
+		return true
+	}
+	if afterChildren(n) {
+		return true
+	}
+	return false
+}
+
+func (n *VarSpecNode) Accept(v Visitor) bool {
+	return v.VisitVarSpecNode(n)
+}
+
+func (n *VarSpecNode) Rewrite(r Rewriter) Node {
+	n.i = rewriteChild(r, n.i)
+	n.t = rewriteChild(r, n.t)
+	n.e = rewriteChild(r, n.e)
+	return r.RewriteVarSpecNode(n)
+}
+
+func (n *VarSpecNode) Dump(hook func(Node, map[string]string) string) map[string]string {
+	ret := make(map[string]string)
+	ret["kind"] = "\"var_spec\""
+	ret["i"] = DumpNode(n.I(), hook)
+	ret["t"] = DumpNode(n.T(), hook)
+	ret["e"] = DumpNode(n.E(), hook)
+	return ret
+}
+
+const eofRune rune = -1
+
+func NewTokenizer(filePath string, fileContent []rune) *Tokenizer {
+	tk := &Tokenizer{
+		_filePath:  filePath,
+		_buf:       fileContent,
+		_bufSize:   len(fileContent),
+		_pos:       Position{},
+		_prevPos:   Position{},
+		_lookahead: eofRune,
+	}
+	tk._lookahead = tk._safeRead()
+	tk.initKeywords()
+	return tk
+}
+
+type Tokenizer struct {
+	_filePath  string
+	_buf       []rune
+	_bufSize   int
+	_pos       Position
+	_prevPos   Position
+	_lookahead rune
+	_keywords  map[string]string
+	_lines     *LineIndex
+}
+
+func (tk *Tokenizer) _lineIndex() *LineIndex {
+	if tk._lines == nil {
+		tk._lines = NewLineIndex(tk._buf)
+	}
+	return tk._lines
+}
+
+func (tk *Tokenizer) Parse() (tokens []*Token, err error) {
+	tokens = make([]*Token, 0)
+	for {
+		var tok *Token
+		tok, err = tk.next()
+		if err != nil {
+			return nil, err
+		}
+		tokens = append(tokens, tok)
+		if tok.Kind == TokenTypeEndOfFile {
+			break
+		}
+	}
+	return tokens, nil
+}
+
+func (tk *Tokenizer) _lineEnd(offset int) bool {
+	return offset < len(tk._buf) && lineBreakAt(tk._buf, offset)
+}
+
+func (tk *Tokenizer) _errorMsg(msg string) string {
+	return fmt.Sprintf("fail to tokenize %s\n%s", msg, errorContext(tk._filePath, tk._lineIndex(), tk._prevPos.Offset))
+}
+
+func (tk *Tokenizer) _stepForward() {
+	p := &tk._pos
+	lineEnd := tk._lineEnd(p.Offset)
+	p.Offset++
+	p.CharIdx++
+	if lineEnd {
+		p.LineIdx++
+		p.CharIdx = 0
+	}
+}
+
+func (tk *Tokenizer) _forward() {
+	tk._stepForward()
+	tk._lookahead = tk._safeRead()
+}
+
+func (tk *Tokenizer) _mark() Position {
+	return tk._pos
+}
+
+func (tk *Tokenizer) _reset(p Position) {
+	tk._pos = p
+	tk._lookahead = tk._safeRead()
+}
+
+func (tk *Tokenizer) _reachEnd() bool {
+	return tk._pos.Offset >= tk._bufSize
+}
+
+func (tk *Tokenizer) _safeRead() rune {
+	if tk._reachEnd() {
+		return eofRune
+	} else {
+		return tk._buf[tk._pos.Offset]
+	}
+}
+
+func (tk *Tokenizer) _expect(r rune) bool {
+	if equalRune(r, tk._lookahead) {
+		tk._forward()
+		return true
+	}
+	return false
+}
+
+func (tk *Tokenizer) _expectS(s string) bool {
+	pos := tk._pos
+	for i := 0; i < len(s); i++ {
+		if equalRune(rune(s[i]), tk._lookahead) {
+			tk._forward()
+		} else {
+			tk._reset(pos)
+			return false
+		}
+	}
+	return true
+}
+
+func (tk *Tokenizer) _expectU(s []rune) bool {
+	pos := tk._pos
+	for i := 0; i < len(s); i++ {
+		if equalRune(s[i], tk._lookahead) {
+			tk._forward()
+		} else {
+			tk._reset(pos)
+			return false
+		}
+	}
+	return true
+}
+
+func (tk *Tokenizer) _expectR(s, e rune) bool {
+	if inRange(tk._lookahead, s, e) {
+		tk._forward()
+		return true
+	}
+	return false
+}
+
+func (tk *Tokenizer) _anyButEof() bool {
+	if !tk._reachEnd() {
+		tk._forward()
+		return true
+	} else {
+		return false
+	}
+}
+
+func (tk *Tokenizer) _createToken(kind string) *Token {
+	val := tk._buf[tk._prevPos.Offset:tk._pos.Offset]
+	token := NewToken(kind, tk._prevPos, tk._pos, val)
+	tk._prevPos = tk._pos
+	return token
+}
+
+// newline:
+//
+//	| '\r\n'
+//	| '\n'
+//	| '\r'
+func (tk *Tokenizer) newline() bool {
+	// '\r\n'
+	if tk._expectS("\r\n") {
+		return true
+	}
+	// '\n'
+	if tk._expectS("\n") {
+		return true
+	}
+	// '\r'
+	if tk._expectS("\r") {
+		return true
+	}
+	return false
+}
+
+// _any_but_eol:
+//
+//	| !newline _any_but_eof
+func (tk *Tokenizer) _anyButEol() bool {
+	// !newline _any_but_eof
+	_p := tk._mark()
+	_ok := false
+	if tk.newline() {
+		_ok = true
+	}
+	tk._reset(_p)
+	if !_ok {
+		if tk._anyButEof() {
+			return true
+		}
+	}
+	return false
+}
+
+// _whitespace_ch:
+//
+//	| [ \t\f\u1680\u180E\u2000-\u200A\u202F\u205F\u3000\uFEFF\u00A0]
+func (tk *Tokenizer) _whitespaceCh() bool {
+	// [ \t\f\u1680\u180E\u2000-\u200A\u202F\u205F\u3000\uFEFF\u00A0]
+	if tk._expect(0x20) || tk._expect(0x9) || tk._expect(0xC) || tk._expect(0x1680) || tk._expect(0x180E) || tk._expectR(0x2000, 0x200A) || tk._expect(0x202F) || tk._expect(0x205F) || tk._expect(0x3000) || tk._expect(0xFEFF) || tk._expect(0xA0) {
 		return true
 	}
 	return false
@@ -11752,7 +18281,7 @@ func (tk *Tokenizer) whitespace() bool {
 	return false
 }
 
-func (tk *Tokenizer) op() string {
+func (tk *Tokenizer) op() (string, error) {
 	entered := false
 	kind := TokenTypeDummy
 	switch tk._lookahead {
@@ -11982,19 +18511,18 @@ func (tk *Tokenizer) op() string {
 		break
 	}
 	if entered && kind == TokenTypeDummy {
-		panic(tk._errorMsg("op"))
+		return kind, errors.New(tk._errorMsg("op"))
 	} else {
-		return kind
+		return kind, nil
 	}
 }
 
 func (tk *Tokenizer) next() (*Token, error) {
 	kind := TokenTypeDummy
-	if tk._lookahead == '\x00' {
+	if tk._reachEnd() {
 		if tk._pos.Offset > tk._bufSize {
-			panic(tk._errorMsg("eof"))
+			return nil, errors.New(tk._errorMsg("eof"))
 		}
-		tk._stepForward('\x00')
 		kind = TokenTypeEndOfFile
 	} else if tk.whitespace() {
 		kind = TokenTypeWhitespace
@@ -12009,7 +18537,11 @@ func (tk *Tokenizer) next() (*Token, error) {
 	} else if tk.number() {
 		kind = TokenTypeNumber
 	} else {
-		kind = tk.op()
+		var err error
+		kind, err = tk.op()
+		if err != nil {
+			return nil, err
+		}
 		if kind == TokenTypeDummy {
 			return nil, errors.New(tk._errorMsg(string(tk._buf[tk._prevPos.Offset])))
 		}
@@ -12028,6 +18560,9 @@ func (tk *Tokenizer) next() (*Token, error) {
 		}
 	}
 	ret := NewToken(kind, tk._prevPos, tk._pos, val)
+	if kind == TokenTypeEndOfFile {
+		tk._stepForward()
+	}
 	tk._prevPos = tk._pos
 	return ret, nil
 }
@@ -12494,11 +19029,47 @@ func (tk *Tokenizer) _group9() bool {
 
 const expressionMemoId = 0
 
+type parserState struct {
+	ctrl *ctrlClause
+}
+
+// The memo cache compares parser states, so every field of the state block at
+// 225:227 of the grammar must be comparable; this fails to compile otherwise.
+var _ = parserState{} == parserState{}
+
 type NodeCache struct {
-	val Node
-	pos int
+	val   Node
+	end   parserMark
+	state parserState
+}
+
+const (
+	LimitSteps      = "steps"
+	LimitBacktracks = "backtracks"
+	LimitDepth      = "depth"
+	LimitContext    = "context"
+)
+
+type LimitError struct {
+	FilePath string
+	Limit    string
+	Max      int
+	Err      error
+}
+
+func (e *LimitError) Error() string {
+	if e.Err != nil {
+		return fmt.Sprintf("fail to parse: %s\n%v", e.FilePath, e.Err)
+	}
+	return fmt.Sprintf("fail to parse: %s\nexceed max %s %d", e.FilePath, e.Limit, e.Max)
+}
+
+func (e *LimitError) Unwrap() error {
+	return e.Err
 }
 
+const DefaultMaxDepth = 1 << 14
+
 type Parser struct {
 	_filePath    string
 	_fileContent []rune
@@ -12508,697 +19079,1431 @@ type Parser struct {
 	_pos    int
 	_x      int
 
-	_bracketDepth  int
-	_bracketDepths []int
+	_bracketDepth int
+
+	state parserState
 
 	_nodeCache []map[int]*NodeCache
 
-	_any any
+	_err           error
+	_ctx           context.Context
+	_steps         int
+	_maxSteps      int
+	_backtracks    int
+	_maxBacktracks int
+	_depth         int
+	_maxDepth      int
+	_lines         *LineIndex
+}
+
+func NewParser(filePath string, fileContent []rune, tokens []*Token) *Parser {
+	ps := Parser{_filePath: filePath, _fileContent: fileContent, _tokens: tokens}
+	ps._max = len(ps._tokens)
+	ps._pos = 0
+	ps._x = 0
+	ps._maxDepth = DefaultMaxDepth
+
+	ps._nodeCache = make([]map[int]*NodeCache, ps._max)
+
+	return &ps
+}
+
+func (ps *Parser) _lineIndex() *LineIndex {
+	if ps._lines == nil {
+		ps._lines = NewLineIndex(ps._fileContent)
+	}
+	return ps._lines
+}
+
+func (ps *Parser) SetContext(ctx context.Context) {
+	ps._ctx = ctx
+}
+
+func (ps *Parser) SetMaxSteps(n int) {
+	ps._maxSteps = n
+}
+
+func (ps *Parser) SetMaxBacktracks(n int) {
+	ps._maxBacktracks = n
+}
+
+func (ps *Parser) SetMaxDepth(n int) {
+	ps._maxDepth = n
+}
+
+// _enterDepth and _leaveDepth bracket every rule call and every [ ] region, so
+// the nesting depth they track is bounded by SetMaxDepth. The _enter and _leave
+// names stay free for the hooks a hack file may define for [ ] regions.
+func (ps *Parser) _enterDepth() bool {
+	if ps._err != nil {
+		return false
+	}
+	if ps._maxDepth > 0 && ps._depth >= ps._maxDepth {
+		ps._setError(&LimitError{FilePath: ps._filePath, Limit: LimitDepth, Max: ps._maxDepth})
+		return false
+	}
+	ps._depth++
+	return true
+}
+
+func (ps *Parser) _leaveDepth() {
+	ps._depth--
+}
+
+func (ps *Parser) _exhausted() bool {
+	if ps._err != nil {
+		return true
+	}
+	ps._steps++
+	if ps._maxSteps > 0 && ps._steps > ps._maxSteps {
+		ps._setError(&LimitError{FilePath: ps._filePath, Limit: LimitSteps, Max: ps._maxSteps})
+		return true
+	}
+	if ps._ctx != nil && ps._steps&1023 == 0 {
+		if err := ps._ctx.Err(); err != nil {
+			ps._setError(&LimitError{FilePath: ps._filePath, Limit: LimitContext, Err: err})
+			return true
+		}
+	}
+	return false
+}
+
+// parserMark is a backtracking point: the token position together with the
+// bracket depth and the state at that point.
+type parserMark struct {
+	pos          int
+	bracketDepth int
+	state        parserState
+}
+
+func (ps *Parser) _mark() parserMark {
+	return parserMark{pos: ps._pos, bracketDepth: ps._bracketDepth, state: ps.state}
+}
+
+func (ps *Parser) _reset(mark parserMark) {
+	if mark.pos < ps._pos {
+		ps._backtracks++
+		if ps._maxBacktracks > 0 && ps._backtracks > ps._maxBacktracks {
+			ps._setError(&LimitError{FilePath: ps._filePath, Limit: LimitBacktracks, Max: ps._maxBacktracks})
+		}
+	}
+	ps._pos = mark.pos
+	ps._bracketDepth = mark.bracketDepth
+	ps.state = mark.state
+}
+
+func (ps *Parser) _stepForward(tok *Token) {
+	if len(tok.Value) == 1 {
+		val := tok.Value[0]
+		if val == '(' || val == '[' || val == '{' {
+			ps._bracketDepth++
+		} else if val == ')' || val == ']' || val == '}' {
+			ps._bracketDepth--
+		}
+	}
+	ps._pos++
+	if ps._pos >= ps._max {
+		ps._pos = ps._max - 1
+	}
+	if ps._pos > ps._x {
+		ps._x = ps._pos
+	}
+}
+
+func (ps *Parser) _expectK(kind string) Node {
+	if ps._exhausted() {
+		return nil
+	}
+	tok := ps._tokens[ps._pos]
+	if tok.Kind == kind {
+		ps._stepForward(tok)
+		return NewTokenNode(ps._filePath, ps._fileContent, tok)
+	}
+	return nil
+}
+
+func (ps *Parser) _expectV(val string) Node {
+	if ps._exhausted() {
+		return nil
+	}
+	tok := ps._tokens[ps._pos]
+	if len(tok.Value) == len(val) && string(tok.Value) == val {
+		ps._stepForward(tok)
+		return NewTokenNode(ps._filePath, ps._fileContent, tok)
+	}
+	return nil
+}
+
+func (ps *Parser) _anyToken() Node {
+	if ps._exhausted() {
+		return nil
+	}
+	tok := ps._tokens[ps._pos]
+	ps._stepForward(tok)
+	return NewTokenNode(ps._filePath, ps._fileContent, tok)
+}
+
+func (ps *Parser) _pseudoToken(v ...any) Node {
+	var start, end *Position
+	for _, t := range v {
+		switch vv := t.(type) {
+		case *Token:
+			if vv == nil {
+				continue
+			}
+			if start == nil {
+				start = &vv.Start
+			}
+			end = &vv.End
+		case []*Token:
+			if vv == nil {
+				continue
+			}
+			if len(vv) > 0 {
+				if start == nil {
+					start = &vv[0].Start
+				}
+				end = &vv[len(vv)-1].End
+			}
+		case Node:
+			if vv == nil {
+				continue
+			}
+			if start == nil {
+				p := vv.RangeStart()
+				start = &p
+			}
+			p := vv.RangeEnd()
+			end = &p
+		case []Node:
+			if vv == nil {
+				continue
+			}
+			if len(vv) > 0 {
+				if start == nil {
+					p := vv[0].RangeStart()
+					start = &p
+				}
+				p := vv[len(vv)-1].RangeEnd()
+				end = &p
+			}
+		default:
+			return nil
+		}
+	}
+	if start == nil || end == nil {
+		return nil
+	}
+	val := ps._fileContent[start.Offset:end.Offset]
+	return NewTokenNode(ps._filePath, ps._fileContent, NewToken(TokenTypePseudo, *start, *end, val))
+}
+
+func (ps *Parser) _expectPseudoNewline() Node {
+	if ps._exhausted() {
+		return nil
+	}
+	if ps._pos < 1 || ps._pos >= len(ps._tokens) {
+		return nil
+	}
+	current := ps._tokens[ps._pos-1]
+	lookahead := ps._tokens[ps._pos]
+	if current.End.LineIdx == lookahead.Start.LineIdx {
+		return nil
+	}
+	return NewTokenNode(ps._filePath, ps._fileContent, lookahead)
+}
+
+func (ps *Parser) _visibleTokenBefore(pos int) *Token {
+	for i := pos - 1; i >= 0; i-- {
+		kind := ps._tokens[i].Kind
+		if kind != TokenTypeWhitespace && kind != TokenTypeNewline {
+			return ps._tokens[i]
+		}
+	}
+	return nil
+}
+
+func (ps *Parser) _mergeNodes(items ...any) Node {
+	ret := make([]Node, 0)
+	for _, item := range items {
+		if item == nil {
+			continue
+		}
+		if n, ok := item.(Node); ok {
+			if n != nil && !n.IsDummy() {
+				ret = append(ret, n)
+			}
+		} else if s, ok := item.([]Node); ok {
+			if s != nil {
+				for _, ss := range s {
+					if ss != nil && !ss.IsDummy() {
+						ret = append(ret, ss)
+					}
+				}
+			}
+		} else {
+			ps._setError(fmt.Errorf("misused merge_nodes api: %T", item))
+		}
+	}
+	return NewNodesNode(ret)
+}
+
+func (ps *Parser) _literalToken(val string) Node {
+	var pos Position
+	if tok := ps._visibleTokenBefore(ps._pos); tok != nil {
+		pos = tok.End
+	}
+	return NewTokenNode(ps._filePath, ps._fileContent, NewToken(TokenTypeLiteral, pos, pos, []rune(val)))
 }
 
-func NewParser(filePath string, fileContent []rune, tokens []*Token) *Parser {
-	ps := Parser{_filePath: filePath, _fileContent: fileContent, _tokens: tokens}
-	ps._max = len(ps._tokens)
-	ps._pos = 0
-	ps._x = 0
+func (ps *Parser) _selectField(node Node, field string) Node {
+	if node == nil {
+		return nil
+	}
+	return node.Child(field)
+}
 
-	ps._bracketDepths = make([]int, ps._max+1)
-	ps._nodeCache = make([]map[int]*NodeCache, ps._max)
+func (ps *Parser) _spread(node Node) []Node {
+	if node == nil || node.IsDummy() {
+		return nil
+	}
+	if node.Kind() == NodeTypeNodes {
+		return node.UnpackNodes()
+	}
+	return []Node{node}
+}
 
-	return &ps
+func (ps *Parser) _coalesce(node, fallback Node) Node {
+	if node == nil || node.IsDummy() {
+		return fallback
+	}
+	return node
 }
 
-func (ps *Parser) _mark() int {
-	ps._bracketDepths[ps._pos] = ps._bracketDepth
-	return ps._pos
+func (ps *Parser) _setError(err error) {
+	if ps._err == nil {
+		ps._err = err
+	}
 }
 
-func (ps *Parser) _reset(pos int) {
-	ps._pos = pos
-	ps._bracketDepth = ps._bracketDepths[ps._pos]
+func (ps *Parser) Parse() (ret Node, err error) {
+	if ps._ctx != nil {
+		if err = ps._ctx.Err(); err != nil {
+			return nil, &LimitError{FilePath: ps._filePath, Limit: LimitContext, Err: err}
+		}
+	}
+	ret = ps.file()
+	if ps._err != nil {
+		return nil, ps._err
+	}
+	if ret != nil && ps._expectK(TokenTypeEndOfFile) != nil {
+		return ret, nil
+	}
+	tok := ps._tokens[ps._x]
+	return nil, fmt.Errorf("fail to parse: %s\n%s", ps._filePath, errorContext(ps._filePath, ps._lineIndex(), tok.Start.Offset))
 }
 
-func (ps *Parser) _stepForward(tok *Token) {
-	if len(tok.Value) == 1 {
-		val := tok.Value[0]
-		if val == '(' || val == '[' || val == '{' {
-			ps._bracketDepth++
-		} else if val == ')' || val == ']' || val == '}' {
-			ps._bracketDepth--
+// file parses a whole source file up to END_OF_FILE.
+/*
+file:
+| package=package_decl imports=import_decl* decls=top_level_decl* END_OF_FILE {file(package, imports, decls)}
+*/
+func (ps *Parser) file() Node {
+	if !ps._enterDepth() {
+		return nil
+	}
+	defer ps._leaveDepth()
+	/* package=package_decl imports=import_decl* decls=top_level_decl* END_OF_FILE {file(package, imports, decls)}
+	 */
+	pos := ps._mark()
+	for {
+		var decls Node
+		var imports Node
+		var package_ Node
+		package_ = ps.packageDecl()
+		if package_ == nil {
+			break
+		}
+		_1 := make([]Node, 0)
+		var _2 Node
+		for {
+			_2 = ps.importDecl()
+			if _2 == nil {
+				break
+			}
+			_1 = append(_1, _2)
+		}
+		imports = NewNodesNode(_1)
+		_ = imports
+		_3 := make([]Node, 0)
+		var _4 Node
+		for {
+			_4 = ps.topLevelDecl()
+			if _4 == nil {
+				break
+			}
+			_3 = append(_3, _4)
+		}
+		decls = NewNodesNode(_3)
+		_ = decls
+		var _5 Node
+		_5 = ps._expectK(TokenTypeEndOfFile)
+		if _5 == nil {
+			break
 		}
+		return NewFileNode(ps._filePath, ps._fileContent, package_, imports, decls, ps._tokens[pos.pos].Start, ps._visibleTokenBefore(ps._pos).End)
 	}
-	ps._pos++
-	if ps._pos >= ps._max {
-		ps._pos = ps._max - 1
+	ps._reset(pos)
+	return nil
+}
+
+// package_decl parses the package clause.
+/*
+package_decl:
+| 'package' ident=package_ident ';' {package_decl(ident)}
+*/
+func (ps *Parser) packageDecl() Node {
+	if !ps._enterDepth() {
+		return nil
 	}
-	if ps._pos > ps._x {
-		ps._x = ps._pos
+	defer ps._leaveDepth()
+	/* 'package' ident=package_ident ';' {package_decl(ident)}
+	 */
+	pos := ps._mark()
+	for {
+		var ident Node
+		var _1 Node
+		_1 = ps._expectK(TokenTypeKwPackage)
+		if _1 == nil {
+			break
+		}
+		ident = ps.packageIdent()
+		if ident == nil {
+			break
+		}
+		var _2 Node
+		_2 = ps._expectK(TokenTypeOpSemi)
+		if _2 == nil {
+			break
+		}
+		return NewPackageDeclNode(ps._filePath, ps._fileContent, ident, ps._tokens[pos.pos].Start, ps._visibleTokenBefore(ps._pos).End)
 	}
+	ps._reset(pos)
+	return nil
 }
 
-func (ps *Parser) _expectK(kind string) Node {
-	tok := ps._tokens[ps._pos]
-	if tok.Kind == kind {
-		ps._stepForward(tok)
-		return NewTokenNode(ps._filePath, ps._fileContent, tok)
+/*
+package_ident:
+| ident=IDENT {package_ident(ident)}
+*/
+func (ps *Parser) packageIdent() Node {
+	if !ps._enterDepth() {
+		return nil
 	}
+	defer ps._leaveDepth()
+	/* ident=IDENT {package_ident(ident)}
+	 */
+	pos := ps._mark()
+	for {
+		var ident Node
+		ident = ps._expectK(TokenTypeIdent)
+		if ident == nil {
+			break
+		}
+		return NewPackageIdentNode(ps._filePath, ps._fileContent, ident, ps._tokens[pos.pos].Start, ps._visibleTokenBefore(ps._pos).End)
+	}
+	ps._reset(pos)
 	return nil
 }
 
-func (ps *Parser) _expectV(val string) Node {
-	tok := ps._tokens[ps._pos]
-	if len(tok.Value) == len(val) && string(tok.Value) == val {
-		ps._stepForward(tok)
-		return NewTokenNode(ps._filePath, ps._fileContent, tok)
+/*
+top_level_decl:
+| function_decl
+| method_decl
+| const_decl
+| var_decl
+| type_decl
+*/
+func (ps *Parser) topLevelDecl() Node {
+	if !ps._enterDepth() {
+		return nil
+	}
+	defer ps._leaveDepth()
+	/* function_decl
+	 */
+	for {
+		var _1 Node
+		_1 = ps.functionDecl()
+		if _1 == nil {
+			break
+		}
+		return _1
+	}
+	/* method_decl
+	 */
+	for {
+		var _1 Node
+		_1 = ps.methodDecl()
+		if _1 == nil {
+			break
+		}
+		return _1
+	}
+	/* const_decl
+	 */
+	for {
+		var _1 Node
+		_1 = ps.constDecl()
+		if _1 == nil {
+			break
+		}
+		return _1
+	}
+	/* var_decl
+	 */
+	for {
+		var _1 Node
+		_1 = ps.varDecl()
+		if _1 == nil {
+			break
+		}
+		return _1
+	}
+	/* type_decl
+	 */
+	for {
+		var _1 Node
+		_1 = ps.typeDecl()
+		if _1 == nil {
+			break
+		}
+		return _1
 	}
 	return nil
 }
 
-func (ps *Parser) _anyToken() Node {
-	tok := ps._tokens[ps._pos]
-	ps._stepForward(tok)
-	return NewTokenNode(ps._filePath, ps._fileContent, tok)
+/*
+import_decl:
+| import_group_decl
+| import_one_decl
+*/
+func (ps *Parser) importDecl() Node {
+	if !ps._enterDepth() {
+		return nil
+	}
+	defer ps._leaveDepth()
+	/* import_group_decl
+	 */
+	for {
+		var _1 Node
+		_1 = ps.importGroupDecl()
+		if _1 == nil {
+			break
+		}
+		return _1
+	}
+	/* import_one_decl
+	 */
+	for {
+		var _1 Node
+		_1 = ps.importOneDecl()
+		if _1 == nil {
+			break
+		}
+		return _1
+	}
+	return nil
 }
 
-func (ps *Parser) _pseudoToken(v ...any) Node {
-	var start, end *Position
-	for _, t := range v {
-		switch vv := t.(type) {
-		case *Token:
-			if vv == nil {
-				continue
-			}
-			if start == nil {
-				start = &vv.Start
-			}
-			end = &vv.End
-		case []*Token:
-			if vv == nil {
-				continue
-			}
-			if len(vv) > 0 {
-				if start == nil {
-					start = &vv[0].Start
-				}
-				end = &vv[len(vv)-1].End
-			}
-		case Node:
-			if vv == nil {
-				continue
-			}
-			if start == nil {
-				p := vv.RangeStart()
-				start = &p
-			}
-			p := vv.RangeEnd()
-			end = &p
-		case []Node:
-			if vv == nil {
-				continue
-			}
-			if len(vv) > 0 {
-				if start == nil {
-					p := vv[0].RangeStart()
-					start = &p
-				}
-				p := vv[len(vv)-1].RangeEnd()
-				end = &p
+/*
+import_group_decl:
+| 'import' '(' targets=import_name_path* ')' ';'? {import_group_decl(targets)}
+*/
+func (ps *Parser) importGroupDecl() Node {
+	if !ps._enterDepth() {
+		return nil
+	}
+	defer ps._leaveDepth()
+	/* 'import' '(' targets=import_name_path* ')' ';'? {import_group_decl(targets)}
+	 */
+	pos := ps._mark()
+	for {
+		var targets Node
+		var _1 Node
+		_1 = ps._expectK(TokenTypeKwImport)
+		if _1 == nil {
+			break
+		}
+		var _2 Node
+		_2 = ps._expectK(TokenTypeOpLeftParen)
+		if _2 == nil {
+			break
+		}
+		_3 := make([]Node, 0)
+		var _4 Node
+		for {
+			_4 = ps.importNamePath()
+			if _4 == nil {
+				break
 			}
-		default:
-			return nil
+			_3 = append(_3, _4)
+		}
+		targets = NewNodesNode(_3)
+		_ = targets
+		var _5 Node
+		_5 = ps._expectK(TokenTypeOpRightParen)
+		if _5 == nil {
+			break
 		}
+		var _6 Node
+		_6 = ps._expectK(TokenTypeOpSemi)
+		_ = _6
+		return NewImportGroupDeclNode(ps._filePath, ps._fileContent, targets, ps._tokens[pos.pos].Start, ps._visibleTokenBefore(ps._pos).End)
 	}
-	if start == nil || end == nil {
+	ps._reset(pos)
+	return nil
+}
+
+/*
+import_one_decl:
+| 'import' target=import_name_path {import_one_decl(target)}
+*/
+func (ps *Parser) importOneDecl() Node {
+	if !ps._enterDepth() {
 		return nil
 	}
-	val := ps._fileContent[start.Offset:end.Offset]
-	return NewTokenNode(ps._filePath, ps._fileContent, NewToken(TokenTypePseudo, *start, *end, val))
+	defer ps._leaveDepth()
+	/* 'import' target=import_name_path {import_one_decl(target)}
+	 */
+	pos := ps._mark()
+	for {
+		var target Node
+		var _1 Node
+		_1 = ps._expectK(TokenTypeKwImport)
+		if _1 == nil {
+			break
+		}
+		target = ps.importNamePath()
+		if target == nil {
+			break
+		}
+		return NewImportOneDeclNode(ps._filePath, ps._fileContent, target, ps._tokens[pos.pos].Start, ps._visibleTokenBefore(ps._pos).End)
+	}
+	ps._reset(pos)
+	return nil
 }
 
-func (ps *Parser) _expectPseudoNewline() Node {
-	if ps._pos < 1 || ps._pos >= len(ps._tokens) {
+/*
+import_name_path:
+| name=(import_dot | import_ident)? path=import_path ';'? {import_name_path(name, path)}
+_group_1 <-- (import_dot | import_ident)
+*/
+func (ps *Parser) importNamePath() Node {
+	if !ps._enterDepth() {
 		return nil
 	}
-	current := ps._tokens[ps._pos-1]
-	lookahead := ps._tokens[ps._pos]
-	if current.End.LineIdx == lookahead.Start.LineIdx {
-		return nil
+	defer ps._leaveDepth()
+	/* name=(import_dot | import_ident)? path=import_path ';'? {import_name_path(name, path)}
+	 */
+	pos := ps._mark()
+	for {
+		var name Node
+		var path Node
+		name = ps._group1()
+		_ = name
+		path = ps.importPath()
+		if path == nil {
+			break
+		}
+		var _1 Node
+		_1 = ps._expectK(TokenTypeOpSemi)
+		_ = _1
+		return NewImportNamePathNode(ps._filePath, ps._fileContent, name, path, ps._tokens[pos.pos].Start, ps._visibleTokenBefore(ps._pos).End)
 	}
-	return NewTokenNode(ps._filePath, ps._fileContent, lookahead)
+	ps._reset(pos)
+	return nil
 }
 
-func (ps *Parser) _visibleTokenBefore(pos int) *Token {
-	for i := pos - 1; i >= 0; i-- {
-		kind := ps._tokens[i].Kind
-		if kind != TokenTypeWhitespace && kind != TokenTypeNewline {
-			return ps._tokens[i]
+/*
+import_dot:
+| '.' {import_dot()}
+*/
+func (ps *Parser) importDot() Node {
+	if !ps._enterDepth() {
+		return nil
+	}
+	defer ps._leaveDepth()
+	/* '.' {import_dot()}
+	 */
+	pos := ps._mark()
+	for {
+		var _1 Node
+		_1 = ps._expectK(TokenTypeOpDot)
+		if _1 == nil {
+			break
 		}
+		return NewImportDotNode(ps._filePath, ps._fileContent, ps._tokens[pos.pos].Start, ps._visibleTokenBefore(ps._pos).End)
 	}
+	ps._reset(pos)
 	return nil
 }
 
-func (ps *Parser) _mergeNodes(items ...any) Node {
-	ret := make([]Node, 0)
-	for _, item := range items {
-		if item == nil {
-			continue
-		}
-		if n, ok := item.(Node); ok {
-			if n != nil && !n.IsDummy() {
-				ret = append(ret, n)
-			}
-		} else if s, ok := item.([]Node); ok {
-			if s != nil {
-				for _, ss := range s {
-					if ss != nil && !ss.IsDummy() {
-						ret = append(ret, ss)
-					}
-				}
-			}
-		} else {
-			panic("misused merge_nodes api")
+/*
+import_ident:
+| ident=IDENT {import_ident(ident)}
+*/
+func (ps *Parser) importIdent() Node {
+	if !ps._enterDepth() {
+		return nil
+	}
+	defer ps._leaveDepth()
+	/* ident=IDENT {import_ident(ident)}
+	 */
+	pos := ps._mark()
+	for {
+		var ident Node
+		ident = ps._expectK(TokenTypeIdent)
+		if ident == nil {
+			break
 		}
+		return NewImportIdentNode(ps._filePath, ps._fileContent, ident, ps._tokens[pos.pos].Start, ps._visibleTokenBefore(ps._pos).End)
 	}
-	return NewNodesNode(ret)
+	ps._reset(pos)
+	return nil
 }
 
-func (ps *Parser) Parse() (ret Node, err error) {
-	ret = ps.file()
-	if ps._expectK(TokenTypeEndOfFile) != nil {
-		return ret, nil
+/*
+import_path:
+| path=STRING {import_path(path)}
+*/
+func (ps *Parser) importPath() Node {
+	if !ps._enterDepth() {
+		return nil
 	}
-	tok := ps._tokens[ps._x]
-	return nil, fmt.Errorf("fail to parse: %s\n%s", ps._filePath, errorContext(ps._filePath, ps._fileContent, tok.Start.Offset, tok.Start.LineIdx, tok.Start.CharIdx))
+	defer ps._leaveDepth()
+	/* path=STRING {import_path(path)}
+	 */
+	pos := ps._mark()
+	for {
+		var path Node
+		path = ps._expectK(TokenTypeString)
+		if path == nil {
+			break
+		}
+		return NewImportPathNode(ps._filePath, ps._fileContent, path, ps._tokens[pos.pos].Start, ps._visibleTokenBefore(ps._pos).End)
+	}
+	ps._reset(pos)
+	return nil
 }
 
 /*
-file:
-| 'package' n=package_ident ';' i=import_decl* t=top_level_decl_semi* END_OF_FILE {file(n, i, t)}
+generic_parameter_decl:
+| '[' parameters=','.generic_parameter+ ','? ']' {generic_parameter_decl(parameters)}
 */
-func (ps *Parser) file() Node {
-	/* 'package' n=package_ident ';' i=import_decl* t=top_level_decl_semi* END_OF_FILE {file(n, i, t)}
+func (ps *Parser) genericParameterDecl() Node {
+	if !ps._enterDepth() {
+		return nil
+	}
+	defer ps._leaveDepth()
+	/* '[' parameters=','.generic_parameter+ ','? ']' {generic_parameter_decl(parameters)}
 	 */
 	pos := ps._mark()
 	for {
-		var i Node
-		var n Node
-		var t Node
+		var parameters Node
 		var _1 Node
-		_1 = ps._expectK(TokenTypeKwPackage)
+		_1 = ps._expectK(TokenTypeOpLeftBracket)
 		if _1 == nil {
 			break
 		}
-		n = ps.packageIdent()
-		if n == nil {
-			break
-		}
-		var _2 Node
-		_2 = ps._expectK(TokenTypeOpSemi)
-		if _2 == nil {
+		_2 := make([]Node, 0)
+		var _3, _4 Node
+		_3 = ps.genericParameter()
+		if _3 == nil {
 			break
 		}
-		_3 := make([]Node, 0)
-		var _4 Node
+		_2 = append(_2, _3)
 		for {
-			_4 = ps.importDecl()
+			_p := ps._mark()
+			_4 = ps._expectK(TokenTypeOpComma)
 			if _4 == nil {
 				break
 			}
-			_3 = append(_3, _4)
-		}
-		i = NewNodesNode(_3)
-		_ = i
-		_5 := make([]Node, 0)
-		var _6 Node
-		for {
-			_6 = ps.topLevelDeclSemi()
-			if _6 == nil {
+			_3 = ps.genericParameter()
+			if _3 == nil {
+				ps._reset(_p)
 				break
 			}
-			_5 = append(_5, _6)
+			_2 = append(_2, _3)
 		}
-		t = NewNodesNode(_5)
-		_ = t
-		var _7 Node
-		_7 = ps._expectK(TokenTypeEndOfFile)
-		if _7 == nil {
+		parameters = NewNodesNode(_2)
+		_ = parameters
+		var _5 Node
+		_5 = ps._expectK(TokenTypeOpComma)
+		_ = _5
+		var _6 Node
+		_6 = ps._expectK(TokenTypeOpRightBracket)
+		if _6 == nil {
 			break
 		}
-		return NewFileNode(ps._filePath, ps._fileContent, n, i, t, ps._tokens[pos].Start, ps._visibleTokenBefore(ps._mark()).End)
+		return NewGenericParameterDeclNode(ps._filePath, ps._fileContent, parameters, ps._tokens[pos.pos].Start, ps._visibleTokenBefore(ps._pos).End)
 	}
 	ps._reset(pos)
 	return nil
 }
 
 /*
-package_ident:
-| n=IDENT {package_ident(n)}
+generic_parameter:
+| ident=generic_parameter_ident constraint=generic_union_constraint? {generic_parameter(ident, constraint)}
 */
-func (ps *Parser) packageIdent() Node {
-	/* n=IDENT {package_ident(n)}
+func (ps *Parser) genericParameter() Node {
+	if !ps._enterDepth() {
+		return nil
+	}
+	defer ps._leaveDepth()
+	/* ident=generic_parameter_ident constraint=generic_union_constraint? {generic_parameter(ident, constraint)}
 	 */
 	pos := ps._mark()
 	for {
-		var n Node
-		n = ps._expectK(TokenTypeIdent)
-		if n == nil {
+		var constraint Node
+		var ident Node
+		ident = ps.genericParameterIdent()
+		if ident == nil {
 			break
 		}
-		return NewPackageIdentNode(ps._filePath, ps._fileContent, n, ps._tokens[pos].Start, ps._visibleTokenBefore(ps._mark()).End)
+		constraint = ps.genericUnionConstraint()
+		_ = constraint
+		return NewGenericParameterNode(ps._filePath, ps._fileContent, ident, constraint, ps._tokens[pos.pos].Start, ps._visibleTokenBefore(ps._pos).End)
+	}
+	ps._reset(pos)
+	return nil
+}
+
+/*
+generic_parameter_ident:
+| ident=IDENT {generic_parameter_ident(ident)}
+*/
+func (ps *Parser) genericParameterIdent() Node {
+	if !ps._enterDepth() {
+		return nil
+	}
+	defer ps._leaveDepth()
+	/* ident=IDENT {generic_parameter_ident(ident)}
+	 */
+	pos := ps._mark()
+	for {
+		var ident Node
+		ident = ps._expectK(TokenTypeIdent)
+		if ident == nil {
+			break
+		}
+		return NewGenericParameterIdentNode(ps._filePath, ps._fileContent, ident, ps._tokens[pos.pos].Start, ps._visibleTokenBefore(ps._pos).End)
+	}
+	ps._reset(pos)
+	return nil
+}
+
+/*
+generic_union_constraint:
+| x=type_constraint !'|' {x}
+| types='|'.type_constraint+ {generic_union_constraint(types)}
+*/
+func (ps *Parser) genericUnionConstraint() Node {
+	if !ps._enterDepth() {
+		return nil
+	}
+	defer ps._leaveDepth()
+	/* x=type_constraint !'|' {x}
+	 */
+	pos := ps._mark()
+	for {
+		var x Node
+		x = ps.typeConstraint()
+		if x == nil {
+			break
+		}
+		var _1 Node
+		_p := ps._mark()
+		_1 = ps._expectK(TokenTypeOpBar)
+		if _1 != nil {
+			ps._reset(_p)
+		}
+		if _1 != nil {
+			break
+		}
+		return x
+	}
+	ps._reset(pos)
+	/* types='|'.type_constraint+ {generic_union_constraint(types)}
+	 */
+	for {
+		var types Node
+		_1 := make([]Node, 0)
+		var _2, _3 Node
+		_2 = ps.typeConstraint()
+		if _2 == nil {
+			break
+		}
+		_1 = append(_1, _2)
+		for {
+			_p := ps._mark()
+			_3 = ps._expectK(TokenTypeOpBar)
+			if _3 == nil {
+				break
+			}
+			_2 = ps.typeConstraint()
+			if _2 == nil {
+				ps._reset(_p)
+				break
+			}
+			_1 = append(_1, _2)
+		}
+		types = NewNodesNode(_1)
+		_ = types
+		return NewGenericUnionConstraintNode(ps._filePath, ps._fileContent, types, ps._tokens[pos.pos].Start, ps._visibleTokenBefore(ps._pos).End)
 	}
 	ps._reset(pos)
 	return nil
 }
 
 /*
-import_decl:
-| 'import' '(' specs=(t=import_spec pseudo_semi {t})* ')' ';'? {import_decl(specs)}
-| 'import' specs=import_spec ';'? {import_decl([specs])}
-_group_1 <-- (t=import_spec pseudo_semi {t})
+type_constraint:
+| '~' type=type {generic_underlying_type_constraint(type)}
+| type=type {generic_type_constraint(type)}
 */
-func (ps *Parser) importDecl() Node {
-	/* 'import' '(' specs=(t=import_spec pseudo_semi {t})* ')' ';'? {import_decl(specs)}
+func (ps *Parser) typeConstraint() Node {
+	if !ps._enterDepth() {
+		return nil
+	}
+	defer ps._leaveDepth()
+	/* '~' type=type {generic_underlying_type_constraint(type)}
 	 */
 	pos := ps._mark()
 	for {
-		var specs Node
+		var type_ Node
 		var _1 Node
-		_1 = ps._expectK(TokenTypeKwImport)
+		_1 = ps._expectK(TokenTypeOpTilde)
 		if _1 == nil {
 			break
 		}
-		var _2 Node
-		_2 = ps._expectK(TokenTypeOpLeftParen)
-		if _2 == nil {
-			break
-		}
-		_3 := make([]Node, 0)
-		var _4 Node
-		for {
-			_4 = ps._group1()
-			if _4 == nil {
-				break
-			}
-			_3 = append(_3, _4)
-		}
-		specs = NewNodesNode(_3)
-		_ = specs
-		var _5 Node
-		_5 = ps._expectK(TokenTypeOpRightParen)
-		if _5 == nil {
+		type_ = ps.type_()
+		if type_ == nil {
 			break
 		}
-		var _6 Node
-		_6 = ps._expectK(TokenTypeOpSemi)
-		_ = _6
-		return NewImportDeclNode(ps._filePath, ps._fileContent, specs, ps._tokens[pos].Start, ps._visibleTokenBefore(ps._mark()).End)
+		return NewGenericUnderlyingTypeConstraintNode(ps._filePath, ps._fileContent, type_, ps._tokens[pos.pos].Start, ps._visibleTokenBefore(ps._pos).End)
 	}
 	ps._reset(pos)
-	/* 'import' specs=import_spec ';'? {import_decl([specs])}
+	/* type=type {generic_type_constraint(type)}
 	 */
 	for {
-		var specs Node
-		var _1 Node
-		_1 = ps._expectK(TokenTypeKwImport)
-		if _1 == nil {
-			break
-		}
-		specs = ps.importSpec()
-		if specs == nil {
+		var type_ Node
+		type_ = ps.type_()
+		if type_ == nil {
 			break
 		}
-		var _2 Node
-		_2 = ps._expectK(TokenTypeOpSemi)
-		_ = _2
-		return NewImportDeclNode(ps._filePath, ps._fileContent, NewNodesNode([]Node{specs}), ps._tokens[pos].Start, ps._visibleTokenBefore(ps._mark()).End)
+		return NewGenericTypeConstraintNode(ps._filePath, ps._fileContent, type_, ps._tokens[pos.pos].Start, ps._visibleTokenBefore(ps._pos).End)
 	}
 	ps._reset(pos)
 	return nil
 }
 
 /*
-import_spec:
-| name=(import_dot | import_ident)? path=import_path {import_spec(name, path)}
-_group_2 <-- (import_dot | import_ident)
+parameter_decl:
+| parameters=paren_list<parameter> {parameter_decl(parameters)}
 */
-func (ps *Parser) importSpec() Node {
-	/* name=(import_dot | import_ident)? path=import_path {import_spec(name, path)}
+func (ps *Parser) parameterDecl() Node {
+	if !ps._enterDepth() {
+		return nil
+	}
+	defer ps._leaveDepth()
+	/* parameters=paren_list<parameter> {parameter_decl(parameters)}
 	 */
 	pos := ps._mark()
 	for {
-		var name Node
-		var path Node
-		name = ps._group2()
-		_ = name
-		path = ps.importPath()
-		if path == nil {
+		var parameters Node
+		parameters = ps.parenListOfParameter()
+		if parameters == nil {
 			break
 		}
-		return NewImportSpecNode(ps._filePath, ps._fileContent, name, path, ps._tokens[pos].Start, ps._visibleTokenBefore(ps._mark()).End)
+		return NewParameterDeclNode(ps._filePath, ps._fileContent, parameters, ps._tokens[pos.pos].Start, ps._visibleTokenBefore(ps._pos).End)
 	}
 	ps._reset(pos)
 	return nil
 }
 
 /*
-import_dot:
-| d='.' {import_dot(d)}
+parameter:
+| name=parameter_ident '...' type=type {ellipsis_parameter(name, type)}
+| name=parameter_ident type=type {name_type_parameter(name, type)}
+| name=parameter_ident {name_parameter(name)}
 */
-func (ps *Parser) importDot() Node {
-	/* d='.' {import_dot(d)}
+func (ps *Parser) parameter() Node {
+	if !ps._enterDepth() {
+		return nil
+	}
+	defer ps._leaveDepth()
+	/* name=parameter_ident '...' type=type {ellipsis_parameter(name, type)}
 	 */
 	pos := ps._mark()
 	for {
-		var d Node
-		d = ps._expectK(TokenTypeOpDot)
-		if d == nil {
+		var name Node
+		var type_ Node
+		name = ps.parameterIdent()
+		if name == nil {
+			break
+		}
+		var _1 Node
+		_1 = ps._expectK(TokenTypeOpDotDotDot)
+		if _1 == nil {
+			break
+		}
+		type_ = ps.type_()
+		if type_ == nil {
 			break
 		}
-		return NewImportDotNode(ps._filePath, ps._fileContent, d, ps._tokens[pos].Start, ps._visibleTokenBefore(ps._mark()).End)
+		return NewEllipsisParameterNode(ps._filePath, ps._fileContent, name, type_, ps._tokens[pos.pos].Start, ps._visibleTokenBefore(ps._pos).End)
 	}
 	ps._reset(pos)
-	return nil
-}
-
-/*
-import_ident:
-| n=IDENT {import_ident(n)}
-*/
-func (ps *Parser) importIdent() Node {
-	/* n=IDENT {import_ident(n)}
+	/* name=parameter_ident type=type {name_type_parameter(name, type)}
 	 */
-	pos := ps._mark()
 	for {
-		var n Node
-		n = ps._expectK(TokenTypeIdent)
-		if n == nil {
+		var name Node
+		var type_ Node
+		name = ps.parameterIdent()
+		if name == nil {
 			break
 		}
-		return NewImportIdentNode(ps._filePath, ps._fileContent, n, ps._tokens[pos].Start, ps._visibleTokenBefore(ps._mark()).End)
+		type_ = ps.type_()
+		if type_ == nil {
+			break
+		}
+		return NewNameTypeParameterNode(ps._filePath, ps._fileContent, name, type_, ps._tokens[pos.pos].Start, ps._visibleTokenBefore(ps._pos).End)
 	}
 	ps._reset(pos)
-	return nil
-}
-
-/*
-import_path:
-| s=STRING {import_path(s)}
-*/
-func (ps *Parser) importPath() Node {
-	/* s=STRING {import_path(s)}
+	/* name=parameter_ident {name_parameter(name)}
 	 */
-	pos := ps._mark()
 	for {
-		var s Node
-		s = ps._expectK(TokenTypeString)
-		if s == nil {
+		var name Node
+		name = ps.parameterIdent()
+		if name == nil {
 			break
 		}
-		return NewImportPathNode(ps._filePath, ps._fileContent, s, ps._tokens[pos].Start, ps._visibleTokenBefore(ps._mark()).End)
+		return NewNameParameterNode(ps._filePath, ps._fileContent, name, ps._tokens[pos.pos].Start, ps._visibleTokenBefore(ps._pos).End)
 	}
 	ps._reset(pos)
 	return nil
 }
 
 /*
-top_level_decl_semi:
-| t=top_level_decl ';'? {t}
+parameter_ident:
+| ident=IDENT {parameter_ident(ident)}
 */
-func (ps *Parser) topLevelDeclSemi() Node {
-	/* t=top_level_decl ';'? {t}
+func (ps *Parser) parameterIdent() Node {
+	if !ps._enterDepth() {
+		return nil
+	}
+	defer ps._leaveDepth()
+	/* ident=IDENT {parameter_ident(ident)}
 	 */
 	pos := ps._mark()
 	for {
-		var t Node
-		t = ps.topLevelDecl()
-		if t == nil {
+		var ident Node
+		ident = ps._expectK(TokenTypeIdent)
+		if ident == nil {
 			break
 		}
-		var _1 Node
-		_1 = ps._expectK(TokenTypeOpSemi)
-		_ = _1
-		return t
+		return NewParameterIdentNode(ps._filePath, ps._fileContent, ident, ps._tokens[pos.pos].Start, ps._visibleTokenBefore(ps._pos).End)
 	}
 	ps._reset(pos)
 	return nil
 }
 
 /*
-top_level_decl:
-| function_decl
-| method_decl
-| const_decl
-| var_decl
-| type_decl
+result_decl:
+| result_group_decl
+| result_one_decl
 */
-func (ps *Parser) topLevelDecl() Node {
-	/* function_decl
+func (ps *Parser) resultDecl() Node {
+	if !ps._enterDepth() {
+		return nil
+	}
+	defer ps._leaveDepth()
+	/* result_group_decl
 	 */
 	for {
 		var _1 Node
-		_1 = ps.functionDecl()
+		_1 = ps.resultGroupDecl()
 		if _1 == nil {
 			break
 		}
 		return _1
 	}
-	/* method_decl
+	/* result_one_decl
 	 */
 	for {
 		var _1 Node
-		_1 = ps.methodDecl()
+		_1 = ps.resultOneDecl()
 		if _1 == nil {
 			break
 		}
 		return _1
 	}
-	/* const_decl
+	return nil
+}
+
+/*
+result_group_decl:
+| '(' types=','.type+ ','? ')' {result_types_decl(types)}
+| '(' results=','.result_name_type+ ','? ')' {result_group_decl(results)}
+*/
+func (ps *Parser) resultGroupDecl() Node {
+	if !ps._enterDepth() {
+		return nil
+	}
+	defer ps._leaveDepth()
+	/* '(' types=','.type+ ','? ')' {result_types_decl(types)}
 	 */
+	pos := ps._mark()
 	for {
+		var types Node
 		var _1 Node
-		_1 = ps.constDecl()
+		_1 = ps._expectK(TokenTypeOpLeftParen)
 		if _1 == nil {
 			break
 		}
-		return _1
+		_2 := make([]Node, 0)
+		var _3, _4 Node
+		_3 = ps.type_()
+		if _3 == nil {
+			break
+		}
+		_2 = append(_2, _3)
+		for {
+			_p := ps._mark()
+			_4 = ps._expectK(TokenTypeOpComma)
+			if _4 == nil {
+				break
+			}
+			_3 = ps.type_()
+			if _3 == nil {
+				ps._reset(_p)
+				break
+			}
+			_2 = append(_2, _3)
+		}
+		types = NewNodesNode(_2)
+		_ = types
+		var _5 Node
+		_5 = ps._expectK(TokenTypeOpComma)
+		_ = _5
+		var _6 Node
+		_6 = ps._expectK(TokenTypeOpRightParen)
+		if _6 == nil {
+			break
+		}
+		return NewResultTypesDeclNode(ps._filePath, ps._fileContent, types, ps._tokens[pos.pos].Start, ps._visibleTokenBefore(ps._pos).End)
 	}
-	/* var_decl
+	ps._reset(pos)
+	/* '(' results=','.result_name_type+ ','? ')' {result_group_decl(results)}
 	 */
 	for {
+		var results Node
 		var _1 Node
-		_1 = ps.varDecl()
+		_1 = ps._expectK(TokenTypeOpLeftParen)
 		if _1 == nil {
 			break
 		}
-		return _1
+		_2 := make([]Node, 0)
+		var _3, _4 Node
+		_3 = ps.resultNameType()
+		if _3 == nil {
+			break
+		}
+		_2 = append(_2, _3)
+		for {
+			_p := ps._mark()
+			_4 = ps._expectK(TokenTypeOpComma)
+			if _4 == nil {
+				break
+			}
+			_3 = ps.resultNameType()
+			if _3 == nil {
+				ps._reset(_p)
+				break
+			}
+			_2 = append(_2, _3)
+		}
+		results = NewNodesNode(_2)
+		_ = results
+		var _5 Node
+		_5 = ps._expectK(TokenTypeOpComma)
+		_ = _5
+		var _6 Node
+		_6 = ps._expectK(TokenTypeOpRightParen)
+		if _6 == nil {
+			break
+		}
+		return NewResultGroupDeclNode(ps._filePath, ps._fileContent, results, ps._tokens[pos.pos].Start, ps._visibleTokenBefore(ps._pos).End)
 	}
-	/* type_decl
+	ps._reset(pos)
+	return nil
+}
+
+/*
+result_one_decl:
+| type=type {result_one_decl(type)}
+*/
+func (ps *Parser) resultOneDecl() Node {
+	if !ps._enterDepth() {
+		return nil
+	}
+	defer ps._leaveDepth()
+	/* type=type {result_one_decl(type)}
 	 */
+	pos := ps._mark()
 	for {
-		var _1 Node
-		_1 = ps.typeDecl()
-		if _1 == nil {
+		var type_ Node
+		type_ = ps.type_()
+		if type_ == nil {
 			break
 		}
-		return _1
+		return NewResultOneDeclNode(ps._filePath, ps._fileContent, type_, ps._tokens[pos.pos].Start, ps._visibleTokenBefore(ps._pos).End)
 	}
+	ps._reset(pos)
 	return nil
 }
 
 /*
-function_decl:
-| 'func' n=function_ident t=generic_parameter_decl? p=function_parameters r=function_results? b=block? {function_decl(n, t, p, r, b)}
+result_name_type:
+| name=result_ident type=type {result_name_type(name, type)}
+| name=result_ident {result_name(name)}
 */
-func (ps *Parser) functionDecl() Node {
-	/* 'func' n=function_ident t=generic_parameter_decl? p=function_parameters r=function_results? b=block? {function_decl(n, t, p, r, b)}
+func (ps *Parser) resultNameType() Node {
+	if !ps._enterDepth() {
+		return nil
+	}
+	defer ps._leaveDepth()
+	/* name=result_ident type=type {result_name_type(name, type)}
 	 */
 	pos := ps._mark()
 	for {
-		var b Node
-		var n Node
-		var p Node
-		var r Node
-		var t Node
-		var _1 Node
-		_1 = ps._expectK(TokenTypeKwFunc)
-		if _1 == nil {
+		var name Node
+		var type_ Node
+		name = ps.resultIdent()
+		if name == nil {
 			break
 		}
-		n = ps.functionIdent()
-		if n == nil {
+		type_ = ps.type_()
+		if type_ == nil {
 			break
 		}
-		t = ps.genericParameterDecl()
-		_ = t
-		p = ps.functionParameters()
-		if p == nil {
+		return NewResultNameTypeNode(ps._filePath, ps._fileContent, name, type_, ps._tokens[pos.pos].Start, ps._visibleTokenBefore(ps._pos).End)
+	}
+	ps._reset(pos)
+	/* name=result_ident {result_name(name)}
+	 */
+	for {
+		var name Node
+		name = ps.resultIdent()
+		if name == nil {
 			break
 		}
-		r = ps.functionResults()
-		_ = r
-		b = ps.block()
-		_ = b
-		return NewFunctionDeclNode(ps._filePath, ps._fileContent, n, t, p, r, b, ps._tokens[pos].Start, ps._visibleTokenBefore(ps._mark()).End)
+		return NewResultNameNode(ps._filePath, ps._fileContent, name, ps._tokens[pos.pos].Start, ps._visibleTokenBefore(ps._pos).End)
 	}
 	ps._reset(pos)
 	return nil
 }
 
 /*
-function_ident:
-| n=IDENT {function_ident(n)}
+result_ident:
+| ident=IDENT {result_ident(ident)}
 */
-func (ps *Parser) functionIdent() Node {
-	/* n=IDENT {function_ident(n)}
+func (ps *Parser) resultIdent() Node {
+	if !ps._enterDepth() {
+		return nil
+	}
+	defer ps._leaveDepth()
+	/* ident=IDENT {result_ident(ident)}
 	 */
 	pos := ps._mark()
 	for {
-		var n Node
-		n = ps._expectK(TokenTypeIdent)
-		if n == nil {
+		var ident Node
+		ident = ps._expectK(TokenTypeIdent)
+		if ident == nil {
 			break
 		}
-		return NewFunctionIdentNode(ps._filePath, ps._fileContent, n, ps._tokens[pos].Start, ps._visibleTokenBefore(ps._mark()).End)
+		return NewResultIdentNode(ps._filePath, ps._fileContent, ident, ps._tokens[pos.pos].Start, ps._visibleTokenBefore(ps._pos).End)
 	}
 	ps._reset(pos)
 	return nil
 }
 
 /*
-function_parameters:
-| '(' p=','.parameter* ','? ')' {p}
+receiver_decl:
+| '(' name=receiver_ident? type=(receiver_type | star_receiver_type) ')' {receiver_decl(name, type)}
+_group_2 <-- (receiver_type | star_receiver_type)
 */
-func (ps *Parser) functionParameters() Node {
-	/* '(' p=','.parameter* ','? ')' {p}
+func (ps *Parser) receiverDecl() Node {
+	if !ps._enterDepth() {
+		return nil
+	}
+	defer ps._leaveDepth()
+	/* '(' name=receiver_ident? type=(receiver_type | star_receiver_type) ')' {receiver_decl(name, type)}
 	 */
 	pos := ps._mark()
 	for {
-		var p Node
+		var name Node
+		var type_ Node
 		var _1 Node
 		_1 = ps._expectK(TokenTypeOpLeftParen)
 		if _1 == nil {
 			break
 		}
-		_2 := make([]Node, 0)
-		var _3 Node
-		var _4 Node
-		_3 = ps.parameter()
-		if _3 != nil {
-			_2 = append(_2, _3)
-			for {
-				_p := ps._mark()
-				_4 = ps._expectK(TokenTypeOpComma)
-				if _4 == nil {
-					break
-				}
-				_3 = ps.parameter()
-				if _3 == nil {
-					ps._reset(_p)
-					break
-				}
-				_2 = append(_2, _3)
-			}
-		}
-		p = NewNodesNode(_2)
-		_ = p
-		var _5 Node
-		_5 = ps._expectK(TokenTypeOpComma)
-		_ = _5
-		var _6 Node
-		_6 = ps._expectK(TokenTypeOpRightParen)
-		if _6 == nil {
+		name = ps.receiverIdent()
+		_ = name
+		type_ = ps._group2()
+		if type_ == nil {
+			break
+		}
+		var _2 Node
+		_2 = ps._expectK(TokenTypeOpRightParen)
+		if _2 == nil {
 			break
 		}
-		return p
+		return NewReceiverDeclNode(ps._filePath, ps._fileContent, name, type_, ps._tokens[pos.pos].Start, ps._visibleTokenBefore(ps._pos).End)
 	}
 	ps._reset(pos)
 	return nil
 }
 
 /*
-parameter:
-| n=parameter_ident '...' t=type {ellipsis_parameter(n, t)}
-| n=parameter_ident t=type? {parameter(n, t)}
+receiver_ident:
+| ident=IDENT {receiver_ident(ident)}
 */
-func (ps *Parser) parameter() Node {
-	/* n=parameter_ident '...' t=type {ellipsis_parameter(n, t)}
+func (ps *Parser) receiverIdent() Node {
+	if !ps._enterDepth() {
+		return nil
+	}
+	defer ps._leaveDepth()
+	/* ident=IDENT {receiver_ident(ident)}
 	 */
 	pos := ps._mark()
 	for {
-		var n Node
-		var t Node
-		n = ps.parameterIdent()
-		if n == nil {
-			break
-		}
-		var _1 Node
-		_1 = ps._expectK(TokenTypeOpDotDotDot)
-		if _1 == nil {
+		var ident Node
+		ident = ps._expectK(TokenTypeIdent)
+		if ident == nil {
 			break
 		}
-		t = ps.type_()
-		if t == nil {
+		return NewReceiverIdentNode(ps._filePath, ps._fileContent, ident, ps._tokens[pos.pos].Start, ps._visibleTokenBefore(ps._pos).End)
+	}
+	ps._reset(pos)
+	return nil
+}
+
+/*
+receiver_type:
+| type=receiver_type_ident generic_type=receiver_generic_type_decl? {receiver_type(type, generic_type)}
+*/
+func (ps *Parser) receiverType() Node {
+	if !ps._enterDepth() {
+		return nil
+	}
+	defer ps._leaveDepth()
+	/* type=receiver_type_ident generic_type=receiver_generic_type_decl? {receiver_type(type, generic_type)}
+	 */
+	pos := ps._mark()
+	for {
+		var generic_type Node
+		var type_ Node
+		type_ = ps.receiverTypeIdent()
+		if type_ == nil {
 			break
 		}
-		return NewEllipsisParameterNode(ps._filePath, ps._fileContent, n, t, ps._tokens[pos].Start, ps._visibleTokenBefore(ps._mark()).End)
+		generic_type = ps.receiverGenericTypeDecl()
+		_ = generic_type
+		return NewReceiverTypeNode(ps._filePath, ps._fileContent, type_, generic_type, ps._tokens[pos.pos].Start, ps._visibleTokenBefore(ps._pos).End)
 	}
 	ps._reset(pos)
-	/* n=parameter_ident t=type? {parameter(n, t)}
+	return nil
+}
+
+/*
+star_receiver_type:
+| '*' type=receiver_type_ident generic_type=receiver_generic_type_decl? {star_receiver_type(type, generic_type)}
+*/
+func (ps *Parser) starReceiverType() Node {
+	if !ps._enterDepth() {
+		return nil
+	}
+	defer ps._leaveDepth()
+	/* '*' type=receiver_type_ident generic_type=receiver_generic_type_decl? {star_receiver_type(type, generic_type)}
 	 */
+	pos := ps._mark()
 	for {
-		var n Node
-		var t Node
-		n = ps.parameterIdent()
-		if n == nil {
+		var generic_type Node
+		var type_ Node
+		var _1 Node
+		_1 = ps._expectK(TokenTypeOpStar)
+		if _1 == nil {
 			break
 		}
-		t = ps.type_()
-		_ = t
-		return NewParameterNode(ps._filePath, ps._fileContent, n, t, ps._tokens[pos].Start, ps._visibleTokenBefore(ps._mark()).End)
+		type_ = ps.receiverTypeIdent()
+		if type_ == nil {
+			break
+		}
+		generic_type = ps.receiverGenericTypeDecl()
+		_ = generic_type
+		return NewStarReceiverTypeNode(ps._filePath, ps._fileContent, type_, generic_type, ps._tokens[pos.pos].Start, ps._visibleTokenBefore(ps._pos).End)
 	}
 	ps._reset(pos)
 	return nil
 }
 
 /*
-parameter_ident:
-| n=IDENT {parameter_ident(n)}
+receiver_type_ident:
+| ident=IDENT {receiver_type_ident(ident)}
 */
-func (ps *Parser) parameterIdent() Node {
-	/* n=IDENT {parameter_ident(n)}
+func (ps *Parser) receiverTypeIdent() Node {
+	if !ps._enterDepth() {
+		return nil
+	}
+	defer ps._leaveDepth()
+	/* ident=IDENT {receiver_type_ident(ident)}
 	 */
 	pos := ps._mark()
 	for {
-		var n Node
-		n = ps._expectK(TokenTypeIdent)
-		if n == nil {
+		var ident Node
+		ident = ps._expectK(TokenTypeIdent)
+		if ident == nil {
 			break
 		}
-		return NewParameterIdentNode(ps._filePath, ps._fileContent, n, ps._tokens[pos].Start, ps._visibleTokenBefore(ps._mark()).End)
+		return NewReceiverTypeIdentNode(ps._filePath, ps._fileContent, ident, ps._tokens[pos.pos].Start, ps._visibleTokenBefore(ps._pos).End)
 	}
 	ps._reset(pos)
 	return nil
 }
 
 /*
-function_results:
-| '(' s=','.function_result+ ','? ')' {s}
-| r=type_only_function_result {[r]}
+receiver_generic_type_decl:
+| '[' types=','.receiver_generic_type_ident+ ','? ']' {receiver_generic_type_decl(types)}
 */
-func (ps *Parser) functionResults() Node {
-	/* '(' s=','.function_result+ ','? ')' {s}
+func (ps *Parser) receiverGenericTypeDecl() Node {
+	if !ps._enterDepth() {
+		return nil
+	}
+	defer ps._leaveDepth()
+	/* '[' types=','.receiver_generic_type_ident+ ','? ']' {receiver_generic_type_decl(types)}
 	 */
 	pos := ps._mark()
 	for {
-		var s Node
+		var types Node
 		var _1 Node
-		_1 = ps._expectK(TokenTypeOpLeftParen)
+		_1 = ps._expectK(TokenTypeOpLeftBracket)
 		if _1 == nil {
 			break
 		}
 		_2 := make([]Node, 0)
 		var _3, _4 Node
-		_3 = ps.functionResult()
+		_3 = ps.receiverGenericTypeIdent()
 		if _3 == nil {
 			break
 		}
@@ -13209,109 +20514,145 @@ func (ps *Parser) functionResults() Node {
 			if _4 == nil {
 				break
 			}
-			_3 = ps.functionResult()
+			_3 = ps.receiverGenericTypeIdent()
 			if _3 == nil {
 				ps._reset(_p)
 				break
 			}
 			_2 = append(_2, _3)
 		}
-		s = NewNodesNode(_2)
-		_ = s
+		types = NewNodesNode(_2)
+		_ = types
 		var _5 Node
 		_5 = ps._expectK(TokenTypeOpComma)
 		_ = _5
 		var _6 Node
-		_6 = ps._expectK(TokenTypeOpRightParen)
+		_6 = ps._expectK(TokenTypeOpRightBracket)
 		if _6 == nil {
 			break
 		}
-		return s
-	}
-	ps._reset(pos)
-	/* r=type_only_function_result {[r]}
-	 */
-	for {
-		var r Node
-		r = ps.typeOnlyFunctionResult()
-		if r == nil {
-			break
-		}
-		return NewNodesNode([]Node{r})
+		return NewReceiverGenericTypeDeclNode(ps._filePath, ps._fileContent, types, ps._tokens[pos.pos].Start, ps._visibleTokenBefore(ps._pos).End)
 	}
 	ps._reset(pos)
 	return nil
 }
 
 /*
-function_result:
-| n=function_result_ident t=type? {function_result(n, t)}
-| type_only_function_result
+receiver_generic_type_ident:
+| ident=IDENT {receiver_generic_type_ident(ident)}
 */
-func (ps *Parser) functionResult() Node {
-	/* n=function_result_ident t=type? {function_result(n, t)}
+func (ps *Parser) receiverGenericTypeIdent() Node {
+	if !ps._enterDepth() {
+		return nil
+	}
+	defer ps._leaveDepth()
+	/* ident=IDENT {receiver_generic_type_ident(ident)}
 	 */
 	pos := ps._mark()
 	for {
-		var n Node
-		var t Node
-		n = ps.functionResultIdent()
-		if n == nil {
+		var ident Node
+		ident = ps._expectK(TokenTypeIdent)
+		if ident == nil {
 			break
 		}
-		t = ps.type_()
-		_ = t
-		return NewFunctionResultNode(ps._filePath, ps._fileContent, n, t, ps._tokens[pos].Start, ps._visibleTokenBefore(ps._mark()).End)
+		return NewReceiverGenericTypeIdentNode(ps._filePath, ps._fileContent, ident, ps._tokens[pos.pos].Start, ps._visibleTokenBefore(ps._pos).End)
 	}
 	ps._reset(pos)
-	/* type_only_function_result
+	return nil
+}
+
+/*
+function_decl:
+| 'func' name=function_ident generic_parameter=generic_parameter_decl? parameter=parameter_decl result=result_decl? body=block? ';'? {function_decl(name, generic_parameter, parameter, result, body)}
+*/
+func (ps *Parser) functionDecl() Node {
+	if !ps._enterDepth() {
+		return nil
+	}
+	defer ps._leaveDepth()
+	/* 'func' name=function_ident generic_parameter=generic_parameter_decl? parameter=parameter_decl result=result_decl? body=block? ';'? {function_decl(name, generic_parameter, parameter, result, body)}
 	 */
+	pos := ps._mark()
 	for {
+		var body Node
+		var generic_parameter Node
+		var name Node
+		var parameter Node
+		var result Node
 		var _1 Node
-		_1 = ps.typeOnlyFunctionResult()
+		_1 = ps._expectK(TokenTypeKwFunc)
 		if _1 == nil {
 			break
 		}
-		return _1
+		name = ps.functionIdent()
+		if name == nil {
+			break
+		}
+		generic_parameter = ps.genericParameterDecl()
+		_ = generic_parameter
+		parameter = ps.parameterDecl()
+		if parameter == nil {
+			break
+		}
+		result = ps.resultDecl()
+		_ = result
+		body = ps.block()
+		_ = body
+		var _2 Node
+		_2 = ps._expectK(TokenTypeOpSemi)
+		_ = _2
+		return NewFunctionDeclNode(ps._filePath, ps._fileContent, name, generic_parameter, parameter, result, body, ps._tokens[pos.pos].Start, ps._visibleTokenBefore(ps._pos).End)
 	}
+	ps._reset(pos)
 	return nil
 }
 
 /*
-type_only_function_result:
-| t=type {function_result(_, t)}
+function_ident:
+| ident=IDENT {function_ident(ident)}
 */
-func (ps *Parser) typeOnlyFunctionResult() Node {
-	/* t=type {function_result(_, t)}
+func (ps *Parser) functionIdent() Node {
+	if !ps._enterDepth() {
+		return nil
+	}
+	defer ps._leaveDepth()
+	/* ident=IDENT {function_ident(ident)}
 	 */
 	pos := ps._mark()
 	for {
-		var t Node
-		t = ps.type_()
-		if t == nil {
+		var ident Node
+		ident = ps._expectK(TokenTypeIdent)
+		if ident == nil {
 			break
 		}
-		return NewFunctionResultNode(ps._filePath, ps._fileContent, nil, t, ps._tokens[pos].Start, ps._visibleTokenBefore(ps._mark()).End)
+		return NewFunctionIdentNode(ps._filePath, ps._fileContent, ident, ps._tokens[pos.pos].Start, ps._visibleTokenBefore(ps._pos).End)
 	}
 	ps._reset(pos)
 	return nil
 }
 
 /*
-function_result_ident:
-| n=IDENT {function_result_ident(n)}
+signature:
+| parameter=parameter_decl result=result_decl? {function_type(parameter, result)}
 */
-func (ps *Parser) functionResultIdent() Node {
-	/* n=IDENT {function_result_ident(n)}
+func (ps *Parser) signature() Node {
+	if !ps._enterDepth() {
+		return nil
+	}
+	defer ps._leaveDepth()
+	/* parameter=parameter_decl result=result_decl? {function_type(parameter, result)}
 	 */
 	pos := ps._mark()
 	for {
-		var n Node
-		n = ps._expectK(TokenTypeIdent)
-		if n == nil {
+		var parameter Node
+		var result Node
+		parameter = ps.parameterDecl()
+		if parameter == nil {
 			break
 		}
-		return NewFunctionResultIdentNode(ps._filePath, ps._fileContent, n, ps._tokens[pos].Start, ps._visibleTokenBefore(ps._mark()).End)
+		result = ps.resultDecl()
+		_ = result
+		return NewFunctionTypeNode(ps._filePath, ps._fileContent, parameter, result, ps._tokens[pos.pos].Start, ps._visibleTokenBefore(ps._pos).End)
 	}
 	ps._reset(pos)
 	return nil
@@ -13319,81 +20660,47 @@ func (ps *Parser) functionResultIdent() Node {
 
 /*
 method_decl:
-| 'func' '(' rc=receiver ')' n=method_ident '(' p=','.parameter* ','? ')' rs=result_decl? b=block? {method_decl(rc, n, p, rs, b)}
+| 'func' receiver=receiver_decl name=method_ident parameter=parameter_decl result=result_decl? body=block? ';'? {method_decl(receiver, name, parameter, result, body)}
 */
 func (ps *Parser) methodDecl() Node {
-	/* 'func' '(' rc=receiver ')' n=method_ident '(' p=','.parameter* ','? ')' rs=result_decl? b=block? {method_decl(rc, n, p, rs, b)}
+	if !ps._enterDepth() {
+		return nil
+	}
+	defer ps._leaveDepth()
+	/* 'func' receiver=receiver_decl name=method_ident parameter=parameter_decl result=result_decl? body=block? ';'? {method_decl(receiver, name, parameter, result, body)}
 	 */
 	pos := ps._mark()
 	for {
-		var b Node
-		var n Node
-		var p Node
-		var rc Node
-		var rs Node
+		var body Node
+		var name Node
+		var parameter Node
+		var receiver Node
+		var result Node
 		var _1 Node
 		_1 = ps._expectK(TokenTypeKwFunc)
 		if _1 == nil {
 			break
 		}
-		var _2 Node
-		_2 = ps._expectK(TokenTypeOpLeftParen)
-		if _2 == nil {
-			break
-		}
-		rc = ps.receiver()
-		if rc == nil {
-			break
-		}
-		var _3 Node
-		_3 = ps._expectK(TokenTypeOpRightParen)
-		if _3 == nil {
-			break
-		}
-		n = ps.methodIdent()
-		if n == nil {
+		receiver = ps.receiverDecl()
+		if receiver == nil {
 			break
 		}
-		var _4 Node
-		_4 = ps._expectK(TokenTypeOpLeftParen)
-		if _4 == nil {
+		name = ps.methodIdent()
+		if name == nil {
 			break
 		}
-		_5 := make([]Node, 0)
-		var _6 Node
-		var _7 Node
-		_6 = ps.parameter()
-		if _6 != nil {
-			_5 = append(_5, _6)
-			for {
-				_p := ps._mark()
-				_7 = ps._expectK(TokenTypeOpComma)
-				if _7 == nil {
-					break
-				}
-				_6 = ps.parameter()
-				if _6 == nil {
-					ps._reset(_p)
-					break
-				}
-				_5 = append(_5, _6)
-			}
-		}
-		p = NewNodesNode(_5)
-		_ = p
-		var _8 Node
-		_8 = ps._expectK(TokenTypeOpComma)
-		_ = _8
-		var _9 Node
-		_9 = ps._expectK(TokenTypeOpRightParen)
-		if _9 == nil {
+		parameter = ps.parameterDecl()
+		if parameter == nil {
 			break
 		}
-		rs = ps.resultDecl()
-		_ = rs
-		b = ps.block()
-		_ = b
-		return NewMethodDeclNode(ps._filePath, ps._fileContent, rc, n, p, rs, b, ps._tokens[pos].Start, ps._visibleTokenBefore(ps._mark()).End)
+		result = ps.resultDecl()
+		_ = result
+		body = ps.block()
+		_ = body
+		var _2 Node
+		_2 = ps._expectK(TokenTypeOpSemi)
+		_ = _2
+		return NewMethodDeclNode(ps._filePath, ps._fileContent, receiver, name, parameter, result, body, ps._tokens[pos.pos].Start, ps._visibleTokenBefore(ps._pos).End)
 	}
 	ps._reset(pos)
 	return nil
@@ -13401,171 +20708,75 @@ func (ps *Parser) methodDecl() Node {
 
 /*
 method_ident:
-| n=IDENT {method_ident(n)}
+| ident=IDENT {method_ident(ident)}
 */
 func (ps *Parser) methodIdent() Node {
-	/* n=IDENT {method_ident(n)}
-	 */
-	pos := ps._mark()
-	for {
-		var n Node
-		n = ps._expectK(TokenTypeIdent)
-		if n == nil {
-			break
-		}
-		return NewMethodIdentNode(ps._filePath, ps._fileContent, n, ps._tokens[pos].Start, ps._visibleTokenBefore(ps._mark()).End)
+	if !ps._enterDepth() {
+		return nil
 	}
-	ps._reset(pos)
-	return nil
-}
-
-/*
-receiver:
-| n=receiver_ident? star='*'? t=receiver_type_ident ('[' g=','.receiver_generic_type_ident+ ','? ']')? {receiver(n, star, t, g)}
-*/
-func (ps *Parser) receiver() Node {
-	/* n=receiver_ident? star='*'? t=receiver_type_ident ('[' g=','.receiver_generic_type_ident+ ','? ']')? {receiver(n, star, t, g)}
+	defer ps._leaveDepth()
+	/* ident=IDENT {method_ident(ident)}
 	 */
 	pos := ps._mark()
 	for {
-		var g Node
-		var n Node
-		var star Node
-		var t Node
-		n = ps.receiverIdent()
-		_ = n
-		star = ps._expectK(TokenTypeOpStar)
-		_ = star
-		t = ps.receiverTypeIdent()
-		if t == nil {
-			break
-		}
-		var _1 Node
-		for {
-			_ok := false
-			_p := ps._mark()
-			for {
-				var _2 Node
-				_2 = ps._expectK(TokenTypeOpLeftBracket)
-				if _2 == nil {
-					break
-				}
-				_3 := make([]Node, 0)
-				var _4, _5 Node
-				_4 = ps.receiverGenericTypeIdent()
-				if _4 == nil {
-					break
-				}
-				_3 = append(_3, _4)
-				for {
-					_p1 := ps._mark()
-					_5 = ps._expectK(TokenTypeOpComma)
-					if _5 == nil {
-						break
-					}
-					_4 = ps.receiverGenericTypeIdent()
-					if _4 == nil {
-						ps._reset(_p1)
-						break
-					}
-					_3 = append(_3, _4)
-				}
-				g = NewNodesNode(_3)
-				_ = g
-				var _6 Node
-				_6 = ps._expectK(TokenTypeOpComma)
-				_ = _6
-				_1 = ps._expectK(TokenTypeOpRightBracket)
-				if _1 == nil {
-					break
-				}
-				_ok = true
-				break
-			}
-			if !_ok {
-				ps._reset(_p)
-				g = nil
-			}
+		var ident Node
+		ident = ps._expectK(TokenTypeIdent)
+		if ident == nil {
 			break
 		}
-		_ = _1
-		return NewReceiverNode(ps._filePath, ps._fileContent, n, star, t, g, ps._tokens[pos].Start, ps._visibleTokenBefore(ps._mark()).End)
+		return NewMethodIdentNode(ps._filePath, ps._fileContent, ident, ps._tokens[pos.pos].Start, ps._visibleTokenBefore(ps._pos).End)
 	}
 	ps._reset(pos)
 	return nil
 }
 
 /*
-receiver_ident:
-| n=IDENT {receiver_ident(n)}
+const_decl:
+| const_group_decl
+| const_one_decl
 */
-func (ps *Parser) receiverIdent() Node {
-	/* n=IDENT {receiver_ident(n)}
-	 */
-	pos := ps._mark()
-	for {
-		var n Node
-		n = ps._expectK(TokenTypeIdent)
-		if n == nil {
-			break
-		}
-		return NewReceiverIdentNode(ps._filePath, ps._fileContent, n, ps._tokens[pos].Start, ps._visibleTokenBefore(ps._mark()).End)
+func (ps *Parser) constDecl() Node {
+	if !ps._enterDepth() {
+		return nil
 	}
-	ps._reset(pos)
-	return nil
-}
-
-/*
-receiver_type_ident:
-| n=IDENT {receiver_type_ident(n)}
-*/
-func (ps *Parser) receiverTypeIdent() Node {
-	/* n=IDENT {receiver_type_ident(n)}
+	defer ps._leaveDepth()
+	/* const_group_decl
 	 */
-	pos := ps._mark()
 	for {
-		var n Node
-		n = ps._expectK(TokenTypeIdent)
-		if n == nil {
+		var _1 Node
+		_1 = ps.constGroupDecl()
+		if _1 == nil {
 			break
 		}
-		return NewReceiverTypeIdentNode(ps._filePath, ps._fileContent, n, ps._tokens[pos].Start, ps._visibleTokenBefore(ps._mark()).End)
+		return _1
 	}
-	ps._reset(pos)
-	return nil
-}
-
-/*
-receiver_generic_type_ident:
-| n=IDENT {receiver_generic_type_ident(n)}
-*/
-func (ps *Parser) receiverGenericTypeIdent() Node {
-	/* n=IDENT {receiver_generic_type_ident(n)}
+	/* const_one_decl
 	 */
-	pos := ps._mark()
 	for {
-		var n Node
-		n = ps._expectK(TokenTypeIdent)
-		if n == nil {
+		var _1 Node
+		_1 = ps.constOneDecl()
+		if _1 == nil {
 			break
 		}
-		return NewReceiverGenericTypeIdentNode(ps._filePath, ps._fileContent, n, ps._tokens[pos].Start, ps._visibleTokenBefore(ps._mark()).End)
+		return _1
 	}
-	ps._reset(pos)
 	return nil
 }
 
 /*
-const_decl:
-| 'const' '(' c=const_spec_semi* ')' {const_decl(c)}
-| 'const' c=const_spec {const_decl([c])}
+const_group_decl:
+| 'const' '(' constants=const_name_type_value* ')' {const_group_decl(constants)}
 */
-func (ps *Parser) constDecl() Node {
-	/* 'const' '(' c=const_spec_semi* ')' {const_decl(c)}
+func (ps *Parser) constGroupDecl() Node {
+	if !ps._enterDepth() {
+		return nil
+	}
+	defer ps._leaveDepth()
+	/* 'const' '(' constants=const_name_type_value* ')' {const_group_decl(constants)}
 	 */
 	pos := ps._mark()
 	for {
-		var c Node
+		var constants Node
 		var _1 Node
 		_1 = ps._expectK(TokenTypeKwConst)
 		if _1 == nil {
@@ -13579,78 +20790,70 @@ func (ps *Parser) constDecl() Node {
 		_3 := make([]Node, 0)
 		var _4 Node
 		for {
-			_4 = ps.constSpecSemi()
+			_4 = ps.constNameTypeValue()
 			if _4 == nil {
 				break
 			}
 			_3 = append(_3, _4)
 		}
-		c = NewNodesNode(_3)
-		_ = c
+		constants = NewNodesNode(_3)
+		_ = constants
 		var _5 Node
 		_5 = ps._expectK(TokenTypeOpRightParen)
 		if _5 == nil {
 			break
 		}
-		return NewConstDeclNode(ps._filePath, ps._fileContent, c, ps._tokens[pos].Start, ps._visibleTokenBefore(ps._mark()).End)
-	}
-	ps._reset(pos)
-	/* 'const' c=const_spec {const_decl([c])}
-	 */
-	for {
-		var c Node
-		var _1 Node
-		_1 = ps._expectK(TokenTypeKwConst)
-		if _1 == nil {
-			break
-		}
-		c = ps.constSpec()
-		if c == nil {
-			break
-		}
-		return NewConstDeclNode(ps._filePath, ps._fileContent, NewNodesNode([]Node{c}), ps._tokens[pos].Start, ps._visibleTokenBefore(ps._mark()).End)
+		return NewConstGroupDeclNode(ps._filePath, ps._fileContent, constants, ps._tokens[pos.pos].Start, ps._visibleTokenBefore(ps._pos).End)
 	}
 	ps._reset(pos)
 	return nil
 }
 
 /*
-const_spec_semi:
-| c=const_spec pseudo_semi {c}
+const_one_decl:
+| 'const' constant=const_name_type_value {const_one_decl(constant)}
 */
-func (ps *Parser) constSpecSemi() Node {
-	/* c=const_spec pseudo_semi {c}
+func (ps *Parser) constOneDecl() Node {
+	if !ps._enterDepth() {
+		return nil
+	}
+	defer ps._leaveDepth()
+	/* 'const' constant=const_name_type_value {const_one_decl(constant)}
 	 */
 	pos := ps._mark()
 	for {
-		var c Node
-		c = ps.constSpec()
-		if c == nil {
-			break
-		}
+		var constant Node
 		var _1 Node
-		_1 = ps.pseudoSemi()
+		_1 = ps._expectK(TokenTypeKwConst)
 		if _1 == nil {
 			break
 		}
-		return c
+		constant = ps.constNameTypeValue()
+		if constant == nil {
+			break
+		}
+		return NewConstOneDeclNode(ps._filePath, ps._fileContent, constant, ps._tokens[pos.pos].Start, ps._visibleTokenBefore(ps._pos).End)
 	}
 	ps._reset(pos)
 	return nil
 }
 
 /*
-const_spec:
-| i=','.const_ident+ (t=type? '=' e=expression_list)? {const_spec(i, t, e)}
+const_name_type_value:
+| names=','.const_ident+ type=type? ('=' values=expression_list)? ';'? {const_name_type_value(names, type, values)}
 */
-func (ps *Parser) constSpec() Node {
-	/* i=','.const_ident+ (t=type? '=' e=expression_list)? {const_spec(i, t, e)}
+func (ps *Parser) constNameTypeValue() Node {
+	if !ps._enterDepth() {
+		return nil
+	}
+	defer ps._leaveDepth()
+	/* names=','.const_ident+ type=type? ('=' values=expression_list)? ';'? {const_name_type_value(names, type, values)}
 	 */
 	pos := ps._mark()
 	for {
-		var e Node
-		var i Node
-		var t Node
+		var names Node
+		var type_ Node
+		var values Node
 		_1 := make([]Node, 0)
 		var _2, _3 Node
 		_2 = ps.constIdent()
@@ -13671,36 +20874,38 @@ func (ps *Parser) constSpec() Node {
 			}
 			_1 = append(_1, _2)
 		}
-		i = NewNodesNode(_1)
-		_ = i
+		names = NewNodesNode(_1)
+		_ = names
+		type_ = ps.type_()
+		_ = type_
 		var _4 Node
 		for {
 			_ok := false
 			_p1 := ps._mark()
 			for {
-				t = ps.type_()
-				_ = t
 				var _5 Node
 				_5 = ps._expectK(TokenTypeOpEqual)
 				if _5 == nil {
 					break
 				}
-				e = ps.expressionList()
-				if e == nil {
+				values = ps.expressionList()
+				if values == nil {
 					break
 				}
-				_4 = e
+				_4 = values
 				_ok = true
 				break
 			}
 			if !_ok {
 				ps._reset(_p1)
-				t = nil
 			}
 			break
 		}
 		_ = _4
-		return NewConstSpecNode(ps._filePath, ps._fileContent, i, t, e, ps._tokens[pos].Start, ps._visibleTokenBefore(ps._mark()).End)
+		var _6 Node
+		_6 = ps._expectK(TokenTypeOpSemi)
+		_ = _6
+		return NewConstNameTypeValueNode(ps._filePath, ps._fileContent, names, type_, values, ps._tokens[pos.pos].Start, ps._visibleTokenBefore(ps._pos).End)
 	}
 	ps._reset(pos)
 	return nil
@@ -13708,19 +20913,23 @@ func (ps *Parser) constSpec() Node {
 
 /*
 const_ident:
-| n=IDENT {const_ident(n)}
+| ident=IDENT {const_ident(ident)}
 */
 func (ps *Parser) constIdent() Node {
-	/* n=IDENT {const_ident(n)}
+	if !ps._enterDepth() {
+		return nil
+	}
+	defer ps._leaveDepth()
+	/* ident=IDENT {const_ident(ident)}
 	 */
 	pos := ps._mark()
 	for {
-		var n Node
-		n = ps._expectK(TokenTypeIdent)
-		if n == nil {
+		var ident Node
+		ident = ps._expectK(TokenTypeIdent)
+		if ident == nil {
 			break
 		}
-		return NewConstIdentNode(ps._filePath, ps._fileContent, n, ps._tokens[pos].Start, ps._visibleTokenBefore(ps._mark()).End)
+		return NewConstIdentNode(ps._filePath, ps._fileContent, ident, ps._tokens[pos.pos].Start, ps._visibleTokenBefore(ps._pos).End)
 	}
 	ps._reset(pos)
 	return nil
@@ -13728,11 +20937,15 @@ func (ps *Parser) constIdent() Node {
 
 /*
 var_decl:
-| 'var' '(' x=var_spec_semi* ')' {var_decl(x)}
+| 'var' '(' x=semi<var_spec>* ')' {var_decl(x)}
 | 'var' x=var_spec {var_decl([x])}
 */
 func (ps *Parser) varDecl() Node {
-	/* 'var' '(' x=var_spec_semi* ')' {var_decl(x)}
+	if !ps._enterDepth() {
+		return nil
+	}
+	defer ps._leaveDepth()
+	/* 'var' '(' x=semi<var_spec>* ')' {var_decl(x)}
 	 */
 	pos := ps._mark()
 	for {
@@ -13750,7 +20963,7 @@ func (ps *Parser) varDecl() Node {
 		_3 := make([]Node, 0)
 		var _4 Node
 		for {
-			_4 = ps.varSpecSemi()
+			_4 = ps.semiOfVarSpec()
 			if _4 == nil {
 				break
 			}
@@ -13763,7 +20976,7 @@ func (ps *Parser) varDecl() Node {
 		if _5 == nil {
 			break
 		}
-		return NewVarDeclNode(ps._filePath, ps._fileContent, x, ps._tokens[pos].Start, ps._visibleTokenBefore(ps._mark()).End)
+		return NewVarDeclNode(ps._filePath, ps._fileContent, x, ps._tokens[pos.pos].Start, ps._visibleTokenBefore(ps._pos).End)
 	}
 	ps._reset(pos)
 	/* 'var' x=var_spec {var_decl([x])}
@@ -13779,32 +20992,7 @@ func (ps *Parser) varDecl() Node {
 		if x == nil {
 			break
 		}
-		return NewVarDeclNode(ps._filePath, ps._fileContent, NewNodesNode([]Node{x}), ps._tokens[pos].Start, ps._visibleTokenBefore(ps._mark()).End)
-	}
-	ps._reset(pos)
-	return nil
-}
-
-/*
-var_spec_semi:
-| x=var_spec pseudo_semi {x}
-*/
-func (ps *Parser) varSpecSemi() Node {
-	/* x=var_spec pseudo_semi {x}
-	 */
-	pos := ps._mark()
-	for {
-		var x Node
-		x = ps.varSpec()
-		if x == nil {
-			break
-		}
-		var _1 Node
-		_1 = ps.pseudoSemi()
-		if _1 == nil {
-			break
-		}
-		return x
+		return NewVarDeclNode(ps._filePath, ps._fileContent, NewNodesNode([]Node{x}), ps._tokens[pos.pos].Start, ps._visibleTokenBefore(ps._pos).End)
 	}
 	ps._reset(pos)
 	return nil
@@ -13815,6 +21003,10 @@ var_spec:
 | i=','.var_ident+  (t=type? '=' e=expression_list)? {var_spec(i, t, e)}
 */
 func (ps *Parser) varSpec() Node {
+	if !ps._enterDepth() {
+		return nil
+	}
+	defer ps._leaveDepth()
 	/* i=','.var_ident+ (t=type? '=' e=expression_list)? {var_spec(i, t, e)}
 	 */
 	pos := ps._mark()
@@ -13871,7 +21063,7 @@ func (ps *Parser) varSpec() Node {
 			break
 		}
 		_ = _4
-		return NewVarSpecNode(ps._filePath, ps._fileContent, i, t, e, ps._tokens[pos].Start, ps._visibleTokenBefore(ps._mark()).End)
+		return NewVarSpecNode(ps._filePath, ps._fileContent, i, t, e, ps._tokens[pos.pos].Start, ps._visibleTokenBefore(ps._pos).End)
 	}
 	ps._reset(pos)
 	return nil
@@ -13882,6 +21074,10 @@ var_ident:
 | n=IDENT {var_ident(n)}
 */
 func (ps *Parser) varIdent() Node {
+	if !ps._enterDepth() {
+		return nil
+	}
+	defer ps._leaveDepth()
 	/* n=IDENT {var_ident(n)}
 	 */
 	pos := ps._mark()
@@ -13891,7 +21087,7 @@ func (ps *Parser) varIdent() Node {
 		if n == nil {
 			break
 		}
-		return NewVarIdentNode(ps._filePath, ps._fileContent, n, ps._tokens[pos].Start, ps._visibleTokenBefore(ps._mark()).End)
+		return NewVarIdentNode(ps._filePath, ps._fileContent, n, ps._tokens[pos.pos].Start, ps._visibleTokenBefore(ps._pos).End)
 	}
 	ps._reset(pos)
 	return nil
@@ -13899,11 +21095,15 @@ func (ps *Parser) varIdent() Node {
 
 /*
 type_decl:
-| 'type' '(' x=type_spec_semi* ')' {type_decl(x)}
+| 'type' '(' x=semi<type_spec>* ')' {type_decl(x)}
 | 'type' x=type_spec {type_decl([x])}
 */
 func (ps *Parser) typeDecl() Node {
-	/* 'type' '(' x=type_spec_semi* ')' {type_decl(x)}
+	if !ps._enterDepth() {
+		return nil
+	}
+	defer ps._leaveDepth()
+	/* 'type' '(' x=semi<type_spec>* ')' {type_decl(x)}
 	 */
 	pos := ps._mark()
 	for {
@@ -13921,7 +21121,7 @@ func (ps *Parser) typeDecl() Node {
 		_3 := make([]Node, 0)
 		var _4 Node
 		for {
-			_4 = ps.typeSpecSemi()
+			_4 = ps.semiOfTypeSpec()
 			if _4 == nil {
 				break
 			}
@@ -13934,7 +21134,7 @@ func (ps *Parser) typeDecl() Node {
 		if _5 == nil {
 			break
 		}
-		return NewTypeDeclNode(ps._filePath, ps._fileContent, x, ps._tokens[pos].Start, ps._visibleTokenBefore(ps._mark()).End)
+		return NewTypeDeclNode(ps._filePath, ps._fileContent, x, ps._tokens[pos.pos].Start, ps._visibleTokenBefore(ps._pos).End)
 	}
 	ps._reset(pos)
 	/* 'type' x=type_spec {type_decl([x])}
@@ -13950,32 +21150,7 @@ func (ps *Parser) typeDecl() Node {
 		if x == nil {
 			break
 		}
-		return NewTypeDeclNode(ps._filePath, ps._fileContent, NewNodesNode([]Node{x}), ps._tokens[pos].Start, ps._visibleTokenBefore(ps._mark()).End)
-	}
-	ps._reset(pos)
-	return nil
-}
-
-/*
-type_spec_semi:
-| x=type_spec pseudo_semi {x}
-*/
-func (ps *Parser) typeSpecSemi() Node {
-	/* x=type_spec pseudo_semi {x}
-	 */
-	pos := ps._mark()
-	for {
-		var x Node
-		x = ps.typeSpec()
-		if x == nil {
-			break
-		}
-		var _1 Node
-		_1 = ps.pseudoSemi()
-		if _1 == nil {
-			break
-		}
-		return x
+		return NewTypeDeclNode(ps._filePath, ps._fileContent, NewNodesNode([]Node{x}), ps._tokens[pos.pos].Start, ps._visibleTokenBefore(ps._pos).End)
 	}
 	ps._reset(pos)
 	return nil
@@ -13987,258 +21162,76 @@ type_spec:
 | x=type_ident t=generic_parameter_decl? y=type {type_spec(x, t, y)}
 */
 func (ps *Parser) typeSpec() Node {
-	/* x=type_ident t=generic_parameter_decl? '=' y=type {type_eq_spec(x, t, y)}
-	 */
-	pos := ps._mark()
-	for {
-		var t Node
-		var x Node
-		var y Node
-		x = ps.typeIdent()
-		if x == nil {
-			break
-		}
-		t = ps.genericParameterDecl()
-		_ = t
-		var _1 Node
-		_1 = ps._expectK(TokenTypeOpEqual)
-		if _1 == nil {
-			break
-		}
-		y = ps.type_()
-		if y == nil {
-			break
-		}
-		return NewTypeEqSpecNode(ps._filePath, ps._fileContent, x, t, y, ps._tokens[pos].Start, ps._visibleTokenBefore(ps._mark()).End)
-	}
-	ps._reset(pos)
-	/* x=type_ident t=generic_parameter_decl? y=type {type_spec(x, t, y)}
-	 */
-	for {
-		var t Node
-		var x Node
-		var y Node
-		x = ps.typeIdent()
-		if x == nil {
-			break
-		}
-		t = ps.genericParameterDecl()
-		_ = t
-		y = ps.type_()
-		if y == nil {
-			break
-		}
-		return NewTypeSpecNode(ps._filePath, ps._fileContent, x, t, y, ps._tokens[pos].Start, ps._visibleTokenBefore(ps._mark()).End)
-	}
-	ps._reset(pos)
-	return nil
-}
-
-/*
-type_ident:
-| n=IDENT {type_ident(n)}
-*/
-func (ps *Parser) typeIdent() Node {
-	/* n=IDENT {type_ident(n)}
-	 */
-	pos := ps._mark()
-	for {
-		var n Node
-		n = ps._expectK(TokenTypeIdent)
-		if n == nil {
-			break
-		}
-		return NewTypeIdentNode(ps._filePath, ps._fileContent, n, ps._tokens[pos].Start, ps._visibleTokenBefore(ps._mark()).End)
-	}
-	ps._reset(pos)
-	return nil
-}
-
-/*
-generic_parameter_decl:
-| '[' x=','.generic_parameter+ ','? ']' {x}
-*/
-func (ps *Parser) genericParameterDecl() Node {
-	/* '[' x=','.generic_parameter+ ','? ']' {x}
-	 */
-	pos := ps._mark()
-	for {
-		var x Node
-		var _1 Node
-		_1 = ps._expectK(TokenTypeOpLeftBracket)
-		if _1 == nil {
-			break
-		}
-		_2 := make([]Node, 0)
-		var _3, _4 Node
-		_3 = ps.genericParameter()
-		if _3 == nil {
-			break
-		}
-		_2 = append(_2, _3)
-		for {
-			_p := ps._mark()
-			_4 = ps._expectK(TokenTypeOpComma)
-			if _4 == nil {
-				break
-			}
-			_3 = ps.genericParameter()
-			if _3 == nil {
-				ps._reset(_p)
-				break
-			}
-			_2 = append(_2, _3)
-		}
-		x = NewNodesNode(_2)
-		_ = x
-		var _5 Node
-		_5 = ps._expectK(TokenTypeOpComma)
-		_ = _5
-		var _6 Node
-		_6 = ps._expectK(TokenTypeOpRightBracket)
-		if _6 == nil {
-			break
-		}
-		return x
-	}
-	ps._reset(pos)
-	return nil
-}
-
-/*
-generic_parameter:
-| n=generic_parameter_ident t=generic_union_constraint? {generic_parameter(n, t)}
-*/
-func (ps *Parser) genericParameter() Node {
-	/* n=generic_parameter_ident t=generic_union_constraint? {generic_parameter(n, t)}
-	 */
-	pos := ps._mark()
-	for {
-		var n Node
-		var t Node
-		n = ps.genericParameterIdent()
-		if n == nil {
-			break
-		}
-		t = ps.genericUnionConstraint()
-		_ = t
-		return NewGenericParameterNode(ps._filePath, ps._fileContent, n, t, ps._tokens[pos].Start, ps._visibleTokenBefore(ps._mark()).End)
-	}
-	ps._reset(pos)
-	return nil
-}
-
-/*
-generic_parameter_ident:
-| n=IDENT {generic_parameter_ident(n)}
-*/
-func (ps *Parser) genericParameterIdent() Node {
-	/* n=IDENT {generic_parameter_ident(n)}
-	 */
-	pos := ps._mark()
-	for {
-		var n Node
-		n = ps._expectK(TokenTypeIdent)
-		if n == nil {
-			break
-		}
-		return NewGenericParameterIdentNode(ps._filePath, ps._fileContent, n, ps._tokens[pos].Start, ps._visibleTokenBefore(ps._mark()).End)
-	}
-	ps._reset(pos)
-	return nil
-}
-
-/*
-generic_union_constraint:
-| x=type_constraint !'|' {x}
-| x='|'.type_constraint+ {generic_union_constraint(x)}
-*/
-func (ps *Parser) genericUnionConstraint() Node {
-	/* x=type_constraint !'|' {x}
+	if !ps._enterDepth() {
+		return nil
+	}
+	defer ps._leaveDepth()
+	/* x=type_ident t=generic_parameter_decl? '=' y=type {type_eq_spec(x, t, y)}
 	 */
 	pos := ps._mark()
 	for {
+		var t Node
 		var x Node
-		x = ps.typeConstraint()
+		var y Node
+		x = ps.typeIdent()
 		if x == nil {
 			break
 		}
+		t = ps.genericParameterDecl()
+		_ = t
 		var _1 Node
-		_p := ps._mark()
-		_1 = ps._expectK(TokenTypeOpBar)
-		if _1 != nil {
-			ps._reset(_p)
+		_1 = ps._expectK(TokenTypeOpEqual)
+		if _1 == nil {
+			break
 		}
-		if _1 != nil {
+		y = ps.type_()
+		if y == nil {
 			break
 		}
-		return x
+		return NewTypeEqSpecNode(ps._filePath, ps._fileContent, x, t, y, ps._tokens[pos.pos].Start, ps._visibleTokenBefore(ps._pos).End)
 	}
 	ps._reset(pos)
-	/* x='|'.type_constraint+ {generic_union_constraint(x)}
+	/* x=type_ident t=generic_parameter_decl? y=type {type_spec(x, t, y)}
 	 */
 	for {
+		var t Node
 		var x Node
-		_1 := make([]Node, 0)
-		var _2, _3 Node
-		_2 = ps.typeConstraint()
-		if _2 == nil {
+		var y Node
+		x = ps.typeIdent()
+		if x == nil {
 			break
 		}
-		_1 = append(_1, _2)
-		for {
-			_p := ps._mark()
-			_3 = ps._expectK(TokenTypeOpBar)
-			if _3 == nil {
-				break
-			}
-			_2 = ps.typeConstraint()
-			if _2 == nil {
-				ps._reset(_p)
-				break
-			}
-			_1 = append(_1, _2)
+		t = ps.genericParameterDecl()
+		_ = t
+		y = ps.type_()
+		if y == nil {
+			break
 		}
-		x = NewNodesNode(_1)
-		_ = x
-		return NewGenericUnionConstraintNode(ps._filePath, ps._fileContent, x, ps._tokens[pos].Start, ps._visibleTokenBefore(ps._mark()).End)
+		return NewTypeSpecNode(ps._filePath, ps._fileContent, x, t, y, ps._tokens[pos.pos].Start, ps._visibleTokenBefore(ps._pos).End)
 	}
 	ps._reset(pos)
 	return nil
 }
 
 /*
-type_constraint:
-| '~' x=type {generic_underlying_type_constraint(x)}
-| x=type {generic_type_constraint(x)}
+type_ident:
+| n=IDENT {type_ident(n)}
 */
-func (ps *Parser) typeConstraint() Node {
-	/* '~' x=type {generic_underlying_type_constraint(x)}
-	 */
-	pos := ps._mark()
-	for {
-		var x Node
-		var _1 Node
-		_1 = ps._expectK(TokenTypeOpTilde)
-		if _1 == nil {
-			break
-		}
-		x = ps.type_()
-		if x == nil {
-			break
-		}
-		return NewGenericUnderlyingTypeConstraintNode(ps._filePath, ps._fileContent, x, ps._tokens[pos].Start, ps._visibleTokenBefore(ps._mark()).End)
+func (ps *Parser) typeIdent() Node {
+	if !ps._enterDepth() {
+		return nil
 	}
-	ps._reset(pos)
-	/* x=type {generic_type_constraint(x)}
+	defer ps._leaveDepth()
+	/* n=IDENT {type_ident(n)}
 	 */
+	pos := ps._mark()
 	for {
-		var x Node
-		x = ps.type_()
-		if x == nil {
+		var n Node
+		n = ps._expectK(TokenTypeIdent)
+		if n == nil {
 			break
 		}
-		return NewGenericTypeConstraintNode(ps._filePath, ps._fileContent, x, ps._tokens[pos].Start, ps._visibleTokenBefore(ps._mark()).End)
+		return NewTypeIdentNode(ps._filePath, ps._fileContent, n, ps._tokens[pos.pos].Start, ps._visibleTokenBefore(ps._pos).End)
 	}
 	ps._reset(pos)
 	return nil
@@ -14246,22 +21239,26 @@ func (ps *Parser) typeConstraint() Node {
 
 /*
 statement_semi_list:
-| statement_semi+
+| semi<statement>+
 */
 func (ps *Parser) statementSemiList() Node {
-	/* statement_semi+
+	if !ps._enterDepth() {
+		return nil
+	}
+	defer ps._leaveDepth()
+	/* semi<statement>+
 	 */
 	for {
 		var _1 Node
 		_2 := make([]Node, 0)
 		var _3 Node
-		_3 = ps.statementSemi()
+		_3 = ps.semiOfStatement()
 		if _3 == nil {
 			break
 		}
 		_2 = append(_2, _3)
 		for {
-			_3 = ps.statementSemi()
+			_3 = ps.semiOfStatement()
 			if _3 == nil {
 				break
 			}
@@ -14274,31 +21271,6 @@ func (ps *Parser) statementSemiList() Node {
 	return nil
 }
 
-/*
-statement_semi:
-| x=statement pseudo_semi {x}
-*/
-func (ps *Parser) statementSemi() Node {
-	/* x=statement pseudo_semi {x}
-	 */
-	pos := ps._mark()
-	for {
-		var x Node
-		x = ps.statement()
-		if x == nil {
-			break
-		}
-		var _1 Node
-		_1 = ps.pseudoSemi()
-		if _1 == nil {
-			break
-		}
-		return x
-	}
-	ps._reset(pos)
-	return nil
-}
-
 /*
 statement:
 | var_decl
@@ -14321,6 +21293,10 @@ statement:
 | block
 */
 func (ps *Parser) statement() Node {
+	if !ps._enterDepth() {
+		return nil
+	}
+	defer ps._leaveDepth()
 	/* var_decl
 	 */
 	for {
@@ -14509,6 +21485,10 @@ block:
 | '{' x=statement_semi_list? '}' {block_stmt(x)}
 */
 func (ps *Parser) block() Node {
+	if !ps._enterDepth() {
+		return nil
+	}
+	defer ps._leaveDepth()
 	/* '{' x=statement_semi_list? '}' {block_stmt(x)}
 	 */
 	pos := ps._mark()
@@ -14526,7 +21506,7 @@ func (ps *Parser) block() Node {
 		if _2 == nil {
 			break
 		}
-		return NewBlockStmtNode(ps._filePath, ps._fileContent, x, ps._tokens[pos].Start, ps._visibleTokenBefore(ps._mark()).End)
+		return NewBlockStmtNode(ps._filePath, ps._fileContent, x, ps._tokens[pos.pos].Start, ps._visibleTokenBefore(ps._pos).End)
 	}
 	ps._reset(pos)
 	return nil
@@ -14537,6 +21517,10 @@ label_ident:
 | i=IDENT {label_ident(i)}
 */
 func (ps *Parser) labelIdent() Node {
+	if !ps._enterDepth() {
+		return nil
+	}
+	defer ps._leaveDepth()
 	/* i=IDENT {label_ident(i)}
 	 */
 	pos := ps._mark()
@@ -14546,7 +21530,7 @@ func (ps *Parser) labelIdent() Node {
 		if i == nil {
 			break
 		}
-		return NewLabelIdentNode(ps._filePath, ps._fileContent, i, ps._tokens[pos].Start, ps._visibleTokenBefore(ps._mark()).End)
+		return NewLabelIdentNode(ps._filePath, ps._fileContent, i, ps._tokens[pos.pos].Start, ps._visibleTokenBefore(ps._pos).End)
 	}
 	ps._reset(pos)
 	return nil
@@ -14554,10 +21538,14 @@ func (ps *Parser) labelIdent() Node {
 
 /*
 fallthrough_stmt:
-| x='fallthrough' {fallthrough_stmt(x,_)}
+| x='fallthrough' {fallthrough_stmt()}
 */
 func (ps *Parser) fallthroughStmt() Node {
-	/* x='fallthrough' {fallthrough_stmt(x,_)}
+	if !ps._enterDepth() {
+		return nil
+	}
+	defer ps._leaveDepth()
+	/* x='fallthrough' {fallthrough_stmt()}
 	 */
 	pos := ps._mark()
 	for {
@@ -14566,7 +21554,7 @@ func (ps *Parser) fallthroughStmt() Node {
 		if x == nil {
 			break
 		}
-		return NewFallthroughStmtNode(ps._filePath, ps._fileContent, x, nil, ps._tokens[pos].Start, ps._visibleTokenBefore(ps._mark()).End)
+		return NewFallthroughStmtNode(ps._filePath, ps._fileContent, ps._tokens[pos.pos].Start, ps._visibleTokenBefore(ps._pos).End)
 	}
 	ps._reset(pos)
 	return nil
@@ -14577,6 +21565,10 @@ goto_stmt:
 | 'goto' x=label_ident {goto_stmt(x)}
 */
 func (ps *Parser) gotoStmt() Node {
+	if !ps._enterDepth() {
+		return nil
+	}
+	defer ps._leaveDepth()
 	/* 'goto' x=label_ident {goto_stmt(x)}
 	 */
 	pos := ps._mark()
@@ -14591,7 +21583,7 @@ func (ps *Parser) gotoStmt() Node {
 		if x == nil {
 			break
 		}
-		return NewGotoStmtNode(ps._filePath, ps._fileContent, x, ps._tokens[pos].Start, ps._visibleTokenBefore(ps._mark()).End)
+		return NewGotoStmtNode(ps._filePath, ps._fileContent, x, ps._tokens[pos.pos].Start, ps._visibleTokenBefore(ps._pos).End)
 	}
 	ps._reset(pos)
 	return nil
@@ -14602,6 +21594,10 @@ continue_stmt:
 | 'continue' x=label_ident? {continue_stmt(x)}
 */
 func (ps *Parser) continueStmt() Node {
+	if !ps._enterDepth() {
+		return nil
+	}
+	defer ps._leaveDepth()
 	/* 'continue' x=label_ident? {continue_stmt(x)}
 	 */
 	pos := ps._mark()
@@ -14614,7 +21610,7 @@ func (ps *Parser) continueStmt() Node {
 		}
 		x = ps.labelIdent()
 		_ = x
-		return NewContinueStmtNode(ps._filePath, ps._fileContent, x, ps._tokens[pos].Start, ps._visibleTokenBefore(ps._mark()).End)
+		return NewContinueStmtNode(ps._filePath, ps._fileContent, x, ps._tokens[pos.pos].Start, ps._visibleTokenBefore(ps._pos).End)
 	}
 	ps._reset(pos)
 	return nil
@@ -14625,6 +21621,10 @@ break_stmt:
 | 'break' x=label_ident? {break_stmt(x)}
 */
 func (ps *Parser) breakStmt() Node {
+	if !ps._enterDepth() {
+		return nil
+	}
+	defer ps._leaveDepth()
 	/* 'break' x=label_ident? {break_stmt(x)}
 	 */
 	pos := ps._mark()
@@ -14637,7 +21637,7 @@ func (ps *Parser) breakStmt() Node {
 		}
 		x = ps.labelIdent()
 		_ = x
-		return NewBreakStmtNode(ps._filePath, ps._fileContent, x, ps._tokens[pos].Start, ps._visibleTokenBefore(ps._mark()).End)
+		return NewBreakStmtNode(ps._filePath, ps._fileContent, x, ps._tokens[pos.pos].Start, ps._visibleTokenBefore(ps._pos).End)
 	}
 	ps._reset(pos)
 	return nil
@@ -14650,6 +21650,10 @@ labeled_stmt:
 | x=label_ident ':' {labeled_stmt(x,_)}
 */
 func (ps *Parser) labeledStmt() Node {
+	if !ps._enterDepth() {
+		return nil
+	}
+	defer ps._leaveDepth()
 	/* x=label_ident ':' b=empty_block {labeled_stmt(x,b)}
 	 */
 	pos := ps._mark()
@@ -14669,7 +21673,7 @@ func (ps *Parser) labeledStmt() Node {
 		if b == nil {
 			break
 		}
-		return NewLabeledStmtNode(ps._filePath, ps._fileContent, x, b, ps._tokens[pos].Start, ps._visibleTokenBefore(ps._mark()).End)
+		return NewLabeledStmtNode(ps._filePath, ps._fileContent, x, b, ps._tokens[pos.pos].Start, ps._visibleTokenBefore(ps._pos).End)
 	}
 	ps._reset(pos)
 	/* x=label_ident ':' y=statement {labeled_stmt(x,y)}
@@ -14690,7 +21694,7 @@ func (ps *Parser) labeledStmt() Node {
 		if y == nil {
 			break
 		}
-		return NewLabeledStmtNode(ps._filePath, ps._fileContent, x, y, ps._tokens[pos].Start, ps._visibleTokenBefore(ps._mark()).End)
+		return NewLabeledStmtNode(ps._filePath, ps._fileContent, x, y, ps._tokens[pos.pos].Start, ps._visibleTokenBefore(ps._pos).End)
 	}
 	ps._reset(pos)
 	/* x=label_ident ':' {labeled_stmt(x,_)}
@@ -14706,7 +21710,7 @@ func (ps *Parser) labeledStmt() Node {
 		if _1 == nil {
 			break
 		}
-		return NewLabeledStmtNode(ps._filePath, ps._fileContent, x, nil, ps._tokens[pos].Start, ps._visibleTokenBefore(ps._mark()).End)
+		return NewLabeledStmtNode(ps._filePath, ps._fileContent, x, nil, ps._tokens[pos.pos].Start, ps._visibleTokenBefore(ps._pos).End)
 	}
 	ps._reset(pos)
 	return nil
@@ -14717,6 +21721,10 @@ empty_block:
 | '{' '}' {block_stmt(_)}
 */
 func (ps *Parser) emptyBlock() Node {
+	if !ps._enterDepth() {
+		return nil
+	}
+	defer ps._leaveDepth()
 	/* '{' '}' {block_stmt(_)}
 	 */
 	pos := ps._mark()
@@ -14731,7 +21739,7 @@ func (ps *Parser) emptyBlock() Node {
 		if _2 == nil {
 			break
 		}
-		return NewBlockStmtNode(ps._filePath, ps._fileContent, nil, ps._tokens[pos].Start, ps._visibleTokenBefore(ps._mark()).End)
+		return NewBlockStmtNode(ps._filePath, ps._fileContent, nil, ps._tokens[pos.pos].Start, ps._visibleTokenBefore(ps._pos).End)
 	}
 	ps._reset(pos)
 	return nil
@@ -14742,6 +21750,10 @@ defer_stmt:
 | 'defer' x=expression {defer_stmt(x)}
 */
 func (ps *Parser) deferStmt() Node {
+	if !ps._enterDepth() {
+		return nil
+	}
+	defer ps._leaveDepth()
 	/* 'defer' x=expression {defer_stmt(x)}
 	 */
 	pos := ps._mark()
@@ -14756,7 +21768,7 @@ func (ps *Parser) deferStmt() Node {
 		if x == nil {
 			break
 		}
-		return NewDeferStmtNode(ps._filePath, ps._fileContent, x, ps._tokens[pos].Start, ps._visibleTokenBefore(ps._mark()).End)
+		return NewDeferStmtNode(ps._filePath, ps._fileContent, x, ps._tokens[pos.pos].Start, ps._visibleTokenBefore(ps._pos).End)
 	}
 	ps._reset(pos)
 	return nil
@@ -14767,6 +21779,10 @@ go_stmt:
 | 'go' x=expression {go_stmt(x)}
 */
 func (ps *Parser) goStmt() Node {
+	if !ps._enterDepth() {
+		return nil
+	}
+	defer ps._leaveDepth()
 	/* 'go' x=expression {go_stmt(x)}
 	 */
 	pos := ps._mark()
@@ -14781,7 +21797,7 @@ func (ps *Parser) goStmt() Node {
 		if x == nil {
 			break
 		}
-		return NewGoStmtNode(ps._filePath, ps._fileContent, x, ps._tokens[pos].Start, ps._visibleTokenBefore(ps._mark()).End)
+		return NewGoStmtNode(ps._filePath, ps._fileContent, x, ps._tokens[pos.pos].Start, ps._visibleTokenBefore(ps._pos).End)
 	}
 	ps._reset(pos)
 	return nil
@@ -14792,6 +21808,10 @@ return_stmt:
 | 'return' x=expression_list? {return_stmt(x)}
 */
 func (ps *Parser) returnStmt() Node {
+	if !ps._enterDepth() {
+		return nil
+	}
+	defer ps._leaveDepth()
 	/* 'return' x=expression_list? {return_stmt(x)}
 	 */
 	pos := ps._mark()
@@ -14804,7 +21824,7 @@ func (ps *Parser) returnStmt() Node {
 		}
 		x = ps.expressionList()
 		_ = x
-		return NewReturnStmtNode(ps._filePath, ps._fileContent, x, ps._tokens[pos].Start, ps._visibleTokenBefore(ps._mark()).End)
+		return NewReturnStmtNode(ps._filePath, ps._fileContent, x, ps._tokens[pos.pos].Start, ps._visibleTokenBefore(ps._pos).End)
 	}
 	ps._reset(pos)
 	return nil
@@ -14812,29 +21832,44 @@ func (ps *Parser) returnStmt() Node {
 
 /*
 simple_stmt:
-| assignment
-| short_val_decl
+| assign_stmt
+| aug_assign_stmt
+| var_decl_stmt
 | inc_stmt
 | dec_stmt
 | send_stmt
 | expression_stmt
 */
 func (ps *Parser) simpleStmt() Node {
-	/* assignment
+	if !ps._enterDepth() {
+		return nil
+	}
+	defer ps._leaveDepth()
+	/* assign_stmt
+	 */
+	for {
+		var _1 Node
+		_1 = ps.assignStmt()
+		if _1 == nil {
+			break
+		}
+		return _1
+	}
+	/* aug_assign_stmt
 	 */
 	for {
 		var _1 Node
-		_1 = ps.assignment()
+		_1 = ps.augAssignStmt()
 		if _1 == nil {
 			break
 		}
 		return _1
 	}
-	/* short_val_decl
+	/* var_decl_stmt
 	 */
 	for {
 		var _1 Node
-		_1 = ps.shortValDecl()
+		_1 = ps.varDeclStmt()
 		if _1 == nil {
 			break
 		}
@@ -14888,6 +21923,10 @@ inc_stmt:
 | x=expression '++' {inc_stmt(x)}
 */
 func (ps *Parser) incStmt() Node {
+	if !ps._enterDepth() {
+		return nil
+	}
+	defer ps._leaveDepth()
 	/* x=expression '++' {inc_stmt(x)}
 	 */
 	pos := ps._mark()
@@ -14902,7 +21941,7 @@ func (ps *Parser) incStmt() Node {
 		if _1 == nil {
 			break
 		}
-		return NewIncStmtNode(ps._filePath, ps._fileContent, x, ps._tokens[pos].Start, ps._visibleTokenBefore(ps._mark()).End)
+		return NewIncStmtNode(ps._filePath, ps._fileContent, x, ps._tokens[pos.pos].Start, ps._visibleTokenBefore(ps._pos).End)
 	}
 	ps._reset(pos)
 	return nil
@@ -14913,6 +21952,10 @@ dec_stmt:
 | x=expression '--' {dec_stmt(x)}
 */
 func (ps *Parser) decStmt() Node {
+	if !ps._enterDepth() {
+		return nil
+	}
+	defer ps._leaveDepth()
 	/* x=expression '--' {dec_stmt(x)}
 	 */
 	pos := ps._mark()
@@ -14927,7 +21970,7 @@ func (ps *Parser) decStmt() Node {
 		if _1 == nil {
 			break
 		}
-		return NewDecStmtNode(ps._filePath, ps._fileContent, x, ps._tokens[pos].Start, ps._visibleTokenBefore(ps._mark()).End)
+		return NewDecStmtNode(ps._filePath, ps._fileContent, x, ps._tokens[pos.pos].Start, ps._visibleTokenBefore(ps._pos).End)
 	}
 	ps._reset(pos)
 	return nil
@@ -14938,6 +21981,10 @@ send_stmt:
 | x=expression '<-' y=expression {send_stmt(x,y)}
 */
 func (ps *Parser) sendStmt() Node {
+	if !ps._enterDepth() {
+		return nil
+	}
+	defer ps._leaveDepth()
 	/* x=expression '<-' y=expression {send_stmt(x,y)}
 	 */
 	pos := ps._mark()
@@ -14957,17 +22004,21 @@ func (ps *Parser) sendStmt() Node {
 		if y == nil {
 			break
 		}
-		return NewSendStmtNode(ps._filePath, ps._fileContent, x, y, ps._tokens[pos].Start, ps._visibleTokenBefore(ps._mark()).End)
+		return NewSendStmtNode(ps._filePath, ps._fileContent, x, y, ps._tokens[pos.pos].Start, ps._visibleTokenBefore(ps._pos).End)
 	}
 	ps._reset(pos)
 	return nil
 }
 
 /*
-short_val_decl:
+var_decl_stmt:
 | l=identifier_list ':=' r=expression_list {short_var_decl(l, r)}
 */
-func (ps *Parser) shortValDecl() Node {
+func (ps *Parser) varDeclStmt() Node {
+	if !ps._enterDepth() {
+		return nil
+	}
+	defer ps._leaveDepth()
 	/* l=identifier_list ':=' r=expression_list {short_var_decl(l, r)}
 	 */
 	pos := ps._mark()
@@ -14987,17 +22038,21 @@ func (ps *Parser) shortValDecl() Node {
 		if r == nil {
 			break
 		}
-		return NewShortVarDeclNode(ps._filePath, ps._fileContent, l, r, ps._tokens[pos].Start, ps._visibleTokenBefore(ps._mark()).End)
+		return NewShortVarDeclNode(ps._filePath, ps._fileContent, l, r, ps._tokens[pos.pos].Start, ps._visibleTokenBefore(ps._pos).End)
 	}
 	ps._reset(pos)
 	return nil
 }
 
 /*
-assignment:
+assign_stmt:
 | l=expression_list '=' r=expression_list {assign_stmt(l, r)}
 */
-func (ps *Parser) assignment() Node {
+func (ps *Parser) assignStmt() Node {
+	if !ps._enterDepth() {
+		return nil
+	}
+	defer ps._leaveDepth()
 	/* l=expression_list '=' r=expression_list {assign_stmt(l, r)}
 	 */
 	pos := ps._mark()
@@ -15017,17 +22072,21 @@ func (ps *Parser) assignment() Node {
 		if r == nil {
 			break
 		}
-		return NewAssignStmtNode(ps._filePath, ps._fileContent, l, r, ps._tokens[pos].Start, ps._visibleTokenBefore(ps._mark()).End)
+		return NewAssignStmtNode(ps._filePath, ps._fileContent, l, r, ps._tokens[pos.pos].Start, ps._visibleTokenBefore(ps._pos).End)
 	}
 	ps._reset(pos)
 	return nil
 }
 
 /*
-aug_assignment:
+aug_assign_stmt:
 | l=expression_list op=aug_op r=expression_list {aug_assign_stmt(l, op, r)}
 */
-func (ps *Parser) augAssignment() Node {
+func (ps *Parser) augAssignStmt() Node {
+	if !ps._enterDepth() {
+		return nil
+	}
+	defer ps._leaveDepth()
 	/* l=expression_list op=aug_op r=expression_list {aug_assign_stmt(l, op, r)}
 	 */
 	pos := ps._mark()
@@ -15047,7 +22106,7 @@ func (ps *Parser) augAssignment() Node {
 		if r == nil {
 			break
 		}
-		return NewAugAssignStmtNode(ps._filePath, ps._fileContent, l, op, r, ps._tokens[pos].Start, ps._visibleTokenBefore(ps._mark()).End)
+		return NewAugAssignStmtNode(ps._filePath, ps._fileContent, l, op, r, ps._tokens[pos.pos].Start, ps._visibleTokenBefore(ps._pos).End)
 	}
 	ps._reset(pos)
 	return nil
@@ -15068,6 +22127,10 @@ aug_op:
 | '&^='
 */
 func (ps *Parser) augOp() Node {
+	if !ps._enterDepth() {
+		return nil
+	}
+	defer ps._leaveDepth()
 	/* '+='
 	 */
 	for {
@@ -15183,13 +22246,17 @@ func (ps *Parser) augOp() Node {
 
 /*
 if_stmt:
-| 'if' [ (init=simple_stmt ';')? cond=expression ] body=block 'else' else_=if_stmt {if_stmt(init, cond, body, else_)}
-| 'if' [ (init=simple_stmt ';')? cond=expression ] body=block 'else' else_=block {if_stmt(init, cond, body, else_)}
-| 'if' [ init=simple_stmt ';' cond=expression ] body=block {if_stmt(init, cond, body, _)}
-| 'if' [ cond=expression ] body=block {if_stmt(_, cond, body, _)}
+| 'if' @enter_ctrl (init=simple_stmt ';')? cond=expression @leave_ctrl body=block 'else' else_=if_stmt {if_stmt(init, cond, body, else_)}
+| 'if' @enter_ctrl (init=simple_stmt ';')? cond=expression @leave_ctrl body=block 'else' else_=block {if_stmt(init, cond, body, else_)}
+| 'if' @enter_ctrl init=simple_stmt ';' cond=expression @leave_ctrl body=block {if_stmt(init, cond, body, _)}
+| 'if' @enter_ctrl cond=expression @leave_ctrl body=block {if_stmt(_, cond, body, _)}
 */
 func (ps *Parser) ifStmt() Node {
-	/* 'if' [ (init=simple_stmt ';')? cond=expression ] body=block 'else' else_=if_stmt {if_stmt(init, cond, body, else_)}
+	if !ps._enterDepth() {
+		return nil
+	}
+	defer ps._leaveDepth()
+	/* 'if' @enter_ctrl (init=simple_stmt ';')? cond=expression @leave_ctrl body=block 'else' else_=if_stmt {if_stmt(init, cond, body, else_)}
 	 */
 	pos := ps._mark()
 	for {
@@ -15202,43 +22269,35 @@ func (ps *Parser) ifStmt() Node {
 		if _1 == nil {
 			break
 		}
-		_break := true
-		ps._enter()
+		ps.enterCtrl()
+		var _2 Node
 		for {
-			var _2 Node
+			_ok := false
+			_p := ps._mark()
 			for {
-				_ok := false
-				_p := ps._mark()
-				for {
-					init = ps.simpleStmt()
-					if init == nil {
-						break
-					}
-					_2 = ps._expectK(TokenTypeOpSemi)
-					if _2 == nil {
-						break
-					}
-					_ok = true
+				init = ps.simpleStmt()
+				if init == nil {
 					break
 				}
-				if !_ok {
-					ps._reset(_p)
-					init = nil
+				_2 = ps._expectK(TokenTypeOpSemi)
+				if _2 == nil {
+					break
 				}
+				_ok = true
 				break
 			}
-			_ = _2
-			cond = ps.expression()
-			if cond == nil {
-				break
+			if !_ok {
+				ps._reset(_p)
+				init = nil
 			}
-			_break = false
 			break
 		}
-		ps._leave()
-		if _break {
+		_ = _2
+		cond = ps.expression()
+		if cond == nil {
 			break
 		}
+		ps.leaveCtrl()
 		body = ps.block()
 		if body == nil {
 			break
@@ -15252,10 +22311,10 @@ func (ps *Parser) ifStmt() Node {
 		if else_ == nil {
 			break
 		}
-		return NewIfStmtNode(ps._filePath, ps._fileContent, init, cond, body, else_, ps._tokens[pos].Start, ps._visibleTokenBefore(ps._mark()).End)
+		return NewIfStmtNode(ps._filePath, ps._fileContent, init, cond, body, else_, ps._tokens[pos.pos].Start, ps._visibleTokenBefore(ps._pos).End)
 	}
 	ps._reset(pos)
-	/* 'if' [ (init=simple_stmt ';')? cond=expression ] body=block 'else' else_=block {if_stmt(init, cond, body, else_)}
+	/* 'if' @enter_ctrl (init=simple_stmt ';')? cond=expression @leave_ctrl body=block 'else' else_=block {if_stmt(init, cond, body, else_)}
 	 */
 	for {
 		var body Node
@@ -15267,43 +22326,35 @@ func (ps *Parser) ifStmt() Node {
 		if _1 == nil {
 			break
 		}
-		_break := true
-		ps._enter()
+		ps.enterCtrl()
+		var _2 Node
 		for {
-			var _2 Node
+			_ok := false
+			_p := ps._mark()
 			for {
-				_ok := false
-				_p := ps._mark()
-				for {
-					init = ps.simpleStmt()
-					if init == nil {
-						break
-					}
-					_2 = ps._expectK(TokenTypeOpSemi)
-					if _2 == nil {
-						break
-					}
-					_ok = true
+				init = ps.simpleStmt()
+				if init == nil {
 					break
 				}
-				if !_ok {
-					ps._reset(_p)
-					init = nil
+				_2 = ps._expectK(TokenTypeOpSemi)
+				if _2 == nil {
+					break
 				}
+				_ok = true
 				break
 			}
-			_ = _2
-			cond = ps.expression()
-			if cond == nil {
-				break
+			if !_ok {
+				ps._reset(_p)
+				init = nil
 			}
-			_break = false
 			break
 		}
-		ps._leave()
-		if _break {
+		_ = _2
+		cond = ps.expression()
+		if cond == nil {
 			break
 		}
+		ps.leaveCtrl()
 		body = ps.block()
 		if body == nil {
 			break
@@ -15317,10 +22368,10 @@ func (ps *Parser) ifStmt() Node {
 		if else_ == nil {
 			break
 		}
-		return NewIfStmtNode(ps._filePath, ps._fileContent, init, cond, body, else_, ps._tokens[pos].Start, ps._visibleTokenBefore(ps._mark()).End)
+		return NewIfStmtNode(ps._filePath, ps._fileContent, init, cond, body, else_, ps._tokens[pos.pos].Start, ps._visibleTokenBefore(ps._pos).End)
 	}
 	ps._reset(pos)
-	/* 'if' [ init=simple_stmt ';' cond=expression ] body=block {if_stmt(init, cond, body, _)}
+	/* 'if' @enter_ctrl init=simple_stmt ';' cond=expression @leave_ctrl body=block {if_stmt(init, cond, body, _)}
 	 */
 	for {
 		var body Node
@@ -15331,37 +22382,29 @@ func (ps *Parser) ifStmt() Node {
 		if _1 == nil {
 			break
 		}
-		_break := true
-		ps._enter()
-		for {
-			init = ps.simpleStmt()
-			if init == nil {
-				break
-			}
-			var _2 Node
-			_2 = ps._expectK(TokenTypeOpSemi)
-			if _2 == nil {
-				break
-			}
-			cond = ps.expression()
-			if cond == nil {
-				break
-			}
-			_break = false
+		ps.enterCtrl()
+		init = ps.simpleStmt()
+		if init == nil {
+			break
+		}
+		var _2 Node
+		_2 = ps._expectK(TokenTypeOpSemi)
+		if _2 == nil {
 			break
 		}
-		ps._leave()
-		if _break {
+		cond = ps.expression()
+		if cond == nil {
 			break
 		}
+		ps.leaveCtrl()
 		body = ps.block()
 		if body == nil {
 			break
 		}
-		return NewIfStmtNode(ps._filePath, ps._fileContent, init, cond, body, nil, ps._tokens[pos].Start, ps._visibleTokenBefore(ps._mark()).End)
+		return NewIfStmtNode(ps._filePath, ps._fileContent, init, cond, body, nil, ps._tokens[pos.pos].Start, ps._visibleTokenBefore(ps._pos).End)
 	}
 	ps._reset(pos)
-	/* 'if' [ cond=expression ] body=block {if_stmt(_, cond, body, _)}
+	/* 'if' @enter_ctrl cond=expression @leave_ctrl body=block {if_stmt(_, cond, body, _)}
 	 */
 	for {
 		var body Node
@@ -15371,25 +22414,17 @@ func (ps *Parser) ifStmt() Node {
 		if _1 == nil {
 			break
 		}
-		_break := true
-		ps._enter()
-		for {
-			cond = ps.expression()
-			if cond == nil {
-				break
-			}
-			_break = false
-			break
-		}
-		ps._leave()
-		if _break {
+		ps.enterCtrl()
+		cond = ps.expression()
+		if cond == nil {
 			break
 		}
+		ps.leaveCtrl()
 		body = ps.block()
 		if body == nil {
 			break
 		}
-		return NewIfStmtNode(ps._filePath, ps._fileContent, nil, cond, body, nil, ps._tokens[pos].Start, ps._visibleTokenBefore(ps._mark()).End)
+		return NewIfStmtNode(ps._filePath, ps._fileContent, nil, cond, body, nil, ps._tokens[pos.pos].Start, ps._visibleTokenBefore(ps._pos).End)
 	}
 	ps._reset(pos)
 	return nil
@@ -15397,13 +22432,17 @@ func (ps *Parser) ifStmt() Node {
 
 /*
 for_stmt:
-| 'for' [ c=expression? ] b=block {for_stmt(_,c,_,b)}
-| 'for' [ i=simple_stmt? ';' c=expression? ';' u=simple_stmt? ] b=block {for_stmt(i,c,u,b)}
-| 'for' [ (k=expression (',' v=expression)?)? ':=' 'range' x=expression ] b=block {for_decl_range_stmt(k,v,x,b)}
-| 'for' [ (k=expression (',' v=expression)?)? '=' 'range' x=expression ] b=block {for_assign_range_stmt(k,v,x,b)}
+| 'for' @enter_ctrl c=expression? @leave_ctrl b=block {for_stmt(_,c,_,b)}
+| 'for' @enter_ctrl i=simple_stmt? ';' c=expression? ';' u=simple_stmt? @leave_ctrl b=block {for_stmt(i,c,u,b)}
+| 'for' @enter_ctrl (k=expression (',' v=expression)?)? ':=' 'range' x=expression @leave_ctrl b=block {for_decl_range_stmt(k,v,x,b)}
+| 'for' @enter_ctrl (k=expression (',' v=expression)?)? '=' 'range' x=expression @leave_ctrl b=block {for_assign_range_stmt(k,v,x,b)}
 */
 func (ps *Parser) forStmt() Node {
-	/* 'for' [ c=expression? ] b=block {for_stmt(_,c,_,b)}
+	if !ps._enterDepth() {
+		return nil
+	}
+	defer ps._leaveDepth()
+	/* 'for' @enter_ctrl c=expression? @leave_ctrl b=block {for_stmt(_,c,_,b)}
 	 */
 	pos := ps._mark()
 	for {
@@ -15414,26 +22453,18 @@ func (ps *Parser) forStmt() Node {
 		if _1 == nil {
 			break
 		}
-		_break := true
-		ps._enter()
-		for {
-			c = ps.expression()
-			_ = c
-			_break = false
-			break
-		}
-		ps._leave()
-		if _break {
-			break
-		}
+		ps.enterCtrl()
+		c = ps.expression()
+		_ = c
+		ps.leaveCtrl()
 		b = ps.block()
 		if b == nil {
 			break
 		}
-		return NewForStmtNode(ps._filePath, ps._fileContent, nil, c, nil, b, ps._tokens[pos].Start, ps._visibleTokenBefore(ps._mark()).End)
+		return NewForStmtNode(ps._filePath, ps._fileContent, nil, c, nil, b, ps._tokens[pos.pos].Start, ps._visibleTokenBefore(ps._pos).End)
 	}
 	ps._reset(pos)
-	/* 'for' [ i=simple_stmt? ';' c=expression? ';' u=simple_stmt? ] b=block {for_stmt(i,c,u,b)}
+	/* 'for' @enter_ctrl i=simple_stmt? ';' c=expression? ';' u=simple_stmt? @leave_ctrl b=block {for_stmt(i,c,u,b)}
 	 */
 	for {
 		var b Node
@@ -15445,40 +22476,32 @@ func (ps *Parser) forStmt() Node {
 		if _1 == nil {
 			break
 		}
-		_break := true
-		ps._enter()
-		for {
-			i = ps.simpleStmt()
-			_ = i
-			var _2 Node
-			_2 = ps._expectK(TokenTypeOpSemi)
-			if _2 == nil {
-				break
-			}
-			c = ps.expression()
-			_ = c
-			var _3 Node
-			_3 = ps._expectK(TokenTypeOpSemi)
-			if _3 == nil {
-				break
-			}
-			u = ps.simpleStmt()
-			_ = u
-			_break = false
+		ps.enterCtrl()
+		i = ps.simpleStmt()
+		_ = i
+		var _2 Node
+		_2 = ps._expectK(TokenTypeOpSemi)
+		if _2 == nil {
 			break
 		}
-		ps._leave()
-		if _break {
+		c = ps.expression()
+		_ = c
+		var _3 Node
+		_3 = ps._expectK(TokenTypeOpSemi)
+		if _3 == nil {
 			break
 		}
+		u = ps.simpleStmt()
+		_ = u
+		ps.leaveCtrl()
 		b = ps.block()
 		if b == nil {
 			break
 		}
-		return NewForStmtNode(ps._filePath, ps._fileContent, i, c, u, b, ps._tokens[pos].Start, ps._visibleTokenBefore(ps._mark()).End)
+		return NewForStmtNode(ps._filePath, ps._fileContent, i, c, u, b, ps._tokens[pos.pos].Start, ps._visibleTokenBefore(ps._pos).End)
 	}
 	ps._reset(pos)
-	/* 'for' [ (k=expression (',' v=expression)?)? ':=' 'range' x=expression ] b=block {for_decl_range_stmt(k,v,x,b)}
+	/* 'for' @enter_ctrl (k=expression (',' v=expression)?)? ':=' 'range' x=expression @leave_ctrl b=block {for_decl_range_stmt(k,v,x,b)}
 	 */
 	for {
 		var b Node
@@ -15490,80 +22513,72 @@ func (ps *Parser) forStmt() Node {
 		if _1 == nil {
 			break
 		}
-		_break := true
-		ps._enter()
+		ps.enterCtrl()
+		var _2 Node
 		for {
-			var _2 Node
+			_ok := false
+			_p := ps._mark()
 			for {
-				_ok := false
-				_p := ps._mark()
+				k = ps.expression()
+				if k == nil {
+					break
+				}
 				for {
-					k = ps.expression()
-					if k == nil {
-						break
-					}
+					_ok1 := false
+					_p1 := ps._mark()
 					for {
-						_ok1 := false
-						_p1 := ps._mark()
-						for {
-							var _3 Node
-							_3 = ps._expectK(TokenTypeOpComma)
-							if _3 == nil {
-								break
-							}
-							v = ps.expression()
-							if v == nil {
-								break
-							}
-							_2 = v
-							_ok1 = true
+						var _3 Node
+						_3 = ps._expectK(TokenTypeOpComma)
+						if _3 == nil {
 							break
 						}
-						if !_ok1 {
-							ps._reset(_p1)
+						v = ps.expression()
+						if v == nil {
+							break
 						}
+						_2 = v
+						_ok1 = true
 						break
 					}
-					_ = _2
-					_ok = true
+					if !_ok1 {
+						ps._reset(_p1)
+					}
 					break
 				}
-				if !_ok {
-					ps._reset(_p)
-					k = nil
-				}
-				break
-			}
-			_ = _2
-			var _4 Node
-			_4 = ps._expectK(TokenTypeOpColonEqual)
-			if _4 == nil {
-				break
-			}
-			var _5 Node
-			_5 = ps._expectK(TokenTypeKwRange)
-			if _5 == nil {
+				_ = _2
+				_ok = true
 				break
 			}
-			x = ps.expression()
-			if x == nil {
-				break
+			if !_ok {
+				ps._reset(_p)
+				k = nil
 			}
-			_break = false
 			break
 		}
-		ps._leave()
-		if _break {
+		_ = _2
+		var _4 Node
+		_4 = ps._expectK(TokenTypeOpColonEqual)
+		if _4 == nil {
+			break
+		}
+		var _5 Node
+		_5 = ps._expectK(TokenTypeKwRange)
+		if _5 == nil {
+			break
+		}
+		x = ps.expression()
+		if x == nil {
 			break
 		}
+		ps.leaveCtrl()
 		b = ps.block()
 		if b == nil {
 			break
 		}
-		return NewForDeclRangeStmtNode(ps._filePath, ps._fileContent, k, v, x, b, ps._tokens[pos].Start, ps._visibleTokenBefore(ps._mark()).End)
+		return NewForDeclRangeStmtNode(ps._filePath, ps._fileContent, k, v, x, b, ps._tokens[pos.pos].Start, ps._visibleTokenBefore(ps._pos).End)
 	}
 	ps._reset(pos)
-	/* 'for' [ (k=expression (',' v=expression)?)? '=' 'range' x=expression ] b=block {for_assign_range_stmt(k,v,x,b)}
+	/* 'for' @enter_ctrl (k=expression (',' v=expression)?)? '=' 'range' x=expression @leave_ctrl b=block {for_assign_range_stmt(k,v,x,b)}
 	 */
 	for {
 		var b Node
@@ -15575,77 +22590,69 @@ func (ps *Parser) forStmt() Node {
 		if _1 == nil {
 			break
 		}
-		_break := true
-		ps._enter()
+		ps.enterCtrl()
+		var _2 Node
 		for {
-			var _2 Node
+			_ok := false
+			_p := ps._mark()
 			for {
-				_ok := false
-				_p := ps._mark()
+				k = ps.expression()
+				if k == nil {
+					break
+				}
 				for {
-					k = ps.expression()
-					if k == nil {
-						break
-					}
+					_ok1 := false
+					_p1 := ps._mark()
 					for {
-						_ok1 := false
-						_p1 := ps._mark()
-						for {
-							var _3 Node
-							_3 = ps._expectK(TokenTypeOpComma)
-							if _3 == nil {
-								break
-							}
-							v = ps.expression()
-							if v == nil {
-								break
-							}
-							_2 = v
-							_ok1 = true
+						var _3 Node
+						_3 = ps._expectK(TokenTypeOpComma)
+						if _3 == nil {
 							break
 						}
-						if !_ok1 {
-							ps._reset(_p1)
+						v = ps.expression()
+						if v == nil {
+							break
 						}
+						_2 = v
+						_ok1 = true
 						break
 					}
-					_ = _2
-					_ok = true
+					if !_ok1 {
+						ps._reset(_p1)
+					}
 					break
 				}
-				if !_ok {
-					ps._reset(_p)
-					k = nil
-				}
-				break
-			}
-			_ = _2
-			var _4 Node
-			_4 = ps._expectK(TokenTypeOpEqual)
-			if _4 == nil {
-				break
-			}
-			var _5 Node
-			_5 = ps._expectK(TokenTypeKwRange)
-			if _5 == nil {
+				_ = _2
+				_ok = true
 				break
 			}
-			x = ps.expression()
-			if x == nil {
-				break
+			if !_ok {
+				ps._reset(_p)
+				k = nil
 			}
-			_break = false
 			break
 		}
-		ps._leave()
-		if _break {
+		_ = _2
+		var _4 Node
+		_4 = ps._expectK(TokenTypeOpEqual)
+		if _4 == nil {
+			break
+		}
+		var _5 Node
+		_5 = ps._expectK(TokenTypeKwRange)
+		if _5 == nil {
+			break
+		}
+		x = ps.expression()
+		if x == nil {
 			break
 		}
+		ps.leaveCtrl()
 		b = ps.block()
 		if b == nil {
 			break
 		}
-		return NewForAssignRangeStmtNode(ps._filePath, ps._fileContent, k, v, x, b, ps._tokens[pos].Start, ps._visibleTokenBefore(ps._mark()).End)
+		return NewForAssignRangeStmtNode(ps._filePath, ps._fileContent, k, v, x, b, ps._tokens[pos.pos].Start, ps._visibleTokenBefore(ps._pos).End)
 	}
 	ps._reset(pos)
 	return nil
@@ -15656,6 +22663,10 @@ select_stmt:
 | 'select' '{' s=select_case_clause* '}' {select_stmt(s)}
 */
 func (ps *Parser) selectStmt() Node {
+	if !ps._enterDepth() {
+		return nil
+	}
+	defer ps._leaveDepth()
 	/* 'select' '{' s=select_case_clause* '}' {select_stmt(s)}
 	 */
 	pos := ps._mark()
@@ -15687,7 +22698,7 @@ func (ps *Parser) selectStmt() Node {
 		if _5 == nil {
 			break
 		}
-		return NewSelectStmtNode(ps._filePath, ps._fileContent, s, ps._tokens[pos].Start, ps._visibleTokenBefore(ps._mark()).End)
+		return NewSelectStmtNode(ps._filePath, ps._fileContent, s, ps._tokens[pos.pos].Start, ps._visibleTokenBefore(ps._pos).End)
 	}
 	ps._reset(pos)
 	return nil
@@ -15699,6 +22710,10 @@ select_case_clause:
 | 'default' ':' x=statement_semi_list? {default_clause(x)}
 */
 func (ps *Parser) selectCaseClause() Node {
+	if !ps._enterDepth() {
+		return nil
+	}
+	defer ps._leaveDepth()
 	/* 'case' x=select_case_condition ':' y=statement_semi_list? {select_case_clause(x,y)}
 	 */
 	pos := ps._mark()
@@ -15721,7 +22736,7 @@ func (ps *Parser) selectCaseClause() Node {
 		}
 		y = ps.statementSemiList()
 		_ = y
-		return NewSelectCaseClauseNode(ps._filePath, ps._fileContent, x, y, ps._tokens[pos].Start, ps._visibleTokenBefore(ps._mark()).End)
+		return NewSelectCaseClauseNode(ps._filePath, ps._fileContent, x, y, ps._tokens[pos.pos].Start, ps._visibleTokenBefore(ps._pos).End)
 	}
 	ps._reset(pos)
 	/* 'default' ':' x=statement_semi_list? {default_clause(x)}
@@ -15740,7 +22755,7 @@ func (ps *Parser) selectCaseClause() Node {
 		}
 		x = ps.statementSemiList()
 		_ = x
-		return NewDefaultClauseNode(ps._filePath, ps._fileContent, x, ps._tokens[pos].Start, ps._visibleTokenBefore(ps._mark()).End)
+		return NewDefaultClauseNode(ps._filePath, ps._fileContent, x, ps._tokens[pos.pos].Start, ps._visibleTokenBefore(ps._pos).End)
 	}
 	ps._reset(pos)
 	return nil
@@ -15749,11 +22764,15 @@ func (ps *Parser) selectCaseClause() Node {
 /*
 select_case_condition:
 | send_stmt
-| assignment
-| short_var_decl
+| assign_stmt
+| var_decl_stmt
 | expression_stmt
 */
 func (ps *Parser) selectCaseCondition() Node {
+	if !ps._enterDepth() {
+		return nil
+	}
+	defer ps._leaveDepth()
 	/* send_stmt
 	 */
 	for {
@@ -15764,21 +22783,21 @@ func (ps *Parser) selectCaseCondition() Node {
 		}
 		return _1
 	}
-	/* assignment
+	/* assign_stmt
 	 */
 	for {
 		var _1 Node
-		_1 = ps.assignment()
+		_1 = ps.assignStmt()
 		if _1 == nil {
 			break
 		}
 		return _1
 	}
-	/* short_var_decl
+	/* var_decl_stmt
 	 */
 	for {
 		var _1 Node
-		_1 = ps.shortVarDecl()
+		_1 = ps.varDeclStmt()
 		if _1 == nil {
 			break
 		}
@@ -15799,10 +22818,14 @@ func (ps *Parser) selectCaseCondition() Node {
 
 /*
 type_switch_stmt:
-| 'switch' [ (init=simple_stmt ';')? assign=type_switch_guard ] '{' s=type_case_clause* '}' {type_switch_stmt(init,assign,s)}
+| 'switch' @enter_ctrl (init=simple_stmt ';')? assign=type_switch_guard @leave_ctrl '{' s=type_case_clause* '}' {type_switch_stmt(init,assign,s)}
 */
 func (ps *Parser) typeSwitchStmt() Node {
-	/* 'switch' [ (init=simple_stmt ';')? assign=type_switch_guard ] '{' s=type_case_clause* '}' {type_switch_stmt(init,assign,s)}
+	if !ps._enterDepth() {
+		return nil
+	}
+	defer ps._leaveDepth()
+	/* 'switch' @enter_ctrl (init=simple_stmt ';')? assign=type_switch_guard @leave_ctrl '{' s=type_case_clause* '}' {type_switch_stmt(init,assign,s)}
 	 */
 	pos := ps._mark()
 	for {
@@ -15814,43 +22837,35 @@ func (ps *Parser) typeSwitchStmt() Node {
 		if _1 == nil {
 			break
 		}
-		_break := true
-		ps._enter()
+		ps.enterCtrl()
+		var _2 Node
 		for {
-			var _2 Node
+			_ok := false
+			_p := ps._mark()
 			for {
-				_ok := false
-				_p := ps._mark()
-				for {
-					init = ps.simpleStmt()
-					if init == nil {
-						break
-					}
-					_2 = ps._expectK(TokenTypeOpSemi)
-					if _2 == nil {
-						break
-					}
-					_ok = true
+				init = ps.simpleStmt()
+				if init == nil {
 					break
 				}
-				if !_ok {
-					ps._reset(_p)
-					init = nil
+				_2 = ps._expectK(TokenTypeOpSemi)
+				if _2 == nil {
+					break
 				}
+				_ok = true
 				break
 			}
-			_ = _2
-			assign = ps.typeSwitchGuard()
-			if assign == nil {
-				break
+			if !_ok {
+				ps._reset(_p)
+				init = nil
 			}
-			_break = false
 			break
 		}
-		ps._leave()
-		if _break {
+		_ = _2
+		assign = ps.typeSwitchGuard()
+		if assign == nil {
 			break
 		}
+		ps.leaveCtrl()
 		var _3 Node
 		_3 = ps._expectK(TokenTypeOpLeftBrace)
 		if _3 == nil {
@@ -15872,7 +22887,7 @@ func (ps *Parser) typeSwitchStmt() Node {
 		if _6 == nil {
 			break
 		}
-		return NewTypeSwitchStmtNode(ps._filePath, ps._fileContent, init, assign, s, ps._tokens[pos].Start, ps._visibleTokenBefore(ps._mark()).End)
+		return NewTypeSwitchStmtNode(ps._filePath, ps._fileContent, init, assign, s, ps._tokens[pos.pos].Start, ps._visibleTokenBefore(ps._pos).End)
 	}
 	ps._reset(pos)
 	return nil
@@ -15883,6 +22898,10 @@ type_switch_guard:
 | (i=type_switch_guard_ident ':=')? r=primary_expr '.' '(' 'type' ')' {type_switch_guard(i, r)}
 */
 func (ps *Parser) typeSwitchGuard() Node {
+	if !ps._enterDepth() {
+		return nil
+	}
+	defer ps._leaveDepth()
 	/* (i=type_switch_guard_ident ':=')? r=primary_expr '.' '(' 'type' ')' {type_switch_guard(i, r)}
 	 */
 	pos := ps._mark()
@@ -15936,7 +22955,7 @@ func (ps *Parser) typeSwitchGuard() Node {
 		if _5 == nil {
 			break
 		}
-		return NewTypeSwitchGuardNode(ps._filePath, ps._fileContent, i, r, ps._tokens[pos].Start, ps._visibleTokenBefore(ps._mark()).End)
+		return NewTypeSwitchGuardNode(ps._filePath, ps._fileContent, i, r, ps._tokens[pos.pos].Start, ps._visibleTokenBefore(ps._pos).End)
 	}
 	ps._reset(pos)
 	return nil
@@ -15947,6 +22966,10 @@ type_switch_guard_ident:
 | i=IDENT {type_switch_guard_ident(i)}
 */
 func (ps *Parser) typeSwitchGuardIdent() Node {
+	if !ps._enterDepth() {
+		return nil
+	}
+	defer ps._leaveDepth()
 	/* i=IDENT {type_switch_guard_ident(i)}
 	 */
 	pos := ps._mark()
@@ -15956,7 +22979,7 @@ func (ps *Parser) typeSwitchGuardIdent() Node {
 		if i == nil {
 			break
 		}
-		return NewTypeSwitchGuardIdentNode(ps._filePath, ps._fileContent, i, ps._tokens[pos].Start, ps._visibleTokenBefore(ps._mark()).End)
+		return NewTypeSwitchGuardIdentNode(ps._filePath, ps._fileContent, i, ps._tokens[pos.pos].Start, ps._visibleTokenBefore(ps._pos).End)
 	}
 	ps._reset(pos)
 	return nil
@@ -15968,6 +22991,10 @@ type_case_clause:
 | 'default' ':' x=statement_semi_list? {default_clause(x)}
 */
 func (ps *Parser) typeCaseClause() Node {
+	if !ps._enterDepth() {
+		return nil
+	}
+	defer ps._leaveDepth()
 	/* 'case' x=','.type+ ':' y=statement_semi_list? {type_case_clause(x,y)}
 	 */
 	pos := ps._mark()
@@ -16008,7 +23035,7 @@ func (ps *Parser) typeCaseClause() Node {
 		}
 		y = ps.statementSemiList()
 		_ = y
-		return NewTypeCaseClauseNode(ps._filePath, ps._fileContent, x, y, ps._tokens[pos].Start, ps._visibleTokenBefore(ps._mark()).End)
+		return NewTypeCaseClauseNode(ps._filePath, ps._fileContent, x, y, ps._tokens[pos.pos].Start, ps._visibleTokenBefore(ps._pos).End)
 	}
 	ps._reset(pos)
 	/* 'default' ':' x=statement_semi_list? {default_clause(x)}
@@ -16027,7 +23054,7 @@ func (ps *Parser) typeCaseClause() Node {
 		}
 		x = ps.statementSemiList()
 		_ = x
-		return NewDefaultClauseNode(ps._filePath, ps._fileContent, x, ps._tokens[pos].Start, ps._visibleTokenBefore(ps._mark()).End)
+		return NewDefaultClauseNode(ps._filePath, ps._fileContent, x, ps._tokens[pos.pos].Start, ps._visibleTokenBefore(ps._pos).End)
 	}
 	ps._reset(pos)
 	return nil
@@ -16035,10 +23062,14 @@ func (ps *Parser) typeCaseClause() Node {
 
 /*
 expr_switch_stmt:
-| 'switch' [ (init=simple_stmt ';')? tag=expression? ] '{' s=expr_case_clause* '}' {switch_stmt(init,tag,s)}
+| 'switch' @enter_ctrl (init=simple_stmt ';')? tag=expression? @leave_ctrl '{' s=expr_case_clause* '}' {switch_stmt(init,tag,s)}
 */
 func (ps *Parser) exprSwitchStmt() Node {
-	/* 'switch' [ (init=simple_stmt ';')? tag=expression? ] '{' s=expr_case_clause* '}' {switch_stmt(init,tag,s)}
+	if !ps._enterDepth() {
+		return nil
+	}
+	defer ps._leaveDepth()
+	/* 'switch' @enter_ctrl (init=simple_stmt ';')? tag=expression? @leave_ctrl '{' s=expr_case_clause* '}' {switch_stmt(init,tag,s)}
 	 */
 	pos := ps._mark()
 	for {
@@ -16050,41 +23081,33 @@ func (ps *Parser) exprSwitchStmt() Node {
 		if _1 == nil {
 			break
 		}
-		_break := true
-		ps._enter()
+		ps.enterCtrl()
+		var _2 Node
 		for {
-			var _2 Node
+			_ok := false
+			_p := ps._mark()
 			for {
-				_ok := false
-				_p := ps._mark()
-				for {
-					init = ps.simpleStmt()
-					if init == nil {
-						break
-					}
-					_2 = ps._expectK(TokenTypeOpSemi)
-					if _2 == nil {
-						break
-					}
-					_ok = true
+				init = ps.simpleStmt()
+				if init == nil {
 					break
 				}
-				if !_ok {
-					ps._reset(_p)
-					init = nil
+				_2 = ps._expectK(TokenTypeOpSemi)
+				if _2 == nil {
+					break
 				}
+				_ok = true
 				break
 			}
-			_ = _2
-			tag = ps.expression()
-			_ = tag
-			_break = false
-			break
-		}
-		ps._leave()
-		if _break {
+			if !_ok {
+				ps._reset(_p)
+				init = nil
+			}
 			break
 		}
+		_ = _2
+		tag = ps.expression()
+		_ = tag
+		ps.leaveCtrl()
 		var _3 Node
 		_3 = ps._expectK(TokenTypeOpLeftBrace)
 		if _3 == nil {
@@ -16106,7 +23129,7 @@ func (ps *Parser) exprSwitchStmt() Node {
 		if _6 == nil {
 			break
 		}
-		return NewSwitchStmtNode(ps._filePath, ps._fileContent, init, tag, s, ps._tokens[pos].Start, ps._visibleTokenBefore(ps._mark()).End)
+		return NewSwitchStmtNode(ps._filePath, ps._fileContent, init, tag, s, ps._tokens[pos.pos].Start, ps._visibleTokenBefore(ps._pos).End)
 	}
 	ps._reset(pos)
 	return nil
@@ -16118,6 +23141,10 @@ expr_case_clause:
 | 'default' ':' x=statement_semi_list? {default_clause(x)}
 */
 func (ps *Parser) exprCaseClause() Node {
+	if !ps._enterDepth() {
+		return nil
+	}
+	defer ps._leaveDepth()
 	/* 'case' x=expression_list ':' y=statement_semi_list? {expr_case_clause(x,y)}
 	 */
 	pos := ps._mark()
@@ -16140,7 +23167,7 @@ func (ps *Parser) exprCaseClause() Node {
 		}
 		y = ps.statementSemiList()
 		_ = y
-		return NewExprCaseClauseNode(ps._filePath, ps._fileContent, x, y, ps._tokens[pos].Start, ps._visibleTokenBefore(ps._mark()).End)
+		return NewExprCaseClauseNode(ps._filePath, ps._fileContent, x, y, ps._tokens[pos.pos].Start, ps._visibleTokenBefore(ps._pos).End)
 	}
 	ps._reset(pos)
 	/* 'default' ':' x=statement_semi_list? {default_clause(x)}
@@ -16159,7 +23186,7 @@ func (ps *Parser) exprCaseClause() Node {
 		}
 		x = ps.statementSemiList()
 		_ = x
-		return NewDefaultClauseNode(ps._filePath, ps._fileContent, x, ps._tokens[pos].Start, ps._visibleTokenBefore(ps._mark()).End)
+		return NewDefaultClauseNode(ps._filePath, ps._fileContent, x, ps._tokens[pos.pos].Start, ps._visibleTokenBefore(ps._pos).End)
 	}
 	ps._reset(pos)
 	return nil
@@ -16170,6 +23197,10 @@ expression_stmt:
 | x=expression {expr_stmt(x)}
 */
 func (ps *Parser) expressionStmt() Node {
+	if !ps._enterDepth() {
+		return nil
+	}
+	defer ps._leaveDepth()
 	/* x=expression {expr_stmt(x)}
 	 */
 	pos := ps._mark()
@@ -16179,7 +23210,7 @@ func (ps *Parser) expressionStmt() Node {
 		if x == nil {
 			break
 		}
-		return NewExprStmtNode(ps._filePath, ps._fileContent, x, ps._tokens[pos].Start, ps._visibleTokenBefore(ps._mark()).End)
+		return NewExprStmtNode(ps._filePath, ps._fileContent, x, ps._tokens[pos.pos].Start, ps._visibleTokenBefore(ps._pos).End)
 	}
 	ps._reset(pos)
 	return nil
@@ -16192,6 +23223,10 @@ type:
 | '(' x=type ')' {paren_expr(x)}
 */
 func (ps *Parser) type_() Node {
+	if !ps._enterDepth() {
+		return nil
+	}
+	defer ps._leaveDepth()
 	/* type_name_or_generic_type_instantiation
 	 */
 	for {
@@ -16231,7 +23266,7 @@ func (ps *Parser) type_() Node {
 		if _2 == nil {
 			break
 		}
-		return NewParenExprNode(ps._filePath, ps._fileContent, x, ps._tokens[pos].Start, ps._visibleTokenBefore(ps._mark()).End)
+		return NewParenExprNode(ps._filePath, ps._fileContent, x, ps._tokens[pos.pos].Start, ps._visibleTokenBefore(ps._pos).End)
 	}
 	ps._reset(pos)
 	return nil
@@ -16243,6 +23278,10 @@ type_name_or_generic_type_instantiation:
 | type_name
 */
 func (ps *Parser) typeNameOrGenericTypeInstantiation() Node {
+	if !ps._enterDepth() {
+		return nil
+	}
+	defer ps._leaveDepth()
 	/* x=type_name y=generic_args {generic_type_instantiation(x, y)}
 	 */
 	pos := ps._mark()
@@ -16257,7 +23296,7 @@ func (ps *Parser) typeNameOrGenericTypeInstantiation() Node {
 		if y == nil {
 			break
 		}
-		return NewGenericTypeInstantiationNode(ps._filePath, ps._fileContent, x, y, ps._tokens[pos].Start, ps._visibleTokenBefore(ps._mark()).End)
+		return NewGenericTypeInstantiationNode(ps._filePath, ps._fileContent, x, y, ps._tokens[pos.pos].Start, ps._visibleTokenBefore(ps._pos).End)
 	}
 	ps._reset(pos)
 	/* type_name
@@ -16279,6 +23318,10 @@ type_name:
 | IDENT
 */
 func (ps *Parser) typeName() Node {
+	if !ps._enterDepth() {
+		return nil
+	}
+	defer ps._leaveDepth()
 	/* qualified_ident
 	 */
 	for {
@@ -16307,6 +23350,10 @@ generic_args:
 | '[' s=','.type+ ']' {s}
 */
 func (ps *Parser) genericArgs() Node {
+	if !ps._enterDepth() {
+		return nil
+	}
+	defer ps._leaveDepth()
 	/* '[' s=','.type+ ']' {s}
 	 */
 	pos := ps._mark()
@@ -16361,6 +23408,10 @@ type_lit:
 | channel_type
 */
 func (ps *Parser) typeLit() Node {
+	if !ps._enterDepth() {
+		return nil
+	}
+	defer ps._leaveDepth()
 	/* '*' x=type {star_expr(x)}
 	 */
 	pos := ps._mark()
@@ -16375,7 +23426,7 @@ func (ps *Parser) typeLit() Node {
 		if x == nil {
 			break
 		}
-		return NewStarExprNode(ps._filePath, ps._fileContent, x, ps._tokens[pos].Start, ps._visibleTokenBefore(ps._mark()).End)
+		return NewStarExprNode(ps._filePath, ps._fileContent, x, ps._tokens[pos.pos].Start, ps._visibleTokenBefore(ps._pos).End)
 	}
 	ps._reset(pos)
 	/* array_type
@@ -16427,7 +23478,7 @@ func (ps *Parser) typeLit() Node {
 		if b == nil {
 			break
 		}
-		return NewInterfaceTypeNode(ps._filePath, ps._fileContent, b, ps._tokens[pos].Start, ps._visibleTokenBefore(ps._mark()).End)
+		return NewInterfaceTypeNode(ps._filePath, ps._fileContent, b, ps._tokens[pos.pos].Start, ps._visibleTokenBefore(ps._pos).End)
 	}
 	ps._reset(pos)
 	/* map_type
@@ -16458,6 +23509,10 @@ interface_body:
 | '{' x=method_spec_and_interface_type_name_semi* '}' {field_list(x)}
 */
 func (ps *Parser) interfaceBody() Node {
+	if !ps._enterDepth() {
+		return nil
+	}
+	defer ps._leaveDepth()
 	/* '{' x=method_spec_and_interface_type_name_semi* '}' {field_list(x)}
 	 */
 	pos := ps._mark()
@@ -16484,7 +23539,7 @@ func (ps *Parser) interfaceBody() Node {
 		if _4 == nil {
 			break
 		}
-		return NewFieldListNode(ps._filePath, ps._fileContent, x, ps._tokens[pos].Start, ps._visibleTokenBefore(ps._mark()).End)
+		return NewFieldListNode(ps._filePath, ps._fileContent, x, ps._tokens[pos.pos].Start, ps._visibleTokenBefore(ps._pos).End)
 	}
 	ps._reset(pos)
 	return nil
@@ -16492,17 +23547,21 @@ func (ps *Parser) interfaceBody() Node {
 
 /*
 method_spec_and_interface_type_name_semi:
-| method_spec_semi
+| semi<method_spec>
 | interface_type_name_semi
 | '|'.('~'? t=type {t})+ pseudo_semi {field(_,_,_)}
 _group_3 <-- ('~'? t=type {t})
 */
 func (ps *Parser) methodSpecAndInterfaceTypeNameSemi() Node {
-	/* method_spec_semi
+	if !ps._enterDepth() {
+		return nil
+	}
+	defer ps._leaveDepth()
+	/* semi<method_spec>
 	 */
 	for {
 		var _1 Node
-		_1 = ps.methodSpecSemi()
+		_1 = ps.semiOfMethodSpec()
 		if _1 == nil {
 			break
 		}
@@ -16550,32 +23609,7 @@ func (ps *Parser) methodSpecAndInterfaceTypeNameSemi() Node {
 		if _5 == nil {
 			break
 		}
-		return NewFieldNode(ps._filePath, ps._fileContent, nil, nil, nil, ps._tokens[pos].Start, ps._visibleTokenBefore(ps._mark()).End)
-	}
-	ps._reset(pos)
-	return nil
-}
-
-/*
-method_spec_semi:
-| x=method_spec pseudo_semi {x}
-*/
-func (ps *Parser) methodSpecSemi() Node {
-	/* x=method_spec pseudo_semi {x}
-	 */
-	pos := ps._mark()
-	for {
-		var x Node
-		x = ps.methodSpec()
-		if x == nil {
-			break
-		}
-		var _1 Node
-		_1 = ps.pseudoSemi()
-		if _1 == nil {
-			break
-		}
-		return x
+		return NewFieldNode(ps._filePath, ps._fileContent, nil, nil, nil, ps._tokens[pos.pos].Start, ps._visibleTokenBefore(ps._pos).End)
 	}
 	ps._reset(pos)
 	return nil
@@ -16583,16 +23617,20 @@ func (ps *Parser) methodSpecSemi() Node {
 
 /*
 interface_type_name_semi:
-| x=type_name pseudo_semi {field(_,x,_)}
+| type=type_name pseudo_semi {field(_,type,_)}
 */
 func (ps *Parser) interfaceTypeNameSemi() Node {
-	/* x=type_name pseudo_semi {field(_,x,_)}
+	if !ps._enterDepth() {
+		return nil
+	}
+	defer ps._leaveDepth()
+	/* type=type_name pseudo_semi {field(_,type,_)}
 	 */
 	pos := ps._mark()
 	for {
-		var x Node
-		x = ps.typeName()
-		if x == nil {
+		var type_ Node
+		type_ = ps.typeName()
+		if type_ == nil {
 			break
 		}
 		var _1 Node
@@ -16600,7 +23638,7 @@ func (ps *Parser) interfaceTypeNameSemi() Node {
 		if _1 == nil {
 			break
 		}
-		return NewFieldNode(ps._filePath, ps._fileContent, nil, x, nil, ps._tokens[pos].Start, ps._visibleTokenBefore(ps._mark()).End)
+		return NewFieldNode(ps._filePath, ps._fileContent, nil, type_, nil, ps._tokens[pos.pos].Start, ps._visibleTokenBefore(ps._pos).End)
 	}
 	ps._reset(pos)
 	return nil
@@ -16608,11 +23646,15 @@ func (ps *Parser) interfaceTypeNameSemi() Node {
 
 /*
 channel_type:
-| t=(a='chan' b='<-' {_pseudo_token(a, b)} | a='<-' b='chan' {_pseudo_token(a, b)} | 'chan') x=type {chan_type(t, x)}
-_group_4 <-- (a='chan' b='<-' {_pseudo_token(a, b)} | a='<-' b='chan' {_pseudo_token(a, b)} | 'chan')
+| t=(a='chan' b='<-' {a..b} | a='<-' b='chan' {a..b} | 'chan') x=type {chan_type(t, x)}
+_group_4 <-- (a='chan' b='<-' {a..b} | a='<-' b='chan' {a..b} | 'chan')
 */
 func (ps *Parser) channelType() Node {
-	/* t=(a='chan' b='<-' {_pseudo_token(a, b)} | a='<-' b='chan' {_pseudo_token(a, b)} | 'chan') x=type {chan_type(t, x)}
+	if !ps._enterDepth() {
+		return nil
+	}
+	defer ps._leaveDepth()
+	/* t=(a='chan' b='<-' {a..b} | a='<-' b='chan' {a..b} | 'chan') x=type {chan_type(t, x)}
 	 */
 	pos := ps._mark()
 	for {
@@ -16626,7 +23668,7 @@ func (ps *Parser) channelType() Node {
 		if x == nil {
 			break
 		}
-		return NewChanTypeNode(ps._filePath, ps._fileContent, t, x, ps._tokens[pos].Start, ps._visibleTokenBefore(ps._mark()).End)
+		return NewChanTypeNode(ps._filePath, ps._fileContent, t, x, ps._tokens[pos.pos].Start, ps._visibleTokenBefore(ps._pos).End)
 	}
 	ps._reset(pos)
 	return nil
@@ -16637,6 +23679,10 @@ map_type:
 | 'map' '[' x=type ']' y=type {map_type(x,y)}
 */
 func (ps *Parser) mapType() Node {
+	if !ps._enterDepth() {
+		return nil
+	}
+	defer ps._leaveDepth()
 	/* 'map' '[' x=type ']' y=type {map_type(x,y)}
 	 */
 	pos := ps._mark()
@@ -16666,7 +23712,7 @@ func (ps *Parser) mapType() Node {
 		if y == nil {
 			break
 		}
-		return NewMapTypeNode(ps._filePath, ps._fileContent, x, y, ps._tokens[pos].Start, ps._visibleTokenBefore(ps._mark()).End)
+		return NewMapTypeNode(ps._filePath, ps._fileContent, x, y, ps._tokens[pos.pos].Start, ps._visibleTokenBefore(ps._pos).End)
 	}
 	ps._reset(pos)
 	return nil
@@ -16677,6 +23723,10 @@ qualified_ident:
 | x=IDENT '.' y=IDENT { selector_expr(x, y) }
 */
 func (ps *Parser) qualifiedIdent() Node {
+	if !ps._enterDepth() {
+		return nil
+	}
+	defer ps._leaveDepth()
 	/* x=IDENT '.' y=IDENT { selector_expr(x, y) }
 	 */
 	pos := ps._mark()
@@ -16696,35 +23746,21 @@ func (ps *Parser) qualifiedIdent() Node {
 		if y == nil {
 			break
 		}
-		return NewSelectorExprNode(ps._filePath, ps._fileContent, x, y, ps._tokens[pos].Start, ps._visibleTokenBefore(ps._mark()).End)
+		return NewSelectorExprNode(ps._filePath, ps._fileContent, x, y, ps._tokens[pos.pos].Start, ps._visibleTokenBefore(ps._pos).End)
 	}
 	ps._reset(pos)
 	return nil
 }
 
-/*
-receiver:
-| parameters
-*/
-func (ps *Parser) receiver() Node {
-	/* parameters
-	 */
-	for {
-		var _1 Node
-		_1 = ps.parameters()
-		if _1 == nil {
-			break
-		}
-		return _1
-	}
-	return nil
-}
-
 /*
 identifier_list:
 | x=','.IDENT+ {x}
 */
 func (ps *Parser) identifierList() Node {
+	if !ps._enterDepth() {
+		return nil
+	}
+	defer ps._leaveDepth()
 	/* x=','.IDENT+ {x}
 	 */
 	pos := ps._mark()
@@ -16763,6 +23799,10 @@ expression_list:
 | ','.expression+
 */
 func (ps *Parser) expressionList() Node {
+	if !ps._enterDepth() {
+		return nil
+	}
+	defer ps._leaveDepth()
 	/* ','.expression+
 	 */
 	for {
@@ -16787,45 +23827,260 @@ func (ps *Parser) expressionList() Node {
 			}
 			_2 = append(_2, _3)
 		}
-		_1 = NewNodesNode(_2)
-		_ = _1
-		return _1
+		_1 = NewNodesNode(_2)
+		_ = _1
+		return _1
+	}
+	return nil
+}
+
+// expression parses binary expressions by operator precedence.
+func (ps *Parser) expression() Node {
+	pos := ps._mark()
+	var ok bool
+	var cache *NodeCache
+	cacheAtPos := ps._nodeCache[pos.pos]
+	if cacheAtPos != nil {
+		if cache, ok = cacheAtPos[expressionMemoId]; ok && cache.state == ps.state {
+			if cache.val == nil {
+				return nil
+			}
+			ps._reset(cache.end)
+			return cache.val
+		}
+	} else {
+		cacheAtPos = make(map[int]*NodeCache)
+		ps._nodeCache[pos.pos] = cacheAtPos
+	}
+	state := ps.state
+	t := ps.expression_()
+	cacheAtPos[expressionMemoId] = &NodeCache{t, ps._mark(), state}
+	return t
+}
+
+/*
+expression!:
+| binary_expression
+*/
+func (ps *Parser) expression_() Node {
+	if !ps._enterDepth() {
+		return nil
+	}
+	defer ps._leaveDepth()
+	/* binary_expression
+	 */
+	for {
+		var _1 Node
+		_1 = ps.binaryExpression()
+		if _1 == nil {
+			break
+		}
+		return _1
+	}
+	return nil
+}
+
+/*
+binary_expression:
+%left '||' {logical_or_expr(lhs, rhs)}
+%left '&&' {logical_and_expr(lhs, rhs)}
+%left '==' | '!=' | '<' | '<=' | '>' | '>=' {compare_expr(lhs, op, rhs)}
+%left '+' | '-' | '|' | '^' {add_op_expr(lhs, op, rhs)}
+%left '*' | '/' | '%' | '<<' | '>>' | '&' | '&^' {mul_op_expr(lhs, op, rhs)}
+| unary_expr
+*/
+func (ps *Parser) binaryExpression() Node {
+	if !ps._enterDepth() {
+		return nil
+	}
+	defer ps._leaveDepth()
+	return ps.binaryExpressionPrecedence(0)
+}
+
+func (ps *Parser) binaryExpressionOperand() Node {
+	/* unary_expr
+	 */
+	for {
+		var _1 Node
+		_1 = ps.unaryExpr()
+		if _1 == nil {
+			break
+		}
+		return _1
+	}
+	return nil
+}
+
+func (ps *Parser) binaryExpressionOperator(minLevel int) (Node, int) {
+	var op Node
+	if minLevel <= 0 {
+		op = ps._expectK(TokenTypeOpBarBar)
+		if op != nil {
+			return op, 0
+		}
+	}
+	if minLevel <= 1 {
+		op = ps._expectK(TokenTypeOpAndAnd)
+		if op != nil {
+			return op, 1
+		}
+	}
+	if minLevel <= 2 {
+		op = ps._expectK(TokenTypeOpEqualEqual)
+		if op != nil {
+			return op, 2
+		}
+		op = ps._expectK(TokenTypeOpNotEqual)
+		if op != nil {
+			return op, 2
+		}
+		op = ps._expectK(TokenTypeOpLess)
+		if op != nil {
+			return op, 2
+		}
+		op = ps._expectK(TokenTypeOpLessEqual)
+		if op != nil {
+			return op, 2
+		}
+		op = ps._expectK(TokenTypeOpGreater)
+		if op != nil {
+			return op, 2
+		}
+		op = ps._expectK(TokenTypeOpGreaterEqual)
+		if op != nil {
+			return op, 2
+		}
+	}
+	if minLevel <= 3 {
+		op = ps._expectK(TokenTypeOpPlus)
+		if op != nil {
+			return op, 3
+		}
+		op = ps._expectK(TokenTypeOpMinus)
+		if op != nil {
+			return op, 3
+		}
+		op = ps._expectK(TokenTypeOpBar)
+		if op != nil {
+			return op, 3
+		}
+		op = ps._expectK(TokenTypeOpCaret)
+		if op != nil {
+			return op, 3
+		}
+	}
+	if minLevel <= 4 {
+		op = ps._expectK(TokenTypeOpStar)
+		if op != nil {
+			return op, 4
+		}
+		op = ps._expectK(TokenTypeOpSlash)
+		if op != nil {
+			return op, 4
+		}
+		op = ps._expectK(TokenTypeOpPercent)
+		if op != nil {
+			return op, 4
+		}
+		op = ps._expectK(TokenTypeOpLessLess)
+		if op != nil {
+			return op, 4
+		}
+		op = ps._expectK(TokenTypeOpGreaterGreater)
+		if op != nil {
+			return op, 4
+		}
+		op = ps._expectK(TokenTypeOpAnd)
+		if op != nil {
+			return op, 4
+		}
+		op = ps._expectK(TokenTypeOpAndCaret)
+		if op != nil {
+			return op, 4
+		}
 	}
-	return nil
+	return nil, -1
 }
 
-func (ps *Parser) expression() Node {
-	pos := ps._mark()
-	var ok bool
-	var cache *NodeCache
-	cacheAtPos := ps._nodeCache[pos]
-	if cacheAtPos != nil {
-		if cache, ok = cacheAtPos[expressionMemoId]; ok {
-			if cache.val == nil {
-				return nil
+func (ps *Parser) binaryExpressionPrecedence(minLevel int) Node {
+	if !ps._enterDepth() {
+		return nil
+	}
+	defer ps._leaveDepth()
+	lhs := ps.binaryExpressionOperand()
+	if lhs == nil {
+		return nil
+	}
+	for {
+		pos := ps._mark()
+		op, level := ps.binaryExpressionOperator(minLevel)
+		_ = op
+		var rhs Node
+		switch level {
+		case 0:
+			if rhs = ps.binaryExpressionPrecedence(1); rhs != nil {
+				lhs = NewLogicalOrExprNode(ps._filePath, ps._fileContent, lhs, rhs, lhs.RangeStart(), ps._visibleTokenBefore(ps._pos).End)
+				continue
+			}
+		case 1:
+			if rhs = ps.binaryExpressionPrecedence(2); rhs != nil {
+				lhs = NewLogicalAndExprNode(ps._filePath, ps._fileContent, lhs, rhs, lhs.RangeStart(), ps._visibleTokenBefore(ps._pos).End)
+				continue
+			}
+		case 2:
+			if rhs = ps.binaryExpressionPrecedence(3); rhs != nil {
+				lhs = NewCompareExprNode(ps._filePath, ps._fileContent, lhs, op, rhs, lhs.RangeStart(), ps._visibleTokenBefore(ps._pos).End)
+				continue
+			}
+		case 3:
+			if rhs = ps.binaryExpressionPrecedence(4); rhs != nil {
+				lhs = NewAddOpExprNode(ps._filePath, ps._fileContent, lhs, op, rhs, lhs.RangeStart(), ps._visibleTokenBefore(ps._pos).End)
+				continue
+			}
+		case 4:
+			if rhs = ps.binaryExpressionPrecedence(5); rhs != nil {
+				lhs = NewMulOpExprNode(ps._filePath, ps._fileContent, lhs, op, rhs, lhs.RangeStart(), ps._visibleTokenBefore(ps._pos).End)
+				continue
 			}
-			ps._reset(cache.pos)
-			return cache.val
 		}
-	} else {
-		cacheAtPos = make(map[int]*NodeCache)
-		ps._nodeCache[pos] = cacheAtPos
+		ps._reset(pos)
+		return lhs
 	}
-	t := ps.expression_()
-	cacheAtPos[expressionMemoId] = &NodeCache{t, ps._mark()}
-	return t
 }
 
 /*
-expression!:
-| conditional_or_expression
+unary_expr:
+| op=('*' | '+' | '-' | '!' | '^' | '*' | '&' | '<-') expr=unary_expr {unary_expr(op, expr)}
+| primary_expr
+_group_5 <-- ('*' | '+' | '-' | '!' | '^' | '*' | '&' | '<-')
 */
-func (ps *Parser) expression_() Node {
-	/* conditional_or_expression
+func (ps *Parser) unaryExpr() Node {
+	if !ps._enterDepth() {
+		return nil
+	}
+	defer ps._leaveDepth()
+	/* op=('*' | '+' | '-' | '!' | '^' | '*' | '&' | '<-') expr=unary_expr {unary_expr(op, expr)}
+	 */
+	pos := ps._mark()
+	for {
+		var expr Node
+		var op Node
+		op = ps._group5()
+		if op == nil {
+			break
+		}
+		expr = ps.unaryExpr()
+		if expr == nil {
+			break
+		}
+		return NewUnaryExprNode(ps._filePath, ps._fileContent, op, expr, ps._tokens[pos.pos].Start, ps._visibleTokenBefore(ps._pos).End)
+	}
+	ps._reset(pos)
+	/* primary_expr
 	 */
 	for {
 		var _1 Node
-		_1 = ps.conditionalOrExpression()
+		_1 = ps.primaryExpr()
 		if _1 == nil {
 			break
 		}
@@ -16835,375 +24090,487 @@ func (ps *Parser) expression_() Node {
 }
 
 /*
-conditional_or_expression:
-| x=conditional_or_expression z='||' y=conditional_and_expression {binary_expr(x,y,z)}
-| conditional_and_expression
+primary_expr:
+| 'make' '(' '[' ']' type=type ',' len=expression (',' cap=expression)? ','? ')' {make_slice_expr(type, len, cap)}
+| 'make' '(' 'map' '[' k=type ']' v=type (',' hint=expression)? ','? ')' {make_map_expr(k, v, hint)}
+| 'make' '(' 'chan' type=type (',' buffer=expression)? ','? ')' {make_chan_expr(type, buffer)}
+| 'new' '(' type=type ','? ')' {new_expr(type)}
+| callee=primary_expr type_argument=type_argument_decl? argument=argument_decl {call_expr(callee, type_argument, argument)}
+| callee=type type_argument=type_argument_decl? argument=argument_decl {call_expr(callee, type_argument, argument)}
+| expr=primary_expr '.' '(' type=type ')' {type_assert_expr(expr, type)}
+| target=primary_expr '[' low=expression? ':' high=expression? ':' max=expression ']' {full_slice_expr(target, low, high, max)}
+| target=primary_expr '[' low=expression? ':' high=expression? ']' {slice_expr(target, low, high)}
+| target=primary_expr '[' index=expression ']' {index_expr(target, index)}
+| target=primary_expr '.' selector=IDENT {selector_expr(target, selector)}
+| '(' expr=expression ')' {paren_expr(expr)}
+| number=NUMBER {number_expr(number)}
+| string=STRING {string_expr(string)}
+| x=literal_type '{' y=','.keyed_element* ','? '}' {composite_lit(x, y)}
+| !{ps.inControlClause()} x=composite_lit {x}
+| 'func' x=signature y=block {function_lit(x,y)}
+| x=type '.' y=IDENT {selector_expr(x,y)}
+| i=IDENT {ident(i)}
 */
-func (ps *Parser) conditionalOrExpression() Node {
-	_left := ps.conditionalOrExpressionLeftMost()
+func (ps *Parser) primaryExpr() Node {
+	if !ps._enterDepth() {
+		return nil
+	}
+	defer ps._leaveDepth()
+	_left := ps.primaryExprLeftMost()
 	if _left == nil {
 		return nil
 	}
-	_ret := ps.conditionalOrExpressionRightPart(_left)
+	_ret := ps.primaryExprRightPart(_left)
 	for _ret != nil {
 		_left = _ret
-		_ret = ps.conditionalOrExpressionRightPart(_left)
+		_ret = ps.primaryExprRightPart(_left)
 	}
 	return _left
 }
 
-func (ps *Parser) conditionalOrExpressionLeftMost() Node {
-	/* conditional_and_expression
+func (ps *Parser) primaryExprLeftMost() Node {
+	/* 'make' '(' '[' ']' type=type ',' len=expression (',' cap=expression)? ','? ')' {make_slice_expr(type, len, cap)}
 	 */
+	pos := ps._mark()
 	for {
+		var cap Node
+		var len_ Node
+		var type_ Node
 		var _1 Node
-		_1 = ps.conditionalAndExpression()
+		_1 = ps._expectV("\u006Da\u006Be")
 		if _1 == nil {
 			break
 		}
-		return _1
-	}
-	return nil
-}
-
-func (ps *Parser) conditionalOrExpressionRightPart(_left Node) Node {
-	/* x=conditional_or_expression z='||' y=conditional_and_expression {binary_expr(x,y,z)}
-	 */
-	pos := ps._mark()
-	for {
-		var x Node
-		var y Node
-		var z Node
-		x = _left
-		z = ps._expectK(TokenTypeOpBarBar)
-		if z == nil {
+		var _2 Node
+		_2 = ps._expectK(TokenTypeOpLeftParen)
+		if _2 == nil {
 			break
 		}
-		y = ps.conditionalAndExpression()
-		if y == nil {
+		var _3 Node
+		_3 = ps._expectK(TokenTypeOpLeftBracket)
+		if _3 == nil {
+			break
+		}
+		var _4 Node
+		_4 = ps._expectK(TokenTypeOpRightBracket)
+		if _4 == nil {
+			break
+		}
+		type_ = ps.type_()
+		if type_ == nil {
+			break
+		}
+		var _5 Node
+		_5 = ps._expectK(TokenTypeOpComma)
+		if _5 == nil {
+			break
+		}
+		len_ = ps.expression()
+		if len_ == nil {
 			break
 		}
-		return NewBinaryExprNode(ps._filePath, ps._fileContent, x, y, z, _left.RangeStart(), ps._visibleTokenBefore(ps._mark()).End)
+		var _6 Node
+		for {
+			_ok := false
+			_p := ps._mark()
+			for {
+				var _7 Node
+				_7 = ps._expectK(TokenTypeOpComma)
+				if _7 == nil {
+					break
+				}
+				cap = ps.expression()
+				if cap == nil {
+					break
+				}
+				_6 = cap
+				_ok = true
+				break
+			}
+			if !_ok {
+				ps._reset(_p)
+			}
+			break
+		}
+		_ = _6
+		var _8 Node
+		_8 = ps._expectK(TokenTypeOpComma)
+		_ = _8
+		var _9 Node
+		_9 = ps._expectK(TokenTypeOpRightParen)
+		if _9 == nil {
+			break
+		}
+		return NewMakeSliceExprNode(ps._filePath, ps._fileContent, type_, len_, cap, ps._tokens[pos.pos].Start, ps._visibleTokenBefore(ps._pos).End)
 	}
 	ps._reset(pos)
-	return nil
-}
-
-/*
-conditional_and_expression:
-| x=conditional_and_expression z='&&' y=rel_op_expression {binary_expr(x,y,z)}
-| rel_op_expression
-*/
-func (ps *Parser) conditionalAndExpression() Node {
-	_left := ps.conditionalAndExpressionLeftMost()
-	if _left == nil {
-		return nil
-	}
-	_ret := ps.conditionalAndExpressionRightPart(_left)
-	for _ret != nil {
-		_left = _ret
-		_ret = ps.conditionalAndExpressionRightPart(_left)
-	}
-	return _left
-}
-
-func (ps *Parser) conditionalAndExpressionLeftMost() Node {
-	/* rel_op_expression
+	/* 'make' '(' 'map' '[' k=type ']' v=type (',' hint=expression)? ','? ')' {make_map_expr(k, v, hint)}
 	 */
 	for {
+		var hint Node
+		var k Node
+		var v Node
 		var _1 Node
-		_1 = ps.relOpExpression()
+		_1 = ps._expectV("\u006Da\u006Be")
 		if _1 == nil {
 			break
 		}
-		return _1
+		var _2 Node
+		_2 = ps._expectK(TokenTypeOpLeftParen)
+		if _2 == nil {
+			break
+		}
+		var _3 Node
+		_3 = ps._expectK(TokenTypeKwMap)
+		if _3 == nil {
+			break
+		}
+		var _4 Node
+		_4 = ps._expectK(TokenTypeOpLeftBracket)
+		if _4 == nil {
+			break
+		}
+		k = ps.type_()
+		if k == nil {
+			break
+		}
+		var _5 Node
+		_5 = ps._expectK(TokenTypeOpRightBracket)
+		if _5 == nil {
+			break
+		}
+		v = ps.type_()
+		if v == nil {
+			break
+		}
+		var _6 Node
+		for {
+			_ok := false
+			_p := ps._mark()
+			for {
+				var _7 Node
+				_7 = ps._expectK(TokenTypeOpComma)
+				if _7 == nil {
+					break
+				}
+				hint = ps.expression()
+				if hint == nil {
+					break
+				}
+				_6 = hint
+				_ok = true
+				break
+			}
+			if !_ok {
+				ps._reset(_p)
+			}
+			break
+		}
+		_ = _6
+		var _8 Node
+		_8 = ps._expectK(TokenTypeOpComma)
+		_ = _8
+		var _9 Node
+		_9 = ps._expectK(TokenTypeOpRightParen)
+		if _9 == nil {
+			break
+		}
+		return NewMakeMapExprNode(ps._filePath, ps._fileContent, k, v, hint, ps._tokens[pos.pos].Start, ps._visibleTokenBefore(ps._pos).End)
 	}
-	return nil
-}
-
-func (ps *Parser) conditionalAndExpressionRightPart(_left Node) Node {
-	/* x=conditional_and_expression z='&&' y=rel_op_expression {binary_expr(x,y,z)}
+	ps._reset(pos)
+	/* 'make' '(' 'chan' type=type (',' buffer=expression)? ','? ')' {make_chan_expr(type, buffer)}
 	 */
-	pos := ps._mark()
 	for {
-		var x Node
-		var y Node
-		var z Node
-		x = _left
-		z = ps._expectK(TokenTypeOpAndAnd)
-		if z == nil {
+		var buffer Node
+		var type_ Node
+		var _1 Node
+		_1 = ps._expectV("\u006Da\u006Be")
+		if _1 == nil {
 			break
 		}
-		y = ps.relOpExpression()
-		if y == nil {
+		var _2 Node
+		_2 = ps._expectK(TokenTypeOpLeftParen)
+		if _2 == nil {
+			break
+		}
+		var _3 Node
+		_3 = ps._expectK(TokenTypeKwChan)
+		if _3 == nil {
+			break
+		}
+		type_ = ps.type_()
+		if type_ == nil {
+			break
+		}
+		var _4 Node
+		for {
+			_ok := false
+			_p := ps._mark()
+			for {
+				var _5 Node
+				_5 = ps._expectK(TokenTypeOpComma)
+				if _5 == nil {
+					break
+				}
+				buffer = ps.expression()
+				if buffer == nil {
+					break
+				}
+				_4 = buffer
+				_ok = true
+				break
+			}
+			if !_ok {
+				ps._reset(_p)
+			}
+			break
+		}
+		_ = _4
+		var _6 Node
+		_6 = ps._expectK(TokenTypeOpComma)
+		_ = _6
+		var _7 Node
+		_7 = ps._expectK(TokenTypeOpRightParen)
+		if _7 == nil {
 			break
 		}
-		return NewBinaryExprNode(ps._filePath, ps._fileContent, x, y, z, _left.RangeStart(), ps._visibleTokenBefore(ps._mark()).End)
+		return NewMakeChanExprNode(ps._filePath, ps._fileContent, type_, buffer, ps._tokens[pos.pos].Start, ps._visibleTokenBefore(ps._pos).End)
 	}
 	ps._reset(pos)
-	return nil
-}
-
-/*
-rel_op_expression:
-| x=rel_op_expression z=rel_op y=add_op_expression {binary_expr(x,y,z)}
-| add_op_expression
-*/
-func (ps *Parser) relOpExpression() Node {
-	_left := ps.relOpExpressionLeftMost()
-	if _left == nil {
-		return nil
-	}
-	_ret := ps.relOpExpressionRightPart(_left)
-	for _ret != nil {
-		_left = _ret
-		_ret = ps.relOpExpressionRightPart(_left)
-	}
-	return _left
-}
-
-func (ps *Parser) relOpExpressionLeftMost() Node {
-	/* add_op_expression
+	/* 'new' '(' type=type ','? ')' {new_expr(type)}
 	 */
 	for {
+		var type_ Node
 		var _1 Node
-		_1 = ps.addOpExpression()
+		_1 = ps._expectV("\u006Ee\u0077")
 		if _1 == nil {
 			break
 		}
-		return _1
+		var _2 Node
+		_2 = ps._expectK(TokenTypeOpLeftParen)
+		if _2 == nil {
+			break
+		}
+		type_ = ps.type_()
+		if type_ == nil {
+			break
+		}
+		var _3 Node
+		_3 = ps._expectK(TokenTypeOpComma)
+		_ = _3
+		var _4 Node
+		_4 = ps._expectK(TokenTypeOpRightParen)
+		if _4 == nil {
+			break
+		}
+		return NewNewExprNode(ps._filePath, ps._fileContent, type_, ps._tokens[pos.pos].Start, ps._visibleTokenBefore(ps._pos).End)
 	}
-	return nil
-}
-
-func (ps *Parser) relOpExpressionRightPart(_left Node) Node {
-	/* x=rel_op_expression z=rel_op y=add_op_expression {binary_expr(x,y,z)}
+	ps._reset(pos)
+	/* callee=type type_argument=type_argument_decl? argument=argument_decl {call_expr(callee, type_argument, argument)}
 	 */
-	pos := ps._mark()
 	for {
-		var x Node
-		var y Node
-		var z Node
-		x = _left
-		z = ps.relOp()
-		if z == nil {
+		var argument Node
+		var callee Node
+		var type_argument Node
+		callee = ps.type_()
+		if callee == nil {
 			break
 		}
-		y = ps.addOpExpression()
-		if y == nil {
+		type_argument = ps.typeArgumentDecl()
+		_ = type_argument
+		argument = ps.argumentDecl()
+		if argument == nil {
 			break
 		}
-		return NewBinaryExprNode(ps._filePath, ps._fileContent, x, y, z, _left.RangeStart(), ps._visibleTokenBefore(ps._mark()).End)
+		return NewCallExprNode(ps._filePath, ps._fileContent, callee, type_argument, argument, ps._tokens[pos.pos].Start, ps._visibleTokenBefore(ps._pos).End)
 	}
 	ps._reset(pos)
-	return nil
-}
-
-/*
-add_op_expression:
-| x=add_op_expression z=add_op y=mul_op_expression {binary_expr(x,y,z)}
-| mul_op_expression
-*/
-func (ps *Parser) addOpExpression() Node {
-	_left := ps.addOpExpressionLeftMost()
-	if _left == nil {
-		return nil
-	}
-	_ret := ps.addOpExpressionRightPart(_left)
-	for _ret != nil {
-		_left = _ret
-		_ret = ps.addOpExpressionRightPart(_left)
-	}
-	return _left
-}
-
-func (ps *Parser) addOpExpressionLeftMost() Node {
-	/* mul_op_expression
+	/* '(' expr=expression ')' {paren_expr(expr)}
 	 */
 	for {
+		var expr Node
 		var _1 Node
-		_1 = ps.mulOpExpression()
+		_1 = ps._expectK(TokenTypeOpLeftParen)
 		if _1 == nil {
 			break
 		}
-		return _1
+		expr = ps.expression()
+		if expr == nil {
+			break
+		}
+		var _2 Node
+		_2 = ps._expectK(TokenTypeOpRightParen)
+		if _2 == nil {
+			break
+		}
+		return NewParenExprNode(ps._filePath, ps._fileContent, expr, ps._tokens[pos.pos].Start, ps._visibleTokenBefore(ps._pos).End)
 	}
-	return nil
-}
-
-func (ps *Parser) addOpExpressionRightPart(_left Node) Node {
-	/* x=add_op_expression z=add_op y=mul_op_expression {binary_expr(x,y,z)}
+	ps._reset(pos)
+	/* number=NUMBER {number_expr(number)}
 	 */
-	pos := ps._mark()
 	for {
-		var x Node
-		var y Node
-		var z Node
-		x = _left
-		z = ps.addOp()
-		if z == nil {
+		var number Node
+		number = ps._expectK(TokenTypeNumber)
+		if number == nil {
 			break
 		}
-		y = ps.mulOpExpression()
-		if y == nil {
+		return NewNumberExprNode(ps._filePath, ps._fileContent, number, ps._tokens[pos.pos].Start, ps._visibleTokenBefore(ps._pos).End)
+	}
+	ps._reset(pos)
+	/* string=STRING {string_expr(string)}
+	 */
+	for {
+		var string_ Node
+		string_ = ps._expectK(TokenTypeString)
+		if string_ == nil {
 			break
 		}
-		return NewBinaryExprNode(ps._filePath, ps._fileContent, x, y, z, _left.RangeStart(), ps._visibleTokenBefore(ps._mark()).End)
+		return NewStringExprNode(ps._filePath, ps._fileContent, string_, ps._tokens[pos.pos].Start, ps._visibleTokenBefore(ps._pos).End)
 	}
 	ps._reset(pos)
-	return nil
-}
-
-/*
-mul_op_expression:
-| x=mul_op_expression z=mul_op y=unary_expr {binary_expr(x,y,z)}
-| unary_expr
-*/
-func (ps *Parser) mulOpExpression() Node {
-	_left := ps.mulOpExpressionLeftMost()
-	if _left == nil {
-		return nil
-	}
-	_ret := ps.mulOpExpressionRightPart(_left)
-	for _ret != nil {
-		_left = _ret
-		_ret = ps.mulOpExpressionRightPart(_left)
-	}
-	return _left
-}
-
-func (ps *Parser) mulOpExpressionLeftMost() Node {
-	/* unary_expr
+	/* x=literal_type '{' y=','.keyed_element* ','? '}' {composite_lit(x, y)}
 	 */
 	for {
+		var x Node
+		var y Node
+		x = ps.literalType()
+		if x == nil {
+			break
+		}
 		var _1 Node
-		_1 = ps.unaryExpr()
+		_1 = ps._expectK(TokenTypeOpLeftBrace)
 		if _1 == nil {
 			break
 		}
-		return _1
+		_2 := make([]Node, 0)
+		var _3 Node
+		var _4 Node
+		_3 = ps.keyedElement()
+		if _3 != nil {
+			_2 = append(_2, _3)
+			for {
+				_p := ps._mark()
+				_4 = ps._expectK(TokenTypeOpComma)
+				if _4 == nil {
+					break
+				}
+				_3 = ps.keyedElement()
+				if _3 == nil {
+					ps._reset(_p)
+					break
+				}
+				_2 = append(_2, _3)
+			}
+		}
+		y = NewNodesNode(_2)
+		_ = y
+		var _5 Node
+		_5 = ps._expectK(TokenTypeOpComma)
+		_ = _5
+		var _6 Node
+		_6 = ps._expectK(TokenTypeOpRightBrace)
+		if _6 == nil {
+			break
+		}
+		return NewCompositeLitNode(ps._filePath, ps._fileContent, x, y, ps._tokens[pos.pos].Start, ps._visibleTokenBefore(ps._pos).End)
 	}
-	return nil
-}
-
-func (ps *Parser) mulOpExpressionRightPart(_left Node) Node {
-	/* x=mul_op_expression z=mul_op y=unary_expr {binary_expr(x,y,z)}
+	ps._reset(pos)
+	/* !{ps.inControlClause()} x=composite_lit {x}
 	 */
-	pos := ps._mark()
 	for {
 		var x Node
-		var y Node
-		var z Node
-		x = _left
-		z = ps.mulOp()
-		if z == nil {
+		if ps.inControlClause() {
 			break
 		}
-		y = ps.unaryExpr()
-		if y == nil {
+		x = ps.compositeLit()
+		if x == nil {
 			break
 		}
-		return NewBinaryExprNode(ps._filePath, ps._fileContent, x, y, z, _left.RangeStart(), ps._visibleTokenBefore(ps._mark()).End)
+		return x
 	}
 	ps._reset(pos)
-	return nil
-}
-
-/*
-unary_expr:
-| '*' x=unary_expr {star_expr(x)}
-| x=unary_op y=unary_expr {unary_expr(x,y)}
-| primary_expr
-*/
-func (ps *Parser) unaryExpr() Node {
-	/* '*' x=unary_expr {star_expr(x)}
+	/* 'func' x=signature y=block {function_lit(x,y)}
 	 */
-	pos := ps._mark()
 	for {
 		var x Node
+		var y Node
 		var _1 Node
-		_1 = ps._expectK(TokenTypeOpStar)
+		_1 = ps._expectK(TokenTypeKwFunc)
 		if _1 == nil {
 			break
 		}
-		x = ps.unaryExpr()
+		x = ps.signature()
 		if x == nil {
 			break
 		}
-		return NewStarExprNode(ps._filePath, ps._fileContent, x, ps._tokens[pos].Start, ps._visibleTokenBefore(ps._mark()).End)
+		y = ps.block()
+		if y == nil {
+			break
+		}
+		return NewFunctionLitNode(ps._filePath, ps._fileContent, x, y, ps._tokens[pos.pos].Start, ps._visibleTokenBefore(ps._pos).End)
 	}
 	ps._reset(pos)
-	/* x=unary_op y=unary_expr {unary_expr(x,y)}
+	/* x=type '.' y=IDENT {selector_expr(x,y)}
 	 */
 	for {
 		var x Node
 		var y Node
-		x = ps.unaryOp()
+		x = ps.type_()
 		if x == nil {
 			break
 		}
-		y = ps.unaryExpr()
+		var _1 Node
+		_1 = ps._expectK(TokenTypeOpDot)
+		if _1 == nil {
+			break
+		}
+		y = ps._expectK(TokenTypeIdent)
 		if y == nil {
 			break
 		}
-		return NewUnaryExprNode(ps._filePath, ps._fileContent, x, y, ps._tokens[pos].Start, ps._visibleTokenBefore(ps._mark()).End)
+		return NewSelectorExprNode(ps._filePath, ps._fileContent, x, y, ps._tokens[pos.pos].Start, ps._visibleTokenBefore(ps._pos).End)
 	}
 	ps._reset(pos)
-	/* primary_expr
+	/* i=IDENT {ident(i)}
 	 */
 	for {
-		var _1 Node
-		_1 = ps.primaryExpr()
-		if _1 == nil {
+		var i Node
+		i = ps._expectK(TokenTypeIdent)
+		if i == nil {
 			break
 		}
-		return _1
+		return NewIdentNode(ps._filePath, ps._fileContent, i, ps._tokens[pos.pos].Start, ps._visibleTokenBefore(ps._pos).End)
 	}
+	ps._reset(pos)
 	return nil
 }
 
-/*
-primary_expr:
-| make_ident '(' t=type (',' expression_list)? ','? ')' {make_expr(t)}
-| new_ident '(' t=type ')' {new_expr(t)}
-| x=primary_expr g=generic_args? '(' ')' {call_expr(x, g, _)}
-| x=primary_expr g=generic_args? '(' y=expression_list '...'? ','? ')' {call_expr(x,g,y)}
-| x=primary_expr '.' '(' y=type ')' {type_assert_expr(x,y)}
-| e=primary_expr '[' l=expression? ':' h=expression ':' m=expression ']' {slice_expr(e,l,h,m)}
-| e=primary_expr '[' l=expression? ':' h=expression? ']' {slice_expr(e,l,h,_)}
-| x=primary_expr '[' y=expression ']' {index_expr(x,y)}
-| x=primary_expr '.' y=IDENT {selector_expr(x, y)}
-| x=type g=generic_args? '(' y=expression ','? ')' {call_expr(x,g,[y])}
-| '(' x=expression ')' {paren_expr(x)}
-| x=NUMBER {basic_lit(x)}
-| x=STRING {basic_lit(x)}
-| x=literal_type '{' y=','.keyed_element* ','? '}' {composite_lit(x, y)}
-| _hack_composite_lit_node
-| 'func' x=signature y=block {function_lit(x,y)}
-| x=type '.' y=IDENT {selector_expr(x,y)}
-| i=IDENT {ident(i)}
-*/
-func (ps *Parser) primaryExpr() Node {
-	_left := ps.primaryExprLeftMost()
-	if _left == nil {
-		return nil
-	}
-	_ret := ps.primaryExprRightPart(_left)
-	for _ret != nil {
-		_left = _ret
-		_ret = ps.primaryExprRightPart(_left)
-	}
-	return _left
-}
-
-func (ps *Parser) primaryExprLeftMost() Node {
-	/* make_ident '(' t=type (',' expression_list)? ','? ')' {make_expr(t)}
+func (ps *Parser) primaryExprRightPart(_left Node) Node {
+	/* callee=primary_expr type_argument=type_argument_decl? argument=argument_decl {call_expr(callee, type_argument, argument)}
 	 */
 	pos := ps._mark()
 	for {
-		var t Node
+		var argument Node
+		var callee Node
+		var type_argument Node
+		callee = _left
+		type_argument = ps.typeArgumentDecl()
+		_ = type_argument
+		argument = ps.argumentDecl()
+		if argument == nil {
+			break
+		}
+		return NewCallExprNode(ps._filePath, ps._fileContent, callee, type_argument, argument, _left.RangeStart(), ps._visibleTokenBefore(ps._pos).End)
+	}
+	ps._reset(pos)
+	/* expr=primary_expr '.' '(' type=type ')' {type_assert_expr(expr, type)}
+	 */
+	for {
+		var expr Node
+		var type_ Node
+		expr = _left
 		var _1 Node
-		_1 = ps.makeIdent()
+		_1 = ps._expectK(TokenTypeOpDot)
 		if _1 == nil {
 			break
 		}
@@ -17212,154 +24579,267 @@ func (ps *Parser) primaryExprLeftMost() Node {
 		if _2 == nil {
 			break
 		}
-		t = ps.type_()
-		if t == nil {
+		type_ = ps.type_()
+		if type_ == nil {
 			break
 		}
 		var _3 Node
-		for {
-			_ok := false
-			_p := ps._mark()
-			for {
-				var _4 Node
-				_4 = ps._expectK(TokenTypeOpComma)
-				if _4 == nil {
-					break
-				}
-				_3 = ps.expressionList()
-				if _3 == nil {
-					break
-				}
-				_ok = true
-				break
-			}
-			if !_ok {
-				ps._reset(_p)
-			}
+		_3 = ps._expectK(TokenTypeOpRightParen)
+		if _3 == nil {
 			break
 		}
-		_ = _3
-		var _5 Node
-		_5 = ps._expectK(TokenTypeOpComma)
-		_ = _5
-		var _6 Node
-		_6 = ps._expectK(TokenTypeOpRightParen)
-		if _6 == nil {
+		return NewTypeAssertExprNode(ps._filePath, ps._fileContent, expr, type_, _left.RangeStart(), ps._visibleTokenBefore(ps._pos).End)
+	}
+	ps._reset(pos)
+	/* target=primary_expr '[' low=expression? ':' high=expression? ':' max=expression ']' {full_slice_expr(target, low, high, max)}
+	 */
+	for {
+		var high Node
+		var low Node
+		var max_ Node
+		var target Node
+		target = _left
+		var _1 Node
+		_1 = ps._expectK(TokenTypeOpLeftBracket)
+		if _1 == nil {
+			break
+		}
+		low = ps.expression()
+		_ = low
+		var _2 Node
+		_2 = ps._expectK(TokenTypeOpColon)
+		if _2 == nil {
+			break
+		}
+		high = ps.expression()
+		_ = high
+		var _3 Node
+		_3 = ps._expectK(TokenTypeOpColon)
+		if _3 == nil {
+			break
+		}
+		max_ = ps.expression()
+		if max_ == nil {
 			break
 		}
-		return NewMakeExprNode(ps._filePath, ps._fileContent, t, ps._tokens[pos].Start, ps._visibleTokenBefore(ps._mark()).End)
+		var _4 Node
+		_4 = ps._expectK(TokenTypeOpRightBracket)
+		if _4 == nil {
+			break
+		}
+		return NewFullSliceExprNode(ps._filePath, ps._fileContent, target, low, high, max_, _left.RangeStart(), ps._visibleTokenBefore(ps._pos).End)
 	}
 	ps._reset(pos)
-	/* new_ident '(' t=type ')' {new_expr(t)}
+	/* target=primary_expr '[' low=expression? ':' high=expression? ']' {slice_expr(target, low, high)}
 	 */
 	for {
-		var t Node
+		var high Node
+		var low Node
+		var target Node
+		target = _left
 		var _1 Node
-		_1 = ps.newIdent()
+		_1 = ps._expectK(TokenTypeOpLeftBracket)
 		if _1 == nil {
 			break
 		}
+		low = ps.expression()
+		_ = low
 		var _2 Node
-		_2 = ps._expectK(TokenTypeOpLeftParen)
+		_2 = ps._expectK(TokenTypeOpColon)
 		if _2 == nil {
 			break
 		}
-		t = ps.type_()
-		if t == nil {
+		high = ps.expression()
+		_ = high
+		var _3 Node
+		_3 = ps._expectK(TokenTypeOpRightBracket)
+		if _3 == nil {
+			break
+		}
+		return NewSliceExprNode(ps._filePath, ps._fileContent, target, low, high, _left.RangeStart(), ps._visibleTokenBefore(ps._pos).End)
+	}
+	ps._reset(pos)
+	/* target=primary_expr '[' index=expression ']' {index_expr(target, index)}
+	 */
+	for {
+		var index Node
+		var target Node
+		target = _left
+		var _1 Node
+		_1 = ps._expectK(TokenTypeOpLeftBracket)
+		if _1 == nil {
+			break
+		}
+		index = ps.expression()
+		if index == nil {
 			break
 		}
-		var _3 Node
-		_3 = ps._expectK(TokenTypeOpRightParen)
-		if _3 == nil {
+		var _2 Node
+		_2 = ps._expectK(TokenTypeOpRightBracket)
+		if _2 == nil {
 			break
 		}
-		return NewNewExprNode(ps._filePath, ps._fileContent, t, ps._tokens[pos].Start, ps._visibleTokenBefore(ps._mark()).End)
+		return NewIndexExprNode(ps._filePath, ps._fileContent, target, index, _left.RangeStart(), ps._visibleTokenBefore(ps._pos).End)
 	}
 	ps._reset(pos)
-	/* x=type g=generic_args? '(' y=expression ','? ')' {call_expr(x,g,[y])}
+	/* target=primary_expr '.' selector=IDENT {selector_expr(target, selector)}
 	 */
 	for {
-		var g Node
-		var x Node
-		var y Node
-		x = ps.type_()
-		if x == nil {
-			break
-		}
-		g = ps.genericArgs()
-		_ = g
+		var selector Node
+		var target Node
+		target = _left
 		var _1 Node
-		_1 = ps._expectK(TokenTypeOpLeftParen)
+		_1 = ps._expectK(TokenTypeOpDot)
 		if _1 == nil {
 			break
 		}
-		y = ps.expression()
-		if y == nil {
-			break
-		}
-		var _2 Node
-		_2 = ps._expectK(TokenTypeOpComma)
-		_ = _2
-		var _3 Node
-		_3 = ps._expectK(TokenTypeOpRightParen)
-		if _3 == nil {
+		selector = ps._expectK(TokenTypeIdent)
+		if selector == nil {
 			break
 		}
-		return NewCallExprNode(ps._filePath, ps._fileContent, x, g, NewNodesNode([]Node{y}), ps._tokens[pos].Start, ps._visibleTokenBefore(ps._mark()).End)
+		return NewSelectorExprNode(ps._filePath, ps._fileContent, target, selector, _left.RangeStart(), ps._visibleTokenBefore(ps._pos).End)
 	}
 	ps._reset(pos)
-	/* '(' x=expression ')' {paren_expr(x)}
+	return nil
+}
+
+/*
+type_argument_decl:
+| '[' types=','.type+ ','? ']' {type_argument_decl(types)}
+*/
+func (ps *Parser) typeArgumentDecl() Node {
+	if !ps._enterDepth() {
+		return nil
+	}
+	defer ps._leaveDepth()
+	/* '[' types=','.type+ ','? ']' {type_argument_decl(types)}
 	 */
+	pos := ps._mark()
 	for {
-		var x Node
+		var types Node
 		var _1 Node
-		_1 = ps._expectK(TokenTypeOpLeftParen)
+		_1 = ps._expectK(TokenTypeOpLeftBracket)
 		if _1 == nil {
 			break
 		}
-		x = ps.expression()
-		if x == nil {
+		_2 := make([]Node, 0)
+		var _3, _4 Node
+		_3 = ps.type_()
+		if _3 == nil {
 			break
 		}
-		var _2 Node
-		_2 = ps._expectK(TokenTypeOpRightParen)
-		if _2 == nil {
+		_2 = append(_2, _3)
+		for {
+			_p := ps._mark()
+			_4 = ps._expectK(TokenTypeOpComma)
+			if _4 == nil {
+				break
+			}
+			_3 = ps.type_()
+			if _3 == nil {
+				ps._reset(_p)
+				break
+			}
+			_2 = append(_2, _3)
+		}
+		types = NewNodesNode(_2)
+		_ = types
+		var _5 Node
+		_5 = ps._expectK(TokenTypeOpComma)
+		_ = _5
+		var _6 Node
+		_6 = ps._expectK(TokenTypeOpRightBracket)
+		if _6 == nil {
 			break
 		}
-		return NewParenExprNode(ps._filePath, ps._fileContent, x, ps._tokens[pos].Start, ps._visibleTokenBefore(ps._mark()).End)
+		return NewTypeArgumentDeclNode(ps._filePath, ps._fileContent, types, ps._tokens[pos.pos].Start, ps._visibleTokenBefore(ps._pos).End)
 	}
 	ps._reset(pos)
-	/* x=NUMBER {basic_lit(x)}
+	return nil
+}
+
+/*
+argument_decl:
+| arguments=paren_list<argument> {argument_decl(arguments)}
+*/
+func (ps *Parser) argumentDecl() Node {
+	if !ps._enterDepth() {
+		return nil
+	}
+	defer ps._leaveDepth()
+	/* arguments=paren_list<argument> {argument_decl(arguments)}
 	 */
+	pos := ps._mark()
 	for {
-		var x Node
-		x = ps._expectK(TokenTypeNumber)
-		if x == nil {
+		var arguments Node
+		arguments = ps.parenListOfArgument()
+		if arguments == nil {
 			break
 		}
-		return NewBasicLitNode(ps._filePath, ps._fileContent, x, ps._tokens[pos].Start, ps._visibleTokenBefore(ps._mark()).End)
+		return NewArgumentDeclNode(ps._filePath, ps._fileContent, arguments, ps._tokens[pos.pos].Start, ps._visibleTokenBefore(ps._pos).End)
 	}
 	ps._reset(pos)
-	/* x=STRING {basic_lit(x)}
+	return nil
+}
+
+/*
+argument:
+| expr=expression '...' {ellipsis_argument(expr)}
+| expr=expression {argument(expr)}
+*/
+func (ps *Parser) argument() Node {
+	if !ps._enterDepth() {
+		return nil
+	}
+	defer ps._leaveDepth()
+	/* expr=expression '...' {ellipsis_argument(expr)}
 	 */
+	pos := ps._mark()
 	for {
-		var x Node
-		x = ps._expectK(TokenTypeString)
-		if x == nil {
+		var expr Node
+		expr = ps.expression()
+		if expr == nil {
+			break
+		}
+		var _1 Node
+		_1 = ps._expectK(TokenTypeOpDotDotDot)
+		if _1 == nil {
 			break
 		}
-		return NewBasicLitNode(ps._filePath, ps._fileContent, x, ps._tokens[pos].Start, ps._visibleTokenBefore(ps._mark()).End)
+		return NewEllipsisArgumentNode(ps._filePath, ps._fileContent, expr, ps._tokens[pos.pos].Start, ps._visibleTokenBefore(ps._pos).End)
 	}
 	ps._reset(pos)
-	/* x=literal_type '{' y=','.keyed_element* ','? '}' {composite_lit(x, y)}
+	/* expr=expression {argument(expr)}
 	 */
 	for {
-		var x Node
-		var y Node
-		x = ps.literalType()
-		if x == nil {
+		var expr Node
+		expr = ps.expression()
+		if expr == nil {
 			break
 		}
+		return NewArgumentNode(ps._filePath, ps._fileContent, expr, ps._tokens[pos.pos].Start, ps._visibleTokenBefore(ps._pos).End)
+	}
+	ps._reset(pos)
+	return nil
+}
+
+/*
+composite_lit:
+| x=type_name_or_generic_type_instantiation? '{' y=','.keyed_element* ','? '}' {composite_lit(x, y)}
+*/
+func (ps *Parser) compositeLit() Node {
+	if !ps._enterDepth() {
+		return nil
+	}
+	defer ps._leaveDepth()
+	/* x=type_name_or_generic_type_instantiation? '{' y=','.keyed_element* ','? '}' {composite_lit(x, y)}
+	 */
+	pos := ps._mark()
+	for {
+		var x Node
+		var y Node
+		x = ps.typeNameOrGenericTypeInstantiation()
+		_ = x
 		var _1 Node
 		_1 = ps._expectK(TokenTypeOpLeftBrace)
 		if _1 == nil {
@@ -17395,241 +24875,224 @@ func (ps *Parser) primaryExprLeftMost() Node {
 		if _6 == nil {
 			break
 		}
-		return NewCompositeLitNode(ps._filePath, ps._fileContent, x, y, ps._tokens[pos].Start, ps._visibleTokenBefore(ps._mark()).End)
+		return NewCompositeLitNode(ps._filePath, ps._fileContent, x, y, ps._tokens[pos.pos].Start, ps._visibleTokenBefore(ps._pos).End)
+	}
+	ps._reset(pos)
+	return nil
+}
+
+/*
+ellipsis:
+| '...' {ellipsis()}
+*/
+func (ps *Parser) ellipsis() Node {
+	if !ps._enterDepth() {
+		return nil
+	}
+	defer ps._leaveDepth()
+	/* '...' {ellipsis()}
+	 */
+	pos := ps._mark()
+	for {
+		var _1 Node
+		_1 = ps._expectK(TokenTypeOpDotDotDot)
+		if _1 == nil {
+			break
+		}
+		return NewEllipsisNode(ps._filePath, ps._fileContent, ps._tokens[pos.pos].Start, ps._visibleTokenBefore(ps._pos).End)
 	}
 	ps._reset(pos)
-	/* _hack_composite_lit_node
+	return nil
+}
+
+/*
+literal_type:
+| struct_type
+| '[' e=ellipsis ']' x=type {array_type(e,x)}
+| array_type
+| map_type
+*/
+func (ps *Parser) literalType() Node {
+	if !ps._enterDepth() {
+		return nil
+	}
+	defer ps._leaveDepth()
+	/* struct_type
 	 */
 	for {
 		var _1 Node
-		_1 = ps._hackCompositeLitNode()
+		_1 = ps.structType()
 		if _1 == nil {
 			break
 		}
 		return _1
 	}
-	/* 'func' x=signature y=block {function_lit(x,y)}
+	/* '[' e=ellipsis ']' x=type {array_type(e,x)}
 	 */
+	pos := ps._mark()
 	for {
+		var e Node
 		var x Node
-		var y Node
 		var _1 Node
-		_1 = ps._expectK(TokenTypeKwFunc)
+		_1 = ps._expectK(TokenTypeOpLeftBracket)
 		if _1 == nil {
 			break
 		}
-		x = ps.signature()
-		if x == nil {
+		e = ps.ellipsis()
+		if e == nil {
 			break
 		}
-		y = ps.block()
-		if y == nil {
+		var _2 Node
+		_2 = ps._expectK(TokenTypeOpRightBracket)
+		if _2 == nil {
 			break
 		}
-		return NewFunctionLitNode(ps._filePath, ps._fileContent, x, y, ps._tokens[pos].Start, ps._visibleTokenBefore(ps._mark()).End)
-	}
-	ps._reset(pos)
-	/* x=type '.' y=IDENT {selector_expr(x,y)}
-	 */
-	for {
-		var x Node
-		var y Node
 		x = ps.type_()
 		if x == nil {
 			break
 		}
+		return NewArrayTypeNode(ps._filePath, ps._fileContent, e, x, ps._tokens[pos.pos].Start, ps._visibleTokenBefore(ps._pos).End)
+	}
+	ps._reset(pos)
+	/* array_type
+	 */
+	for {
 		var _1 Node
-		_1 = ps._expectK(TokenTypeOpDot)
+		_1 = ps.arrayType()
 		if _1 == nil {
 			break
 		}
-		y = ps._expectK(TokenTypeIdent)
-		if y == nil {
-			break
-		}
-		return NewSelectorExprNode(ps._filePath, ps._fileContent, x, y, ps._tokens[pos].Start, ps._visibleTokenBefore(ps._mark()).End)
+		return _1
 	}
-	ps._reset(pos)
-	/* i=IDENT {ident(i)}
+	/* map_type
 	 */
 	for {
-		var i Node
-		i = ps._expectK(TokenTypeIdent)
-		if i == nil {
+		var _1 Node
+		_1 = ps.mapType()
+		if _1 == nil {
 			break
 		}
-		return NewIdentNode(ps._filePath, ps._fileContent, i, ps._tokens[pos].Start, ps._visibleTokenBefore(ps._mark()).End)
+		return _1
 	}
-	ps._reset(pos)
 	return nil
 }
 
-func (ps *Parser) primaryExprRightPart(_left Node) Node {
-	/* x=primary_expr g=generic_args? '(' ')' {call_expr(x, g, _)}
+/*
+keyed_element:
+| x=expression ':' y=expression {key_value_expr(x,y)}
+| expression
+*/
+func (ps *Parser) keyedElement() Node {
+	if !ps._enterDepth() {
+		return nil
+	}
+	defer ps._leaveDepth()
+	/* x=expression ':' y=expression {key_value_expr(x,y)}
 	 */
 	pos := ps._mark()
 	for {
-		var g Node
 		var x Node
-		x = _left
-		g = ps.genericArgs()
-		_ = g
-		var _1 Node
-		_1 = ps._expectK(TokenTypeOpLeftParen)
-		if _1 == nil {
-			break
-		}
-		var _2 Node
-		_2 = ps._expectK(TokenTypeOpRightParen)
-		if _2 == nil {
+		var y Node
+		x = ps.expression()
+		if x == nil {
 			break
 		}
-		return NewCallExprNode(ps._filePath, ps._fileContent, x, g, nil, _left.RangeStart(), ps._visibleTokenBefore(ps._mark()).End)
-	}
-	ps._reset(pos)
-	/* x=primary_expr g=generic_args? '(' y=expression_list '...'? ','? ')' {call_expr(x,g,y)}
-	 */
-	for {
-		var g Node
-		var x Node
-		var y Node
-		x = _left
-		g = ps.genericArgs()
-		_ = g
 		var _1 Node
-		_1 = ps._expectK(TokenTypeOpLeftParen)
+		_1 = ps._expectK(TokenTypeOpColon)
 		if _1 == nil {
 			break
 		}
-		y = ps.expressionList()
+		y = ps.expression()
 		if y == nil {
 			break
 		}
-		var _2 Node
-		_2 = ps._expectK(TokenTypeOpDotDotDot)
-		_ = _2
-		var _3 Node
-		_3 = ps._expectK(TokenTypeOpComma)
-		_ = _3
-		var _4 Node
-		_4 = ps._expectK(TokenTypeOpRightParen)
-		if _4 == nil {
-			break
-		}
-		return NewCallExprNode(ps._filePath, ps._fileContent, x, g, y, _left.RangeStart(), ps._visibleTokenBefore(ps._mark()).End)
+		return NewKeyValueExprNode(ps._filePath, ps._fileContent, x, y, ps._tokens[pos.pos].Start, ps._visibleTokenBefore(ps._pos).End)
 	}
 	ps._reset(pos)
-	/* x=primary_expr '.' '(' y=type ')' {type_assert_expr(x,y)}
+	/* expression
 	 */
 	for {
-		var x Node
-		var y Node
-		x = _left
 		var _1 Node
-		_1 = ps._expectK(TokenTypeOpDot)
-		if _1 == nil {
-			break
-		}
-		var _2 Node
-		_2 = ps._expectK(TokenTypeOpLeftParen)
-		if _2 == nil {
-			break
-		}
-		y = ps.type_()
-		if y == nil {
-			break
-		}
-		var _3 Node
-		_3 = ps._expectK(TokenTypeOpRightParen)
-		if _3 == nil {
+		_1 = ps.expression()
+		if _1 == nil {
 			break
 		}
-		return NewTypeAssertExprNode(ps._filePath, ps._fileContent, x, y, _left.RangeStart(), ps._visibleTokenBefore(ps._mark()).End)
+		return _1
 	}
-	ps._reset(pos)
-	/* e=primary_expr '[' l=expression? ':' h=expression ':' m=expression ']' {slice_expr(e,l,h,m)}
+	return nil
+}
+
+/*
+array_type:
+| '[' ']' x=type {array_type(_,x)}
+| '[' e=ellipsis ']' x=type {array_type(e,x)}
+| '[' x=expression ']' y=type {array_type(x,y)}
+*/
+func (ps *Parser) arrayType() Node {
+	if !ps._enterDepth() {
+		return nil
+	}
+	defer ps._leaveDepth()
+	/* '[' ']' x=type {array_type(_,x)}
 	 */
+	pos := ps._mark()
 	for {
-		var e Node
-		var h Node
-		var l Node
-		var m Node
-		e = _left
+		var x Node
 		var _1 Node
 		_1 = ps._expectK(TokenTypeOpLeftBracket)
 		if _1 == nil {
 			break
 		}
-		l = ps.expression()
-		_ = l
 		var _2 Node
-		_2 = ps._expectK(TokenTypeOpColon)
+		_2 = ps._expectK(TokenTypeOpRightBracket)
 		if _2 == nil {
 			break
 		}
-		h = ps.expression()
-		if h == nil {
-			break
-		}
-		var _3 Node
-		_3 = ps._expectK(TokenTypeOpColon)
-		if _3 == nil {
-			break
-		}
-		m = ps.expression()
-		if m == nil {
-			break
-		}
-		var _4 Node
-		_4 = ps._expectK(TokenTypeOpRightBracket)
-		if _4 == nil {
+		x = ps.type_()
+		if x == nil {
 			break
 		}
-		return NewSliceExprNode(ps._filePath, ps._fileContent, e, l, h, m, _left.RangeStart(), ps._visibleTokenBefore(ps._mark()).End)
+		return NewArrayTypeNode(ps._filePath, ps._fileContent, nil, x, ps._tokens[pos.pos].Start, ps._visibleTokenBefore(ps._pos).End)
 	}
 	ps._reset(pos)
-	/* e=primary_expr '[' l=expression? ':' h=expression? ']' {slice_expr(e,l,h,_)}
+	/* '[' e=ellipsis ']' x=type {array_type(e,x)}
 	 */
 	for {
 		var e Node
-		var h Node
-		var l Node
-		e = _left
+		var x Node
 		var _1 Node
 		_1 = ps._expectK(TokenTypeOpLeftBracket)
 		if _1 == nil {
 			break
 		}
-		l = ps.expression()
-		_ = l
+		e = ps.ellipsis()
+		if e == nil {
+			break
+		}
 		var _2 Node
-		_2 = ps._expectK(TokenTypeOpColon)
+		_2 = ps._expectK(TokenTypeOpRightBracket)
 		if _2 == nil {
 			break
 		}
-		h = ps.expression()
-		_ = h
-		var _3 Node
-		_3 = ps._expectK(TokenTypeOpRightBracket)
-		if _3 == nil {
+		x = ps.type_()
+		if x == nil {
 			break
 		}
-		return NewSliceExprNode(ps._filePath, ps._fileContent, e, l, h, nil, _left.RangeStart(), ps._visibleTokenBefore(ps._mark()).End)
+		return NewArrayTypeNode(ps._filePath, ps._fileContent, e, x, ps._tokens[pos.pos].Start, ps._visibleTokenBefore(ps._pos).End)
 	}
 	ps._reset(pos)
-	/* x=primary_expr '[' y=expression ']' {index_expr(x,y)}
+	/* '[' x=expression ']' y=type {array_type(x,y)}
 	 */
 	for {
 		var x Node
 		var y Node
-		x = _left
 		var _1 Node
 		_1 = ps._expectK(TokenTypeOpLeftBracket)
 		if _1 == nil {
 			break
 		}
-		y = ps.expression()
-		if y == nil {
+		x = ps.expression()
+		if x == nil {
 			break
 		}
 		var _2 Node
@@ -17637,1210 +25100,2195 @@ func (ps *Parser) primaryExprRightPart(_left Node) Node {
 		if _2 == nil {
 			break
 		}
-		return NewIndexExprNode(ps._filePath, ps._fileContent, x, y, _left.RangeStart(), ps._visibleTokenBefore(ps._mark()).End)
-	}
-	ps._reset(pos)
-	/* x=primary_expr '.' y=IDENT {selector_expr(x, y)}
-	 */
-	for {
-		var x Node
-		var y Node
-		x = _left
-		var _1 Node
-		_1 = ps._expectK(TokenTypeOpDot)
-		if _1 == nil {
-			break
-		}
-		y = ps._expectK(TokenTypeIdent)
+		y = ps.type_()
 		if y == nil {
 			break
 		}
-		return NewSelectorExprNode(ps._filePath, ps._fileContent, x, y, _left.RangeStart(), ps._visibleTokenBefore(ps._mark()).End)
+		return NewArrayTypeNode(ps._filePath, ps._fileContent, x, y, ps._tokens[pos.pos].Start, ps._visibleTokenBefore(ps._pos).End)
 	}
 	ps._reset(pos)
 	return nil
 }
 
 /*
-make_ident:
-| x='make' {ident(x)}
+method_spec:
+| names=IDENT type=signature {field([names],type,_)}
 */
-func (ps *Parser) makeIdent() Node {
-	/* x='make' {ident(x)}
+func (ps *Parser) methodSpec() Node {
+	if !ps._enterDepth() {
+		return nil
+	}
+	defer ps._leaveDepth()
+	/* names=IDENT type=signature {field([names],type,_)}
 	 */
 	pos := ps._mark()
 	for {
-		var x Node
-		x = ps._expectV("\u006Da\u006Be")
-		if x == nil {
+		var names Node
+		var type_ Node
+		names = ps._expectK(TokenTypeIdent)
+		if names == nil {
+			break
+		}
+		type_ = ps.signature()
+		if type_ == nil {
 			break
 		}
-		return NewIdentNode(ps._filePath, ps._fileContent, x, ps._tokens[pos].Start, ps._visibleTokenBefore(ps._mark()).End)
+		return NewFieldNode(ps._filePath, ps._fileContent, NewNodesNode([]Node{names}), type_, nil, ps._tokens[pos.pos].Start, ps._visibleTokenBefore(ps._pos).End)
 	}
 	ps._reset(pos)
 	return nil
 }
 
 /*
-new_ident:
-| x='new' {ident(x)}
+struct_body:
+| '{' x=semi<field_decl>* '}' {field_list(x)}
 */
-func (ps *Parser) newIdent() Node {
-	/* x='new' {ident(x)}
+func (ps *Parser) structBody() Node {
+	if !ps._enterDepth() {
+		return nil
+	}
+	defer ps._leaveDepth()
+	/* '{' x=semi<field_decl>* '}' {field_list(x)}
 	 */
 	pos := ps._mark()
 	for {
 		var x Node
-		x = ps._expectV("\u006Ee\u0077")
-		if x == nil {
+		var _1 Node
+		_1 = ps._expectK(TokenTypeOpLeftBrace)
+		if _1 == nil {
+			break
+		}
+		_2 := make([]Node, 0)
+		var _3 Node
+		for {
+			_3 = ps.semiOfFieldDecl()
+			if _3 == nil {
+				break
+			}
+			_2 = append(_2, _3)
+		}
+		x = NewNodesNode(_2)
+		_ = x
+		var _4 Node
+		_4 = ps._expectK(TokenTypeOpRightBrace)
+		if _4 == nil {
 			break
 		}
-		return NewIdentNode(ps._filePath, ps._fileContent, x, ps._tokens[pos].Start, ps._visibleTokenBefore(ps._mark()).End)
+		return NewFieldListNode(ps._filePath, ps._fileContent, x, ps._tokens[pos.pos].Start, ps._visibleTokenBefore(ps._pos).End)
 	}
 	ps._reset(pos)
 	return nil
 }
 
 /*
-rel_op:
-| '=='
-| '!='
-| '<'
-| '<='
-| '>'
-| '>='
+struct_type:
+| 'struct' b=struct_body {struct_type(b)}
 */
-func (ps *Parser) relOp() Node {
-	/* '=='
+func (ps *Parser) structType() Node {
+	if !ps._enterDepth() {
+		return nil
+	}
+	defer ps._leaveDepth()
+	/* 'struct' b=struct_body {struct_type(b)}
 	 */
+	pos := ps._mark()
 	for {
+		var b Node
 		var _1 Node
-		_1 = ps._expectK(TokenTypeOpEqualEqual)
+		_1 = ps._expectK(TokenTypeKwStruct)
 		if _1 == nil {
 			break
 		}
-		return _1
+		b = ps.structBody()
+		if b == nil {
+			break
+		}
+		return NewStructTypeNode(ps._filePath, ps._fileContent, b, ps._tokens[pos.pos].Start, ps._visibleTokenBefore(ps._pos).End)
+	}
+	ps._reset(pos)
+	return nil
+}
+
+/*
+field_decl:
+| names=identifier_list type=type tag=tag? {field(names,type,tag)}
+| type=embedded_field tag=tag? {field(_,type,tag)}
+*/
+func (ps *Parser) fieldDecl() Node {
+	if !ps._enterDepth() {
+		return nil
 	}
-	/* '!='
+	defer ps._leaveDepth()
+	/* names=identifier_list type=type tag=tag? {field(names,type,tag)}
 	 */
+	pos := ps._mark()
 	for {
-		var _1 Node
-		_1 = ps._expectK(TokenTypeOpNotEqual)
-		if _1 == nil {
+		var names Node
+		var tag Node
+		var type_ Node
+		names = ps.identifierList()
+		if names == nil {
 			break
 		}
-		return _1
+		type_ = ps.type_()
+		if type_ == nil {
+			break
+		}
+		tag = ps.tag()
+		_ = tag
+		return NewFieldNode(ps._filePath, ps._fileContent, names, type_, tag, ps._tokens[pos.pos].Start, ps._visibleTokenBefore(ps._pos).End)
 	}
-	/* '<'
+	ps._reset(pos)
+	/* type=embedded_field tag=tag? {field(_,type,tag)}
 	 */
 	for {
-		var _1 Node
-		_1 = ps._expectK(TokenTypeOpLess)
-		if _1 == nil {
+		var tag Node
+		var type_ Node
+		type_ = ps.embeddedField()
+		if type_ == nil {
 			break
 		}
-		return _1
+		tag = ps.tag()
+		_ = tag
+		return NewFieldNode(ps._filePath, ps._fileContent, nil, type_, tag, ps._tokens[pos.pos].Start, ps._visibleTokenBefore(ps._pos).End)
+	}
+	ps._reset(pos)
+	return nil
+}
+
+/*
+embedded_field:
+| '*' x=type_name_or_generic_type_instantiation {star_expr(x)}
+| t=type_name_or_generic_type_instantiation {t}
+*/
+func (ps *Parser) embeddedField() Node {
+	if !ps._enterDepth() {
+		return nil
 	}
-	/* '<='
+	defer ps._leaveDepth()
+	/* '*' x=type_name_or_generic_type_instantiation {star_expr(x)}
 	 */
+	pos := ps._mark()
 	for {
+		var x Node
 		var _1 Node
-		_1 = ps._expectK(TokenTypeOpLessEqual)
+		_1 = ps._expectK(TokenTypeOpStar)
 		if _1 == nil {
 			break
 		}
-		return _1
+		x = ps.typeNameOrGenericTypeInstantiation()
+		if x == nil {
+			break
+		}
+		return NewStarExprNode(ps._filePath, ps._fileContent, x, ps._tokens[pos.pos].Start, ps._visibleTokenBefore(ps._pos).End)
 	}
-	/* '>'
+	ps._reset(pos)
+	/* t=type_name_or_generic_type_instantiation {t}
 	 */
 	for {
-		var _1 Node
-		_1 = ps._expectK(TokenTypeOpGreater)
-		if _1 == nil {
+		var t Node
+		t = ps.typeNameOrGenericTypeInstantiation()
+		if t == nil {
 			break
 		}
-		return _1
+		return t
+	}
+	ps._reset(pos)
+	return nil
+}
+
+/*
+tag:
+| x=STRING {basic_lit(x)}
+*/
+func (ps *Parser) tag() Node {
+	if !ps._enterDepth() {
+		return nil
 	}
-	/* '>='
+	defer ps._leaveDepth()
+	/* x=STRING {basic_lit(x)}
 	 */
+	pos := ps._mark()
 	for {
-		var _1 Node
-		_1 = ps._expectK(TokenTypeOpGreaterEqual)
-		if _1 == nil {
+		var x Node
+		x = ps._expectK(TokenTypeString)
+		if x == nil {
 			break
 		}
-		return _1
+		return NewBasicLitNode(ps._filePath, ps._fileContent, x, ps._tokens[pos.pos].Start, ps._visibleTokenBefore(ps._pos).End)
 	}
+	ps._reset(pos)
 	return nil
 }
 
 /*
-add_op:
-| '+'
-| '-'
-| '|'
-| '^'
+pseudo_semi:
+| ';'
+| &')'
+| &'}'
 */
-func (ps *Parser) addOp() Node {
-	/* '+'
+func (ps *Parser) pseudoSemi() Node {
+	if !ps._enterDepth() {
+		return nil
+	}
+	defer ps._leaveDepth()
+	/* ';'
 	 */
 	for {
 		var _1 Node
-		_1 = ps._expectK(TokenTypeOpPlus)
+		_1 = ps._expectK(TokenTypeOpSemi)
 		if _1 == nil {
 			break
 		}
 		return _1
 	}
-	/* '-'
+	/* &')'
 	 */
 	for {
 		var _1 Node
-		_1 = ps._expectK(TokenTypeOpMinus)
+		_p := ps._mark()
+		_1 = ps._expectK(TokenTypeOpRightParen)
+		if _1 != nil {
+			ps._reset(_p)
+		}
 		if _1 == nil {
 			break
 		}
 		return _1
 	}
-	/* '|'
+	/* &'}'
 	 */
 	for {
 		var _1 Node
-		_1 = ps._expectK(TokenTypeOpBar)
+		_p := ps._mark()
+		_1 = ps._expectK(TokenTypeOpRightBrace)
+		if _1 != nil {
+			ps._reset(_p)
+		}
 		if _1 == nil {
 			break
 		}
 		return _1
 	}
-	/* '^'
+	return nil
+}
+
+/*
+paren_list_of_parameter:
+| '(' x=','.X* ','? ')' {x}
+*/
+func (ps *Parser) parenListOfParameter() Node {
+	if !ps._enterDepth() {
+		return nil
+	}
+	defer ps._leaveDepth()
+	/* '(' x=','.X* ','? ')' {x}
 	 */
+	pos := ps._mark()
 	for {
+		var x Node
 		var _1 Node
-		_1 = ps._expectK(TokenTypeOpCaret)
+		_1 = ps._expectK(TokenTypeOpLeftParen)
 		if _1 == nil {
 			break
 		}
-		return _1
+		_2 := make([]Node, 0)
+		var _3 Node
+		var _4 Node
+		_3 = ps.parameter()
+		if _3 != nil {
+			_2 = append(_2, _3)
+			for {
+				_p := ps._mark()
+				_4 = ps._expectK(TokenTypeOpComma)
+				if _4 == nil {
+					break
+				}
+				_3 = ps.parameter()
+				if _3 == nil {
+					ps._reset(_p)
+					break
+				}
+				_2 = append(_2, _3)
+			}
+		}
+		x = NewNodesNode(_2)
+		_ = x
+		var _5 Node
+		_5 = ps._expectK(TokenTypeOpComma)
+		_ = _5
+		var _6 Node
+		_6 = ps._expectK(TokenTypeOpRightParen)
+		if _6 == nil {
+			break
+		}
+		return x
 	}
+	ps._reset(pos)
 	return nil
 }
 
 /*
-mul_op:
-| '*'
-| '/'
-| '%'
-| '<<'
-| '>>'
-| '&'
-| '&^'
+semi_of_var_spec:
+| x=X pseudo_semi {x}
 */
-func (ps *Parser) mulOp() Node {
-	/* '*'
+func (ps *Parser) semiOfVarSpec() Node {
+	if !ps._enterDepth() {
+		return nil
+	}
+	defer ps._leaveDepth()
+	/* x=X pseudo_semi {x}
 	 */
+	pos := ps._mark()
 	for {
-		var _1 Node
-		_1 = ps._expectK(TokenTypeOpStar)
-		if _1 == nil {
+		var x Node
+		x = ps.varSpec()
+		if x == nil {
 			break
 		}
-		return _1
-	}
-	/* '/'
-	 */
-	for {
 		var _1 Node
-		_1 = ps._expectK(TokenTypeOpSlash)
+		_1 = ps.pseudoSemi()
 		if _1 == nil {
 			break
 		}
-		return _1
+		return x
+	}
+	ps._reset(pos)
+	return nil
+}
+
+/*
+semi_of_type_spec:
+| x=X pseudo_semi {x}
+*/
+func (ps *Parser) semiOfTypeSpec() Node {
+	if !ps._enterDepth() {
+		return nil
 	}
-	/* '%'
+	defer ps._leaveDepth()
+	/* x=X pseudo_semi {x}
 	 */
+	pos := ps._mark()
 	for {
-		var _1 Node
-		_1 = ps._expectK(TokenTypeOpPercent)
-		if _1 == nil {
+		var x Node
+		x = ps.typeSpec()
+		if x == nil {
 			break
 		}
-		return _1
-	}
-	/* '<<'
-	 */
-	for {
 		var _1 Node
-		_1 = ps._expectK(TokenTypeOpLessLess)
+		_1 = ps.pseudoSemi()
 		if _1 == nil {
 			break
 		}
-		return _1
+		return x
+	}
+	ps._reset(pos)
+	return nil
+}
+
+/*
+semi_of_statement:
+| x=X pseudo_semi {x}
+*/
+func (ps *Parser) semiOfStatement() Node {
+	if !ps._enterDepth() {
+		return nil
 	}
-	/* '>>'
+	defer ps._leaveDepth()
+	/* x=X pseudo_semi {x}
 	 */
+	pos := ps._mark()
 	for {
-		var _1 Node
-		_1 = ps._expectK(TokenTypeOpGreaterGreater)
-		if _1 == nil {
+		var x Node
+		x = ps.statement()
+		if x == nil {
 			break
 		}
-		return _1
-	}
-	/* '&'
-	 */
-	for {
 		var _1 Node
-		_1 = ps._expectK(TokenTypeOpAnd)
+		_1 = ps.pseudoSemi()
 		if _1 == nil {
 			break
 		}
-		return _1
+		return x
+	}
+	ps._reset(pos)
+	return nil
+}
+
+/*
+semi_of_method_spec:
+| x=X pseudo_semi {x}
+*/
+func (ps *Parser) semiOfMethodSpec() Node {
+	if !ps._enterDepth() {
+		return nil
 	}
-	/* '&^'
+	defer ps._leaveDepth()
+	/* x=X pseudo_semi {x}
 	 */
+	pos := ps._mark()
 	for {
+		var x Node
+		x = ps.methodSpec()
+		if x == nil {
+			break
+		}
 		var _1 Node
-		_1 = ps._expectK(TokenTypeOpAndCaret)
+		_1 = ps.pseudoSemi()
 		if _1 == nil {
 			break
 		}
-		return _1
+		return x
 	}
+	ps._reset(pos)
 	return nil
 }
 
 /*
-unary_op:
-| '+'
-| '-'
-| '!'
-| '^'
-| '*'
-| '&'
-| '<-'
+paren_list_of_argument:
+| '(' x=','.X* ','? ')' {x}
 */
-func (ps *Parser) unaryOp() Node {
-	/* '+'
+func (ps *Parser) parenListOfArgument() Node {
+	if !ps._enterDepth() {
+		return nil
+	}
+	defer ps._leaveDepth()
+	/* '(' x=','.X* ','? ')' {x}
 	 */
+	pos := ps._mark()
 	for {
+		var x Node
 		var _1 Node
-		_1 = ps._expectK(TokenTypeOpPlus)
+		_1 = ps._expectK(TokenTypeOpLeftParen)
 		if _1 == nil {
 			break
 		}
-		return _1
+		_2 := make([]Node, 0)
+		var _3 Node
+		var _4 Node
+		_3 = ps.argument()
+		if _3 != nil {
+			_2 = append(_2, _3)
+			for {
+				_p := ps._mark()
+				_4 = ps._expectK(TokenTypeOpComma)
+				if _4 == nil {
+					break
+				}
+				_3 = ps.argument()
+				if _3 == nil {
+					ps._reset(_p)
+					break
+				}
+				_2 = append(_2, _3)
+			}
+		}
+		x = NewNodesNode(_2)
+		_ = x
+		var _5 Node
+		_5 = ps._expectK(TokenTypeOpComma)
+		_ = _5
+		var _6 Node
+		_6 = ps._expectK(TokenTypeOpRightParen)
+		if _6 == nil {
+			break
+		}
+		return x
 	}
-	/* '-'
+	ps._reset(pos)
+	return nil
+}
+
+/*
+semi_of_field_decl:
+| x=X pseudo_semi {x}
+*/
+func (ps *Parser) semiOfFieldDecl() Node {
+	if !ps._enterDepth() {
+		return nil
+	}
+	defer ps._leaveDepth()
+	/* x=X pseudo_semi {x}
 	 */
+	pos := ps._mark()
 	for {
+		var x Node
+		x = ps.fieldDecl()
+		if x == nil {
+			break
+		}
 		var _1 Node
-		_1 = ps._expectK(TokenTypeOpMinus)
+		_1 = ps.pseudoSemi()
 		if _1 == nil {
 			break
 		}
-		return _1
+		return x
 	}
-	/* '!'
+	ps._reset(pos)
+	return nil
+}
+
+/*
+_group_1:
+| import_dot
+| import_ident
+*/
+func (ps *Parser) _group1() Node {
+	if !ps._enterDepth() {
+		return nil
+	}
+	defer ps._leaveDepth()
+	/* import_dot
 	 */
 	for {
 		var _1 Node
-		_1 = ps._expectK(TokenTypeOpNot)
+		_1 = ps.importDot()
 		if _1 == nil {
 			break
 		}
 		return _1
 	}
-	/* '^'
+	/* import_ident
 	 */
 	for {
 		var _1 Node
-		_1 = ps._expectK(TokenTypeOpCaret)
+		_1 = ps.importIdent()
 		if _1 == nil {
 			break
 		}
 		return _1
 	}
-	/* '*'
+	return nil
+}
+
+/*
+_group_2:
+| receiver_type
+| star_receiver_type
+*/
+func (ps *Parser) _group2() Node {
+	if !ps._enterDepth() {
+		return nil
+	}
+	defer ps._leaveDepth()
+	/* receiver_type
 	 */
 	for {
 		var _1 Node
-		_1 = ps._expectK(TokenTypeOpStar)
+		_1 = ps.receiverType()
 		if _1 == nil {
 			break
 		}
 		return _1
 	}
-	/* '&'
+	/* star_receiver_type
 	 */
 	for {
 		var _1 Node
-		_1 = ps._expectK(TokenTypeOpAnd)
+		_1 = ps.starReceiverType()
 		if _1 == nil {
 			break
 		}
 		return _1
 	}
-	/* '<-'
+	return nil
+}
+
+/*
+_group_3:
+| '~'? t=type {t}
+*/
+func (ps *Parser) _group3() Node {
+	if !ps._enterDepth() {
+		return nil
+	}
+	defer ps._leaveDepth()
+	/* '~'? t=type {t}
 	 */
+	pos := ps._mark()
 	for {
+		var t Node
 		var _1 Node
-		_1 = ps._expectK(TokenTypeOpLessMinus)
-		if _1 == nil {
+		_1 = ps._expectK(TokenTypeOpTilde)
+		_ = _1
+		t = ps.type_()
+		if t == nil {
 			break
 		}
-		return _1
+		return t
 	}
+	ps._reset(pos)
 	return nil
 }
 
 /*
-composite_lit:
-| x=type_name_or_generic_type_instantiation? '{' y=','.keyed_element* ','? '}' {composite_lit(x, y)}
+_group_4:
+| a='chan' b='<-' {a..b}
+| a='<-' b='chan' {a..b}
+| 'chan'
 */
-func (ps *Parser) compositeLit() Node {
-	/* x=type_name_or_generic_type_instantiation? '{' y=','.keyed_element* ','? '}' {composite_lit(x, y)}
+func (ps *Parser) _group4() Node {
+	if !ps._enterDepth() {
+		return nil
+	}
+	defer ps._leaveDepth()
+	/* a='chan' b='<-' {a..b}
 	 */
 	pos := ps._mark()
 	for {
-		var x Node
-		var y Node
-		x = ps.typeNameOrGenericTypeInstantiation()
-		_ = x
-		var _1 Node
-		_1 = ps._expectK(TokenTypeOpLeftBrace)
-		if _1 == nil {
+		var a Node
+		var b Node
+		a = ps._expectK(TokenTypeKwChan)
+		if a == nil {
 			break
 		}
-		_2 := make([]Node, 0)
-		var _3 Node
-		var _4 Node
-		_3 = ps.keyedElement()
-		if _3 != nil {
-			_2 = append(_2, _3)
-			for {
-				_p := ps._mark()
-				_4 = ps._expectK(TokenTypeOpComma)
-				if _4 == nil {
-					break
-				}
-				_3 = ps.keyedElement()
-				if _3 == nil {
-					ps._reset(_p)
-					break
-				}
-				_2 = append(_2, _3)
-			}
+		b = ps._expectK(TokenTypeOpLessMinus)
+		if b == nil {
+			break
+		}
+		return ps._pseudoToken(a, b)
+	}
+	ps._reset(pos)
+	/* a='<-' b='chan' {a..b}
+	 */
+	for {
+		var a Node
+		var b Node
+		a = ps._expectK(TokenTypeOpLessMinus)
+		if a == nil {
+			break
 		}
-		y = NewNodesNode(_2)
-		_ = y
-		var _5 Node
-		_5 = ps._expectK(TokenTypeOpComma)
-		_ = _5
-		var _6 Node
-		_6 = ps._expectK(TokenTypeOpRightBrace)
-		if _6 == nil {
+		b = ps._expectK(TokenTypeKwChan)
+		if b == nil {
 			break
 		}
-		return NewCompositeLitNode(ps._filePath, ps._fileContent, x, y, ps._tokens[pos].Start, ps._visibleTokenBefore(ps._mark()).End)
+		return ps._pseudoToken(a, b)
 	}
 	ps._reset(pos)
-	return nil
-}
-
-/*
-ellipsis:
-| '...' {ellipsis(_)}
-*/
-func (ps *Parser) ellipsis() Node {
-	/* '...' {ellipsis(_)}
+	/* 'chan'
 	 */
-	pos := ps._mark()
 	for {
 		var _1 Node
-		_1 = ps._expectK(TokenTypeOpDotDotDot)
+		_1 = ps._expectK(TokenTypeKwChan)
 		if _1 == nil {
 			break
 		}
-		return NewEllipsisNode(ps._filePath, ps._fileContent, nil, ps._tokens[pos].Start, ps._visibleTokenBefore(ps._mark()).End)
+		return _1
 	}
-	ps._reset(pos)
 	return nil
 }
 
 /*
-literal_type:
-| struct_type
-| '[' e=ellipsis ']' x=type {array_type(e,x)}
-| array_type
-| map_type
+_group_5:
+| '*'
+| '+'
+| '-'
+| '!'
+| '^'
+| '*'
+| '&'
+| '<-'
 */
-func (ps *Parser) literalType() Node {
-	/* struct_type
+func (ps *Parser) _group5() Node {
+	if !ps._enterDepth() {
+		return nil
+	}
+	defer ps._leaveDepth()
+	/* '*'
 	 */
 	for {
 		var _1 Node
-		_1 = ps.structType()
+		_1 = ps._expectK(TokenTypeOpStar)
 		if _1 == nil {
 			break
 		}
 		return _1
 	}
-	/* '[' e=ellipsis ']' x=type {array_type(e,x)}
+	/* '+'
 	 */
-	pos := ps._mark()
 	for {
-		var e Node
-		var x Node
 		var _1 Node
-		_1 = ps._expectK(TokenTypeOpLeftBracket)
+		_1 = ps._expectK(TokenTypeOpPlus)
 		if _1 == nil {
 			break
 		}
-		e = ps.ellipsis()
-		if e == nil {
-			break
-		}
-		var _2 Node
-		_2 = ps._expectK(TokenTypeOpRightBracket)
-		if _2 == nil {
-			break
-		}
-		x = ps.type_()
-		if x == nil {
-			break
-		}
-		return NewArrayTypeNode(ps._filePath, ps._fileContent, e, x, ps._tokens[pos].Start, ps._visibleTokenBefore(ps._mark()).End)
+		return _1
 	}
-	ps._reset(pos)
-	/* array_type
+	/* '-'
 	 */
 	for {
 		var _1 Node
-		_1 = ps.arrayType()
+		_1 = ps._expectK(TokenTypeOpMinus)
 		if _1 == nil {
 			break
 		}
 		return _1
 	}
-	/* map_type
+	/* '!'
 	 */
 	for {
 		var _1 Node
-		_1 = ps.mapType()
+		_1 = ps._expectK(TokenTypeOpNot)
 		if _1 == nil {
 			break
 		}
 		return _1
 	}
-	return nil
-}
-
-/*
-keyed_element:
-| x=expression ':' y=expression {key_value_expr(x,y)}
-| expression
-*/
-func (ps *Parser) keyedElement() Node {
-	/* x=expression ':' y=expression {key_value_expr(x,y)}
+	/* '^'
 	 */
-	pos := ps._mark()
 	for {
-		var x Node
-		var y Node
-		x = ps.expression()
-		if x == nil {
+		var _1 Node
+		_1 = ps._expectK(TokenTypeOpCaret)
+		if _1 == nil {
 			break
 		}
+		return _1
+	}
+	/* '*'
+	 */
+	for {
 		var _1 Node
-		_1 = ps._expectK(TokenTypeOpColon)
+		_1 = ps._expectK(TokenTypeOpStar)
 		if _1 == nil {
 			break
 		}
-		y = ps.expression()
-		if y == nil {
+		return _1
+	}
+	/* '&'
+	 */
+	for {
+		var _1 Node
+		_1 = ps._expectK(TokenTypeOpAnd)
+		if _1 == nil {
 			break
 		}
-		return NewKeyValueExprNode(ps._filePath, ps._fileContent, x, y, ps._tokens[pos].Start, ps._visibleTokenBefore(ps._mark()).End)
+		return _1
 	}
-	ps._reset(pos)
-	/* expression
+	/* '<-'
 	 */
 	for {
 		var _1 Node
-		_1 = ps.expression()
+		_1 = ps._expectK(TokenTypeOpLessMinus)
 		if _1 == nil {
 			break
 		}
-		return _1
+		return _1
+	}
+	return nil
+}
+
+func (tk *Tokenizer) Clean(tokens []*Token) []*Token {
+	ret := make([]*Token, 0)
+	var last *Token
+	for _, tok := range tokens {
+		// insert optional semicolon
+		// The formal grammar uses semicolons ";" as terminators in a number of productions. Go programs may omit most of these semicolons using the following two rules:
+		//
+		// When the input is broken into tokens, a semicolon is automatically inserted into the token stream immediately after a line's final token if that token is
+		// an identifier
+		// an integer, floating-point, imaginary, rune, or string literal
+		// one of the keywords break, continue, fallthrough, or return
+		// one of the operators and punctuation ++, --, ), ], or }
+		// To allow complex statements to occupy a single line, a semicolon may be omitted before a closing ")" or "}".
+		if tok.Kind == TokenTypeNewline {
+			if last != nil && last.Kind != TokenTypeOpSemi {
+				insertSemi := false
+				switch last.Kind {
+				case TokenTypeIdent:
+					insertSemi = true
+				case TokenTypeString:
+					insertSemi = true
+				case TokenTypeOpRightParen, TokenTypeOpRightBracket, TokenTypeOpRightBrace:
+					// ),],}
+					insertSemi = true
+				case TokenTypeOpPlusPlus, TokenTypeOpMinusMinus:
+					// ++,--
+					insertSemi = true
+				case TokenTypeNumber:
+					insertSemi = true
+				case TokenTypeKwFallthrough, TokenTypeKwReturn, TokenTypeKwBreak, TokenTypeKwContinue:
+					insertSemi = true
+				}
+
+				if insertSemi {
+					last = NewToken(TokenTypeOpSemi, last.Start, last.End, []rune(";"))
+					ret = append(ret, last)
+				}
+			}
+		}
+
+		if tok.Kind == TokenTypeWhitespace || tok.Kind == TokenTypeNewline || tok.Kind == TokenTypeComment {
+			continue
+		}
+
+		ret = append(ret, tok)
+		last = tok
+		if tok.Kind == TokenTypeEndOfFile {
+			break
+		}
+	}
+	return ret
+}
+
+// ctrlClause marks the header of an if/for/switch statement opened at depth-1 brackets, where a
+// composite literal is only allowed inside a deeper pair of brackets.
+type ctrlClause struct {
+	depth int
+	prev  *ctrlClause
+}
+
+func (ps *Parser) enterCtrl() {
+	ps.state.ctrl = &ctrlClause{depth: ps._bracketDepth + 1, prev: ps.state.ctrl}
+}
+
+func (ps *Parser) leaveCtrl() {
+	if ps.state.ctrl != nil {
+		ps.state.ctrl = ps.state.ctrl.prev
+	}
+}
+
+func (ps *Parser) inControlClause() bool {
+	return ps.state.ctrl != nil && ps._bracketDepth < ps.state.ctrl.depth
+}
+func DumpNode(n Node, hook func(Node, map[string]string) string) string {
+	return CustomDumpNode(n, hook)
+}
+
+// dumpNodeList dumps a list field as a JSON array of its elements, so empty and
+// non-empty lists have the same shape.
+func dumpNodeList(n Node, hook func(Node, map[string]string) string) string {
+	if nodes, ok := n.(*NodesNode); ok {
+		return nodes.dumpNodes(hook)
+	}
+	if n.IsDummy() {
+		return "[]"
+	}
+	return DumpNode(n, hook)
+}
+
+func dumpString(s string) string {
+	var buf bytes.Buffer
+	encoder := json.NewEncoder(&buf)
+	encoder.SetEscapeHTML(false)
+	_ = encoder.Encode(s)
+	return strings.TrimSuffix(buf.String(), "\n")
+}
+
+func DumpNodeIndent(node Node) string {
+	result := SimpleDumpNode(node)
+	var v any
+	err := json.Unmarshal([]byte(result), &v)
+	if err != nil {
+		return result
+	}
+	b, _ := json.MarshalIndent(v, "", "  ")
+	return string(b)
+}
+
+func CustomDumpNode(node Node, hook func(Node, map[string]string) string) string {
+	if node.IsDummy() {
+		return "null"
+	}
+	itemMap := node.Dump(hook)
+	start, end := node.Range()
+	itemMap["start"] = fmt.Sprintf("[%d, %d, %d]", start.Offset, start.LineIdx, start.CharIdx)
+	itemMap["end"] = fmt.Sprintf("[%d, %d, %d]", end.Offset, end.LineIdx, end.CharIdx)
+	ret := hook(node, itemMap)
+	if ret != "" {
+		return ret
+	}
+	items := make([]string, 0)
+	for k, v := range itemMap {
+		if k == "kind" {
+			continue
+		}
+		items = append(items, fmt.Sprintf("\"%s\": %s", k, v))
+	}
+	sort.Strings(items)
+	items = append([]string{fmt.Sprintf("\"kind\": %s", itemMap["kind"])}, items...)
+	return fmt.Sprintf("{%s}", strings.Join(items, ", "))
+}
+
+func SimpleDumpNode(node Node) string {
+	return CustomDumpNode(node, func(n Node, m map[string]string) string {
+		return ""
+	})
+}
+
+type dumpedNode struct {
+	kind     string
+	start    Position
+	end      Position
+	code     string
+	typ      string
+	children []*dumpedNode
+}
+
+// LoadNode rebuilds a tree from the output of SimpleDumpNode. The dump holds neither the
+// file path nor the file content, so the loaded tree has an empty FilePath and its content
+// is reconstructed from the dumped tokens: comments, other trivia and tokens dropped by
+// actions become spaces, line breaks are kept only before tokens, and Code and error
+// messages show this reconstructed text instead of the original source.
+func LoadNode(b []byte) (Node, error) {
+	root, err := decodeDumpedNode(b)
+	if err != nil {
+		return nil, err
+	}
+	return root.build()
+}
+
+// loadMaxSize bounds the file content rebuilt by LoadNode and UnmarshalBinary, so a
+// crafted offset cannot force a huge allocation.
+const loadMaxSize = 1 << 24
+
+func (root *dumpedNode) build() (Node, error) {
+	if root == nil {
+		return DummyNode, nil
+	}
+	size := 0
+	var tokens []*dumpedNode
+	var collect func(*dumpedNode) error
+	collect = func(d *dumpedNode) error {
+		if d == nil {
+			return nil
+		}
+		if d.start.Offset < 0 || d.start.Offset > d.end.Offset {
+			return fmt.Errorf("load error: invalid range of %s", d.kind)
+		}
+		if d.end.Offset > loadMaxSize {
+			return fmt.Errorf("load error: range of %s exceeds %d", d.kind, loadMaxSize)
+		}
+		if d.end.Offset > size {
+			size = d.end.Offset
+		}
+		if d.kind == NodeTypeToken && d.typ != TokenTypeLiteral {
+			tokens = append(tokens, d)
+		}
+		for _, child := range d.children {
+			if err := collect(child); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	if err := collect(root); err != nil {
+		return nil, err
+	}
+	content := make([]rune, size)
+	for i := range content {
+		content[i] = ' '
+	}
+	for _, tok := range tokens {
+		if nl := tok.start.Offset - tok.start.CharIdx - 1; tok.start.LineIdx > 0 && nl >= 0 && nl < size {
+			content[nl] = '\n'
+		}
+	}
+	for _, tok := range tokens {
+		code := []rune(tok.code)
+		if tok.start.Offset+len(code) > size {
+			return nil, fmt.Errorf("load error: token %q out of range", tok.code)
+		}
+		copy(content[tok.start.Offset:], code)
 	}
-	return nil
+	return buildDumpedNode(root, content), nil
 }
 
-/*
-array_type:
-| '[' ']' x=type {array_type(_,x)}
-| '[' e=ellipsis ']' x=type {array_type(e,x)}
-| '[' x=expression ']' y=type {array_type(x,y)}
-*/
-func (ps *Parser) arrayType() Node {
-	/* '[' ']' x=type {array_type(_,x)}
-	 */
-	pos := ps._mark()
-	for {
-		var x Node
-		var _1 Node
-		_1 = ps._expectK(TokenTypeOpLeftBracket)
-		if _1 == nil {
-			break
+func decodeDumpedNode(b []byte) (*dumpedNode, error) {
+	if b = bytes.TrimSpace(b); len(b) > 0 && b[0] == '[' {
+		return decodeDumpedList(b)
+	}
+	var items map[string]json.RawMessage
+	if err := json.Unmarshal(b, &items); err != nil {
+		return nil, fmt.Errorf("load error: %w", err)
+	}
+	if items == nil {
+		return nil, nil
+	}
+	ret := &dumpedNode{}
+	if err := json.Unmarshal(items["kind"], &ret.kind); err != nil {
+		return nil, fmt.Errorf("load error: invalid kind: %w", err)
+	}
+	for key, pos := range map[string]*Position{"start": &ret.start, "end": &ret.end} {
+		var v [3]int
+		if err := json.Unmarshal(items[key], &v); err != nil {
+			return nil, fmt.Errorf("load error: invalid %s of %s: %w", key, ret.kind, err)
 		}
-		var _2 Node
-		_2 = ps._expectK(TokenTypeOpRightBracket)
-		if _2 == nil {
-			break
+		*pos = Position{Offset: v[0], LineIdx: v[1], CharIdx: v[2]}
+	}
+	switch ret.kind {
+	case NodeTypeToken:
+		if err := json.Unmarshal(items["code"], &ret.code); err != nil {
+			return nil, fmt.Errorf("load error: invalid token code: %w", err)
 		}
-		x = ps.type_()
-		if x == nil {
-			break
+		if err := json.Unmarshal(items["type"], &ret.typ); err != nil {
+			return nil, fmt.Errorf("load error: invalid token type: %w", err)
 		}
-		return NewArrayTypeNode(ps._filePath, ps._fileContent, nil, x, ps._tokens[pos].Start, ps._visibleTokenBefore(ps._mark()).End)
-	}
-	ps._reset(pos)
-	/* '[' e=ellipsis ']' x=type {array_type(e,x)}
-	 */
-	for {
-		var e Node
-		var x Node
-		var _1 Node
-		_1 = ps._expectK(TokenTypeOpLeftBracket)
-		if _1 == nil {
-			break
+	case NodeTypeNodes:
+		var nodes []json.RawMessage
+		if err := json.Unmarshal(items["nodes"], &nodes); err != nil {
+			return nil, fmt.Errorf("load error: invalid nodes: %w", err)
 		}
-		e = ps.ellipsis()
-		if e == nil {
-			break
+		for _, item := range nodes {
+			child, err := decodeDumpedNode(item)
+			if err != nil {
+				return nil, err
+			}
+			if child != nil {
+				ret.children = append(ret.children, child)
+			}
 		}
-		var _2 Node
-		_2 = ps._expectK(TokenTypeOpRightBracket)
-		if _2 == nil {
-			break
+	default:
+		fields, ok := nodeKindFields[ret.kind]
+		if !ok {
+			return nil, fmt.Errorf("load error: unknown kind '%s'", ret.kind)
+		}
+		for _, field := range fields {
+			var child *dumpedNode
+			if item, ok := items[strings.TrimRight(field, "_")]; ok {
+				var err error
+				if child, err = decodeDumpedNode(item); err != nil {
+					return nil, err
+				}
+			}
+			ret.children = append(ret.children, child)
 		}
-		x = ps.type_()
-		if x == nil {
-			break
+	}
+	return ret, nil
+}
+
+// decodeDumpedList decodes a list field dumped as a JSON array into a nodes node
+// spanning its elements; an empty list is a dummy node.
+func decodeDumpedList(b []byte) (*dumpedNode, error) {
+	var items []json.RawMessage
+	if err := json.Unmarshal(b, &items); err != nil {
+		return nil, fmt.Errorf("load error: invalid list: %w", err)
+	}
+	ret := &dumpedNode{kind: NodeTypeNodes}
+	for _, item := range items {
+		child, err := decodeDumpedNode(item)
+		if err != nil {
+			return nil, err
+		}
+		if child != nil {
+			ret.children = append(ret.children, child)
 		}
-		return NewArrayTypeNode(ps._filePath, ps._fileContent, e, x, ps._tokens[pos].Start, ps._visibleTokenBefore(ps._mark()).End)
 	}
-	ps._reset(pos)
-	/* '[' x=expression ']' y=type {array_type(x,y)}
-	 */
-	for {
-		var x Node
-		var y Node
-		var _1 Node
-		_1 = ps._expectK(TokenTypeOpLeftBracket)
-		if _1 == nil {
-			break
+	if len(ret.children) == 0 {
+		return nil, nil
+	}
+	ret.start = ret.children[0].start
+	ret.end = ret.children[len(ret.children)-1].end
+	return ret, nil
+}
+
+func buildDumpedNode(d *dumpedNode, content []rune) Node {
+	if d == nil {
+		return nil
+	}
+	switch d.kind {
+	case NodeTypeToken:
+		if d.typ == TokenTypeLiteral {
+			return NewTokenNode("", content, NewToken(d.typ, d.start, d.end, []rune(d.code)))
 		}
-		x = ps.expression()
-		if x == nil {
-			break
+		return NewTokenNode("", content, NewToken(d.typ, d.start, d.end, content[d.start.Offset:d.end.Offset]))
+	case NodeTypeNodes:
+		nodes := make([]Node, 0, len(d.children))
+		for _, child := range d.children {
+			nodes = append(nodes, buildDumpedNode(child, content))
 		}
-		var _2 Node
-		_2 = ps._expectK(TokenTypeOpRightBracket)
-		if _2 == nil {
-			break
+		ret := NewNodesNode(nodes)
+		if !ret.IsDummy() {
+			ret.SetRange(d.start, d.end)
 		}
-		y = ps.type_()
-		if y == nil {
-			break
+		return ret
+	default:
+		children := make([]Node, 0, len(d.children))
+		for _, child := range d.children {
+			children = append(children, buildDumpedNode(child, content))
 		}
-		return NewArrayTypeNode(ps._filePath, ps._fileContent, x, y, ps._tokens[pos].Start, ps._visibleTokenBefore(ps._mark()).End)
+		return newNodeOfKind(d.kind, "", content, children, d.start, d.end)
 	}
-	ps._reset(pos)
-	return nil
 }
 
-/*
-method_spec:
-| x=IDENT y=signature {field([x],y,_)}
-*/
-func (ps *Parser) methodSpec() Node {
-	/* x=IDENT y=signature {field([x],y,_)}
-	 */
-	pos := ps._mark()
-	for {
-		var x Node
-		var y Node
-		x = ps._expectK(TokenTypeIdent)
-		if x == nil {
-			break
+const binaryMagic = "PGAB"
+
+var nodeKindIds map[string]int
+
+func init() {
+	nodeKindIds = make(map[string]int)
+	for i, kind := range nodeKinds {
+		nodeKindIds[kind] = i
+	}
+}
+
+type binaryEncoder struct {
+	buf     []byte
+	strings []string
+	ids     map[string]int
+}
+
+func (e *binaryEncoder) uvarint(v int) {
+	e.buf = binary.AppendUvarint(e.buf, uint64(v))
+}
+
+func (e *binaryEncoder) string(s string) {
+	id, ok := e.ids[s]
+	if !ok {
+		id = len(e.strings)
+		e.ids[s] = id
+		e.strings = append(e.strings, s)
+	}
+	e.uvarint(id)
+}
+
+func (e *binaryEncoder) position(p Position) {
+	e.uvarint(p.Offset)
+	e.uvarint(p.LineIdx)
+	e.uvarint(p.CharIdx)
+}
+
+func (e *binaryEncoder) node(node Node) error {
+	if node == nil || node.IsDummy() {
+		e.uvarint(0)
+		return nil
+	}
+	id, ok := nodeKindIds[node.Kind()]
+	if !ok {
+		return fmt.Errorf("marshal error: unknown kind '%s'", node.Kind())
+	}
+	e.uvarint(id + 1)
+	start, end := node.Range()
+	e.position(start)
+	e.position(end)
+	if tok, ok := node.(*TokenNode); ok {
+		e.string(tok.token.Kind)
+		e.string(string(tok.Code()))
+		return nil
+	}
+	if node.Kind() == NodeTypeNodes {
+		nodes := node.UnpackNodes()
+		e.uvarint(len(nodes))
+		for _, child := range nodes {
+			if err := e.node(child); err != nil {
+				return err
+			}
 		}
-		y = ps.signature()
-		if y == nil {
-			break
+		return nil
+	}
+	for _, field := range nodeKindFields[node.Kind()] {
+		if err := e.node(node.Child(field)); err != nil {
+			return err
 		}
-		return NewFieldNode(ps._filePath, ps._fileContent, NewNodesNode([]Node{x}), y, nil, ps._tokens[pos].Start, ps._visibleTokenBefore(ps._mark()).End)
 	}
-	ps._reset(pos)
 	return nil
 }
 
-/*
-struct_body:
-| '{' x=field_decl_semi* '}' {field_list(x)}
-*/
-func (ps *Parser) structBody() Node {
-	/* '{' x=field_decl_semi* '}' {field_list(x)}
-	 */
-	pos := ps._mark()
-	for {
-		var x Node
-		var _1 Node
-		_1 = ps._expectK(TokenTypeOpLeftBrace)
-		if _1 == nil {
-			break
+// MarshalBinary encodes a tree as the magic, the node schema hash, a string table for
+// token types and codes, then the nodes in pre-order with uvarint kind ids and positions.
+func MarshalBinary(node Node) ([]byte, error) {
+	body := &binaryEncoder{ids: make(map[string]int)}
+	if err := body.node(node); err != nil {
+		return nil, err
+	}
+	ret := []byte(binaryMagic)
+	ret = binary.BigEndian.AppendUint64(ret, nodeSchemaHash)
+	ret = binary.AppendUvarint(ret, uint64(len(body.strings)))
+	for _, s := range body.strings {
+		ret = binary.AppendUvarint(ret, uint64(len(s)))
+		ret = append(ret, s...)
+	}
+	return append(ret, body.buf...), nil
+}
+
+type binaryDecoder struct {
+	buf     []byte
+	strings []string
+}
+
+func (d *binaryDecoder) uvarint() (int, error) {
+	v, n := binary.Uvarint(d.buf)
+	if n <= 0 || v > 1<<31-1 {
+		return 0, errors.New("unmarshal error: invalid uvarint")
+	}
+	d.buf = d.buf[n:]
+	return int(v), nil
+}
+
+func (d *binaryDecoder) string() (string, error) {
+	id, err := d.uvarint()
+	if err != nil {
+		return "", err
+	}
+	if id >= len(d.strings) {
+		return "", fmt.Errorf("unmarshal error: invalid string id %d", id)
+	}
+	return d.strings[id], nil
+}
+
+func (d *binaryDecoder) position() (p Position, err error) {
+	for _, v := range []*int{&p.Offset, &p.LineIdx, &p.CharIdx} {
+		if *v, err = d.uvarint(); err != nil {
+			return p, err
 		}
-		_2 := make([]Node, 0)
-		var _3 Node
-		for {
-			_3 = ps.fieldDeclSemi()
-			if _3 == nil {
-				break
-			}
-			_2 = append(_2, _3)
+		if *v > loadMaxSize {
+			return p, fmt.Errorf("unmarshal error: position %d exceeds %d", *v, loadMaxSize)
 		}
-		x = NewNodesNode(_2)
-		_ = x
-		var _4 Node
-		_4 = ps._expectK(TokenTypeOpRightBrace)
-		if _4 == nil {
-			break
+	}
+	return p, nil
+}
+
+func (d *binaryDecoder) node(depth int) (*dumpedNode, error) {
+	if depth > DefaultMaxDepth {
+		return nil, fmt.Errorf("unmarshal error: nesting exceeds %d", DefaultMaxDepth)
+	}
+	id, err := d.uvarint()
+	if err != nil || id == 0 {
+		return nil, err
+	}
+	if id > len(nodeKinds) {
+		return nil, fmt.Errorf("unmarshal error: invalid kind id %d", id)
+	}
+	ret := &dumpedNode{kind: nodeKinds[id-1]}
+	if ret.start, err = d.position(); err != nil {
+		return nil, err
+	}
+	if ret.end, err = d.position(); err != nil {
+		return nil, err
+	}
+	count := len(nodeKindFields[ret.kind])
+	switch ret.kind {
+	case NodeTypeToken:
+		if ret.typ, err = d.string(); err != nil {
+			return nil, err
+		}
+		ret.code, err = d.string()
+		return ret, err
+	case NodeTypeNodes:
+		if count, err = d.uvarint(); err != nil {
+			return nil, err
+		}
+		if count > len(d.buf) {
+			return nil, errors.New("unmarshal error: invalid nodes count")
 		}
-		return NewFieldListNode(ps._filePath, ps._fileContent, x, ps._tokens[pos].Start, ps._visibleTokenBefore(ps._mark()).End)
 	}
-	ps._reset(pos)
-	return nil
+	for i := 0; i < count; i++ {
+		child, err := d.node(depth + 1)
+		if err != nil {
+			return nil, err
+		}
+		if child != nil || ret.kind != NodeTypeNodes {
+			ret.children = append(ret.children, child)
+		}
+	}
+	return ret, nil
 }
 
-/*
-struct_type:
-| 'struct' b=struct_body {struct_type(b)}
-*/
-func (ps *Parser) structType() Node {
-	/* 'struct' b=struct_body {struct_type(b)}
-	 */
-	pos := ps._mark()
-	for {
-		var b Node
-		var _1 Node
-		_1 = ps._expectK(TokenTypeKwStruct)
-		if _1 == nil {
-			break
+func UnmarshalBinary(b []byte) (Node, error) {
+	if len(b) < len(binaryMagic)+8 || string(b[:len(binaryMagic)]) != binaryMagic {
+		return nil, errors.New("unmarshal error: invalid magic")
+	}
+	b = b[len(binaryMagic):]
+	if hash := binary.BigEndian.Uint64(b); hash != nodeSchemaHash {
+		return nil, fmt.Errorf("unmarshal error: node schema mismatch: %016x, expect %016x", hash, nodeSchemaHash)
+	}
+	d := &binaryDecoder{buf: b[8:]}
+	count, err := d.uvarint()
+	if err != nil {
+		return nil, err
+	}
+	if count > len(d.buf) {
+		return nil, errors.New("unmarshal error: invalid string table")
+	}
+	for i := 0; i < count; i++ {
+		n, err := d.uvarint()
+		if err != nil {
+			return nil, err
 		}
-		b = ps.structBody()
-		if b == nil {
-			break
+		if n > len(d.buf) {
+			return nil, errors.New("unmarshal error: invalid string length")
 		}
-		return NewStructTypeNode(ps._filePath, ps._fileContent, b, ps._tokens[pos].Start, ps._visibleTokenBefore(ps._mark()).End)
+		d.strings = append(d.strings, string(d.buf[:n]))
+		d.buf = d.buf[n:]
 	}
-	ps._reset(pos)
-	return nil
+	root, err := d.node(0)
+	if err != nil {
+		return nil, err
+	}
+	if len(d.buf) != 0 {
+		return nil, errors.New("unmarshal error: trailing data")
+	}
+	return root.build()
 }
 
-/*
-field_decl_semi:
-| x=field_decl pseudo_semi {x}
-*/
-func (ps *Parser) fieldDeclSemi() Node {
-	/* x=field_decl pseudo_semi {x}
-	 */
-	pos := ps._mark()
-	for {
-		var x Node
-		x = ps.fieldDecl()
-		if x == nil {
-			break
+type queryPredicate struct {
+	attr  []string
+	op    string
+	value string
+}
+
+type queryStep struct {
+	descendant bool
+	name       string
+	kind       string
+	predicates []*queryPredicate
+}
+
+type Query struct {
+	steps []*queryStep
+}
+
+// CompileQuery parses a path such as "//call_expr[callee.code='print']/argument".
+// A step name matches either the field name or the kind of a child; "*" matches any
+// child, ":kind" filters by kind, "//" searches all descendants.
+func CompileQuery(query string) (*Query, error) {
+	qp := &queryParser{query: query}
+	steps := make([]*queryStep, 0)
+	for qp.pos < len(qp.query) {
+		step := &queryStep{}
+		if strings.HasPrefix(qp.query[qp.pos:], "//") {
+			step.descendant = true
+			qp.pos += 2
+		} else if qp.query[qp.pos] == '/' {
+			qp.pos++
+		} else if len(steps) > 0 {
+			return nil, qp.error("expect '/'")
 		}
-		var _1 Node
-		_1 = ps.pseudoSemi()
-		if _1 == nil {
+		step.name = qp.name()
+		if qp.peek() == ':' {
+			qp.pos++
+			if step.kind = qp.name(); step.kind == "" {
+				return nil, qp.error("expect kind")
+			}
+		}
+		if step.name == "" {
+			if step.kind == "" {
+				return nil, qp.error("expect step")
+			}
+			step.name = "*"
+		}
+		for qp.peek() == '[' {
+			predicate, err := qp.predicate()
+			if err != nil {
+				return nil, err
+			}
+			step.predicates = append(step.predicates, predicate)
+		}
+		steps = append(steps, step)
+	}
+	return &Query{steps: steps}, nil
+}
+
+type queryParser struct {
+	query string
+	pos   int
+}
+
+func (qp *queryParser) error(msg string) error {
+	return fmt.Errorf("query error: %s at %d: %s", msg, qp.pos, qp.query)
+}
+
+func (qp *queryParser) peek() byte {
+	if qp.pos < len(qp.query) {
+		return qp.query[qp.pos]
+	}
+	return 0
+}
+
+func (qp *queryParser) skipSpaces() {
+	for qp.peek() == ' ' {
+		qp.pos++
+	}
+}
+
+func (qp *queryParser) name() string {
+	start := qp.pos
+	if strings.HasPrefix(qp.query[qp.pos:], "..") {
+		qp.pos += 2
+		return ".."
+	}
+	if qp.peek() == '.' {
+		qp.pos++
+		return "."
+	}
+	for qp.pos < len(qp.query) {
+		ch := qp.query[qp.pos]
+		if ch != '_' && ch != '*' && !(ch >= 'a' && ch <= 'z') && !(ch >= 'A' && ch <= 'Z') && !(ch >= '0' && ch <= '9') {
 			break
 		}
-		return x
+		qp.pos++
 	}
-	ps._reset(pos)
-	return nil
+	return qp.query[start:qp.pos]
 }
 
-/*
-field_decl:
-| x=identifier_list y=type z=tag? {field(x,y,z)}
-| x=embedded_field y=tag? {field(_,x,y)}
-*/
-func (ps *Parser) fieldDecl() Node {
-	/* x=identifier_list y=type z=tag? {field(x,y,z)}
-	 */
-	pos := ps._mark()
+func (qp *queryParser) predicate() (*queryPredicate, error) {
+	qp.pos++
+	qp.skipSpaces()
+	ret := &queryPredicate{}
 	for {
-		var x Node
-		var y Node
-		var z Node
-		x = ps.identifierList()
-		if x == nil {
-			break
+		attr := qp.name()
+		if attr == "" || attr == "*" || attr == "." || attr == ".." {
+			return nil, qp.error("expect attribute")
 		}
-		y = ps.type_()
-		if y == nil {
+		ret.attr = append(ret.attr, attr)
+		if qp.peek() != '.' {
 			break
 		}
-		z = ps.tag()
-		_ = z
-		return NewFieldNode(ps._filePath, ps._fileContent, x, y, z, ps._tokens[pos].Start, ps._visibleTokenBefore(ps._mark()).End)
+		qp.pos++
 	}
-	ps._reset(pos)
-	/* x=embedded_field y=tag? {field(_,x,y)}
-	 */
-	for {
-		var x Node
-		var y Node
-		x = ps.embeddedField()
-		if x == nil {
-			break
+	qp.skipSpaces()
+	if strings.HasPrefix(qp.query[qp.pos:], "!=") {
+		ret.op = "!="
+		qp.pos += 2
+	} else if qp.peek() == '=' {
+		ret.op = "="
+		qp.pos++
+	}
+	if ret.op != "" {
+		qp.skipSpaces()
+		quote := qp.peek()
+		if quote != '\'' && quote != '"' {
+			return nil, qp.error("expect quoted value")
 		}
-		y = ps.tag()
-		_ = y
-		return NewFieldNode(ps._filePath, ps._fileContent, nil, x, y, ps._tokens[pos].Start, ps._visibleTokenBefore(ps._mark()).End)
+		end := strings.IndexByte(qp.query[qp.pos+1:], quote)
+		if end < 0 {
+			return nil, qp.error("unterminated value")
+		}
+		ret.value = qp.query[qp.pos+1 : qp.pos+1+end]
+		qp.pos += end + 2
+		qp.skipSpaces()
 	}
-	ps._reset(pos)
-	return nil
+	if qp.peek() != ']' {
+		return nil, qp.error("expect ']'")
+	}
+	qp.pos++
+	return ret, nil
 }
 
-/*
-embedded_field:
-| '*' x=type_name_or_generic_type_instantiation {star_expr(x)}
-| t=type_name_or_generic_type_instantiation {t}
-*/
-func (ps *Parser) embeddedField() Node {
-	/* '*' x=type_name_or_generic_type_instantiation {star_expr(x)}
-	 */
-	pos := ps._mark()
-	for {
-		var x Node
-		var _1 Node
-		_1 = ps._expectK(TokenTypeOpStar)
-		if _1 == nil {
+func (p *queryPredicate) match(node Node) bool {
+	var value string
+	found := true
+	for i, attr := range p.attr {
+		child := node.Child(attr)
+		if (child == nil || child.IsDummy()) && i == len(p.attr)-1 && (attr == "code" || attr == "kind") {
+			if attr == "code" {
+				value = string(node.Code())
+			} else {
+				value = node.Kind()
+			}
 			break
 		}
-		x = ps.typeNameOrGenericTypeInstantiation()
-		if x == nil {
+		if child == nil || child.IsDummy() {
+			found = false
 			break
 		}
-		return NewStarExprNode(ps._filePath, ps._fileContent, x, ps._tokens[pos].Start, ps._visibleTokenBefore(ps._mark()).End)
+		node = child
+		value = string(node.Code())
 	}
-	ps._reset(pos)
-	/* t=type_name_or_generic_type_instantiation {t}
-	 */
-	for {
-		var t Node
-		t = ps.typeNameOrGenericTypeInstantiation()
-		if t == nil {
-			break
-		}
-		return t
+	switch p.op {
+	case "=":
+		return found && value == p.value
+	case "!=":
+		return !found || value != p.value
+	default:
+		return found
 	}
-	ps._reset(pos)
-	return nil
 }
 
-/*
-tag:
-| x=STRING {basic_lit(x)}
-*/
-func (ps *Parser) tag() Node {
-	/* x=STRING {basic_lit(x)}
-	 */
-	pos := ps._mark()
-	for {
-		var x Node
-		x = ps._expectK(TokenTypeString)
-		if x == nil {
-			break
+func (s *queryStep) match(field string, node Node) bool {
+	if s.name != "*" && s.name != "." && s.name != ".." && s.name != field && s.name != node.Kind() {
+		return false
+	}
+	if s.kind != "" && s.kind != node.Kind() {
+		return false
+	}
+	for _, predicate := range s.predicates {
+		if !predicate.match(node) {
+			return false
 		}
-		return NewBasicLitNode(ps._filePath, ps._fileContent, x, ps._tokens[pos].Start, ps._visibleTokenBefore(ps._mark()).End)
 	}
-	ps._reset(pos)
-	return nil
+	return true
 }
 
-/*
-pseudo_semi:
-| ';'
-| &')'
-| &'}'
-*/
-func (ps *Parser) pseudoSemi() Node {
-	/* ';'
-	 */
-	for {
-		var _1 Node
-		_1 = ps._expectK(TokenTypeOpSemi)
-		if _1 == nil {
-			break
-		}
-		return _1
+// selectDescendants visits the descendants of node that match the step in
+// document order.
+func (s *queryStep) selectDescendants(node Node, visit func(Node)) {
+	if s.name == "." || s.name == ".." {
+		node.Visit(func(d Node) (bool, bool) {
+			s.selectFrom(d, visit)
+			return true, false
+		}, func(Node) bool {
+			return false
+		})
+		return
 	}
-	/* &')'
-	 */
-	for {
-		var _1 Node
-		_p := ps._mark()
-		_1 = ps._expectK(TokenTypeOpRightParen)
-		if _1 != nil {
-			ps._reset(_p)
+	for _, field := range node.Fields() {
+		child := node.Child(field)
+		if child == nil || child.IsDummy() {
+			continue
 		}
-		if _1 == nil {
-			break
+		if s.match(field, child) {
+			visit(child)
 		}
-		return _1
+		s.selectDescendants(child, visit)
 	}
-	/* &'}'
-	 */
-	for {
-		var _1 Node
-		_p := ps._mark()
-		_1 = ps._expectK(TokenTypeOpRightBrace)
-		if _1 != nil {
-			ps._reset(_p)
+}
+
+func (s *queryStep) selectFrom(node Node, visit func(Node)) {
+	switch s.name {
+	case ".":
+		if s.match("", node) {
+			visit(node)
 		}
-		if _1 == nil {
-			break
+		return
+	case "..":
+		if parent := node.Parent(); parent != nil && s.match("", parent) {
+			visit(parent)
+		}
+		return
+	}
+	for _, field := range node.Fields() {
+		child := node.Child(field)
+		if child != nil && !child.IsDummy() && s.match(field, child) {
+			visit(child)
 		}
-		return _1
 	}
-	return nil
 }
 
-/*
-_group_1:
-| t=import_spec pseudo_semi {t}
-*/
-func (ps *Parser) _group1() Node {
-	/* t=import_spec pseudo_semi {t}
-	 */
-	pos := ps._mark()
-	for {
-		var t Node
-		t = ps.importSpec()
-		if t == nil {
-			break
+func (q *Query) Select(node Node) []Node {
+	if node == nil || node.IsDummy() {
+		return nil
+	}
+	current := []Node{node}
+	for _, step := range q.steps {
+		next := make([]Node, 0)
+		seen := make(map[Node]bool)
+		collect := func(n Node) {
+			if !seen[n] {
+				seen[n] = true
+				next = append(next, n)
+			}
 		}
-		var _1 Node
-		_1 = ps.pseudoSemi()
-		if _1 == nil {
-			break
+		for _, n := range current {
+			if step.descendant {
+				step.selectDescendants(n, collect)
+			} else {
+				step.selectFrom(n, collect)
+			}
 		}
-		return t
+		if len(current) > 1 {
+			sort.SliceStable(next, func(i, j int) bool {
+				si, ei := next[i].Range()
+				sj, ej := next[j].Range()
+				if si.Offset != sj.Offset {
+					return si.Offset < sj.Offset
+				}
+				return ei.Offset > ej.Offset
+			})
+		}
+		current = next
 	}
-	ps._reset(pos)
-	return nil
+	return current
 }
 
-/*
-_group_2:
-| import_dot
-| import_ident
-*/
-func (ps *Parser) _group2() Node {
-	/* import_dot
-	 */
-	for {
-		var _1 Node
-		_1 = ps.importDot()
-		if _1 == nil {
-			break
-		}
-		return _1
+func QueryNode(node Node, query string) ([]Node, error) {
+	q, err := CompileQuery(query)
+	if err != nil {
+		return nil, err
 	}
-	/* import_ident
-	 */
-	for {
-		var _1 Node
-		_1 = ps.importIdent()
-		if _1 == nil {
+	return q.Select(node), nil
+}
+
+type patternNode struct {
+	kind    string
+	text    *string
+	fields  map[string]*patternNode
+	items   []*patternNode
+	capture string
+	// optional lets the capture match a missing node, which is then not recorded
+	optional bool
+}
+
+type Pattern struct {
+	root *patternNode
+}
+
+type PatternMatch struct {
+	Node     Node
+	Captures map[string]Node
+}
+
+// CompilePattern parses an S-expression pattern such as
+// (call_expr callee:(ident) @fn argument:@args). "_" matches any node, "@name" captures
+// any present node, "@name?" also matches a missing one, "text" matches a node by its code,
+// and items of a (nodes ...) list match by position.
+func CompilePattern(src string) (*Pattern, error) {
+	pp := &patternParser{src: src}
+	root, err := pp.pattern()
+	if err != nil {
+		return nil, err
+	}
+	if pp.skipSpaces(); pp.pos < len(pp.src) {
+		return nil, pp.error("unexpected trailing input")
+	}
+	return &Pattern{root: root}, nil
+}
+
+type patternParser struct {
+	src string
+	pos int
+}
+
+func (pp *patternParser) error(msg string) error {
+	return fmt.Errorf("pattern error: %s at %d: %s", msg, pp.pos, pp.src)
+}
+
+func (pp *patternParser) peek() byte {
+	if pp.pos < len(pp.src) {
+		return pp.src[pp.pos]
+	}
+	return 0
+}
+
+func (pp *patternParser) skipSpaces() {
+	for pp.pos < len(pp.src) && strings.IndexByte(" \t\r\n", pp.src[pp.pos]) >= 0 {
+		pp.pos++
+	}
+}
+
+func (pp *patternParser) ident() string {
+	start := pp.pos
+	for pp.pos < len(pp.src) {
+		ch := pp.src[pp.pos]
+		if ch != '_' && !(ch >= 'a' && ch <= 'z') && !(ch >= 'A' && ch <= 'Z') && !(ch >= '0' && ch <= '9') {
 			break
 		}
-		return _1
+		pp.pos++
+	}
+	return pp.src[start:pp.pos]
+}
+
+func (pp *patternParser) capture(ret *patternNode) error {
+	pp.pos++
+	name := pp.ident()
+	if name == "" {
+		return pp.error("expect capture name")
+	}
+	ret.capture = name
+	if pp.peek() == '?' {
+		pp.pos++
+		ret.optional = true
 	}
 	return nil
 }
 
-/*
-_group_3:
-| '~'? t=type {t}
-*/
-func (ps *Parser) _group3() Node {
-	/* '~'? t=type {t}
-	 */
-	pos := ps._mark()
-	for {
-		var t Node
-		var _1 Node
-		_1 = ps._expectK(TokenTypeOpTilde)
-		_ = _1
-		t = ps.type_()
-		if t == nil {
-			break
+func (pp *patternParser) pattern() (*patternNode, error) {
+	pp.skipSpaces()
+	ret := &patternNode{}
+	switch ch := pp.peek(); {
+	case ch == '@':
+		if err := pp.capture(ret); err != nil {
+			return nil, err
+		}
+		return ret, nil
+	case ch == '"':
+		end := pp.pos + 1
+		for end < len(pp.src) && pp.src[end] != '"' {
+			if pp.src[end] == '\\' {
+				end++
+			}
+			end++
+		}
+		if end >= len(pp.src) {
+			return nil, pp.error("unterminated string")
+		}
+		text, err := strconv.Unquote(pp.src[pp.pos : end+1])
+		if err != nil {
+			return nil, pp.error("invalid string")
+		}
+		ret.text = &text
+		pp.pos = end + 1
+	case ch == '(':
+		pp.pos++
+		pp.skipSpaces()
+		ret.kind = pp.ident()
+		fields, ok := nodeKindFields[ret.kind]
+		if !ok {
+			return nil, pp.error(fmt.Sprintf("unknown kind '%s'", ret.kind))
+		}
+		for {
+			pp.skipSpaces()
+			if pp.peek() == ')' {
+				pp.pos++
+				break
+			}
+			if pp.pos >= len(pp.src) {
+				return nil, pp.error("expect ')'")
+			}
+			if ret.kind == NodeTypeNodes {
+				item, err := pp.pattern()
+				if err != nil {
+					return nil, err
+				}
+				ret.items = append(ret.items, item)
+				continue
+			}
+			field := pp.ident()
+			if field == "" || pp.peek() != ':' {
+				return nil, pp.error("expect field")
+			}
+			if !slices.Contains(fields, field) {
+				return nil, pp.error(fmt.Sprintf("unknown field '%s' of %s", field, ret.kind))
+			}
+			pp.pos++
+			child, err := pp.pattern()
+			if err != nil {
+				return nil, err
+			}
+			if ret.fields == nil {
+				ret.fields = make(map[string]*patternNode)
+			}
+			ret.fields[field] = child
+		}
+	case ch == '_':
+		pp.pos++
+	default:
+		return nil, pp.error("expect pattern")
+	}
+	pp.skipSpaces()
+	if pp.peek() == '@' {
+		if err := pp.capture(ret); err != nil {
+			return nil, err
 		}
-		return t
 	}
-	ps._reset(pos)
-	return nil
+	return ret, nil
 }
 
-/*
-_group_4:
-| a='chan' b='<-' {_pseudo_token(a, b)}
-| a='<-' b='chan' {_pseudo_token(a, b)}
-| 'chan'
-*/
-func (ps *Parser) _group4() Node {
-	/* a='chan' b='<-' {_pseudo_token(a, b)}
-	 */
-	pos := ps._mark()
-	for {
-		var a Node
-		var b Node
-		a = ps._expectK(TokenTypeKwChan)
-		if a == nil {
-			break
-		}
-		b = ps._expectK(TokenTypeOpLessMinus)
-		if b == nil {
-			break
+func (p *patternNode) match(node Node, captures map[string]Node) bool {
+	if node == nil {
+		node = DummyNode
+	}
+	if p.kind != "" && node.Kind() != p.kind {
+		return false
+	}
+	if p.text != nil && (node.IsDummy() || string(node.Code()) != *p.text) {
+		return false
+	}
+	for field, child := range p.fields {
+		if !child.match(node.Child(field), captures) {
+			return false
 		}
-		return ps._pseudoToken(a, b)
 	}
-	ps._reset(pos)
-	/* a='<-' b='chan' {_pseudo_token(a, b)}
-	 */
-	for {
-		var a Node
-		var b Node
-		a = ps._expectK(TokenTypeOpLessMinus)
-		if a == nil {
-			break
+	if p.kind == NodeTypeNodes {
+		nodes := node.UnpackNodes()
+		if len(nodes) != len(p.items) {
+			return false
 		}
-		b = ps._expectK(TokenTypeKwChan)
-		if b == nil {
-			break
+		for i, item := range p.items {
+			if !item.match(nodes[i], captures) {
+				return false
+			}
 		}
-		return ps._pseudoToken(a, b)
 	}
-	ps._reset(pos)
-	/* 'chan'
-	 */
-	for {
-		var _1 Node
-		_1 = ps._expectK(TokenTypeKwChan)
-		if _1 == nil {
-			break
+	if p.capture != "" {
+		if node.IsDummy() {
+			return p.optional
 		}
-		return _1
+		captures[p.capture] = node
 	}
-	return nil
+	return true
 }
 
-func (tk *Tokenizer) Clean(tokens []*Token) []*Token {
-	ret := make([]*Token, 0)
-	var last *Token
-	for _, tok := range tokens {
-		// insert optional semicolon
-		// The formal grammar uses semicolons ";" as terminators in a number of productions. Go programs may omit most of these semicolons using the following two rules:
-		//
-		// When the input is broken into tokens, a semicolon is automatically inserted into the token stream immediately after a line's final token if that token is
-		// an identifier
-		// an integer, floating-point, imaginary, rune, or string literal
-		// one of the keywords break, continue, fallthrough, or return
-		// one of the operators and punctuation ++, --, ), ], or }
-		// To allow complex statements to occupy a single line, a semicolon may be omitted before a closing ")" or "}".
-		if tok.Kind == TokenTypeNewline {
-			if last != nil && last.Kind != TokenTypeOpSemi {
-				insertSemi := false
-				switch last.Kind {
-				case TokenTypeIdent:
-					insertSemi = true
-				case TokenTypeString:
-					insertSemi = true
-				case TokenTypeOpRightParen, TokenTypeOpRightBracket, TokenTypeOpRightBrace:
-					// ),],}
-					insertSemi = true
-				case TokenTypeOpPlusPlus, TokenTypeOpMinusMinus:
-					// ++,--
-					insertSemi = true
-				case TokenTypeNumber:
-					insertSemi = true
-				case TokenTypeKwFallthrough, TokenTypeKwReturn, TokenTypeKwBreak, TokenTypeKwContinue:
-					insertSemi = true
-				}
+func (p *Pattern) Match(node Node) (map[string]Node, bool) {
+	captures := make(map[string]Node)
+	if !p.root.match(node, captures) {
+		return nil, false
+	}
+	return captures, true
+}
 
-				if insertSemi {
-					last = NewToken(TokenTypeOpSemi, last.Start, last.End, []rune(";"))
-					ret = append(ret, last)
-				}
-			}
+func (p *Pattern) FindAll(root Node) []*PatternMatch {
+	ret := make([]*PatternMatch, 0)
+	root.Visit(func(node Node) (bool, bool) {
+		if captures, ok := p.Match(node); ok {
+			ret = append(ret, &PatternMatch{Node: node, Captures: captures})
 		}
+		return true, false
+	}, func(Node) bool {
+		return false
+	})
+	return ret
+}
 
-		if tok.Kind == TokenTypeWhitespace || tok.Kind == TokenTypeNewline || tok.Kind == TokenTypeComment {
+type EqualOptions struct {
+	IgnorePositions bool
+	IgnoreFilePaths bool
+	IgnoreTrivia    bool
+}
+
+func Equal(a, b Node, opts EqualOptions) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if !opts.IgnoreTrivia && string(a.Code()) != string(b.Code()) {
+		return false
+	}
+	return equalNodes(a, b, opts)
+}
+
+func equalNodes(a, b Node, opts EqualOptions) bool {
+	if a.IsDummy() || b.IsDummy() {
+		return a.IsDummy() == b.IsDummy()
+	}
+	if a.Kind() != b.Kind() {
+		return false
+	}
+	if !opts.IgnorePositions {
+		startA, endA := a.Range()
+		startB, endB := b.Range()
+		if startA != startB || endA != endB {
+			return false
+		}
+	}
+	if !opts.IgnoreFilePaths && a.FilePath() != b.FilePath() {
+		return false
+	}
+	if tokenA, ok := a.(*TokenNode); ok {
+		tokenB, ok := b.(*TokenNode)
+		return ok && tokenA.token.Kind == tokenB.token.Kind && string(tokenA.Code()) == string(tokenB.Code())
+	}
+	fieldsA, fieldsB := a.Fields(), b.Fields()
+	if len(fieldsA) != len(fieldsB) {
+		return false
+	}
+	for i, field := range fieldsA {
+		if field != fieldsB[i] {
+			return false
+		}
+		childA, childB := a.Child(field), b.Child(field)
+		if childA == nil || childB == nil {
+			if childA != childB {
+				return false
+			}
 			continue
 		}
-
-		ret = append(ret, tok)
-		last = tok
-		if tok.Kind == TokenTypeEndOfFile {
-			break
+		if !equalNodes(childA, childB, opts) {
+			return false
 		}
 	}
-	return ret
+	return true
 }
 
-func (ps *Parser) _setDepth(d int) {
-    ps._any = d
+func Hash(node Node) uint64 {
+	return hashOf(node, Hash)
 }
 
-func (ps *Parser) _getDepth() int {
-    return ps._any.(int)
+func hashOf(node Node, childHash func(Node) uint64) uint64 {
+	h := fnv.New64a()
+	if node == nil || node.IsDummy() {
+		h.Write([]byte(NodeTypeDummy))
+		return h.Sum64()
+	}
+	h.Write([]byte(node.Kind()))
+	if tok, ok := node.(*TokenNode); ok {
+		_, _ = fmt.Fprintf(h, ";%s:%q", tok.token.Kind, string(tok.Code()))
+	}
+	for _, field := range node.Fields() {
+		child := node.Child(field)
+		if child == nil || child.IsDummy() {
+			_, _ = fmt.Fprintf(h, ";%s:-", field)
+			continue
+		}
+		_, _ = fmt.Fprintf(h, ";%s:%d", field, childHash(child))
+	}
+	return h.Sum64()
 }
 
-func (ps *Parser) _enter() {
-	ps._setDepth(ps._bracketDepth + 1)
-}
+const (
+	ChangeInsert = "insert"
+	ChangeDelete = "delete"
+	ChangeUpdate = "update"
+	ChangeMove   = "move"
+)
 
-func (ps *Parser) _leave() {
-	ps._setDepth(0)
+type Change struct {
+	Kind string
+	Old  Node
+	New  Node
 }
 
-func (ps *Parser) _hackCompositeLitNode() Node {
-	if ps._bracketDepth >= ps._getDepth() {
-		return ps.compositeLit()
+func (c Change) String() string {
+	switch c.Kind {
+	case ChangeInsert:
+		return fmt.Sprintf("insert %s %q", c.New.Kind(), string(c.New.Code()))
+	case ChangeDelete:
+		return fmt.Sprintf("delete %s %q", c.Old.Kind(), string(c.Old.Code()))
+	case ChangeUpdate:
+		return fmt.Sprintf("update %s %q -> %q", c.Old.Kind(), string(c.Old.Code()), string(c.New.Code()))
+	default:
+		return fmt.Sprintf("move %s %q", c.Old.Kind(), string(c.Old.Code()))
 	}
-	return nil
-}
-func DumpNode(n Node, hook func(Node, map[string]string) string) string {
-	return CustomDumpNode(n, hook)
 }
 
-func DumpNodeIndent(node Node) string {
-	result := SimpleDumpNode(node)
-	var v any
-	err := json.Unmarshal([]byte(result), &v)
-	if err != nil {
-		panic(err)
-	}
-	b, _ := json.MarshalIndent(v, "", "  ")
-	return string(b)
+type differ struct {
+	hash   map[Node]uint64
+	size   map[Node]int
+	parent map[Node]Node
+	field  map[Node]string
+	match  map[Node]Node
 }
 
-func CustomDumpNode(node Node, hook func(Node, map[string]string) string) string {
-	if node.IsDummy() {
-		return "null"
+// Diff computes an edit script from a to b. Identical subtrees are matched first,
+// then unmatched nodes are paired through their matched children and parents.
+func Diff(a, b Node) []Change {
+	d := &differ{
+		hash:   make(map[Node]uint64),
+		size:   make(map[Node]int),
+		parent: make(map[Node]Node),
+		field:  make(map[Node]string),
+		match:  make(map[Node]Node),
 	}
-	itemMap := node.Dump(hook)
-	ret := hook(node, itemMap)
-	if ret != "" {
-		return ret
+	if a == nil || a.IsDummy() || b == nil || b.IsDummy() {
+		return d.changes(a, b)
 	}
-	items := make([]string, 0)
-	for k, v := range itemMap {
-		if k == "kind" {
-			continue
+	d.index(a)
+	d.index(b)
+	d.matchIdentical(a, b)
+	d.matchContainers(b)
+	if d.match[a] == nil && d.match[b] == nil && a.Kind() == b.Kind() {
+		d.link(a, b)
+	}
+	d.matchFields(b)
+	return d.changes(a, b)
+}
+
+func (d *differ) index(node Node) uint64 {
+	for _, field := range node.Fields() {
+		if child := node.Child(field); child != nil && !child.IsDummy() {
+			d.parent[child] = node
+			d.field[child] = field
 		}
-		items = append(items, fmt.Sprintf("\"%s\": %s", k, v))
 	}
-	sort.Strings(items)
-	items = append([]string{fmt.Sprintf("\"kind\": %s", itemMap["kind"])}, items...)
-	return fmt.Sprintf("{%s}", strings.Join(items, ", "))
+	d.hash[node] = hashOf(node, d.index)
+	d.size[node] = 1
+	for _, child := range childNodes(node) {
+		d.size[node] += d.size[child]
+	}
+	return d.hash[node]
 }
 
-func SimpleDumpNode(node Node) string {
-	return CustomDumpNode(node, func(n Node, m map[string]string) string {
-		return ""
-	})
+func (d *differ) link(a, b Node) {
+	d.match[a] = b
+	d.match[b] = a
 }
 
-func QueryNode(node Node, path string) (any, error) {
-	if path == "" {
-		return node, nil
+// matchSubtree links two subtrees with equal hashes node by node. It stops at
+// nodes whose kinds or child counts differ, which only a hash collision causes.
+func (d *differ) matchSubtree(a, b Node) bool {
+	childrenA, childrenB := childNodes(a), childNodes(b)
+	if a.Kind() != b.Kind() || len(childrenA) != len(childrenB) {
+		return false
+	}
+	d.link(a, b)
+	for i := range childrenA {
+		d.matchSubtree(childrenA[i], childrenB[i])
 	}
+	return true
+}
 
-	items := strings.Split(path, "/")
-	var base any
-	base = node
-	for _, item := range items {
-		var name, nodeType string
-		if strings.Contains(item, ":") {
-			subs := strings.Split(item, ":")
-			name = toCamelCase(subs[0])
-			nodeType = subs[1]
-		} else {
-			name = toCamelCase(item)
+func (d *differ) matchIdentical(a, b Node) {
+	candidates := make(map[uint64][]Node)
+	a.Visit(func(n Node) (bool, bool) {
+		if d.size[n] > 1 {
+			candidates[d.hash[n]] = append(candidates[d.hash[n]], n)
+		}
+		return true, false
+	}, func(Node) bool {
+		return false
+	})
+	b.Visit(func(n Node) (bool, bool) {
+		if d.size[n] < 2 {
+			return true, false
+		}
+		var pick Node
+		for _, c := range candidates[d.hash[n]] {
+			if _, ok := d.match[c]; ok {
+				continue
+			}
+			if pick == nil {
+				pick = c
+			}
+			if pa := d.parent[c]; pa != nil && d.match[pa] == d.parent[n] && d.field[c] == d.field[n] {
+				pick = c
+				break
+			}
+		}
+		if pick == nil {
+			return true, false
 		}
+		if !d.matchSubtree(pick, n) {
+			return true, false
+		}
+		return false, false
+	}, func(Node) bool {
+		return false
+	})
+}
 
-		switch base.(type) {
-		case Node:
-			node = base.(Node)
-			if name == "." {
-				base = node
-			} else if name == ".." {
-				base = node.Parent()
-				if base == nil {
-					return nil, errors.New("query error: node has no parent")
-				}
-			} else {
-				t := reflect.TypeOf(node)
-				m, ok := t.MethodByName(name)
-				if !ok {
-					methods := make([]string, 0)
-					for i := 0; i < t.NumMethod(); i++ {
-						tmp := t.Method(i).Name
-						methods = append(methods, tmp)
-					}
-					return nil, errors.New(fmt.Sprintf("query error: %v has no method '%s', available: %s", t, name, strings.Join(methods, ", ")))
-				}
-				result := m.Func.Call([]reflect.Value{
-					reflect.ValueOf(node),
-				})
-				base = result[0].Interface()
+func (d *differ) matchContainers(b Node) {
+	b.Visit(func(Node) (bool, bool) {
+		return true, false
+	}, func(n Node) bool {
+		if _, ok := d.match[n]; ok {
+			return false
+		}
+		for _, child := range childNodes(n) {
+			a := d.match[child]
+			if a == nil {
+				continue
 			}
-		case []Node:
-			nodes := base.([]Node)
-			index, err := strconv.Atoi(name)
-			if err != nil {
-				return nil, errors.New(fmt.Sprintf("query error: index should be an integer: '%s'", name))
+			if pa := d.parent[a]; pa != nil && d.match[pa] == nil && pa.Kind() == n.Kind() {
+				d.link(pa, n)
+				break
+			}
+		}
+		return false
+	})
+}
+
+func (d *differ) matchFields(b Node) {
+	b.Visit(func(n Node) (bool, bool) {
+		if _, ok := d.match[n]; ok {
+			return true, false
+		}
+		pa := d.match[d.parent[n]]
+		if pa == nil {
+			return true, false
+		}
+		if c := pa.Child(d.field[n]); c != nil && !c.IsDummy() && d.match[c] == nil && c.Kind() == n.Kind() {
+			d.link(c, n)
+		}
+		return true, false
+	}, func(Node) bool {
+		return false
+	})
+}
+
+// reordered finds the matched items of b's lists that changed order within their
+// matched list: those outside the longest run that keeps the order of a.
+func (d *differ) reordered(b Node) map[Node]bool {
+	ret := make(map[Node]bool)
+	b.Visit(func(n Node) (bool, bool) {
+		pa := d.match[n]
+		if n.Kind() != NodeTypeNodes || pa == nil {
+			return true, false
+		}
+		items := make([]Node, 0)
+		indexes := make([]int, 0)
+		for _, c := range n.UnpackNodes() {
+			if old := d.match[c]; old != nil && d.parent[old] == pa {
+				index, _ := strconv.Atoi(d.field[old])
+				items = append(items, c)
+				indexes = append(indexes, index)
 			}
-			if index < 0 || index >= len(nodes) {
-				return nil, errors.New("index error")
+		}
+		kept := make(map[int]bool)
+		for _, i := range longestIncreasing(indexes) {
+			kept[i] = true
+		}
+		for i, c := range items {
+			if !kept[i] {
+				ret[c] = true
 			}
-			base = nodes[index]
-		default:
-			return nil, errors.New(fmt.Sprintf("query error: neither Node nor []Node: '%s'", name))
 		}
+		return true, false
+	}, func(Node) bool {
+		return false
+	})
+	return ret
+}
+
+// longestIncreasing returns the positions of a longest strictly increasing subsequence of xs.
+func longestIncreasing(xs []int) []int {
+	tails := make([]int, 0)
+	prev := make([]int, len(xs))
+	for i, x := range xs {
+		pos := sort.Search(len(tails), func(j int) bool { return xs[tails[j]] >= x })
+		prev[i] = -1
+		if pos > 0 {
+			prev[i] = tails[pos-1]
+		}
+		if pos == len(tails) {
+			tails = append(tails, i)
+		} else {
+			tails[pos] = i
+		}
+	}
+	ret := make([]int, len(tails))
+	if len(tails) == 0 {
+		return ret
+	}
+	for i, k := len(tails)-1, tails[len(tails)-1]; i >= 0; i-- {
+		ret[i] = k
+		k = prev[k]
+	}
+	return ret
+}
 
-		// type assertion
-		if nodeType != "" {
-			if cast, isNode := base.(Node); isNode {
-				t := TypeNameOf(cast)
-				if strings.ToLower(t) != nodeType {
-					return nil, errors.New(fmt.Sprintf("type assertion error, expect: %s, actual: %s", nodeType, t))
+func (d *differ) changes(a, b Node) []Change {
+	ret := make([]Change, 0)
+	if a != nil && !a.IsDummy() {
+		a.Visit(func(n Node) (bool, bool) {
+			if d.match[n] != nil {
+				return true, false
+			}
+			if p := d.parent[n]; p == nil || d.match[p] != nil {
+				ret = append(ret, Change{Kind: ChangeDelete, Old: n})
+			}
+			return true, false
+		}, func(Node) bool {
+			return false
+		})
+	}
+	if b != nil && !b.IsDummy() {
+		reordered := d.reordered(b)
+		b.Visit(func(n Node) (bool, bool) {
+			old := d.match[n]
+			if old == nil {
+				if p := d.parent[n]; p == nil || d.match[p] != nil {
+					ret = append(ret, Change{Kind: ChangeInsert, New: n})
 				}
-			} else {
-				return nil, errors.New(fmt.Sprintf("type assertion error, not node"))
+				return true, false
 			}
-		}
+			if n.Kind() == NodeTypeToken && string(n.Code()) != string(old.Code()) {
+				ret = append(ret, Change{Kind: ChangeUpdate, Old: old, New: n})
+			}
+			pa, pb := d.parent[old], d.parent[n]
+			if pa != nil && pb != nil && (d.match[pa] != pb || reordered[n] || pb.Kind() != NodeTypeNodes && d.field[old] != d.field[n]) {
+				ret = append(ret, Change{Kind: ChangeMove, Old: old, New: n})
+			}
+			return true, false
+		}, func(Node) bool {
+			return false
+		})
 	}
-	return base, nil
+	return ret
+}
+
+type ParseOption func(ps *Parser)
+
+func WithMaxSteps(n int) ParseOption {
+	return func(ps *Parser) { ps.SetMaxSteps(n) }
 }
 
-func ParseFile(filePath string) (Node, error) {
+func WithMaxBacktracks(n int) ParseOption {
+	return func(ps *Parser) { ps.SetMaxBacktracks(n) }
+}
+
+func WithMaxDepth(n int) ParseOption {
+	return func(ps *Parser) { ps.SetMaxDepth(n) }
+}
+
+func ParseFile(filePath string, opts ...ParseOption) (Node, error) {
 	b, err := os.ReadFile(filePath)
 	if err != nil {
 		return nil, err
@@ -18854,6 +27302,9 @@ func ParseFile(filePath string) (Node, error) {
 	}
 	tokens = tokenizer.Clean(tokens)
 	parser := NewParser(filePath, r, tokens)
+	for _, opt := range opts {
+		opt(parser)
+	}
 	var ret Node
 	ret, err = parser.Parse()
 	if err != nil {
@@ -18865,7 +27316,11 @@ func ParseFile(filePath string) (Node, error) {
 	return ret, nil
 }
 
-func ParseBytes(filePath string, b []byte) (Node, error) {
+func ParseBytes(filePath string, b []byte, opts ...ParseOption) (Node, error) {
+	return ParseBytesContext(context.Background(), filePath, b, opts...)
+}
+
+func ParseBytesContext(ctx context.Context, filePath string, b []byte, opts ...ParseOption) (Node, error) {
 	var err error
 	r, _ := DecodeBytes(b)
 	tokenizer := NewTokenizer(filePath, r)
@@ -18876,6 +27331,10 @@ func ParseBytes(filePath string, b []byte) (Node, error) {
 	}
 	tokens = tokenizer.Clean(tokens)
 	parser := NewParser(filePath, r, tokens)
+	parser.SetContext(ctx)
+	for _, opt := range opts {
+		opt(parser)
+	}
 	var ret Node
 	ret, err = parser.Parse()
 	if err != nil {
@@ -0,0 +1,165 @@
+package goparser
+
+import (
+	"testing"
+)
+
+const fuzzMaxSteps = 1 << 20
+
+// fuzzSeeds are built from the keywords, operators, string atoms and token rules of
+// the grammar. They need not parse, but must not break the parser.
+var fuzzSeeds = []string{
+	"break",
+	"case",
+	"chan",
+	"const",
+	"continue",
+	"default",
+	"defer",
+	"else",
+	"fallthrough",
+	"for",
+	"func",
+	"go",
+	"goto",
+	"if",
+	"import",
+	"interface",
+	"map",
+	"package",
+	"range",
+	"return",
+	"select",
+	"struct",
+	"switch",
+	"type",
+	"var",
+	"!",
+	"!=",
+	"%",
+	"%=",
+	"&",
+	"&&",
+	"&=",
+	"&^",
+	"&^=",
+	"(",
+	")",
+	"*",
+	"*=",
+	"+",
+	"++",
+	"+=",
+	",",
+	"-",
+	"--",
+	"-=",
+	".",
+	"...",
+	"/",
+	"/=",
+	":",
+	":=",
+	";",
+	"<",
+	"<-",
+	"<<",
+	"<<=",
+	"<=",
+	"=",
+	"==",
+	">",
+	">=",
+	">>",
+	">>=",
+	"[",
+	"]",
+	"^",
+	"^=",
+	"{",
+	"|",
+	"|=",
+	"||",
+	"}",
+	"~",
+	"make",
+	"new",
+	"//",
+	"/**/",
+	"a",
+	"``",
+	"\"\"",
+	"0o_",
+	".0",
+	"0",
+	"'\\a'",
+	"package ; import ( ) . [ , ] | ~ ... * func const = var type { } fallthrough goto continue break : defer go return ++ -- <- := += -= |= ^= *= /= %= <<= >>= &= &^= if else for range select case default switch interface map || && == != < <= > >= + - ^ / % << >> & &^ make chan new struct !",
+}
+
+// fuzzSamples are sample programs, each of which must parse.
+var fuzzSamples = []string{
+	"package control\n\nfunc Classify(xs []int, done chan struct{}) (int, error) {\n\tvar names = map[int]string{0: \"low\", 1: \"mid\", 2: \"high\"}\n\tcount := 0\n\tfor i := 0; i < len(xs); i++ {\n\t\tswitch x := xs[i]; {\n\t\tcase x < 0:\n\t\t\tcontinue\n\t\tcase x > 100:\n\t\t\treturn count, nil\n\t\tdefault:\n\t\t\tcount++\n\t\t}\n\t}\n\tselect {\n\tcase <-done:\n\t\treturn count, nil\n\tdefault:\n\t}\n\tvar v any = names[count]\n\tswitch t := v.(type) {\n\tcase string:\n\t\tdefer func() { _ = t }()\n\t}\n\tgo func(n int) {\n\t\tdone <- struct{}{}\n\t}(count)\n\treturn count, nil\n}\n",
+	"package generics\n\nfunc Map[T, U any](xs []T, fn func(T) U) []U {\n\tout := make([]U, 0, len(xs))\n\tfor _, x := range xs {\n\t\tout = append(out, fn(x))\n\t}\n\treturn out\n}\n\nfunc Sum[T int | float64](xs ...T) T {\n\tvar s T = 0\n\tfor _, x := range xs {\n\t\ts += x\n\t}\n\treturn s\n}\n\nfunc Pairs() []Pair[string, int] {\n\tps := []Pair[string, int]{{Key: \"a\", Val: 1}, {\"b\", 2}}\n\tp := &ps[0]\n\tp.Val <<= 2\n\t_ = ps[1:2:2]\n\treturn ps\n}\n",
+	"package main\n\nimport (\n\t\"fmt\"\n\t\"os\"\n)\n\nfunc main() {\n\tname := \"world\"\n\tif len(os.Args) > 1 {\n\t\tname = os.Args[1]\n\t}\n\tfmt.Printf(\"hello, %s\\n\", name)\n}\n",
+	"package shapes\n\nimport \"math\"\n\nfunc (r Rect) Area() float64 {\n\treturn r.W * r.H\n}\n\nfunc (r *Rect) Perimeter() float64 {\n\treturn 2 * (r.W + r.H)\n}\n\nfunc (c Circle) Area() float64 {\n\treturn math.Pi * c.R * c.R\n}\n\nfunc Total(shapes ...Circle) (sum float64) {\n\ttype pair struct {\n\t\tW, H float64\n\t\tTag  string `json:\"tag\"`\n\t}\n\tps := []pair{{1, 2, \"a\"}, {W: 3, H: 4}}\n\tfor _, s := range shapes {\n\t\tsum += s.Area()\n\t}\n\tfor i := range ps {\n\t\tsum += ps[i].W * ps[i].H\n\t}\n\treturn\n}\n",
+}
+
+func TestFuzzSeeds(t *testing.T) {
+	for _, seed := range fuzzSeeds {
+		node, err := ParseBytes("seed", []byte(seed), WithMaxSteps(fuzzMaxSteps))
+		if err == nil {
+			fuzzCheck(t, node, []byte(seed))
+		}
+	}
+	for i, sample := range fuzzSamples {
+		node, err := ParseBytes("sample", []byte(sample), WithMaxSteps(fuzzMaxSteps))
+		if err != nil {
+			t.Fatalf("sample %d: %v", i, err)
+		}
+		fuzzCheck(t, node, []byte(sample))
+	}
+}
+
+func FuzzParseBytes(f *testing.F) {
+	for _, seed := range fuzzSeeds {
+		f.Add([]byte(seed))
+	}
+	for _, sample := range fuzzSamples {
+		f.Add([]byte(sample))
+	}
+	f.Fuzz(func(t *testing.T, b []byte) {
+		node, err := ParseBytes("fuzz", b, WithMaxSteps(fuzzMaxSteps))
+		if err != nil {
+			return
+		}
+		fuzzCheck(t, node, b)
+	})
+}
+
+func fuzzCheck(t *testing.T, node Node, b []byte) {
+	if node == nil {
+		t.Fatal("nil node without error")
+	}
+	r, _ := DecodeBytes(b)
+	fuzzCheckRange(t, node, len(r))
+}
+
+func fuzzCheckRange(t *testing.T, node Node, size int) {
+	start, end := node.Range()
+	if start.Offset < 0 || start.Offset > end.Offset || end.Offset > size {
+		t.Fatalf("invalid range of %s: [%d, %d), file size %d", node.Kind(), start.Offset, end.Offset, size)
+	}
+	prev := start.Offset
+	for _, field := range node.Fields() {
+		child := node.Child(field)
+		if child == nil || child.IsDummy() {
+			continue
+		}
+		childStart, childEnd := child.Range()
+		if childStart.Offset < prev || childEnd.Offset > end.Offset {
+			t.Fatalf("range of %s.%s [%d, %d) overlaps its previous sibling or leaves [%d, %d)", node.Kind(), field, childStart.Offset, childEnd.Offset, prev, end.Offset)
+		}
+		prev = childEnd.Offset
+		fuzzCheckRange(t, child, size)
+	}
+}
@@ -1,7 +1,11 @@
 package goparser
 
 import (
+	"context"
+	"encoding/binary"
+	"errors"
 	"fmt"
+	"strings"
 	"testing"
 )
 
@@ -17,3 +21,654 @@ func main() {
 	dump := DumpNodeIndent(node)
 	fmt.Println(dump)
 }
+
+func TestParserNulInComment(t *testing.T) {
+	code := "package main\n// a\x00b\nfunc main() {\n}\n"
+	node, err := ParseBytes("main.go", []byte(code))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if node.RangeEnd().Offset < len(code)-2 {
+		t.Fatalf("unexpected range end: %d", node.RangeEnd().Offset)
+	}
+}
+
+func TestTokenizerNul(t *testing.T) {
+	tokens, err := NewTokenizer("main.go", []rune("a\x00b")).Parse()
+	if err == nil {
+		t.Fatalf("expect error, got %d tokens", len(tokens))
+	}
+}
+
+func TestParserLimits(t *testing.T) {
+	code := "package main\nfunc main() {\n\tprint(1 + 2 * 3)\n}\n"
+	r, _ := DecodeBytes([]byte(code))
+	tokenizer := NewTokenizer("main.go", r)
+	tokens, err := tokenizer.Parse()
+	if err != nil {
+		t.Fatal(err)
+	}
+	tokens = tokenizer.Clean(tokens)
+	for _, limit := range []string{LimitSteps, LimitBacktracks} {
+		parser := NewParser("main.go", r, tokens)
+		if limit == LimitSteps {
+			parser.SetMaxSteps(10)
+		} else {
+			parser.SetMaxBacktracks(1)
+		}
+		_, err = parser.Parse()
+		var limitErr *LimitError
+		if !errors.As(err, &limitErr) || limitErr.Limit != limit {
+			t.Fatalf("expect %s limit error, got %v", limit, err)
+		}
+	}
+	for limit, opt := range map[string]ParseOption{LimitSteps: WithMaxSteps(10), LimitBacktracks: WithMaxBacktracks(1), LimitDepth: WithMaxDepth(3)} {
+		_, err = ParseBytes("main.go", []byte(code), opt)
+		var limitErr *LimitError
+		if !errors.As(err, &limitErr) || limitErr.Limit != limit {
+			t.Fatalf("expect %s limit error from option, got %v", limit, err)
+		}
+	}
+}
+
+func TestParseBytesContext(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err := ParseBytesContext(ctx, "main.go", []byte("package main\n"))
+	if !errors.Is(err, context.Canceled) {
+		t.Fatalf("expect context canceled, got %v", err)
+	}
+	var limitErr *LimitError
+	if !errors.As(err, &limitErr) || limitErr.Limit != LimitContext {
+		t.Fatalf("expect context limit error, got %v", err)
+	}
+}
+
+func TestParserMaxDepth(t *testing.T) {
+	code := "package main\nfunc main() { a := " + strings.Repeat("(", 10) + "1" + strings.Repeat(")", 10) + " }\n"
+	_, err := ParseBytes("main.go", []byte(code), WithMaxDepth(64))
+	var limitErr *LimitError
+	if !errors.As(err, &limitErr) || limitErr.Limit != LimitDepth || limitErr.Max != 64 {
+		t.Fatalf("expect depth limit error, got %v", err)
+	}
+	if _, err = ParseBytes("main.go", []byte(code)); err != nil {
+		t.Fatal(err)
+	}
+	deep := strings.Repeat("(", 100000) + "1" + strings.Repeat(")", 100000)
+	_, err = ParseBytes("main.go", []byte("package main\nfunc main() { a := "+deep+" }\n"))
+	if !errors.As(err, &limitErr) || limitErr.Limit != LimitDepth || limitErr.Max != DefaultMaxDepth {
+		t.Fatalf("expect default depth limit error, got %v", err)
+	}
+	// a selector chain is parsed by the left recursion loop, so its length does not add depth
+	chain := "a" + strings.Repeat(".b", 100000)
+	if _, err = ParseBytes("main.go", []byte("package main\nfunc main() { a := "+chain+" }\n")); err != nil {
+		t.Fatal(err)
+	}
+}
+
+type callCounter struct {
+	BaseVisitor
+	calls int
+}
+
+func (v *callCounter) VisitCallExprNode(*CallExprNode) bool {
+	v.calls++
+	return true
+}
+
+type exprStmtRemover struct {
+	BaseRewriter
+}
+
+func (r *exprStmtRemover) RewriteExprStmtNode(*ExprStmtNode) Node {
+	return nil
+}
+
+func TestVisitorAndRewriter(t *testing.T) {
+	code := "package main\nfunc main() {\n\tprint(f(1))\n\tprint(2)\n}\n"
+	node, err := ParseBytes("main.go", []byte(code))
+	if err != nil {
+		t.Fatal(err)
+	}
+	counter := &callCounter{}
+	Walk(counter, node)
+	if counter.calls != 3 {
+		t.Fatalf("expect 3 calls, got %d", counter.calls)
+	}
+	node = Rewrite(&exprStmtRemover{}, node)
+	counter = &callCounter{}
+	Walk(counter, node)
+	if counter.calls != 0 {
+		t.Fatalf("expect 0 calls after rewrite, got %d", counter.calls)
+	}
+}
+
+type exprStmtCollector struct {
+	BaseVisitor
+	stmts []Node
+}
+
+func (v *exprStmtCollector) VisitExprStmtNode(n *ExprStmtNode) bool {
+	v.stmts = append(v.stmts, n)
+	return true
+}
+
+func TestCursor(t *testing.T) {
+	code := "package main\nfunc main() {\n\tprint(1)\n\tprint(2)\n\tprint(3)\n}\n"
+	node, err := ParseBytes("main.go", []byte(code))
+	if err != nil {
+		t.Fatal(err)
+	}
+	collector := &exprStmtCollector{}
+	Walk(collector, node)
+	if len(collector.stmts) != 3 {
+		t.Fatalf("expect 3 statements, got %d", len(collector.stmts))
+	}
+	first, second, third := NewCursor(collector.stmts[0]), NewCursor(collector.stmts[1]), NewCursor(collector.stmts[2])
+	if second.Index() != 1 || second.PrevSibling().Node() != first.Node() || second.NextSibling().Node() != third.Node() {
+		t.Fatal("unexpected siblings")
+	}
+	if first.PrevSibling() != nil || third.NextSibling() != nil {
+		t.Fatal("unexpected siblings at list ends")
+	}
+	path := second.Path()
+	if len(path) != second.Depth() || path[len(path)-1] != (PathStep{Field: second.Field(), Index: 1}) {
+		t.Fatalf("unexpected path: %v", path)
+	}
+	if second.Parent().Parent() == nil || NewCursor(node).Parent() != nil {
+		t.Fatal("unexpected parent")
+	}
+	if err = second.Delete(); err != nil {
+		t.Fatal(err)
+	}
+	if third.Index() != 1 || third.PrevSibling().Node() != first.Node() {
+		t.Fatal("unexpected siblings after delete")
+	}
+	if err = first.InsertAfter(second.Node()); err != nil {
+		t.Fatal(err)
+	}
+	if second.Index() != 1 || third.Index() != 2 {
+		t.Fatal("unexpected index after insert")
+	}
+	third.Replace(first.Node().Fork())
+	if third.Index() != 2 || third.PrevSibling().Node() != second.Node() {
+		t.Fatal("unexpected siblings after replace")
+	}
+	if err = NewCursor(node).InsertBefore(first.Node()); err == nil {
+		t.Fatal("expect error when inserting next to root")
+	}
+	callee := NewCursor(first.Node().Child(first.Node().Fields()[0]))
+	if callee.Index() != -1 || callee.Field() == "" {
+		t.Fatalf("unexpected named field cursor: %d %q", callee.Index(), callee.Field())
+	}
+	if err = callee.Delete(); err != nil || !first.Node().Child(callee.Field()).IsDummy() {
+		t.Fatalf("expect field to be deleted: %v", err)
+	}
+}
+
+func TestNodeAt(t *testing.T) {
+	code := "package main\nfunc main() {\n\tprint(12)\n}\n"
+	node, err := ParseBytes("main.go", []byte(code))
+	if err != nil {
+		t.Fatal(err)
+	}
+	lines := NewLineIndex(node.FileContent())
+	path := NodeAt(node, lines, Position{LineIdx: 2, CharIdx: 8})
+	if len(path) < 3 || path[0] != node || string(path[len(path)-1].Code()) != "12" {
+		t.Fatalf("unexpected path: %d nodes", len(path))
+	}
+	for i := 1; i < len(path); i++ {
+		if path[i].Parent() != path[i-1] {
+			t.Fatalf("path is not connected at %d", i)
+		}
+	}
+	if len(NodeAtOffset(node, len(code)+10)) != 0 {
+		t.Fatal("expect empty path out of range")
+	}
+	if other := NodeAt(node, nil, Position{LineIdx: 2, CharIdx: 8}); len(other) != len(path) {
+		t.Fatal("expect same path without a line index")
+	}
+	nodes := NodesInRange(node, lines, Position{LineIdx: 2, CharIdx: 1}, Position{LineIdx: 2, CharIdx: 100})
+	calls := 0
+	for _, n := range nodes {
+		if _, ok := n.(*CallExprNode); ok {
+			calls++
+			if string(n.Code()) != "print(12)" {
+				t.Fatalf("unexpected call: %s", string(n.Code()))
+			}
+		}
+		if n == node {
+			t.Fatal("root is not in range")
+		}
+	}
+	if calls != 1 {
+		t.Fatalf("expect 1 call, got %d", calls)
+	}
+}
+
+func TestLineIndex(t *testing.T) {
+	idx := NewLineIndex([]rune("ab\r\nc😀d\re\n"))
+	if idx.LineCount() != 4 || string(idx.Line(1)) != "c😀d" || string(idx.Line(2)) != "e" {
+		t.Fatalf("unexpected lines: %d", idx.LineCount())
+	}
+	pos := idx.OffsetToPosition(6)
+	if pos != (Position{Offset: 6, LineIdx: 1, CharIdx: 2}) {
+		t.Fatalf("unexpected position: %+v", pos)
+	}
+	if idx.PositionToOffset(1, 2) != 6 || idx.PositionToOffset(1, 100) != 7 || idx.PositionToOffset(100, 0) != 10 {
+		t.Fatal("unexpected offset")
+	}
+	if line, col := idx.OffsetToUTF8Column(6); line != 1 || col != 5 {
+		t.Fatalf("unexpected utf8 column: %d:%d", line, col)
+	}
+	if line, col := idx.OffsetToUTF16Column(6); line != 1 || col != 3 {
+		t.Fatalf("unexpected utf16 column: %d:%d", line, col)
+	}
+	if idx.UTF8ColumnToOffset(1, 5) != 6 || idx.UTF16ColumnToOffset(1, 3) != 6 {
+		t.Fatal("unexpected offset from column")
+	}
+	content := []rune("package main\r")
+	tokens, err := NewTokenizer("main.go", content).Parse()
+	if err != nil {
+		t.Fatal(err)
+	}
+	idx = NewLineIndex(content)
+	for _, tok := range tokens {
+		if pos := idx.OffsetToPosition(tok.End.Offset); pos != tok.End {
+			t.Fatalf("token %q ends at %+v, line index gives %+v", string(tok.Value), tok.End, pos)
+		}
+	}
+	if idx.LineCount() != 2 || tokens[len(tokens)-1].Start.LineIdx != 1 {
+		t.Fatal("expect a trailing \\r to end the line")
+	}
+}
+
+func TestQueryNode(t *testing.T) {
+	code := "package main\nfunc main() {\n\tprint(f(1))\n}\nfunc g() {\n\tprint(2)\n}\n"
+	node, err := ParseBytes("main.go", []byte(code))
+	if err != nil {
+		t.Fatal(err)
+	}
+	cases := []struct {
+		query string
+		codes []string
+	}{
+		{"//call_expr", []string{"print(f(1))", "f(1)", "print(2)"}},
+		{"//function_decl[name.code='main']//call_expr/callee", []string{"print", "f"}},
+		{"//function_decl[name!='main']/name", []string{"g"}},
+		{"//:call_expr/callee/..", []string{"print(f(1))", "f(1)", "print(2)"}},
+		{"//call_expr[callee='f']/argument/*", []string{"1"}},
+		{"//function_decl[result]", nil},
+	}
+	for _, c := range cases {
+		nodes, err := QueryNode(node, c.query)
+		if err != nil {
+			t.Fatal(err)
+		}
+		codes := make([]string, 0)
+		for _, n := range nodes {
+			codes = append(codes, string(n.Code()))
+		}
+		if fmt.Sprint(codes) != fmt.Sprint(c.codes) && !(len(codes) == 0 && len(c.codes) == 0) {
+			t.Fatalf("%s: expect %v, got %v", c.query, c.codes, codes)
+		}
+	}
+	code = "package main\nfunc main() {\n\tx = []T{T{T{1}}, T{2}}\n}\n"
+	if node, err = ParseBytes("main.go", []byte(code)); err != nil {
+		t.Fatal(err)
+	}
+	for _, query := range []string{"//composite_lit", "//assign_stmt//composite_lit", "//*//composite_lit"} {
+		nodes, err := QueryNode(node, query)
+		if err != nil {
+			t.Fatal(err)
+		}
+		codes := make([]string, 0)
+		for _, n := range nodes {
+			codes = append(codes, string(n.Code()))
+		}
+		if fmt.Sprint(codes) != "[[]T{T{T{1}}, T{2}} T{T{1}} T{1} T{2}]" {
+			t.Fatalf("%s: expect document order, got %v", query, codes)
+		}
+	}
+	for _, query := range []string{"a[", "a[b=c]", "a[b='c'", "a b", ":", "a.b", "a[b..c]", "a[b.]"} {
+		if _, err = QueryNode(node, query); err == nil {
+			t.Fatalf("expect error for %q", query)
+		}
+	}
+}
+
+func TestPattern(t *testing.T) {
+	code := "package main\nfunc main() {\n\tfmt.Println(1, 2)\n\tfmt.Printf(\"x\")\n\tlog.Println(3)\n}\n"
+	node, err := ParseBytes("main.go", []byte(code))
+	if err != nil {
+		t.Fatal(err)
+	}
+	pattern, err := CompilePattern(`(call_expr callee:(selector_expr target:@pkg selector:"Println") argument:@args) @call`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	matches := pattern.FindAll(node)
+	if len(matches) != 2 {
+		t.Fatalf("expect 2 matches, got %d", len(matches))
+	}
+	if string(matches[0].Captures["pkg"].Code()) != "fmt" || string(matches[1].Captures["pkg"].Code()) != "log" {
+		t.Fatal("unexpected pkg captures")
+	}
+	if matches[0].Captures["call"] != matches[0].Node || matches[0].Captures["args"] == nil {
+		t.Fatal("unexpected call captures")
+	}
+	// a capture needs the field to be present unless it is marked optional
+	for src, expect := range map[string]int{`(call_expr type_argument:@t)`: 0, `(call_expr type_argument:@t?)`: 3} {
+		if pattern, err = CompilePattern(src); err != nil {
+			t.Fatal(err)
+		}
+		matches = pattern.FindAll(node)
+		if len(matches) != expect {
+			t.Fatalf("expect %d matches of %s, got %d", expect, src, len(matches))
+		}
+		for _, match := range matches {
+			if _, ok := match.Captures["t"]; ok {
+				t.Fatalf("unexpected capture of missing field: %s", src)
+			}
+		}
+	}
+	for _, src := range []string{"(no_such_kind)", "(call_expr no_such_field:_)", "(call_expr callee:", "(call_expr) extra", `"unterminated`, "@?"} {
+		if _, err = CompilePattern(src); err == nil {
+			t.Fatalf("expect error for %s", src)
+		}
+	}
+}
+
+func TestDiff(t *testing.T) {
+	a, err := ParseBytes("a.go", []byte("package main\nfunc main() {\n\tprint(1)\n\tprint(2)\n\tprintln(5, 6)\n}\nfunc g(a int) {\n\tx := 1\n}\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	b, err := ParseBytes("b.go", []byte("package main\nfunc g(a string) {\n\tx := 1\n\tprintln(5, 6)\n}\nfunc main() {\n\tprint(1)\n\tprint(3)\n\tprint(4)\n}\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	changes := make([]string, 0)
+	for _, change := range Diff(a, b) {
+		changes = append(changes, change.String())
+	}
+	expect := []string{
+		`move function_decl "func g(a int) {\n\tx := 1\n}"`,
+		`update token "int" -> "string"`,
+		`move expr_stmt "println(5, 6)"`,
+		`update token "2" -> "3"`,
+		`insert expr_stmt "print(4)"`,
+	}
+	if fmt.Sprint(changes) != fmt.Sprint(expect) {
+		t.Fatalf("unexpected changes:\n%s", strings.Join(changes, "\n"))
+	}
+	if len(Diff(a, a)) != 0 {
+		t.Fatal("expect no change")
+	}
+	for _, change := range Diff(a, b) {
+		if change.Old != nil && change.New != nil && change.Old.Kind() != change.New.Kind() {
+			t.Fatalf("expect same kind in %s", change)
+		}
+	}
+	c, err := ParseBytes("c.go", []byte("package main\nfunc main() {\n\tprint(2)\n\tprint(1)\n\tprintln(5, 6)\n}\nfunc g(a int) {\n\tx := 1\n}\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	changes = changes[:0]
+	for _, change := range Diff(a, c) {
+		changes = append(changes, change.String())
+	}
+	if fmt.Sprint(changes) != fmt.Sprint([]string{`move expr_stmt "print(2)"`}) {
+		t.Fatalf("unexpected changes for swapped statements:\n%s", strings.Join(changes, "\n"))
+	}
+}
+
+func TestEqualAndHash(t *testing.T) {
+	a, err := ParseBytes("a.go", []byte("package main\nfunc main() {\n\tprint(1 + 2)\n}\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	b, err := ParseBytes("b.go", []byte("package main\n\nfunc main() {\n\tprint(1+2)\n}\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	c, err := ParseBytes("a.go", []byte("package main\nfunc main() {\n\tprint(1 - 2)\n}\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	all := EqualOptions{IgnorePositions: true, IgnoreFilePaths: true, IgnoreTrivia: true}
+	if !Equal(a, a.Fork(), EqualOptions{}) || !Equal(a, b, all) || Equal(a, c, all) {
+		t.Fatal("unexpected equality")
+	}
+	if Equal(a, b, EqualOptions{IgnorePositions: true, IgnoreFilePaths: true}) || Equal(a, b, EqualOptions{IgnoreFilePaths: true, IgnoreTrivia: true}) {
+		t.Fatal("expect trivia and positions to matter")
+	}
+	d, err := ParseBytes("d.go", []byte(string(a.Code())))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if Equal(a, d, EqualOptions{}) || !Equal(a, d, EqualOptions{IgnoreFilePaths: true}) {
+		t.Fatal("expect file path to matter")
+	}
+	if Hash(a) != Hash(b) || Hash(a) == Hash(c) {
+		t.Fatal("unexpected hash")
+	}
+}
+
+func TestLoadNode(t *testing.T) {
+	code := "package main\n\nfunc main() {\n\t// say <hi>\n\tprint(\"a\\tb\", `x\ny`, 1 << 2)\n}\n"
+	node, err := ParseBytes("main.go", []byte(code))
+	if err != nil {
+		t.Fatal(err)
+	}
+	dump := SimpleDumpNode(node)
+	loaded, err := LoadNode([]byte(dump))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !Equal(node, loaded, EqualOptions{IgnoreFilePaths: true, IgnoreTrivia: true}) {
+		t.Fatal("loaded node is not equal")
+	}
+	if SimpleDumpNode(loaded) != dump {
+		t.Fatal("dump of loaded node differs")
+	}
+	if loaded.FilePath() != "" || strings.Contains(string(loaded.Code()), "say <hi>") {
+		t.Fatal("expect the path and comments to be dropped as documented")
+	}
+	loaded.BuildLink()
+	calls, _ := QueryNode(loaded, "//call_expr/callee")
+	if len(calls) != 1 || string(calls[0].Code()) != "print" || calls[0].RangeStart().LineIdx != 4 {
+		t.Fatal("unexpected callee of loaded node")
+	}
+	for _, b := range []string{"", "{", `{"kind": "no_such_kind", "start": [0, 0, 0], "end": [0, 0, 0]}`, `{"kind": "token", "start": [0, 0, 0]}`,
+		`{"kind": "nodes", "start": [0, 0, 0], "end": [2000000000, 0, 0], "nodes": [{"kind": "token", "start": [0, 0, 0], "end": [1, 0, 0], "code": "a", "type": "ident"}]}`,
+		`{"kind": "token", "start": [2000000000, 0, 0], "end": [2000000001, 0, 0], "code": "a", "type": "ident"}`} {
+		if _, err = LoadNode([]byte(b)); err == nil {
+			t.Fatalf("expect error for %s", b)
+		}
+	}
+}
+
+func TestMarshalBinary(t *testing.T) {
+	code := "package main\n\nfunc main() {\n\tprint(\"a\", 1 << 2)\n\tprint(\"a\")\n}\n"
+	node, err := ParseBytes("main.go", []byte(code))
+	if err != nil {
+		t.Fatal(err)
+	}
+	b, err := MarshalBinary(node)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(b) >= len(SimpleDumpNode(node))/4 {
+		t.Fatalf("binary is not compact: %d bytes", len(b))
+	}
+	loaded, err := UnmarshalBinary(b)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !Equal(node, loaded, EqualOptions{IgnoreFilePaths: true, IgnoreTrivia: true}) || SimpleDumpNode(loaded) != SimpleDumpNode(node) {
+		t.Fatal("unmarshaled node is not equal")
+	}
+	for i := 0; i < len(b); i++ {
+		if _, err = UnmarshalBinary(b[:i]); err == nil {
+			t.Fatalf("expect error for truncated data of %d bytes", i)
+		}
+	}
+	stale := append([]byte{}, b...)
+	stale[len(binaryMagic)] ^= 0xff
+	if _, err = UnmarshalBinary(stale); err == nil || !strings.Contains(err.Error(), "schema mismatch") {
+		t.Fatalf("expect schema mismatch, got %v", err)
+	}
+	header := binary.BigEndian.AppendUint64([]byte(binaryMagic), nodeSchemaHash)
+	header = binary.AppendUvarint(header, 0)
+	huge := binary.AppendUvarint(header, uint64(nodeKindIds[NodeTypeNodes]+1))
+	for _, v := range []uint64{0, 0, 0, 1<<31 - 1, 0, 0, 0} {
+		huge = binary.AppendUvarint(huge, v)
+	}
+	if _, err = UnmarshalBinary(huge); err == nil || !strings.Contains(err.Error(), "exceeds") {
+		t.Fatalf("expect position error, got %v", err)
+	}
+	deep := header
+	for i := 0; i <= DefaultMaxDepth+1; i++ {
+		deep = binary.AppendUvarint(deep, uint64(nodeKindIds[NodeTypeNodes]+1))
+		deep = append(deep, 0, 0, 0, 0, 0, 0, 1)
+	}
+	if _, err = UnmarshalBinary(deep); err == nil || !strings.Contains(err.Error(), "nesting") {
+		t.Fatalf("expect nesting error, got %v", err)
+	}
+}
+
+func TestPrecedence(t *testing.T) {
+	code := "package main\nfunc main() {\n\tx := a || b && c == d - e - f * g\n}\n"
+	node, err := ParseBytes("main.go", []byte(code))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for query, expect := range map[string]string{
+		"//logical_or_expr/rhs":  "b && c == d - e - f * g",
+		"//logical_and_expr/rhs": "c == d - e - f * g",
+		"//compare_expr/rhs":     "d - e - f * g",
+		"//compare_expr/rhs/lhs": "d - e",
+		"//compare_expr/rhs/rhs": "f * g",
+	} {
+		nodes, err := QueryNode(node, query)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(nodes) != 1 || string(nodes[0].Code()) != expect {
+			t.Fatalf("unexpected result for %s: %v", query, nodes)
+		}
+	}
+}
+
+func TestControlClause(t *testing.T) {
+	for _, c := range []struct{ code, query, expect string }{
+		{"if x {\n\t}", "//if_stmt/cond", "x"},
+		{"if v := f(T{1}); v {\n\t}", "//if_stmt/cond", "v"},
+		{"for _, v := range f(T{}) {\n\t}", "//for_decl_range_stmt/x", "f(T{})"},
+		{"if f(func() {\n\t\tif y {\n\t\t}\n\t\t_ = T{}\n\t}) {\n\t}", "//composite_lit", "T{}"},
+	} {
+		node, err := ParseBytes("main.go", []byte("package main\nfunc main() {\n\t"+c.code+"\n}\n"))
+		if err != nil {
+			t.Fatal(err)
+		}
+		nodes, err := QueryNode(node, c.query)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(nodes) != 1 || string(nodes[0].Code()) != c.expect {
+			t.Fatalf("unexpected result for %q: %v", c.code, nodes)
+		}
+	}
+}
+
+func TestParserMarkState(t *testing.T) {
+	ps := NewParser("main.go", []rune("package main\n"), nil)
+	outer := ps._mark()
+	ps.enterCtrl()
+	inner := ps._mark()
+	ps.leaveCtrl()
+	ps._reset(inner)
+	if ps.state.ctrl == nil {
+		t.Fatal("expect inner mark to restore the control clause")
+	}
+	ps._reset(outer)
+	if ps.state.ctrl != nil {
+		t.Fatal("expect outer mark to restore the state before the control clause")
+	}
+}
+
+type stmtCounter struct {
+	kinds []string
+}
+
+func (c *stmtCounter) VisitStmt(n Stmt) bool {
+	c.kinds = append(c.kinds, n.Kind())
+	return n.Kind() != NodeTypeIfStmt
+}
+
+func TestNodeCategories(t *testing.T) {
+	node, err := ParseBytes("main.go", []byte("package main\nfunc main() {\n\tx := 1\n\tif x > 0 {\n\t\tx++\n\t}\n\treturn\n}\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	c := &stmtCounter{}
+	WalkStmt(c, node)
+	if strings.Join(c.kinds, " ") != "block_stmt short_var_decl if_stmt return_stmt" {
+		t.Fatalf("unexpected statements: %v", c.kinds)
+	}
+	if _, ok := node.(Expr); ok {
+		t.Fatal("file should not be an expression")
+	}
+	if categories := NodeCategory(NodeTypeGenericTypeInstantiation); strings.Join(categories, " ") != "expr type" {
+		t.Fatalf("unexpected categories: %v", categories)
+	}
+	if categories := NodeCategory(NodeTypeFile); len(categories) != 0 {
+		t.Fatalf("unexpected categories: %v", categories)
+	}
+}
+
+func TestFieldInfo(t *testing.T) {
+	if info, ok := NodeFieldInfo(NodeTypeFile, "imports"); !ok || !info.List || info.Optional {
+		t.Fatalf("unexpected field info: %+v", info)
+	}
+	if info, ok := NodeFieldInfo(NodeTypeReturnStmt, "x"); !ok || !info.Optional {
+		t.Fatalf("unexpected field info: %+v", info)
+	}
+	if _, ok := NodeFieldInfo(NodeTypeFile, "nothing"); ok {
+		t.Fatal("unexpected field info of unknown field")
+	}
+	if len(NodeFieldInfos(NodeTypeForStmt)) != len(nodeKindFields[NodeTypeForStmt]) {
+		t.Fatal("field infos do not match fields")
+	}
+	node, err := ParseBytes("main.go", []byte("package main\nfunc main() {\n\treturn\n}\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	dump := SimpleDumpNode(node)
+	if !strings.Contains(dump, `"imports": []`) || !strings.Contains(dump, `"start": [28, 2, 1], "x": null`) {
+		t.Fatalf("unexpected dump: %s", dump)
+	}
+	loaded, err := LoadNode([]byte(dump))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if SimpleDumpNode(loaded) != dump {
+		t.Fatal("dump of loaded node differs")
+	}
+	node, err = ParseBytes("main.go", []byte("package main\nimport \"fmt\"\nfunc main() {\n\tfmt.Println()\n}\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	dump = SimpleDumpNode(node)
+	if !strings.Contains(dump, `"imports": [{"kind": "import_one_decl"`) || !strings.Contains(dump, `"decls": [{"kind": "function_decl"`) {
+		t.Fatalf("unexpected dump: %s", dump)
+	}
+	loaded, err = LoadNode([]byte(dump))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if SimpleDumpNode(loaded) != dump || loaded.(*FileNode).Imports().Kind() != NodeTypeNodes {
+		t.Fatal("dump of loaded node differs")
+	}
+}
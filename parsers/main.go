@@ -4,6 +4,7 @@ import (
 	"github.com/lincaiyong/log"
 	"github.com/lincaiyong/pgen"
 	"os"
+	"path/filepath"
 	"time"
 )
 
@@ -25,5 +26,29 @@ func main() {
 		log.ErrorLog("fail to write file: %v", err)
 		return
 	}
+	sampleFiles, err := filepath.Glob("go/testdata/*.go")
+	if err != nil {
+		log.ErrorLog("fail to list samples: %v", err)
+		return
+	}
+	samples := make([]string, 0, len(sampleFiles))
+	for _, sampleFile := range sampleFiles {
+		b, err := os.ReadFile(sampleFile)
+		if err != nil {
+			log.ErrorLog("fail to read sample: %v", err)
+			return
+		}
+		samples = append(samples, string(b))
+	}
+	fuzzTest, err := pgen.RunFuzzTest(grammar, samples)
+	if err != nil {
+		log.ErrorLog("fail to run: %v", err)
+		return
+	}
+	err = os.WriteFile("goparser/goparser_fuzz_test.go", []byte(fuzzTest), 0644)
+	if err != nil {
+		log.ErrorLog("fail to write file: %v", err)
+		return
+	}
 	log.InfoLog("finished in %s\n", time.Since(start))
 }
@@ -2,6 +2,7 @@ package pgen
 
 import (
 	"errors"
+	"fmt"
 	"github.com/lincaiyong/pgen/stages"
 	"os"
 	"path/filepath"
@@ -11,20 +12,54 @@ import (
 )
 
 func preProcessNodes(text string) string {
-	regex := regexp.MustCompile(`\{([a-z][a-z0-9_]+\([^)]*\))}`)
+	declared := make(map[string]bool)
+	for _, item := range regexp.MustCompile(`(?m)^(\w+) +<[\w ?*:]*>$`).FindAllStringSubmatch(text, -1) {
+		declared[item[1]] = true
+	}
+	regex := regexp.MustCompile(`\{([a-z][a-z0-9_]+)\(([^)]*)\)}`)
 	items := regex.FindAllStringSubmatch(text, -1)
-	var nodes []string
-	nodeMap := make(map[string]struct{})
+	nodeArgs := make(map[string][]string)
+	nodeOptional := make(map[string][]bool)
 	for _, item := range items {
-		if _, ok := nodeMap[item[1]]; !ok {
-			nodeMap[item[1]] = struct{}{}
-			node := strings.ReplaceAll(item[1], " ", "")
-			node = strings.ReplaceAll(node, ",", " ")
-			node = strings.ReplaceAll(node, "(", " <")
-			node = strings.ReplaceAll(node, ")", ">")
-			nodes = append(nodes, node)
+		name := item[1]
+		if declared[name] {
+			continue
+		}
+		args := make([]string, 0)
+		if argsText := strings.ReplaceAll(item[2], " ", ""); argsText != "" {
+			args = strings.Split(argsText, ",")
+		}
+		for i, arg := range args {
+			args[i] = strings.Trim(arg, "[]")
+		}
+		prev, ok := nodeArgs[name]
+		if !ok {
+			nodeArgs[name] = args
+			nodeOptional[name] = make([]bool, len(args))
+			for i, arg := range args {
+				nodeOptional[name][i] = arg == "_"
+			}
+			continue
+		}
+		for i := 0; i < len(prev) && i < len(args); i++ {
+			if prev[i] == "_" {
+				prev[i] = args[i]
+			}
+			if args[i] == "_" {
+				nodeOptional[name][i] = true
+			}
 		}
 	}
+	var nodes []string
+	for name, args := range nodeArgs {
+		fields := make([]string, len(args))
+		for i, arg := range args {
+			if fields[i] = arg; nodeOptional[name][i] && arg != "_" {
+				fields[i] += "?"
+			}
+		}
+		nodes = append(nodes, fmt.Sprintf("%s <%s>", name, strings.Join(fields, " ")))
+	}
 	sort.Strings(nodes)
 	text = strings.ReplaceAll(text, "#include(node)", strings.Join(nodes, "\n"))
 	return text
@@ -88,3 +123,36 @@ func Run(input string) (string, error) {
 	output := strings.TrimRight(s4.Gen.String(), "\n") + "\n"
 	return output, nil
 }
+
+func RunFuzzTest(input string, samples []string) (string, error) {
+	s1 := stages.RunStage1(input)
+	if s1.Error.ToError() != nil {
+		return "", s1.Error.ToError()
+	}
+	s2 := stages.RunStage2(s1)
+	if s2.Error.ToError() != nil {
+		return "", s2.Error.ToError()
+	}
+	s5 := stages.RunStage5(s2, samples)
+	if s5.Error.ToError() != nil {
+		return "", s5.Error.ToError()
+	}
+	output := strings.TrimRight(s5.Gen.String(), "\n") + "\n"
+	return output, nil
+}
+
+func RunDoc(input string) (map[string]string, error) {
+	s1 := stages.RunStage1(input)
+	if s1.Error.ToError() != nil {
+		return nil, s1.Error.ToError()
+	}
+	s2 := stages.RunStage2(s1)
+	if s2.Error.ToError() != nil {
+		return nil, s2.Error.ToError()
+	}
+	s6 := stages.RunStage6(s2)
+	if s6.Error.ToError() != nil {
+		return nil, s6.Error.ToError()
+	}
+	return s6.Files, nil
+}
@@ -1,18 +1,6 @@
 package snippet
 
-const DefaultHackFile = `import (
-	"errors"
-	"fmt"
-)
-
-func (tk *Tokenizer) Parse() (tokens []*Token, err error) {
-	defer func() {
-		err2 := recover()
-		if err2 != nil {
-			err = errors.New(fmt.Sprintf("%v", err2))
-		}
-	}()
-
+const DefaultHackFile = `func (tk *Tokenizer) Parse() (tokens []*Token, err error) {
 	tokens = make([]*Token, 0)
 	for {
 		var tok *Token
@@ -0,0 +1,76 @@
+package snippet
+
+const FuzzTestFile = `import (
+	"testing"
+)
+
+const fuzzMaxSteps = 1 << 20
+
+// fuzzSeeds are built from the keywords, operators, string atoms and token rules of
+// the grammar. They need not parse, but must not break the parser.
+var fuzzSeeds = []string{<seeds_placeholder>
+}
+
+// fuzzSamples are sample programs, each of which must parse.
+var fuzzSamples = []string{<samples_placeholder>
+}
+
+func TestFuzzSeeds(t *testing.T) {
+	for _, seed := range fuzzSeeds {
+		node, err := ParseBytes("seed", []byte(seed), WithMaxSteps(fuzzMaxSteps))
+		if err == nil {
+			fuzzCheck(t, node, []byte(seed))
+		}
+	}
+	for i, sample := range fuzzSamples {
+		node, err := ParseBytes("sample", []byte(sample), WithMaxSteps(fuzzMaxSteps))
+		if err != nil {
+			t.Fatalf("sample %d: %v", i, err)
+		}
+		fuzzCheck(t, node, []byte(sample))
+	}
+}
+
+func FuzzParseBytes(f *testing.F) {
+	for _, seed := range fuzzSeeds {
+		f.Add([]byte(seed))
+	}
+	for _, sample := range fuzzSamples {
+		f.Add([]byte(sample))
+	}
+	f.Fuzz(func(t *testing.T, b []byte) {
+		node, err := ParseBytes("fuzz", b, WithMaxSteps(fuzzMaxSteps))
+		if err != nil {
+			return
+		}
+		fuzzCheck(t, node, b)
+	})
+}
+
+func fuzzCheck(t *testing.T, node Node, b []byte) {
+	if node == nil {
+		t.Fatal("nil node without error")
+	}
+	r, _ := DecodeBytes(b)
+	fuzzCheckRange(t, node, len(r))
+}
+
+func fuzzCheckRange(t *testing.T, node Node, size int) {
+	start, end := node.Range()
+	if start.Offset < 0 || start.Offset > end.Offset || end.Offset > size {
+		t.Fatalf("invalid range of %s: [%d, %d), file size %d", node.Kind(), start.Offset, end.Offset, size)
+	}
+	prev := start.Offset
+	for _, field := range node.Fields() {
+		child := node.Child(field)
+		if child == nil || child.IsDummy() {
+			continue
+		}
+		childStart, childEnd := child.Range()
+		if childStart.Offset < prev || childEnd.Offset > end.Offset {
+			t.Fatalf("range of %s.%s [%d, %d) overlaps its previous sibling or leaves [%d, %d)", node.Kind(), field, childStart.Offset, childEnd.Offset, prev, end.Offset)
+		}
+		prev = childEnd.Offset
+		fuzzCheckRange(t, child, size)
+	}
+}`
@@ -0,0 +1,211 @@
+package snippet
+
+const BinaryFunc = `const binaryMagic = "PGAB"
+
+var nodeKindIds map[string]int
+
+func init() {
+	nodeKindIds = make(map[string]int)
+	for i, kind := range nodeKinds {
+		nodeKindIds[kind] = i
+	}
+}
+
+type binaryEncoder struct {
+	buf     []byte
+	strings []string
+	ids     map[string]int
+}
+
+func (e *binaryEncoder) uvarint(v int) {
+	e.buf = binary.AppendUvarint(e.buf, uint64(v))
+}
+
+func (e *binaryEncoder) string(s string) {
+	id, ok := e.ids[s]
+	if !ok {
+		id = len(e.strings)
+		e.ids[s] = id
+		e.strings = append(e.strings, s)
+	}
+	e.uvarint(id)
+}
+
+func (e *binaryEncoder) position(p Position) {
+	e.uvarint(p.Offset)
+	e.uvarint(p.LineIdx)
+	e.uvarint(p.CharIdx)
+}
+
+func (e *binaryEncoder) node(node Node) error {
+	if node == nil || node.IsDummy() {
+		e.uvarint(0)
+		return nil
+	}
+	id, ok := nodeKindIds[node.Kind()]
+	if !ok {
+		return fmt.Errorf("marshal error: unknown kind '%s'", node.Kind())
+	}
+	e.uvarint(id + 1)
+	start, end := node.Range()
+	e.position(start)
+	e.position(end)
+	if tok, ok := node.(*TokenNode); ok {
+		e.string(tok.token.Kind)
+		e.string(string(tok.Code()))
+		return nil
+	}
+	if node.Kind() == NodeTypeNodes {
+		nodes := node.UnpackNodes()
+		e.uvarint(len(nodes))
+		for _, child := range nodes {
+			if err := e.node(child); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	for _, field := range nodeKindFields[node.Kind()] {
+		if err := e.node(node.Child(field)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// MarshalBinary encodes a tree as the magic, the node schema hash, a string table for
+// token types and codes, then the nodes in pre-order with uvarint kind ids and positions.
+func MarshalBinary(node Node) ([]byte, error) {
+	body := &binaryEncoder{ids: make(map[string]int)}
+	if err := body.node(node); err != nil {
+		return nil, err
+	}
+	ret := []byte(binaryMagic)
+	ret = binary.BigEndian.AppendUint64(ret, nodeSchemaHash)
+	ret = binary.AppendUvarint(ret, uint64(len(body.strings)))
+	for _, s := range body.strings {
+		ret = binary.AppendUvarint(ret, uint64(len(s)))
+		ret = append(ret, s...)
+	}
+	return append(ret, body.buf...), nil
+}
+
+type binaryDecoder struct {
+	buf     []byte
+	strings []string
+}
+
+func (d *binaryDecoder) uvarint() (int, error) {
+	v, n := binary.Uvarint(d.buf)
+	if n <= 0 || v > 1<<31-1 {
+		return 0, errors.New("unmarshal error: invalid uvarint")
+	}
+	d.buf = d.buf[n:]
+	return int(v), nil
+}
+
+func (d *binaryDecoder) string() (string, error) {
+	id, err := d.uvarint()
+	if err != nil {
+		return "", err
+	}
+	if id >= len(d.strings) {
+		return "", fmt.Errorf("unmarshal error: invalid string id %d", id)
+	}
+	return d.strings[id], nil
+}
+
+func (d *binaryDecoder) position() (p Position, err error) {
+	for _, v := range []*int{&p.Offset, &p.LineIdx, &p.CharIdx} {
+		if *v, err = d.uvarint(); err != nil {
+			return p, err
+		}
+		if *v > loadMaxSize {
+			return p, fmt.Errorf("unmarshal error: position %d exceeds %d", *v, loadMaxSize)
+		}
+	}
+	return p, nil
+}
+
+func (d *binaryDecoder) node(depth int) (*dumpedNode, error) {
+	if depth > DefaultMaxDepth {
+		return nil, fmt.Errorf("unmarshal error: nesting exceeds %d", DefaultMaxDepth)
+	}
+	id, err := d.uvarint()
+	if err != nil || id == 0 {
+		return nil, err
+	}
+	if id > len(nodeKinds) {
+		return nil, fmt.Errorf("unmarshal error: invalid kind id %d", id)
+	}
+	ret := &dumpedNode{kind: nodeKinds[id-1]}
+	if ret.start, err = d.position(); err != nil {
+		return nil, err
+	}
+	if ret.end, err = d.position(); err != nil {
+		return nil, err
+	}
+	count := len(nodeKindFields[ret.kind])
+	switch ret.kind {
+	case NodeTypeToken:
+		if ret.typ, err = d.string(); err != nil {
+			return nil, err
+		}
+		ret.code, err = d.string()
+		return ret, err
+	case NodeTypeNodes:
+		if count, err = d.uvarint(); err != nil {
+			return nil, err
+		}
+		if count > len(d.buf) {
+			return nil, errors.New("unmarshal error: invalid nodes count")
+		}
+	}
+	for i := 0; i < count; i++ {
+		child, err := d.node(depth + 1)
+		if err != nil {
+			return nil, err
+		}
+		if child != nil || ret.kind != NodeTypeNodes {
+			ret.children = append(ret.children, child)
+		}
+	}
+	return ret, nil
+}
+
+func UnmarshalBinary(b []byte) (Node, error) {
+	if len(b) < len(binaryMagic)+8 || string(b[:len(binaryMagic)]) != binaryMagic {
+		return nil, errors.New("unmarshal error: invalid magic")
+	}
+	b = b[len(binaryMagic):]
+	if hash := binary.BigEndian.Uint64(b); hash != nodeSchemaHash {
+		return nil, fmt.Errorf("unmarshal error: node schema mismatch: %016x, expect %016x", hash, nodeSchemaHash)
+	}
+	d := &binaryDecoder{buf: b[8:]}
+	count, err := d.uvarint()
+	if err != nil {
+		return nil, err
+	}
+	if count > len(d.buf) {
+		return nil, errors.New("unmarshal error: invalid string table")
+	}
+	for i := 0; i < count; i++ {
+		n, err := d.uvarint()
+		if err != nil {
+			return nil, err
+		}
+		if n > len(d.buf) {
+			return nil, errors.New("unmarshal error: invalid string length")
+		}
+		d.strings = append(d.strings, string(d.buf[:n]))
+		d.buf = d.buf[n:]
+	}
+	root, err := d.node(0)
+	if err != nil {
+		return nil, err
+	}
+	if len(d.buf) != 0 {
+		return nil, errors.New("unmarshal error: trailing data")
+	}
+	return root.build()
+}`
@@ -0,0 +1,272 @@
+package snippet
+
+const DiffFunc = `const (
+	ChangeInsert = "insert"
+	ChangeDelete = "delete"
+	ChangeUpdate = "update"
+	ChangeMove   = "move"
+)
+
+type Change struct {
+	Kind string
+	Old  Node
+	New  Node
+}
+
+func (c Change) String() string {
+	switch c.Kind {
+	case ChangeInsert:
+		return fmt.Sprintf("insert %s %q", c.New.Kind(), string(c.New.Code()))
+	case ChangeDelete:
+		return fmt.Sprintf("delete %s %q", c.Old.Kind(), string(c.Old.Code()))
+	case ChangeUpdate:
+		return fmt.Sprintf("update %s %q -> %q", c.Old.Kind(), string(c.Old.Code()), string(c.New.Code()))
+	default:
+		return fmt.Sprintf("move %s %q", c.Old.Kind(), string(c.Old.Code()))
+	}
+}
+
+type differ struct {
+	hash   map[Node]uint64
+	size   map[Node]int
+	parent map[Node]Node
+	field  map[Node]string
+	match  map[Node]Node
+}
+
+// Diff computes an edit script from a to b. Identical subtrees are matched first,
+// then unmatched nodes are paired through their matched children and parents.
+func Diff(a, b Node) []Change {
+	d := &differ{
+		hash:   make(map[Node]uint64),
+		size:   make(map[Node]int),
+		parent: make(map[Node]Node),
+		field:  make(map[Node]string),
+		match:  make(map[Node]Node),
+	}
+	if a == nil || a.IsDummy() || b == nil || b.IsDummy() {
+		return d.changes(a, b)
+	}
+	d.index(a)
+	d.index(b)
+	d.matchIdentical(a, b)
+	d.matchContainers(b)
+	if d.match[a] == nil && d.match[b] == nil && a.Kind() == b.Kind() {
+		d.link(a, b)
+	}
+	d.matchFields(b)
+	return d.changes(a, b)
+}
+
+func (d *differ) index(node Node) uint64 {
+	for _, field := range node.Fields() {
+		if child := node.Child(field); child != nil && !child.IsDummy() {
+			d.parent[child] = node
+			d.field[child] = field
+		}
+	}
+	d.hash[node] = hashOf(node, d.index)
+	d.size[node] = 1
+	for _, child := range childNodes(node) {
+		d.size[node] += d.size[child]
+	}
+	return d.hash[node]
+}
+
+func (d *differ) link(a, b Node) {
+	d.match[a] = b
+	d.match[b] = a
+}
+
+// matchSubtree links two subtrees with equal hashes node by node. It stops at
+// nodes whose kinds or child counts differ, which only a hash collision causes.
+func (d *differ) matchSubtree(a, b Node) bool {
+	childrenA, childrenB := childNodes(a), childNodes(b)
+	if a.Kind() != b.Kind() || len(childrenA) != len(childrenB) {
+		return false
+	}
+	d.link(a, b)
+	for i := range childrenA {
+		d.matchSubtree(childrenA[i], childrenB[i])
+	}
+	return true
+}
+
+func (d *differ) matchIdentical(a, b Node) {
+	candidates := make(map[uint64][]Node)
+	a.Visit(func(n Node) (bool, bool) {
+		if d.size[n] > 1 {
+			candidates[d.hash[n]] = append(candidates[d.hash[n]], n)
+		}
+		return true, false
+	}, func(Node) bool {
+		return false
+	})
+	b.Visit(func(n Node) (bool, bool) {
+		if d.size[n] < 2 {
+			return true, false
+		}
+		var pick Node
+		for _, c := range candidates[d.hash[n]] {
+			if _, ok := d.match[c]; ok {
+				continue
+			}
+			if pick == nil {
+				pick = c
+			}
+			if pa := d.parent[c]; pa != nil && d.match[pa] == d.parent[n] && d.field[c] == d.field[n] {
+				pick = c
+				break
+			}
+		}
+		if pick == nil {
+			return true, false
+		}
+		if !d.matchSubtree(pick, n) {
+			return true, false
+		}
+		return false, false
+	}, func(Node) bool {
+		return false
+	})
+}
+
+func (d *differ) matchContainers(b Node) {
+	b.Visit(func(Node) (bool, bool) {
+		return true, false
+	}, func(n Node) bool {
+		if _, ok := d.match[n]; ok {
+			return false
+		}
+		for _, child := range childNodes(n) {
+			a := d.match[child]
+			if a == nil {
+				continue
+			}
+			if pa := d.parent[a]; pa != nil && d.match[pa] == nil && pa.Kind() == n.Kind() {
+				d.link(pa, n)
+				break
+			}
+		}
+		return false
+	})
+}
+
+func (d *differ) matchFields(b Node) {
+	b.Visit(func(n Node) (bool, bool) {
+		if _, ok := d.match[n]; ok {
+			return true, false
+		}
+		pa := d.match[d.parent[n]]
+		if pa == nil {
+			return true, false
+		}
+		if c := pa.Child(d.field[n]); c != nil && !c.IsDummy() && d.match[c] == nil && c.Kind() == n.Kind() {
+			d.link(c, n)
+		}
+		return true, false
+	}, func(Node) bool {
+		return false
+	})
+}
+
+// reordered finds the matched items of b's lists that changed order within their
+// matched list: those outside the longest run that keeps the order of a.
+func (d *differ) reordered(b Node) map[Node]bool {
+	ret := make(map[Node]bool)
+	b.Visit(func(n Node) (bool, bool) {
+		pa := d.match[n]
+		if n.Kind() != NodeTypeNodes || pa == nil {
+			return true, false
+		}
+		items := make([]Node, 0)
+		indexes := make([]int, 0)
+		for _, c := range n.UnpackNodes() {
+			if old := d.match[c]; old != nil && d.parent[old] == pa {
+				index, _ := strconv.Atoi(d.field[old])
+				items = append(items, c)
+				indexes = append(indexes, index)
+			}
+		}
+		kept := make(map[int]bool)
+		for _, i := range longestIncreasing(indexes) {
+			kept[i] = true
+		}
+		for i, c := range items {
+			if !kept[i] {
+				ret[c] = true
+			}
+		}
+		return true, false
+	}, func(Node) bool {
+		return false
+	})
+	return ret
+}
+
+// longestIncreasing returns the positions of a longest strictly increasing subsequence of xs.
+func longestIncreasing(xs []int) []int {
+	tails := make([]int, 0)
+	prev := make([]int, len(xs))
+	for i, x := range xs {
+		pos := sort.Search(len(tails), func(j int) bool { return xs[tails[j]] >= x })
+		prev[i] = -1
+		if pos > 0 {
+			prev[i] = tails[pos-1]
+		}
+		if pos == len(tails) {
+			tails = append(tails, i)
+		} else {
+			tails[pos] = i
+		}
+	}
+	ret := make([]int, len(tails))
+	if len(tails) == 0 {
+		return ret
+	}
+	for i, k := len(tails)-1, tails[len(tails)-1]; i >= 0; i-- {
+		ret[i] = k
+		k = prev[k]
+	}
+	return ret
+}
+
+func (d *differ) changes(a, b Node) []Change {
+	ret := make([]Change, 0)
+	if a != nil && !a.IsDummy() {
+		a.Visit(func(n Node) (bool, bool) {
+			if d.match[n] != nil {
+				return true, false
+			}
+			if p := d.parent[n]; p == nil || d.match[p] != nil {
+				ret = append(ret, Change{Kind: ChangeDelete, Old: n})
+			}
+			return true, false
+		}, func(Node) bool {
+			return false
+		})
+	}
+	if b != nil && !b.IsDummy() {
+		reordered := d.reordered(b)
+		b.Visit(func(n Node) (bool, bool) {
+			old := d.match[n]
+			if old == nil {
+				if p := d.parent[n]; p == nil || d.match[p] != nil {
+					ret = append(ret, Change{Kind: ChangeInsert, New: n})
+				}
+				return true, false
+			}
+			if n.Kind() == NodeTypeToken && string(n.Code()) != string(old.Code()) {
+				ret = append(ret, Change{Kind: ChangeUpdate, Old: old, New: n})
+			}
+			pa, pb := d.parent[old], d.parent[n]
+			if pa != nil && pb != nil && (d.match[pa] != pb || reordered[n] || pb.Kind() != NodeTypeNodes && d.field[old] != d.field[n]) {
+				ret = append(ret, Change{Kind: ChangeMove, Old: old, New: n})
+			}
+			return true, false
+		}, func(Node) bool {
+			return false
+		})
+	}
+	return ret
+}`
@@ -4,12 +4,32 @@ const DumpNodeFunc = `func DumpNode(n Node, hook func(Node, map[string]string) s
 	return CustomDumpNode(n, hook)
 }
 
+// dumpNodeList dumps a list field as a JSON array of its elements, so empty and
+// non-empty lists have the same shape.
+func dumpNodeList(n Node, hook func(Node, map[string]string) string) string {
+	if nodes, ok := n.(*NodesNode); ok {
+		return nodes.dumpNodes(hook)
+	}
+	if n.IsDummy() {
+		return "[]"
+	}
+	return DumpNode(n, hook)
+}
+
+func dumpString(s string) string {
+	var buf bytes.Buffer
+	encoder := json.NewEncoder(&buf)
+	encoder.SetEscapeHTML(false)
+	_ = encoder.Encode(s)
+	return strings.TrimSuffix(buf.String(), "\n")
+}
+
 func DumpNodeIndent(node Node) string {
 	result := SimpleDumpNode(node)
 	var v any
 	err := json.Unmarshal([]byte(result), &v)
 	if err != nil {
-		panic(err)
+		return result
 	}
 	b, _ := json.MarshalIndent(v, "", "  ")
 	return string(b)
@@ -20,6 +40,9 @@ func CustomDumpNode(node Node, hook func(Node, map[string]string) string) string
 		return "null"
 	}
 	itemMap := node.Dump(hook)
+	start, end := node.Range()
+	itemMap["start"] = fmt.Sprintf("[%d, %d, %d]", start.Offset, start.LineIdx, start.CharIdx)
+	itemMap["end"] = fmt.Sprintf("[%d, %d, %d]", end.Offset, end.LineIdx, end.CharIdx)
 	ret := hook(node, itemMap)
 	if ret != "" {
 		return ret
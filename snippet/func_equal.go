@@ -0,0 +1,85 @@
+package snippet
+
+const EqualFunc = `type EqualOptions struct {
+	IgnorePositions bool
+	IgnoreFilePaths bool
+	IgnoreTrivia    bool
+}
+
+func Equal(a, b Node, opts EqualOptions) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if !opts.IgnoreTrivia && string(a.Code()) != string(b.Code()) {
+		return false
+	}
+	return equalNodes(a, b, opts)
+}
+
+func equalNodes(a, b Node, opts EqualOptions) bool {
+	if a.IsDummy() || b.IsDummy() {
+		return a.IsDummy() == b.IsDummy()
+	}
+	if a.Kind() != b.Kind() {
+		return false
+	}
+	if !opts.IgnorePositions {
+		startA, endA := a.Range()
+		startB, endB := b.Range()
+		if startA != startB || endA != endB {
+			return false
+		}
+	}
+	if !opts.IgnoreFilePaths && a.FilePath() != b.FilePath() {
+		return false
+	}
+	if tokenA, ok := a.(*TokenNode); ok {
+		tokenB, ok := b.(*TokenNode)
+		return ok && tokenA.token.Kind == tokenB.token.Kind && string(tokenA.Code()) == string(tokenB.Code())
+	}
+	fieldsA, fieldsB := a.Fields(), b.Fields()
+	if len(fieldsA) != len(fieldsB) {
+		return false
+	}
+	for i, field := range fieldsA {
+		if field != fieldsB[i] {
+			return false
+		}
+		childA, childB := a.Child(field), b.Child(field)
+		if childA == nil || childB == nil {
+			if childA != childB {
+				return false
+			}
+			continue
+		}
+		if !equalNodes(childA, childB, opts) {
+			return false
+		}
+	}
+	return true
+}
+
+func Hash(node Node) uint64 {
+	return hashOf(node, Hash)
+}
+
+func hashOf(node Node, childHash func(Node) uint64) uint64 {
+	h := fnv.New64a()
+	if node == nil || node.IsDummy() {
+		h.Write([]byte(NodeTypeDummy))
+		return h.Sum64()
+	}
+	h.Write([]byte(node.Kind()))
+	if tok, ok := node.(*TokenNode); ok {
+		_, _ = fmt.Fprintf(h, ";%s:%q", tok.token.Kind, string(tok.Code()))
+	}
+	for _, field := range node.Fields() {
+		child := node.Child(field)
+		if child == nil || child.IsDummy() {
+			_, _ = fmt.Fprintf(h, ";%s:-", field)
+			continue
+		}
+		_, _ = fmt.Fprintf(h, ";%s:%d", field, childHash(child))
+	}
+	return h.Sum64()
+}`
@@ -0,0 +1,196 @@
+package snippet
+
+const LoadNodeFunc = `type dumpedNode struct {
+	kind     string
+	start    Position
+	end      Position
+	code     string
+	typ      string
+	children []*dumpedNode
+}
+
+// LoadNode rebuilds a tree from the output of SimpleDumpNode. The dump holds neither the
+// file path nor the file content, so the loaded tree has an empty FilePath and its content
+// is reconstructed from the dumped tokens: comments, other trivia and tokens dropped by
+// actions become spaces, line breaks are kept only before tokens, and Code and error
+// messages show this reconstructed text instead of the original source.
+func LoadNode(b []byte) (Node, error) {
+	root, err := decodeDumpedNode(b)
+	if err != nil {
+		return nil, err
+	}
+	return root.build()
+}
+
+// loadMaxSize bounds the file content rebuilt by LoadNode and UnmarshalBinary, so a
+// crafted offset cannot force a huge allocation.
+const loadMaxSize = 1 << 24
+
+func (root *dumpedNode) build() (Node, error) {
+	if root == nil {
+		return DummyNode, nil
+	}
+	size := 0
+	var tokens []*dumpedNode
+	var collect func(*dumpedNode) error
+	collect = func(d *dumpedNode) error {
+		if d == nil {
+			return nil
+		}
+		if d.start.Offset < 0 || d.start.Offset > d.end.Offset {
+			return fmt.Errorf("load error: invalid range of %s", d.kind)
+		}
+		if d.end.Offset > loadMaxSize {
+			return fmt.Errorf("load error: range of %s exceeds %d", d.kind, loadMaxSize)
+		}
+		if d.end.Offset > size {
+			size = d.end.Offset
+		}
+		if d.kind == NodeTypeToken && d.typ != TokenTypeLiteral {
+			tokens = append(tokens, d)
+		}
+		for _, child := range d.children {
+			if err := collect(child); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	if err := collect(root); err != nil {
+		return nil, err
+	}
+	content := make([]rune, size)
+	for i := range content {
+		content[i] = ' '
+	}
+	for _, tok := range tokens {
+		if nl := tok.start.Offset - tok.start.CharIdx - 1; tok.start.LineIdx > 0 && nl >= 0 && nl < size {
+			content[nl] = '\n'
+		}
+	}
+	for _, tok := range tokens {
+		code := []rune(tok.code)
+		if tok.start.Offset+len(code) > size {
+			return nil, fmt.Errorf("load error: token %q out of range", tok.code)
+		}
+		copy(content[tok.start.Offset:], code)
+	}
+	return buildDumpedNode(root, content), nil
+}
+
+func decodeDumpedNode(b []byte) (*dumpedNode, error) {
+	if b = bytes.TrimSpace(b); len(b) > 0 && b[0] == '[' {
+		return decodeDumpedList(b)
+	}
+	var items map[string]json.RawMessage
+	if err := json.Unmarshal(b, &items); err != nil {
+		return nil, fmt.Errorf("load error: %w", err)
+	}
+	if items == nil {
+		return nil, nil
+	}
+	ret := &dumpedNode{}
+	if err := json.Unmarshal(items["kind"], &ret.kind); err != nil {
+		return nil, fmt.Errorf("load error: invalid kind: %w", err)
+	}
+	for key, pos := range map[string]*Position{"start": &ret.start, "end": &ret.end} {
+		var v [3]int
+		if err := json.Unmarshal(items[key], &v); err != nil {
+			return nil, fmt.Errorf("load error: invalid %s of %s: %w", key, ret.kind, err)
+		}
+		*pos = Position{Offset: v[0], LineIdx: v[1], CharIdx: v[2]}
+	}
+	switch ret.kind {
+	case NodeTypeToken:
+		if err := json.Unmarshal(items["code"], &ret.code); err != nil {
+			return nil, fmt.Errorf("load error: invalid token code: %w", err)
+		}
+		if err := json.Unmarshal(items["type"], &ret.typ); err != nil {
+			return nil, fmt.Errorf("load error: invalid token type: %w", err)
+		}
+	case NodeTypeNodes:
+		var nodes []json.RawMessage
+		if err := json.Unmarshal(items["nodes"], &nodes); err != nil {
+			return nil, fmt.Errorf("load error: invalid nodes: %w", err)
+		}
+		for _, item := range nodes {
+			child, err := decodeDumpedNode(item)
+			if err != nil {
+				return nil, err
+			}
+			if child != nil {
+				ret.children = append(ret.children, child)
+			}
+		}
+	default:
+		fields, ok := nodeKindFields[ret.kind]
+		if !ok {
+			return nil, fmt.Errorf("load error: unknown kind '%s'", ret.kind)
+		}
+		for _, field := range fields {
+			var child *dumpedNode
+			if item, ok := items[strings.TrimRight(field, "_")]; ok {
+				var err error
+				if child, err = decodeDumpedNode(item); err != nil {
+					return nil, err
+				}
+			}
+			ret.children = append(ret.children, child)
+		}
+	}
+	return ret, nil
+}
+
+// decodeDumpedList decodes a list field dumped as a JSON array into a nodes node
+// spanning its elements; an empty list is a dummy node.
+func decodeDumpedList(b []byte) (*dumpedNode, error) {
+	var items []json.RawMessage
+	if err := json.Unmarshal(b, &items); err != nil {
+		return nil, fmt.Errorf("load error: invalid list: %w", err)
+	}
+	ret := &dumpedNode{kind: NodeTypeNodes}
+	for _, item := range items {
+		child, err := decodeDumpedNode(item)
+		if err != nil {
+			return nil, err
+		}
+		if child != nil {
+			ret.children = append(ret.children, child)
+		}
+	}
+	if len(ret.children) == 0 {
+		return nil, nil
+	}
+	ret.start = ret.children[0].start
+	ret.end = ret.children[len(ret.children)-1].end
+	return ret, nil
+}
+
+func buildDumpedNode(d *dumpedNode, content []rune) Node {
+	if d == nil {
+		return nil
+	}
+	switch d.kind {
+	case NodeTypeToken:
+		if d.typ == TokenTypeLiteral {
+			return NewTokenNode("", content, NewToken(d.typ, d.start, d.end, []rune(d.code)))
+		}
+		return NewTokenNode("", content, NewToken(d.typ, d.start, d.end, content[d.start.Offset:d.end.Offset]))
+	case NodeTypeNodes:
+		nodes := make([]Node, 0, len(d.children))
+		for _, child := range d.children {
+			nodes = append(nodes, buildDumpedNode(child, content))
+		}
+		ret := NewNodesNode(nodes)
+		if !ret.IsDummy() {
+			ret.SetRange(d.start, d.end)
+		}
+		return ret
+	default:
+		children := make([]Node, 0, len(d.children))
+		for _, child := range d.children {
+			children = append(children, buildDumpedNode(child, content))
+		}
+		return newNodeOfKind(d.kind, "", content, children, d.start, d.end)
+	}
+}`
@@ -0,0 +1,80 @@
+package snippet
+
+const NodeAtFunc = `func childNodes(node Node) []Node {
+	if nodes := node.UnpackNodes(); nodes != nil {
+		return nodes
+	}
+	ret := make([]Node, 0)
+	for _, field := range node.Fields() {
+		if child := node.Child(field); child != nil && !child.IsDummy() {
+			ret = append(ret, child)
+		}
+	}
+	return ret
+}
+
+// NodeAt returns the path from root to the innermost node at pos. lines is the
+// line index of the file, built once with NewLineIndex and shared by lookups; nil
+// builds one for this call.
+func NodeAt(root Node, lines *LineIndex, pos Position) []Node {
+	if lines == nil {
+		lines = NewLineIndex(root.FileContent())
+	}
+	return NodeAtOffset(root, lines.PositionToOffset(pos.LineIdx, pos.CharIdx))
+}
+
+func NodeAtOffset(root Node, offset int) []Node {
+	ret := make([]Node, 0)
+	node := root
+	for node != nil && !node.IsDummy() {
+		start, end := node.Range()
+		if offset < start.Offset || offset >= end.Offset {
+			break
+		}
+		ret = append(ret, node)
+		var next Node
+		for _, child := range childNodes(node) {
+			if child.RangeStart().Offset > offset {
+				break
+			}
+			if offset < child.RangeEnd().Offset {
+				next = child
+				break
+			}
+		}
+		node = next
+	}
+	return ret
+}
+
+// NodesInRange returns the nodes inside [start, end], with lines as in NodeAt.
+func NodesInRange(root Node, lines *LineIndex, start, end Position) []Node {
+	if lines == nil {
+		lines = NewLineIndex(root.FileContent())
+	}
+	return NodesInOffsetRange(root, lines.PositionToOffset(start.LineIdx, start.CharIdx), lines.PositionToOffset(end.LineIdx, end.CharIdx))
+}
+
+func NodesInOffsetRange(root Node, start, end int) []Node {
+	ret := make([]Node, 0)
+	var visit func(node Node)
+	visit = func(node Node) {
+		nodeStart, nodeEnd := node.Range()
+		if nodeEnd.Offset < start || nodeStart.Offset > end {
+			return
+		}
+		if nodeStart.Offset >= start && nodeEnd.Offset <= end {
+			ret = append(ret, node)
+		}
+		for _, child := range childNodes(node) {
+			if child.RangeStart().Offset > end {
+				break
+			}
+			visit(child)
+		}
+	}
+	if root != nil && !root.IsDummy() {
+		visit(root)
+	}
+	return ret
+}`
@@ -1,6 +1,20 @@
 package snippet
 
-const ParseFunc = `func ParseFile(filePath string) (Node, error) {
+const ParseFunc = `type ParseOption func(ps *Parser)
+
+func WithMaxSteps(n int) ParseOption {
+	return func(ps *Parser) { ps.SetMaxSteps(n) }
+}
+
+func WithMaxBacktracks(n int) ParseOption {
+	return func(ps *Parser) { ps.SetMaxBacktracks(n) }
+}
+
+func WithMaxDepth(n int) ParseOption {
+	return func(ps *Parser) { ps.SetMaxDepth(n) }
+}
+
+func ParseFile(filePath string, opts ...ParseOption) (Node, error) {
 	b, err := os.ReadFile(filePath)
 	if err != nil {
 		return nil, err
@@ -14,6 +28,9 @@ const ParseFunc = `func ParseFile(filePath string) (Node, error) {
 	}
 	tokens = tokenizer.Clean(tokens)
 	parser := NewParser(filePath, r, tokens)
+	for _, opt := range opts {
+		opt(parser)
+	}
 	var ret Node
 	ret, err = parser.Parse()
 	if err != nil {
@@ -25,7 +42,11 @@ const ParseFunc = `func ParseFile(filePath string) (Node, error) {
 	return ret, nil
 }
 
-func ParseBytes(filePath string, b []byte) (Node, error) {
+func ParseBytes(filePath string, b []byte, opts ...ParseOption) (Node, error) {
+	return ParseBytesContext(context.Background(), filePath, b, opts...)
+}
+
+func ParseBytesContext(ctx context.Context, filePath string, b []byte, opts ...ParseOption) (Node, error) {
 	var err error
 	r, _ := DecodeBytes(b)
 	tokenizer := NewTokenizer(filePath, r)
@@ -36,6 +57,10 @@ func ParseBytes(filePath string, b []byte) (Node, error) {
 	}
 	tokens = tokenizer.Clean(tokens)
 	parser := NewParser(filePath, r, tokens)
+	parser.SetContext(ctx)
+	for _, opt := range opts {
+		opt(parser)
+	}
 	var ret Node
 	ret, err = parser.Parse()
 	if err != nil {
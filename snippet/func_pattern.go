@@ -0,0 +1,222 @@
+package snippet
+
+const PatternFunc = `type patternNode struct {
+	kind    string
+	text    *string
+	fields  map[string]*patternNode
+	items   []*patternNode
+	capture string
+	// optional lets the capture match a missing node, which is then not recorded
+	optional bool
+}
+
+type Pattern struct {
+	root *patternNode
+}
+
+type PatternMatch struct {
+	Node     Node
+	Captures map[string]Node
+}
+
+// CompilePattern parses an S-expression pattern such as
+// (call_expr callee:(ident) @fn argument:@args). "_" matches any node, "@name" captures
+// any present node, "@name?" also matches a missing one, "text" matches a node by its code,
+// and items of a (nodes ...) list match by position.
+func CompilePattern(src string) (*Pattern, error) {
+	pp := &patternParser{src: src}
+	root, err := pp.pattern()
+	if err != nil {
+		return nil, err
+	}
+	if pp.skipSpaces(); pp.pos < len(pp.src) {
+		return nil, pp.error("unexpected trailing input")
+	}
+	return &Pattern{root: root}, nil
+}
+
+type patternParser struct {
+	src string
+	pos int
+}
+
+func (pp *patternParser) error(msg string) error {
+	return fmt.Errorf("pattern error: %s at %d: %s", msg, pp.pos, pp.src)
+}
+
+func (pp *patternParser) peek() byte {
+	if pp.pos < len(pp.src) {
+		return pp.src[pp.pos]
+	}
+	return 0
+}
+
+func (pp *patternParser) skipSpaces() {
+	for pp.pos < len(pp.src) && strings.IndexByte(" \t\r\n", pp.src[pp.pos]) >= 0 {
+		pp.pos++
+	}
+}
+
+func (pp *patternParser) ident() string {
+	start := pp.pos
+	for pp.pos < len(pp.src) {
+		ch := pp.src[pp.pos]
+		if ch != '_' && !(ch >= 'a' && ch <= 'z') && !(ch >= 'A' && ch <= 'Z') && !(ch >= '0' && ch <= '9') {
+			break
+		}
+		pp.pos++
+	}
+	return pp.src[start:pp.pos]
+}
+
+func (pp *patternParser) capture(ret *patternNode) error {
+	pp.pos++
+	name := pp.ident()
+	if name == "" {
+		return pp.error("expect capture name")
+	}
+	ret.capture = name
+	if pp.peek() == '?' {
+		pp.pos++
+		ret.optional = true
+	}
+	return nil
+}
+
+func (pp *patternParser) pattern() (*patternNode, error) {
+	pp.skipSpaces()
+	ret := &patternNode{}
+	switch ch := pp.peek(); {
+	case ch == '@':
+		if err := pp.capture(ret); err != nil {
+			return nil, err
+		}
+		return ret, nil
+	case ch == '"':
+		end := pp.pos + 1
+		for end < len(pp.src) && pp.src[end] != '"' {
+			if pp.src[end] == '\\' {
+				end++
+			}
+			end++
+		}
+		if end >= len(pp.src) {
+			return nil, pp.error("unterminated string")
+		}
+		text, err := strconv.Unquote(pp.src[pp.pos : end+1])
+		if err != nil {
+			return nil, pp.error("invalid string")
+		}
+		ret.text = &text
+		pp.pos = end + 1
+	case ch == '(':
+		pp.pos++
+		pp.skipSpaces()
+		ret.kind = pp.ident()
+		fields, ok := nodeKindFields[ret.kind]
+		if !ok {
+			return nil, pp.error(fmt.Sprintf("unknown kind '%s'", ret.kind))
+		}
+		for {
+			pp.skipSpaces()
+			if pp.peek() == ')' {
+				pp.pos++
+				break
+			}
+			if pp.pos >= len(pp.src) {
+				return nil, pp.error("expect ')'")
+			}
+			if ret.kind == NodeTypeNodes {
+				item, err := pp.pattern()
+				if err != nil {
+					return nil, err
+				}
+				ret.items = append(ret.items, item)
+				continue
+			}
+			field := pp.ident()
+			if field == "" || pp.peek() != ':' {
+				return nil, pp.error("expect field")
+			}
+			if !slices.Contains(fields, field) {
+				return nil, pp.error(fmt.Sprintf("unknown field '%s' of %s", field, ret.kind))
+			}
+			pp.pos++
+			child, err := pp.pattern()
+			if err != nil {
+				return nil, err
+			}
+			if ret.fields == nil {
+				ret.fields = make(map[string]*patternNode)
+			}
+			ret.fields[field] = child
+		}
+	case ch == '_':
+		pp.pos++
+	default:
+		return nil, pp.error("expect pattern")
+	}
+	pp.skipSpaces()
+	if pp.peek() == '@' {
+		if err := pp.capture(ret); err != nil {
+			return nil, err
+		}
+	}
+	return ret, nil
+}
+
+func (p *patternNode) match(node Node, captures map[string]Node) bool {
+	if node == nil {
+		node = DummyNode
+	}
+	if p.kind != "" && node.Kind() != p.kind {
+		return false
+	}
+	if p.text != nil && (node.IsDummy() || string(node.Code()) != *p.text) {
+		return false
+	}
+	for field, child := range p.fields {
+		if !child.match(node.Child(field), captures) {
+			return false
+		}
+	}
+	if p.kind == NodeTypeNodes {
+		nodes := node.UnpackNodes()
+		if len(nodes) != len(p.items) {
+			return false
+		}
+		for i, item := range p.items {
+			if !item.match(nodes[i], captures) {
+				return false
+			}
+		}
+	}
+	if p.capture != "" {
+		if node.IsDummy() {
+			return p.optional
+		}
+		captures[p.capture] = node
+	}
+	return true
+}
+
+func (p *Pattern) Match(node Node) (map[string]Node, bool) {
+	captures := make(map[string]Node)
+	if !p.root.match(node, captures) {
+		return nil, false
+	}
+	return captures, true
+}
+
+func (p *Pattern) FindAll(root Node) []*PatternMatch {
+	ret := make([]*PatternMatch, 0)
+	root.Visit(func(node Node) (bool, bool) {
+		if captures, ok := p.Match(node); ok {
+			ret = append(ret, &PatternMatch{Node: node, Captures: captures})
+		}
+		return true, false
+	}, func(Node) bool {
+		return false
+	})
+	return ret
+}`
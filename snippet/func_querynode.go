@@ -1,74 +1,279 @@
 package snippet
 
-const QueryNodeFunc = `func QueryNode(node Node, path string) (any, error) {
-	if path == "" {
-		return node, nil
-	}
-
-	items := strings.Split(path, "/")
-	var base any
-	base = node
-	for _, item := range items {
-		var name, nodeType string
-		if strings.Contains(item, ":") {
-			subs := strings.Split(item, ":")
-			name = toCamelCase(subs[0])
-			nodeType = subs[1]
-		} else {
-			name = toCamelCase(item)
-		}
-
-		switch base.(type) {
-		case Node:
-			node = base.(Node)
-			if name == "." {
-				base = node
-			} else if name == ".." {
-				base = node.Parent()
-				if base == nil {
-					return nil, errors.New("query error: node has no parent")
-				}
-			} else {
-				t := reflect.TypeOf(node)
-				m, ok := t.MethodByName(name)
-				if !ok {
-					methods := make([]string, 0)
-					for i := 0; i < t.NumMethod(); i++ {
-						tmp := t.Method(i).Name
-						methods = append(methods, tmp)
-					}
-					return nil, errors.New(fmt.Sprintf("query error: %v has no method '%s', available: %s", t, name, strings.Join(methods, ", ")))
-				}
-				result := m.Func.Call([]reflect.Value{
-					reflect.ValueOf(node),
-				})
-				base = result[0].Interface()
+const QueryNodeFunc = `type queryPredicate struct {
+	attr  []string
+	op    string
+	value string
+}
+
+type queryStep struct {
+	descendant bool
+	name       string
+	kind       string
+	predicates []*queryPredicate
+}
+
+type Query struct {
+	steps []*queryStep
+}
+
+// CompileQuery parses a path such as "//call_expr[callee.code='print']/argument".
+// A step name matches either the field name or the kind of a child; "*" matches any
+// child, ":kind" filters by kind, "//" searches all descendants.
+func CompileQuery(query string) (*Query, error) {
+	qp := &queryParser{query: query}
+	steps := make([]*queryStep, 0)
+	for qp.pos < len(qp.query) {
+		step := &queryStep{}
+		if strings.HasPrefix(qp.query[qp.pos:], "//") {
+			step.descendant = true
+			qp.pos += 2
+		} else if qp.query[qp.pos] == '/' {
+			qp.pos++
+		} else if len(steps) > 0 {
+			return nil, qp.error("expect '/'")
+		}
+		step.name = qp.name()
+		if qp.peek() == ':' {
+			qp.pos++
+			if step.kind = qp.name(); step.kind == "" {
+				return nil, qp.error("expect kind")
+			}
+		}
+		if step.name == "" {
+			if step.kind == "" {
+				return nil, qp.error("expect step")
 			}
-		case []Node:
-			nodes := base.([]Node)
-			index, err := strconv.Atoi(name)
+			step.name = "*"
+		}
+		for qp.peek() == '[' {
+			predicate, err := qp.predicate()
 			if err != nil {
-				return nil, errors.New(fmt.Sprintf("query error: index should be an integer: '%s'", name))
+				return nil, err
 			}
-			if index < 0 || index >= len(nodes) {
-				return nil, errors.New("index error")
+			step.predicates = append(step.predicates, predicate)
+		}
+		steps = append(steps, step)
+	}
+	return &Query{steps: steps}, nil
+}
+
+type queryParser struct {
+	query string
+	pos   int
+}
+
+func (qp *queryParser) error(msg string) error {
+	return fmt.Errorf("query error: %s at %d: %s", msg, qp.pos, qp.query)
+}
+
+func (qp *queryParser) peek() byte {
+	if qp.pos < len(qp.query) {
+		return qp.query[qp.pos]
+	}
+	return 0
+}
+
+func (qp *queryParser) skipSpaces() {
+	for qp.peek() == ' ' {
+		qp.pos++
+	}
+}
+
+func (qp *queryParser) name() string {
+	start := qp.pos
+	if strings.HasPrefix(qp.query[qp.pos:], "..") {
+		qp.pos += 2
+		return ".."
+	}
+	if qp.peek() == '.' {
+		qp.pos++
+		return "."
+	}
+	for qp.pos < len(qp.query) {
+		ch := qp.query[qp.pos]
+		if ch != '_' && ch != '*' && !(ch >= 'a' && ch <= 'z') && !(ch >= 'A' && ch <= 'Z') && !(ch >= '0' && ch <= '9') {
+			break
+		}
+		qp.pos++
+	}
+	return qp.query[start:qp.pos]
+}
+
+func (qp *queryParser) predicate() (*queryPredicate, error) {
+	qp.pos++
+	qp.skipSpaces()
+	ret := &queryPredicate{}
+	for {
+		attr := qp.name()
+		if attr == "" || attr == "*" || attr == "." || attr == ".." {
+			return nil, qp.error("expect attribute")
+		}
+		ret.attr = append(ret.attr, attr)
+		if qp.peek() != '.' {
+			break
+		}
+		qp.pos++
+	}
+	qp.skipSpaces()
+	if strings.HasPrefix(qp.query[qp.pos:], "!=") {
+		ret.op = "!="
+		qp.pos += 2
+	} else if qp.peek() == '=' {
+		ret.op = "="
+		qp.pos++
+	}
+	if ret.op != "" {
+		qp.skipSpaces()
+		quote := qp.peek()
+		if quote != '\'' && quote != '"' {
+			return nil, qp.error("expect quoted value")
+		}
+		end := strings.IndexByte(qp.query[qp.pos+1:], quote)
+		if end < 0 {
+			return nil, qp.error("unterminated value")
+		}
+		ret.value = qp.query[qp.pos+1 : qp.pos+1+end]
+		qp.pos += end + 2
+		qp.skipSpaces()
+	}
+	if qp.peek() != ']' {
+		return nil, qp.error("expect ']'")
+	}
+	qp.pos++
+	return ret, nil
+}
+
+func (p *queryPredicate) match(node Node) bool {
+	var value string
+	found := true
+	for i, attr := range p.attr {
+		child := node.Child(attr)
+		if (child == nil || child.IsDummy()) && i == len(p.attr)-1 && (attr == "code" || attr == "kind") {
+			if attr == "code" {
+				value = string(node.Code())
+			} else {
+				value = node.Kind()
 			}
-			base = nodes[index]
-		default:
-			return nil, errors.New(fmt.Sprintf("query error: neither Node nor []Node: '%s'", name))
+			break
+		}
+		if child == nil || child.IsDummy() {
+			found = false
+			break
 		}
+		node = child
+		value = string(node.Code())
+	}
+	switch p.op {
+	case "=":
+		return found && value == p.value
+	case "!=":
+		return !found || value != p.value
+	default:
+		return found
+	}
+}
 
-		// type assertion
-		if nodeType != "" {
-			if cast, isNode := base.(Node); isNode {
-				t := TypeNameOf(cast)
-				if strings.ToLower(t) != nodeType {
-					return nil, errors.New(fmt.Sprintf("type assertion error, expect: %s, actual: %s", nodeType, t))
-				}
+func (s *queryStep) match(field string, node Node) bool {
+	if s.name != "*" && s.name != "." && s.name != ".." && s.name != field && s.name != node.Kind() {
+		return false
+	}
+	if s.kind != "" && s.kind != node.Kind() {
+		return false
+	}
+	for _, predicate := range s.predicates {
+		if !predicate.match(node) {
+			return false
+		}
+	}
+	return true
+}
+
+// selectDescendants visits the descendants of node that match the step in
+// document order.
+func (s *queryStep) selectDescendants(node Node, visit func(Node)) {
+	if s.name == "." || s.name == ".." {
+		node.Visit(func(d Node) (bool, bool) {
+			s.selectFrom(d, visit)
+			return true, false
+		}, func(Node) bool {
+			return false
+		})
+		return
+	}
+	for _, field := range node.Fields() {
+		child := node.Child(field)
+		if child == nil || child.IsDummy() {
+			continue
+		}
+		if s.match(field, child) {
+			visit(child)
+		}
+		s.selectDescendants(child, visit)
+	}
+}
+
+func (s *queryStep) selectFrom(node Node, visit func(Node)) {
+	switch s.name {
+	case ".":
+		if s.match("", node) {
+			visit(node)
+		}
+		return
+	case "..":
+		if parent := node.Parent(); parent != nil && s.match("", parent) {
+			visit(parent)
+		}
+		return
+	}
+	for _, field := range node.Fields() {
+		child := node.Child(field)
+		if child != nil && !child.IsDummy() && s.match(field, child) {
+			visit(child)
+		}
+	}
+}
+
+func (q *Query) Select(node Node) []Node {
+	if node == nil || node.IsDummy() {
+		return nil
+	}
+	current := []Node{node}
+	for _, step := range q.steps {
+		next := make([]Node, 0)
+		seen := make(map[Node]bool)
+		collect := func(n Node) {
+			if !seen[n] {
+				seen[n] = true
+				next = append(next, n)
+			}
+		}
+		for _, n := range current {
+			if step.descendant {
+				step.selectDescendants(n, collect)
 			} else {
-				return nil, errors.New(fmt.Sprintf("type assertion error, not node"))
+				step.selectFrom(n, collect)
 			}
 		}
+		if len(current) > 1 {
+			sort.SliceStable(next, func(i, j int) bool {
+				si, ei := next[i].Range()
+				sj, ej := next[j].Range()
+				if si.Offset != sj.Offset {
+					return si.Offset < sj.Offset
+				}
+				return ei.Offset > ej.Offset
+			})
+		}
+		current = next
+	}
+	return current
+}
+
+func QueryNode(node Node, query string) ([]Node, error) {
+	q, err := CompileQuery(query)
+	if err != nil {
+		return nil, err
 	}
-	return base, nil
+	return q.Select(node), nil
 }`
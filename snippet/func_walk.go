@@ -0,0 +1,25 @@
+package snippet
+
+const WalkFunc = `func Walk(v Visitor, node Node) {
+	node.Visit(func(n Node) (bool, bool) {
+		return n.Accept(v), false
+	}, func(Node) bool {
+		return false
+	})
+}
+
+func Rewrite(r Rewriter, node Node) Node {
+	node = rewriteChild(r, node)
+	node.BuildLink()
+	return node
+}
+
+func rewriteChild(r Rewriter, node Node) Node {
+	if node == nil || node.IsDummy() {
+		return DummyNode
+	}
+	if node = node.Rewrite(r); node == nil {
+		return DummyNode
+	}
+	return node
+}`
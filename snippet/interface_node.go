@@ -18,6 +18,8 @@ const NodeInterface = `type Node interface {
 	SetChild(nodes []Node)
 	Fork() Node
 	Visit(func(Node) (visitChildren, exit bool), func(Node) (exit bool)) (exit bool)
+	Accept(Visitor) (visitChildren bool)
+	Rewrite(Rewriter) Node
 	FilePath() string
 	FileContent() []rune
 	Code() []rune
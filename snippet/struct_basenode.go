@@ -107,6 +107,14 @@ func (n *BaseNode) Visit(func(Node) (bool, bool), func(Node) bool) bool {
 	return false
 }
 
+func (n *BaseNode) Accept(Visitor) bool {
+	return false
+}
+
+func (n *BaseNode) Rewrite(Rewriter) Node {
+	return n
+}
+
 func (n *BaseNode) Code() []rune {
 	if n.fileContent == nil {
 		return nil
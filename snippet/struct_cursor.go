@@ -0,0 +1,171 @@
+package snippet
+
+const CursorStruct = `type PathStep struct {
+	Field string
+	Index int
+}
+
+func NewCursor(node Node) *Cursor {
+	return &Cursor{node: node}
+}
+
+type Cursor struct {
+	node Node
+}
+
+func (c *Cursor) Node() Node {
+	return c.node
+}
+
+func (c *Cursor) list() *NodesNode {
+	if c.node.Parent() == nil {
+		return nil
+	}
+	l, _ := c.node.Parent().(*NodesNode)
+	return l
+}
+
+func (c *Cursor) Parent() *Cursor {
+	parent := c.node.Parent()
+	if l := c.list(); l != nil {
+		parent = l.Parent()
+	}
+	if parent == nil {
+		return nil
+	}
+	return &Cursor{node: parent}
+}
+
+func (c *Cursor) Field() string {
+	if l := c.list(); l != nil {
+		return l.SelfField()
+	}
+	return c.node.SelfField()
+}
+
+func (c *Cursor) Index() int {
+	if c.list() == nil {
+		return -1
+	}
+	i, err := strconv.Atoi(c.node.SelfField())
+	if err != nil {
+		return -1
+	}
+	return i
+}
+
+func (c *Cursor) Path() []PathStep {
+	ret := make([]PathStep, 0)
+	for cur := c; cur.Parent() != nil; cur = cur.Parent() {
+		ret = append(ret, PathStep{Field: cur.Field(), Index: cur.Index()})
+	}
+	for i, j := 0, len(ret)-1; i < j; i, j = i+1, j-1 {
+		ret[i], ret[j] = ret[j], ret[i]
+	}
+	return ret
+}
+
+func (c *Cursor) Depth() int {
+	return len(c.Path())
+}
+
+func (c *Cursor) sibling(step int) *Cursor {
+	if l := c.list(); l != nil {
+		i := c.Index() + step
+		if i < 0 || i >= len(l.nodes) {
+			return nil
+		}
+		return &Cursor{node: l.nodes[i]}
+	}
+	parent := c.node.Parent()
+	if parent == nil {
+		return nil
+	}
+	fields := parent.Fields()
+	for i, field := range fields {
+		if field != c.node.SelfField() {
+			continue
+		}
+		for j := i + step; j >= 0 && j < len(fields); j += step {
+			if child := parent.Child(fields[j]); child != nil && !child.IsDummy() {
+				return &Cursor{node: child}
+			}
+		}
+		break
+	}
+	return nil
+}
+
+func (c *Cursor) NextSibling() *Cursor {
+	return c.sibling(1)
+}
+
+func (c *Cursor) PrevSibling() *Cursor {
+	return c.sibling(-1)
+}
+
+func (c *Cursor) Replace(node Node) {
+	if node == nil {
+		node = DummyNode
+	}
+	if c.node.Parent() != nil {
+		if node.IsDummy() {
+			_ = c.Delete()
+		} else {
+			node.BuildLink()
+			c.node.ReplaceSelf(node)
+		}
+	}
+	c.node = node
+}
+
+func (c *Cursor) clearField() {
+	parent := c.node.Parent()
+	children := make([]Node, 0)
+	for _, field := range parent.Fields() {
+		child := parent.Child(field)
+		if field == c.node.SelfField() {
+			child = DummyNode
+		}
+		children = append(children, child)
+	}
+	parent.SetChild(children)
+}
+
+func (c *Cursor) insert(node Node, offset int) error {
+	l := c.list()
+	if l == nil {
+		return fmt.Errorf("cannot insert next to %s: not in a list", c.node.Kind())
+	}
+	i := c.Index() + offset
+	node.BuildLink()
+	nodes := make([]Node, 0, len(l.nodes)+1)
+	nodes = append(nodes, l.nodes[:i]...)
+	nodes = append(nodes, node)
+	nodes = append(nodes, l.nodes[i:]...)
+	l.nodes = nodes
+	l.linkNodes()
+	return nil
+}
+
+func (c *Cursor) InsertBefore(node Node) error {
+	return c.insert(node, 0)
+}
+
+func (c *Cursor) InsertAfter(node Node) error {
+	return c.insert(node, 1)
+}
+
+func (c *Cursor) Delete() error {
+	if c.node.Parent() == nil {
+		return fmt.Errorf("cannot delete %s: no parent", c.node.Kind())
+	}
+	if l := c.list(); l != nil {
+		i := c.Index()
+		l.nodes = append(l.nodes[:i:i], l.nodes[i+1:]...)
+		l.linkNodes()
+		return nil
+	}
+	c.clearField()
+	return nil
+}`
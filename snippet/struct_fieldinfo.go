@@ -0,0 +1,21 @@
+package snippet
+
+const FieldInfoStruct = `type FieldInfo struct {
+	Name     string
+	Optional bool
+	List     bool
+	Token    bool
+}
+
+func NodeFieldInfos(kind string) []FieldInfo {
+	return nodeKindFieldInfos[kind]
+}
+
+func NodeFieldInfo(kind, field string) (FieldInfo, bool) {
+	for _, info := range nodeKindFieldInfos[kind] {
+		if info.Name == field {
+			return info, true
+		}
+	}
+	return FieldInfo{}, false
+}`
@@ -0,0 +1,26 @@
+package snippet
+
+const LimitErrorStruct = `const (
+	LimitSteps      = "steps"
+	LimitBacktracks = "backtracks"
+	LimitDepth      = "depth"
+	LimitContext    = "context"
+)
+
+type LimitError struct {
+	FilePath string
+	Limit    string
+	Max      int
+	Err      error
+}
+
+func (e *LimitError) Error() string {
+	if e.Err != nil {
+		return fmt.Sprintf("fail to parse: %s\n%v", e.FilePath, e.Err)
+	}
+	return fmt.Sprintf("fail to parse: %s\nexceed max %s %d", e.FilePath, e.Limit, e.Max)
+}
+
+func (e *LimitError) Unwrap() error {
+	return e.Err
+}`
@@ -0,0 +1,137 @@
+package snippet
+
+const LineIndexStruct = `// lineBreakAt tells whether content[i] ends a line: a \n, or a \r that is not
+// followed by \n, including one at the end of the content. The tokenizer and
+// LineIndex share this rule, so token positions and LineIndex positions agree.
+func lineBreakAt(content []rune, i int) bool {
+	return content[i] == '\n' || (content[i] == '\r' && (i+1 >= len(content) || content[i+1] != '\n'))
+}
+
+func NewLineIndex(content []rune) *LineIndex {
+	lineStarts := []int{0}
+	for i := range content {
+		if lineBreakAt(content, i) {
+			lineStarts = append(lineStarts, i+1)
+		}
+	}
+	return &LineIndex{content: content, lineStarts: lineStarts}
+}
+
+type LineIndex struct {
+	content    []rune
+	lineStarts []int
+}
+
+func (idx *LineIndex) LineCount() int {
+	return len(idx.lineStarts)
+}
+
+func (idx *LineIndex) LineStart(lineIdx int) int {
+	if lineIdx < 0 {
+		return 0
+	}
+	if lineIdx >= len(idx.lineStarts) {
+		return len(idx.content)
+	}
+	return idx.lineStarts[lineIdx]
+}
+
+func (idx *LineIndex) LineEnd(lineIdx int) int {
+	if lineIdx < 0 {
+		lineIdx = 0
+	}
+	if lineIdx >= len(idx.lineStarts) {
+		return len(idx.content)
+	}
+	end := len(idx.content)
+	if lineIdx+1 < len(idx.lineStarts) {
+		end = idx.lineStarts[lineIdx+1]
+	}
+	for end > idx.lineStarts[lineIdx] && (idx.content[end-1] == '\n' || idx.content[end-1] == '\r') {
+		end--
+	}
+	return end
+}
+
+func (idx *LineIndex) Line(lineIdx int) []rune {
+	return idx.content[idx.LineStart(lineIdx):idx.LineEnd(lineIdx)]
+}
+
+func (idx *LineIndex) clampOffset(offset int) int {
+	if offset < 0 {
+		return 0
+	}
+	if offset > len(idx.content) {
+		return len(idx.content)
+	}
+	return offset
+}
+
+func (idx *LineIndex) lineOf(offset int) int {
+	return sort.Search(len(idx.lineStarts), func(i int) bool {
+		return idx.lineStarts[i] > offset
+	}) - 1
+}
+
+func (idx *LineIndex) OffsetToPosition(offset int) Position {
+	offset = idx.clampOffset(offset)
+	lineIdx := idx.lineOf(offset)
+	return Position{Offset: offset, LineIdx: lineIdx, CharIdx: offset - idx.lineStarts[lineIdx]}
+}
+
+func (idx *LineIndex) PositionToOffset(lineIdx, charIdx int) int {
+	start, end := idx.LineStart(lineIdx), idx.LineEnd(lineIdx)
+	if lineIdx >= len(idx.lineStarts) || charIdx < 0 {
+		return start
+	}
+	if start+charIdx > end {
+		return end
+	}
+	return start + charIdx
+}
+
+func (idx *LineIndex) offsetToColumn(offset int, width func(rune) int) (lineIdx, col int) {
+	pos := idx.OffsetToPosition(offset)
+	for _, ch := range idx.content[idx.lineStarts[pos.LineIdx]:pos.Offset] {
+		col += width(ch)
+	}
+	return pos.LineIdx, col
+}
+
+func (idx *LineIndex) columnToOffset(lineIdx, col int, width func(rune) int) int {
+	offset, end := idx.LineStart(lineIdx), idx.LineEnd(lineIdx)
+	for ; offset < end && col > 0; offset++ {
+		col -= width(idx.content[offset])
+	}
+	return offset
+}
+
+func utf8Width(ch rune) int {
+	if n := utf8.RuneLen(ch); n > 0 {
+		return n
+	}
+	return utf8.RuneLen(utf8.RuneError)
+}
+
+func utf16Width(ch rune) int {
+	if ch >= 0x10000 && ch <= utf8.MaxRune {
+		return 2
+	}
+	return 1
+}
+
+func (idx *LineIndex) OffsetToUTF8Column(offset int) (lineIdx, col int) {
+	return idx.offsetToColumn(offset, utf8Width)
+}
+
+func (idx *LineIndex) UTF8ColumnToOffset(lineIdx, col int) int {
+	return idx.columnToOffset(lineIdx, col, utf8Width)
+}
+
+func (idx *LineIndex) OffsetToUTF16Column(offset int) (lineIdx, col int) {
+	return idx.offsetToColumn(offset, utf16Width)
+}
+
+func (idx *LineIndex) UTF16ColumnToOffset(lineIdx, col int) int {
+	return idx.columnToOffset(lineIdx, col, utf16Width)
+}`
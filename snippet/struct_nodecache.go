@@ -1,6 +1,7 @@
 package snippet
 
 const NodeCacheStruct = `type NodeCache struct {
-	val Node
-	pos int
+	val   Node
+	end   parserMark
+	state parserState
 }`
@@ -38,9 +38,15 @@ func (n *NodesNode) Fields() []string {
 }
 
 func (n *NodesNode) BuildLink() {
-	nodesSetParent(n.nodes, n, "")
 	for _, target := range n.nodes {
 		target.BuildLink()
+	}
+	n.linkNodes()
+}
+
+func (n *NodesNode) linkNodes() {
+	nodesSetParent(n.nodes, n, "")
+	for _, target := range n.nodes {
 		target.SetReplaceSelf(func(n Node) {
 			i, _ := strconv.Atoi(n.SelfField())
 			n.Parent().(*NodesNode).Nodes()[i] = n
@@ -93,6 +99,21 @@ func (n *NodesNode) Visit(beforeChildren func(Node) (visitChildren, exit bool),
 	return false
 }
 
+func (n *NodesNode) Accept(v Visitor) bool {
+	return v.VisitNodesNode(n)
+}
+
+func (n *NodesNode) Rewrite(r Rewriter) Node {
+	nodes := make([]Node, 0, len(n.nodes))
+	for _, node := range n.nodes {
+		if node = rewriteChild(r, node); !node.IsDummy() {
+			nodes = append(nodes, node)
+		}
+	}
+	n.nodes = nodes
+	return r.RewriteNodesNode(n)
+}
+
 func (n *NodesNode) dumpNodes(hook func(Node, map[string]string) string) string {
 	items := make([]string, 0)
 	for _, t := range n.nodes {
@@ -1,6 +1,8 @@
 package snippet
 
-const ParserStruct = `type Parser struct {
+const ParserStruct = `const DefaultMaxDepth = 1 << 14
+
+type Parser struct {
 	_filePath    string
 	_fileContent []rune
 
@@ -9,12 +11,21 @@ const ParserStruct = `type Parser struct {
 	_pos    int
 	_x      int
 
-	_bracketDepth  int
-	_bracketDepths []int
+	_bracketDepth int
+
+	state parserState
 
 	_nodeCache []map[int]*NodeCache
 
-	_any any
+	_err           error
+	_ctx           context.Context
+	_steps         int
+	_maxSteps      int
+	_backtracks    int
+	_maxBacktracks int
+	_depth         int
+	_maxDepth      int
+	_lines         *LineIndex
 }
 
 func NewParser(filePath string, fileContent []rune, tokens []*Token) *Parser {
@@ -22,21 +33,95 @@ func NewParser(filePath string, fileContent []rune, tokens []*Token) *Parser {
 	ps._max = len(ps._tokens)
 	ps._pos = 0
 	ps._x = 0
+	ps._maxDepth = DefaultMaxDepth
 
-	ps._bracketDepths = make([]int, ps._max+1)
 	ps._nodeCache = make([]map[int]*NodeCache, ps._max)
 
 	return &ps
 }
 
-func (ps *Parser) _mark() int {
-	ps._bracketDepths[ps._pos] = ps._bracketDepth
-	return ps._pos
+func (ps *Parser) _lineIndex() *LineIndex {
+	if ps._lines == nil {
+		ps._lines = NewLineIndex(ps._fileContent)
+	}
+	return ps._lines
+}
+
+func (ps *Parser) SetContext(ctx context.Context) {
+	ps._ctx = ctx
+}
+
+func (ps *Parser) SetMaxSteps(n int) {
+	ps._maxSteps = n
+}
+
+func (ps *Parser) SetMaxBacktracks(n int) {
+	ps._maxBacktracks = n
+}
+
+func (ps *Parser) SetMaxDepth(n int) {
+	ps._maxDepth = n
+}
+
+// _enterDepth and _leaveDepth bracket every rule call and every [ ] region, so
+// the nesting depth they track is bounded by SetMaxDepth. The _enter and _leave
+// names stay free for the hooks a hack file may define for [ ] regions.
+func (ps *Parser) _enterDepth() bool {
+	if ps._err != nil {
+		return false
+	}
+	if ps._maxDepth > 0 && ps._depth >= ps._maxDepth {
+		ps._setError(&LimitError{FilePath: ps._filePath, Limit: LimitDepth, Max: ps._maxDepth})
+		return false
+	}
+	ps._depth++
+	return true
 }
 
-func (ps *Parser) _reset(pos int) {
-	ps._pos = pos
-	ps._bracketDepth = ps._bracketDepths[ps._pos]
+func (ps *Parser) _leaveDepth() {
+	ps._depth--
+}
+
+func (ps *Parser) _exhausted() bool {
+	if ps._err != nil {
+		return true
+	}
+	ps._steps++
+	if ps._maxSteps > 0 && ps._steps > ps._maxSteps {
+		ps._setError(&LimitError{FilePath: ps._filePath, Limit: LimitSteps, Max: ps._maxSteps})
+		return true
+	}
+	if ps._ctx != nil && ps._steps&1023 == 0 {
+		if err := ps._ctx.Err(); err != nil {
+			ps._setError(&LimitError{FilePath: ps._filePath, Limit: LimitContext, Err: err})
+			return true
+		}
+	}
+	return false
+}
+
+// parserMark is a backtracking point: the token position together with the
+// bracket depth and the state at that point.
+type parserMark struct {
+	pos          int
+	bracketDepth int
+	state        parserState
+}
+
+func (ps *Parser) _mark() parserMark {
+	return parserMark{pos: ps._pos, bracketDepth: ps._bracketDepth, state: ps.state}
+}
+
+func (ps *Parser) _reset(mark parserMark) {
+	if mark.pos < ps._pos {
+		ps._backtracks++
+		if ps._maxBacktracks > 0 && ps._backtracks > ps._maxBacktracks {
+			ps._setError(&LimitError{FilePath: ps._filePath, Limit: LimitBacktracks, Max: ps._maxBacktracks})
+		}
+	}
+	ps._pos = mark.pos
+	ps._bracketDepth = mark.bracketDepth
+	ps.state = mark.state
 }
 
 func (ps *Parser) _stepForward(tok *Token) {
@@ -58,6 +143,9 @@ func (ps *Parser) _stepForward(tok *Token) {
 }
 
 func (ps *Parser) _expectK(kind string) Node {
+	if ps._exhausted() {
+		return nil
+	}
 	tok := ps._tokens[ps._pos]
 	if tok.Kind == kind {
 		ps._stepForward(tok)
@@ -67,6 +155,9 @@ func (ps *Parser) _expectK(kind string) Node {
 }
 
 func (ps *Parser) _expectV(val string) Node {
+	if ps._exhausted() {
+		return nil
+	}
 	tok := ps._tokens[ps._pos]
 	if len(tok.Value) == len(val) && string(tok.Value) == val {
 		ps._stepForward(tok)
@@ -76,6 +167,9 @@ func (ps *Parser) _expectV(val string) Node {
 }
 
 func (ps *Parser) _anyToken() Node {
+	if ps._exhausted() {
+		return nil
+	}
 	tok := ps._tokens[ps._pos]
 	ps._stepForward(tok)
 	return NewTokenNode(ps._filePath, ps._fileContent, tok)
@@ -137,6 +231,9 @@ func (ps *Parser) _pseudoToken(v ...any) Node {
 }
 
 func (ps *Parser) _expectPseudoNewline() Node {
+	if ps._exhausted() {
+		return nil
+	}
 	if ps._pos < 1 || ps._pos >= len(ps._tokens) {
 		return nil
 	}
@@ -177,17 +274,63 @@ func (ps *Parser) _mergeNodes(items ...any) Node {
 				}
 			}
 		} else {
-			panic("misused merge_nodes api")
+			ps._setError(fmt.Errorf("misused merge_nodes api: %T", item))
 		}
 	}
 	return NewNodesNode(ret)
 }
 
+func (ps *Parser) _literalToken(val string) Node {
+	var pos Position
+	if tok := ps._visibleTokenBefore(ps._pos); tok != nil {
+		pos = tok.End
+	}
+	return NewTokenNode(ps._filePath, ps._fileContent, NewToken(TokenTypeLiteral, pos, pos, []rune(val)))
+}
+
+func (ps *Parser) _selectField(node Node, field string) Node {
+	if node == nil {
+		return nil
+	}
+	return node.Child(field)
+}
+
+func (ps *Parser) _spread(node Node) []Node {
+	if node == nil || node.IsDummy() {
+		return nil
+	}
+	if node.Kind() == NodeTypeNodes {
+		return node.UnpackNodes()
+	}
+	return []Node{node}
+}
+
+func (ps *Parser) _coalesce(node, fallback Node) Node {
+	if node == nil || node.IsDummy() {
+		return fallback
+	}
+	return node
+}
+
+func (ps *Parser) _setError(err error) {
+	if ps._err == nil {
+		ps._err = err
+	}
+}
+
 func (ps *Parser) Parse() (ret Node, err error) {
+	if ps._ctx != nil {
+		if err = ps._ctx.Err(); err != nil {
+			return nil, &LimitError{FilePath: ps._filePath, Limit: LimitContext, Err: err}
+		}
+	}
 	ret = ps.file()
-	if ps._expectK(TokenTypeEndOfFile) != nil {
+	if ps._err != nil {
+		return nil, ps._err
+	}
+	if ret != nil && ps._expectK(TokenTypeEndOfFile) != nil {
 		return ret, nil
 	}
 	tok := ps._tokens[ps._x]
-	return nil, fmt.Errorf("fail to parse: %s\n%s", ps._filePath, errorContext(ps._filePath, ps._fileContent, tok.Start.Offset, tok.Start.LineIdx, tok.Start.CharIdx))
+	return nil, fmt.Errorf("fail to parse: %s\n%s", ps._filePath, errorContext(ps._filePath, ps._lineIndex(), tok.Start.Offset))
 }`
@@ -1,13 +1,15 @@
 package snippet
 
-const TokenizerStruct = `func NewTokenizer(filePath string, fileContent []rune) *Tokenizer {
+const TokenizerStruct = `const eofRune rune = -1
+
+func NewTokenizer(filePath string, fileContent []rune) *Tokenizer {
 	tk := &Tokenizer{
 		_filePath:  filePath,
 		_buf:       fileContent,
 		_bufSize:   len(fileContent),
 		_pos:       Position{},
 		_prevPos:   Position{},
-		_lookahead: 0,
+		_lookahead: eofRune,
 	}
 	tk._lookahead = tk._safeRead()
 	tk.initKeywords()
@@ -22,6 +24,14 @@ type Tokenizer struct {
 	_prevPos   Position
 	_lookahead rune
 	_keywords  map[string]string
+	_lines     *LineIndex
+}
+
+func (tk *Tokenizer) _lineIndex() *LineIndex {
+	if tk._lines == nil {
+		tk._lines = NewLineIndex(tk._buf)
+	}
+	return tk._lines
 }
 
 func (tk *Tokenizer) Parse() (tokens []*Token, err error) {
@@ -40,26 +50,27 @@ func (tk *Tokenizer) Parse() (tokens []*Token, err error) {
 	return tokens, nil
 }
 
-func (tk *Tokenizer) _lineEnd(ch rune) bool {
-	return ch == '\n' || (ch == '\r' && tk._pos.Offset < len(tk._buf) && tk._buf[tk._pos.Offset] != '\n')
+func (tk *Tokenizer) _lineEnd(offset int) bool {
+	return offset < len(tk._buf) && lineBreakAt(tk._buf, offset)
 }
 
 func (tk *Tokenizer) _errorMsg(msg string) string {
-	return fmt.Sprintf("fail to tokenize %s\n%s", msg, errorContext(tk._filePath, tk._buf, tk._prevPos.Offset, tk._prevPos.LineIdx, tk._prevPos.CharIdx))
+	return fmt.Sprintf("fail to tokenize %s\n%s", msg, errorContext(tk._filePath, tk._lineIndex(), tk._prevPos.Offset))
 }
 
-func (tk *Tokenizer) _stepForward(ch rune) {
+func (tk *Tokenizer) _stepForward() {
 	p := &tk._pos
+	lineEnd := tk._lineEnd(p.Offset)
 	p.Offset++
 	p.CharIdx++
-	if tk._lineEnd(ch) {
+	if lineEnd {
 		p.LineIdx++
 		p.CharIdx = 0
 	}
 }
 
 func (tk *Tokenizer) _forward() {
-	tk._stepForward(tk._safeRead())
+	tk._stepForward()
 	tk._lookahead = tk._safeRead()
 }
 
@@ -72,9 +83,13 @@ func (tk *Tokenizer) _reset(p Position) {
 	tk._lookahead = tk._safeRead()
 }
 
+func (tk *Tokenizer) _reachEnd() bool {
+	return tk._pos.Offset >= tk._bufSize
+}
+
 func (tk *Tokenizer) _safeRead() rune {
-	if tk._pos.Offset >= tk._bufSize {
-		return '\x00'
+	if tk._reachEnd() {
+		return eofRune
 	} else {
 		return tk._buf[tk._pos.Offset]
 	}
@@ -123,7 +138,7 @@ func (tk *Tokenizer) _expectR(s, e rune) bool {
 }
 
 func (tk *Tokenizer) _anyButEof() bool {
-	if tk._lookahead != 0 {
+	if !tk._reachEnd() {
 		tk._forward()
 		return true
 	} else {
@@ -209,7 +224,7 @@ func (tk *Tokenizer) whitespace() bool {
 	return false
 }
 
-func (tk *Tokenizer) op() string {
+func (tk *Tokenizer) op() (string, error) {
 	entered := false
 	kind := TokenTypeDummy
 	switch tk._lookahead {<op_placeholder>
@@ -217,26 +232,29 @@ func (tk *Tokenizer) op() string {
 		break
 	}
 	if entered && kind == TokenTypeDummy {
-		panic(tk._errorMsg("op"))
+		return kind, errors.New(tk._errorMsg("op"))
 	} else {
-		return kind
+		return kind, nil
 	}
 }
 
 func (tk *Tokenizer) next() (*Token, error) {
 	kind := TokenTypeDummy
-	if tk._lookahead == '\x00' {
+	if tk._reachEnd() {
 		if tk._pos.Offset > tk._bufSize {
-			panic(tk._errorMsg("eof"))
+			return nil, errors.New(tk._errorMsg("eof"))
 		}
-		tk._stepForward('\x00')
 		kind = TokenTypeEndOfFile
 	} else if tk.whitespace() {
 		kind = TokenTypeWhitespace
 	} else if tk.newline() {
 		kind = TokenTypeNewline<next_placeholder>
 	} else {
-		kind = tk.op()
+		var err error
+		kind, err = tk.op()
+		if err != nil {
+			return nil, err
+		}
 		if kind == TokenTypeDummy {
 			return nil, errors.New(tk._errorMsg(string(tk._buf[tk._prevPos.Offset])))
 		}
@@ -255,6 +273,9 @@ func (tk *Tokenizer) next() (*Token, error) {
 		}
 	}
 	ret := NewToken(kind, tk._prevPos, tk._pos, val)
+	if kind == TokenTypeEndOfFile {
+		tk._stepForward()
+	}
 	tk._prevPos = tk._pos
 	return ret, nil
 }`
@@ -18,6 +18,13 @@ func (n *TokenNode) Token() *Token {
 	return n.token
 }
 
+func (n *TokenNode) Code() []rune {
+	if n.token.Kind == TokenTypeLiteral {
+		return n.token.Value
+	}
+	return n.BaseNode.Code()
+}
+
 func (n *TokenNode) Visit(beforeChildren func(Node) (visitChildren, exit bool), afterChildren func(Node) (exit bool)) (exit bool) {
 	vc, e := beforeChildren(n)
 	if e {
@@ -32,6 +39,14 @@ func (n *TokenNode) Visit(beforeChildren func(Node) (visitChildren, exit bool),
 	return false
 }
 
+func (n *TokenNode) Accept(v Visitor) bool {
+	return v.VisitTokenNode(n)
+}
+
+func (n *TokenNode) Rewrite(r Rewriter) Node {
+	return r.RewriteTokenNode(n)
+}
+
 func (n *TokenNode) Fork() Node {
 	return &TokenNode{
 		BaseNode: n.BaseNode.fork(),
@@ -40,15 +55,9 @@ func (n *TokenNode) Fork() Node {
 }
 
 func (n *TokenNode) Dump(func(Node, map[string]string) string) map[string]string {
-	val := string(n.Code())
-	val = strings.ReplaceAll(val, "\\", "\\\\")
-	val = strings.ReplaceAll(val, "\"", "\\\"")
-	val = strings.ReplaceAll(val, "\n", "\\n")
-	val = strings.ReplaceAll(val, "\r", "\\r")
-	val = strings.ReplaceAll(val, "\t", "\\t")
-	val = fmt.Sprintf("\"%s\"", val)
 	return map[string]string{
 		"kind": "\"token\"",
-		"code": val,
+		"type": dumpString(n.token.Kind),
+		"code": dumpString(string(n.Code())),
 	}
 }`
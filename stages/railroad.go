@@ -0,0 +1,200 @@
+package stages
+
+import (
+	"fmt"
+	"html"
+	"strings"
+	"unicode/utf8"
+)
+
+// Railroad diagram layout: every element is drawn from left to right on a
+// baseline and reports how far it extends above (up) and below (down) it.
+
+const (
+	railArc     = 10
+	railGap     = 10
+	railPadding = 20
+	railCharW   = 8
+	railBoxH    = 22
+)
+
+type railElement interface {
+	size() (width, up, down int)
+	draw(sb *strings.Builder, x, y int)
+}
+
+const (
+	railBoxTerminal = iota
+	railBoxNonTerminal
+	railBoxAnnotation
+)
+
+type railBox struct {
+	text string
+	kind int
+}
+
+func (b *railBox) size() (int, int, int) {
+	return utf8.RuneCountInString(b.text)*railCharW + 2*railGap, railBoxH / 2, railBoxH / 2
+}
+
+func (b *railBox) draw(sb *strings.Builder, x, y int) {
+	w, up, _ := b.size()
+	class := []string{"terminal", "nonterminal", "annotation"}[b.kind]
+	rx := 0
+	if b.kind == railBoxTerminal {
+		rx = railBoxH / 2
+	}
+	_, _ = fmt.Fprintf(sb, "<rect class=\"%s\" x=\"%d\" y=\"%d\" width=\"%d\" height=\"%d\" rx=\"%d\"/>\n", class, x, y-up, w, railBoxH, rx)
+	_, _ = fmt.Fprintf(sb, "<text x=\"%d\" y=\"%d\">%s</text>\n", x+w/2, y+4, html.EscapeString(b.text))
+}
+
+type railSkip struct{}
+
+func (railSkip) size() (int, int, int) {
+	return 0, 0, 0
+}
+
+func (railSkip) draw(*strings.Builder, int, int) {}
+
+type railSequence struct {
+	items []railElement
+}
+
+func (s *railSequence) size() (int, int, int) {
+	width, up, down := 0, 0, 0
+	for i, item := range s.items {
+		w, u, d := item.size()
+		if i > 0 {
+			width += railGap
+		}
+		width, up, down = width+w, max(up, u), max(down, d)
+	}
+	return width, up, down
+}
+
+func (s *railSequence) draw(sb *strings.Builder, x, y int) {
+	for i, item := range s.items {
+		w, _, _ := item.size()
+		if i > 0 {
+			railLine(sb, x, y, x+railGap)
+			x += railGap
+		}
+		item.draw(sb, x, y)
+		x += w
+	}
+}
+
+type railChoice struct {
+	items []railElement
+}
+
+func (c *railChoice) offsets() []int {
+	ret := make([]int, len(c.items))
+	prevDown := 0
+	for i, item := range c.items {
+		_, up, down := item.size()
+		if i > 0 {
+			ret[i] = max(ret[i-1]+prevDown+railGap+up, ret[i-1]+2*railArc)
+		}
+		prevDown = down
+	}
+	return ret
+}
+
+func (c *railChoice) size() (int, int, int) {
+	if len(c.items) == 0 {
+		return railSkip{}.size()
+	}
+	width := 0
+	for _, item := range c.items {
+		w, _, _ := item.size()
+		width = max(width, w)
+	}
+	offsets := c.offsets()
+	_, up, _ := c.items[0].size()
+	_, _, down := c.items[len(c.items)-1].size()
+	return width + 4*railArc, up, offsets[len(offsets)-1] + down
+}
+
+func (c *railChoice) draw(sb *strings.Builder, x, y int) {
+	width, _, _ := c.size()
+	for i, item := range c.items {
+		w, _, _ := item.size()
+		off := c.offsets()[i]
+		if i == 0 {
+			railLine(sb, x, y, x+2*railArc)
+			railLine(sb, x+width-2*railArc, y, x+width)
+		} else {
+			_, _ = fmt.Fprintf(sb, "<path d=\"M%d %d a%d %d 0 0 1 %d %d V%d a%d %d 0 0 0 %d %d\"/>\n",
+				x, y, railArc, railArc, railArc, railArc, y+off-railArc, railArc, railArc, railArc, railArc)
+			_, _ = fmt.Fprintf(sb, "<path d=\"M%d %d a%d %d 0 0 0 %d %d V%d a%d %d 0 0 1 %d %d\"/>\n",
+				x+width-2*railArc, y+off, railArc, railArc, railArc, -railArc, y+railArc, railArc, railArc, railArc, -railArc)
+		}
+		item.draw(sb, x+2*railArc, y+off)
+		railLine(sb, x+2*railArc+w, y+off, x+width-2*railArc)
+	}
+}
+
+// railLoop matches item one or more times, with sep between the repetitions.
+type railLoop struct {
+	item railElement
+	sep  railElement
+}
+
+func (l *railLoop) loopOffset() int {
+	_, _, down := l.item.size()
+	_, up, _ := l.sep.size()
+	return max(down+railGap+up, 2*railArc)
+}
+
+func (l *railLoop) size() (int, int, int) {
+	w1, up, _ := l.item.size()
+	w2, _, down := l.sep.size()
+	return max(w1, w2) + 4*railArc, up, l.loopOffset() + down
+}
+
+func (l *railLoop) draw(sb *strings.Builder, x, y int) {
+	width, _, _ := l.size()
+	inner := width - 4*railArc
+	w1, _, _ := l.item.size()
+	x1 := x + 2*railArc + (inner-w1)/2
+	railLine(sb, x, y, x1)
+	l.item.draw(sb, x1, y)
+	railLine(sb, x1+w1, y, x+width)
+
+	yl := y + l.loopOffset()
+	w2, _, _ := l.sep.size()
+	x2 := x + 2*railArc + (inner-w2)/2
+	_, _ = fmt.Fprintf(sb, "<path d=\"M%d %d a%d %d 0 0 1 %d %d V%d a%d %d 0 0 1 %d %d H%d\"/>\n",
+		x+width-2*railArc, y, railArc, railArc, railArc, railArc, yl-railArc, railArc, railArc, -railArc, railArc, x2+w2)
+	l.sep.draw(sb, x2, yl)
+	_, _ = fmt.Fprintf(sb, "<path d=\"M%d %d H%d a%d %d 0 0 1 %d %d V%d a%d %d 0 0 1 %d %d\"/>\n",
+		x2, yl, x+2*railArc, railArc, railArc, -railArc, -railArc, y+railArc, railArc, railArc, railArc, -railArc)
+}
+
+func railLine(sb *strings.Builder, x1, y, x2 int) {
+	if x1 != x2 {
+		_, _ = fmt.Fprintf(sb, "<path d=\"M%d %d H%d\"/>\n", x1, y, x2)
+	}
+}
+
+func railOptional(item railElement) railElement {
+	return &railChoice{items: []railElement{railSkip{}, item}}
+}
+
+func railSVG(root railElement) string {
+	w, up, down := root.size()
+	width, height := w+2*railPadding, up+down+2*railPadding
+	y := railPadding + up
+	var sb strings.Builder
+	_, _ = fmt.Fprintf(&sb, "<svg xmlns=\"http://www.w3.org/2000/svg\" width=\"%d\" height=\"%d\" viewBox=\"0 0 %d %d\">\n", width, height, width, height)
+	sb.WriteString("<style>path{fill:none;stroke:#333;stroke-width:1.5}rect{stroke:#333;stroke-width:1.5}" +
+		"rect.terminal{fill:#e3f2e1}rect.nonterminal{fill:#e4e9f7}rect.annotation{fill:#fff;stroke-dasharray:4 3}" +
+		"text{font:13px monospace;text-anchor:middle}</style>\n")
+	_, _ = fmt.Fprintf(&sb, "<path d=\"M%d %d v%d M%d %d H%d\"/>\n", railPadding/2, y-railArc, 2*railArc, railPadding/2, y, railPadding)
+	root.draw(&sb, railPadding, y)
+	_, _ = fmt.Fprintf(&sb, "<path d=\"M%d %d H%d M%d %d v%d\"/>\n", railPadding+w, y, width-railPadding/2, width-railPadding/2, y-railArc, 2*railArc)
+	sb.WriteString("</svg>\n")
+	return sb.String()
+}
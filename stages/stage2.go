@@ -6,6 +6,7 @@ import (
 	"github.com/lincaiyong/pgen/langparse"
 	"github.com/lincaiyong/pgen/models"
 	"regexp"
+	"slices"
 	"strings"
 )
 
@@ -36,12 +37,13 @@ func (s *Stage2) run() {
 	s.Language.SetHackCode(s.Input.Hack.Text())
 
 	s.convertTokenRules()
+	s.instantiateGrammarRules()
 	s.convertGrammarRules()
 }
 
 func (s *Stage2) parseTokenRules() {
 	for _, snippet := range s.Input.Tokens {
-		if strings.HasPrefix(snippet.Text(), "# ") {
+		if _, ok := s.parseDoc(snippet.Text()); ok || strings.HasPrefix(snippet.Text(), "# ") {
 			continue
 		}
 		rule, err := langparse.ParseTokenRule(snippet)
@@ -56,7 +58,7 @@ func (s *Stage2) parseTokenRules() {
 func (s *Stage2) parseKeywords() {
 	for _, snippet := range s.Input.Keywords {
 		text := strings.TrimSpace(snippet.Text())
-		if strings.HasPrefix(text, "# ") {
+		if _, ok := s.parseDoc(text); ok || strings.HasPrefix(text, "# ") {
 			continue
 		}
 		if config.KeywordRegex().MatchString(text) {
@@ -70,7 +72,7 @@ func (s *Stage2) parseKeywords() {
 func (s *Stage2) parseOperators() {
 	for _, snippet := range s.Input.Operators {
 		text := strings.TrimSpace(snippet.Text())
-		if strings.HasPrefix(text, "# ") {
+		if _, ok := s.parseDoc(text); ok || strings.HasPrefix(text, "# ") {
 			continue
 		}
 		if config.OperatorRegex().MatchString(text) {
@@ -83,35 +85,148 @@ func (s *Stage2) parseOperators() {
 
 func (s *Stage2) parseNodes() {
 	regex := regexp.MustCompile(" +")
+	docs := make([]string, 0)
 	for _, snippet := range s.Input.Nodes {
 		text := strings.TrimSpace(snippet.Text())
+		if doc, ok := s.parseDoc(text); ok {
+			docs = append(docs, doc)
+			continue
+		}
 		if strings.HasPrefix(text, "# ") {
 			continue
 		}
 		if m := config.NodeRegex().FindStringSubmatch(text); len(m) > 0 {
-			args := strings.Split(regex.ReplaceAllString(m[2], " "), " ")
-			node := models.NewAstNode(m[1], args, snippet)
+			fields := make([]*models.AstField, 0)
+			if m[2] != "" {
+				for _, arg := range strings.Split(regex.ReplaceAllString(strings.TrimSpace(m[2]), " "), " ") {
+					fm := config.NodeFieldRegex().FindStringSubmatch(arg)
+					if len(fm) == 0 {
+						s.Error.AddError(fmt.Errorf("invalid node field %s at %d:%d", arg, snippet.Start.LineIdx+1, snippet.End.LineIdx+1))
+						continue
+					}
+					fields = append(fields, models.NewAstField(fm[1], fm[2] == "?", fm[2] == "*", fm[3] != ""))
+				}
+			}
+			node := models.NewAstNode(m[1], fields, snippet)
+			node.SetDoc(strings.Join(docs, "\n"))
 			s.Language.AddAstNode(node)
+		} else if m = config.CategoryRegex().FindStringSubmatch(text); len(m) > 0 {
+			if category := s.parseNodeCategory(snippet, m[1], strings.Fields(m[2])); category != nil && len(docs) > 0 {
+				category.SetDoc(strings.TrimPrefix(category.Doc()+"\n"+strings.Join(docs, "\n"), "\n"))
+			}
 		} else {
 			s.Error.AddError(fmt.Errorf("invalid node %s at %d:%d", snippet.Text(), snippet.Start.LineIdx+1, snippet.End.LineIdx+1))
 		}
+		docs = docs[:0]
+	}
+	s.checkNodeCategories()
+}
+
+func (s *Stage2) parseNodeCategory(snippet *models.Snippet, name string, nodes []string) *models.NodeCategory {
+	if _, ok := config.ReservedCategories()[name]; ok {
+		s.Error.AddError(fmt.Errorf("reserved node category %s at %d:%d", name, snippet.Start.LineIdx+1, snippet.End.LineIdx+1))
+		return nil
+	}
+	category := s.Language.NodeCategory(name)
+	if category == nil {
+		category = models.NewNodeCategory(name, snippet)
+		s.Language.AddNodeCategory(category)
+	}
+	for _, node := range nodes {
+		if slices.Contains(category.Nodes(), node) {
+			s.Error.AddError(fmt.Errorf("duplicate node %s in category %s at %d:%d", node, name, snippet.Start.LineIdx+1, snippet.End.LineIdx+1))
+			continue
+		}
+		category.AddNode(node)
+	}
+	return category
+}
+
+func (s *Stage2) checkNodeCategories() {
+	names := make(map[string]bool)
+	for _, node := range s.Language.AstNodes() {
+		names[node.Name()] = true
+	}
+	for _, category := range s.Language.NodeCategories() {
+		snippet := category.Snippet()
+		if names[category.Name()] {
+			s.Error.AddError(fmt.Errorf("node category %s conflicts with node at %d:%d", category.Name(), snippet.Start.LineIdx+1, snippet.End.LineIdx+1))
+		}
+		for _, node := range category.Nodes() {
+			if !names[node] {
+				s.Error.AddError(fmt.Errorf("unknown node %s in category %s at %d:%d", node, category.Name(), snippet.Start.LineIdx+1, snippet.End.LineIdx+1))
+			}
+		}
 	}
 }
 
 func (s *Stage2) parseGrammarRules() {
+	docs := make([]string, 0)
 	for _, snippet := range s.Input.Grammars {
+		if doc, ok := s.parseDoc(snippet.Text()); ok {
+			docs = append(docs, doc)
+			continue
+		}
 		if strings.HasPrefix(snippet.Text(), "# ") {
 			continue
 		}
+		if m := config.StateRegex().FindStringSubmatch(strings.TrimSpace(snippet.Text())); len(m) > 0 {
+			s.parseStateFields(snippet, m[1])
+			docs = docs[:0]
+			continue
+		}
 		rule, err := langparse.ParseGrammarRule(snippet)
+		if err == nil {
+			rule.SetDoc(strings.Join(docs, "\n"))
+		}
+		docs = docs[:0]
 		if err != nil {
 			s.Error.AddError(err)
+		} else if len(rule.Params()) > 0 {
+			s.Language.AddGrammarTemplate(rule)
 		} else {
 			s.Language.AddGrammarRule(rule)
 		}
 	}
 }
 
+// parseDoc returns the text of a `## ` doc comment line, which documents the
+// node or rule that follows it.
+func (s *Stage2) parseDoc(text string) (string, bool) {
+	text = strings.TrimSpace(text)
+	if text != "##" && !strings.HasPrefix(text, "## ") {
+		return "", false
+	}
+	return strings.TrimPrefix(strings.TrimPrefix(text, "##"), " "), true
+}
+
+func (s *Stage2) parseStateFields(snippet *models.Snippet, body string) {
+	names := make(map[string]bool)
+	for _, field := range s.Language.StateFields() {
+		names[field.Name()] = true
+	}
+	for _, line := range strings.FieldsFunc(body, func(r rune) bool { return r == ';' || r == '\n' }) {
+		text := strings.TrimSpace(line)
+		if text == "" || strings.HasPrefix(text, "# ") {
+			continue
+		}
+		m := config.StateFieldRegex().FindStringSubmatch(text)
+		if len(m) == 0 || names[m[1]] {
+			s.Error.AddError(fmt.Errorf("invalid state field %s at %d:%d", text, snippet.Start.LineIdx+1, snippet.End.LineIdx+1))
+			continue
+		}
+		typ := strings.TrimSpace(m[2])
+		if strings.HasPrefix(typ, "[]") || strings.HasPrefix(typ, "map[") || strings.HasPrefix(typ, "func") {
+			// only the obvious cases are caught here; the generated parser guards the rest
+			s.Error.AddError(fmt.Errorf("state field %s must be comparable, not a slice, map or func, at %d:%d",
+				text, snippet.Start.LineIdx+1, snippet.End.LineIdx+1))
+			continue
+		}
+		names[m[1]] = true
+		s.Language.AddStateField(models.NewStateField(m[1], typ, snippet))
+	}
+}
+
 func (s *Stage2) convertTokenRules() {
 	atomNodes := make([]*models.TokenRuleNode, 0)
 	for _, rule := range s.Language.TokenRules() {
@@ -142,6 +257,127 @@ func (s *Stage2) convertTokenRules() {
 	}
 }
 
+const maxGrammarInstances = 1024
+
+func (s *Stage2) instantiateGrammarRules() {
+	ruleNames := make(map[string]bool)
+	for _, rule := range s.Language.GrammarRules() {
+		ruleNames[rule.Name()] = true
+	}
+	instances := make(map[string]*models.GrammarRuleNode)
+	queue := append([]*models.GrammarRuleNode{}, s.Language.GrammarRules()...)
+	for len(queue) > 0 {
+		rule := queue[0]
+		queue = queue[1:]
+		refs := make([]*models.GrammarRuleNode, 0)
+		rule.Visit(func(node *models.GrammarRuleNode) {
+			if node.Kind() == models.GrammarRuleNodeTypeNameAtom && len(node.Children()) > 0 {
+				refs = append(refs, node)
+			}
+		})
+		for i := len(refs) - 1; i >= 0; i-- { // arguments before the references containing them
+			newRule, err := s.instantiateGrammarRule(refs[i], ruleNames, instances)
+			if err != nil {
+				s.Error.AddError(err)
+				return
+			}
+			if newRule != nil {
+				queue = append(queue, newRule)
+			}
+		}
+	}
+}
+
+func (s *Stage2) instantiateGrammarRule(ref *models.GrammarRuleNode, ruleNames map[string]bool,
+	instances map[string]*models.GrammarRuleNode) (*models.GrammarRuleNode, error) {
+	template := s.Language.GrammarTemplate(ref.Name())
+	if template == nil {
+		return nil, fmt.Errorf("unknown grammar template: %s", ref.Snippet().Text())
+	}
+	args := ref.Children()
+	if len(args) != len(template.Params()) {
+		return nil, fmt.Errorf("grammar template %s expects %d arguments, got %d: %s",
+			template.Name(), len(template.Params()), len(args), ref.Snippet().Text())
+	}
+	names := []string{template.Name()}
+	texts := make([]string, 0, len(args))
+	for _, arg := range args {
+		name, err := s.templateArgName(arg)
+		if err != nil {
+			return nil, err
+		}
+		names = append(names, name)
+		texts = append(texts, arg.Snippet().Text())
+	}
+	name := strings.Join(names, "_of_")
+	ref.SetName(name)
+	ref.SetChildren(nil)
+	if instances[name] != nil {
+		return nil, nil
+	}
+	if ruleNames[name] {
+		return nil, fmt.Errorf("instance of grammar template %s conflicts with rule %s", template.Name(), name)
+	}
+	if len(instances) >= maxGrammarInstances {
+		return nil, fmt.Errorf("too many instances of grammar templates, recursive template %s?", template.Name())
+	}
+	params := make(map[string]*models.GrammarRuleNode)
+	for i, param := range template.Params() {
+		params[param] = args[i]
+	}
+	newRule := template.Clone(nil)
+	newRule.SetName(name)
+	newRule.SetParams(nil)
+	newRule.SetInstance(fmt.Sprintf("%s<%s>", template.Name(), strings.Join(texts, ", ")))
+	s.substituteTemplateParams(newRule, params)
+	instances[name] = newRule
+	s.Language.AddGrammarRule(newRule)
+	return newRule, nil
+}
+
+func (s *Stage2) templateArgName(arg *models.GrammarRuleNode) (string, error) {
+	switch arg.Kind() {
+	case models.GrammarRuleNodeTypeNameAtom:
+		return arg.Name(), nil
+	case models.GrammarRuleNodeTypeTokenAtom:
+		return strings.ToLower(arg.Snippet().Text()), nil
+	case models.GrammarRuleNodeTypeStringAtom:
+		val := arg.Snippet().Text()
+		val = val[1 : len(val)-1]
+		if name := s.Language.OperatorMap()[val]; name != "" {
+			return "op_" + name, nil
+		} else if _, ok := s.Language.KeywordMap()[val]; ok {
+			return "kw_" + val, nil
+		}
+	}
+	return "", fmt.Errorf("unsupported grammar template argument: %s", arg.Snippet().Text())
+}
+
+func (s *Stage2) substituteTemplateParams(node *models.GrammarRuleNode, params map[string]*models.GrammarRuleNode) {
+	substitute := func(child *models.GrammarRuleNode) *models.GrammarRuleNode {
+		var arg *models.GrammarRuleNode
+		if child.Kind() == models.GrammarRuleNodeTypeNameAtom && len(child.Children()) == 0 {
+			arg = params[child.Name()]
+		} else if child.Kind() == models.GrammarRuleNodeTypeTokenAtom {
+			arg = params[child.Snippet().Text()]
+		}
+		if arg == nil {
+			s.substituteTemplateParams(child, params)
+			return child
+		}
+		return arg.Clone(node)
+	}
+	for i, child := range node.Children() {
+		node.Children()[i] = substitute(child)
+	}
+	if node.Separator() != nil {
+		node.SetSeparator(substitute(node.Separator()))
+	}
+	for _, level := range node.Precedences() {
+		s.substituteTemplateParams(level, params)
+	}
+}
+
 func (s *Stage2) convertGrammarRules() {
 	atomNodes := make([]*models.GrammarRuleNode, 0)
 	for _, rule := range s.Language.GrammarRules() {
@@ -1,12 +1,14 @@
 package stages
 
 import (
+	"fmt"
 	"os"
+	"strings"
 	"testing"
 )
 
 func TestStage2(t *testing.T) {
-	b, err := os.ReadFile("../../go.txt")
+	b, err := os.ReadFile("../parsers/go/go.txt")
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -14,3 +16,163 @@ func TestStage2(t *testing.T) {
 	s2 := RunStage2(s1)
 	print(s2)
 }
+
+func TestStage2Templates(t *testing.T) {
+	divider := strings.Repeat("-", 120) + "\n"
+	sections := []string{
+		"ident:\n    | [a-z]+\n",
+		"let\n",
+		"(\n)\n,\n;\n",
+		"name <ident>\n",
+		"",
+		"",
+	}
+	run := func(grammar string) *Stage2 {
+		sections[4] = grammar
+		return RunStage2(RunStage1(strings.Join(sections, divider)))
+	}
+	s2 := run("file: x=semi<paren_list<name>>* y=semi<name> z=semi<'let'> {x}\n" +
+		"semi<X>(memo): x=X ';' {x}\n" +
+		"paren_list<X>: '(' x=','.X* ')' {x}\n" +
+		"name: i=IDENT {name(i)}\n")
+	if err := s2.Error.ToError(); err != nil {
+		t.Fatal(err)
+	}
+	names := make([]string, 0)
+	for _, rule := range s2.Language.GrammarRules() {
+		names = append(names, rule.Name())
+	}
+	expect := "file name semi_of_kw_let semi_of_name paren_list_of_name semi_of_paren_list_of_name"
+	if strings.Join(names, " ") != expect {
+		t.Fatalf("unexpected rules: %v", names)
+	}
+	if len(s2.Language.MemoIdMap()) != 3 {
+		t.Fatalf("expect a memo id per instance, got %d", len(s2.Language.MemoIdMap()))
+	}
+	for _, grammar := range []string{
+		"file: x=semi<name> {x}\nname: i=IDENT {name(i)}\n",
+		"file: x=semi<name, name> {x}\nsemi<X>: x=X ';' {x}\nname: i=IDENT {name(i)}\n",
+		"file: x=nest<name> {x}\nnest<X>: x=nest<paren_list<X>> {x}\nparen_list<X>: '(' x=X ')' {x}\nname: i=IDENT {name(i)}\n",
+	} {
+		if run(grammar).Error.ToError() == nil {
+			t.Fatalf("expect error for %s", grammar)
+		}
+	}
+}
+
+func TestStage2State(t *testing.T) {
+	divider := strings.Repeat("-", 120) + "\n"
+	run := func(state string) *Stage2 {
+		sections := []string{"", "", ";\n", "", state + "\nfile: ';'\n", ""}
+		return RunStage2(RunStage1(strings.Join(sections, divider)))
+	}
+	s2 := run("state {\n    inCtrl bool; depth int\n    ctrl *ctrlClause\n    }")
+	if err := s2.Error.ToError(); err != nil {
+		t.Fatal(err)
+	}
+	fields := make([]string, 0)
+	for _, field := range s2.Language.StateFields() {
+		fields = append(fields, field.Name()+" "+field.Type())
+	}
+	if strings.Join(fields, ", ") != "inCtrl bool, depth int, ctrl *ctrlClause" {
+		t.Fatalf("unexpected state fields: %v", fields)
+	}
+	if len(s2.Language.GrammarRules()) != 1 {
+		t.Fatalf("unexpected rules: %d", len(s2.Language.GrammarRules()))
+	}
+	for _, state := range []string{"state { depth }", "state { a int; a bool }", "state { stack []int }"} {
+		if run(state).Error.ToError() == nil {
+			t.Fatalf("expect error for %s", state)
+		}
+	}
+}
+
+func TestStage2NodeCategories(t *testing.T) {
+	divider := strings.Repeat("-", 120) + "\n"
+	run := func(nodes string) *Stage2 {
+		sections := []string{"", "", ";\n", nodes, "file: ';'\n", ""}
+		return RunStage2(RunStage1(strings.Join(sections, divider)))
+	}
+	s2 := run("call <fn args>\nident <name>\nblock <stmts>\n@expr call ident\n@stmt block\n@expr  call_stmt\ncall_stmt <x>\n")
+	if err := s2.Error.ToError(); err != nil {
+		t.Fatal(err)
+	}
+	categories := make([]string, 0)
+	for _, category := range s2.Language.NodeCategories() {
+		categories = append(categories, category.Name()+": "+strings.Join(category.Nodes(), " "))
+	}
+	if strings.Join(categories, ", ") != "expr: call ident call_stmt, stmt: block" {
+		t.Fatalf("unexpected categories: %v", categories)
+	}
+	for _, nodes := range []string{
+		"ident <name>\n@expr ident call\n",
+		"ident <name>\n@expr ident ident\n",
+		"ident <name>\n@ident ident\n",
+		"ident <name>\n@node ident\n",
+		"ident <name>\n@expr\n",
+	} {
+		if run(nodes).Error.ToError() == nil {
+			t.Fatalf("expect error for %s", nodes)
+		}
+	}
+}
+
+func TestStage2NodeFields(t *testing.T) {
+	divider := strings.Repeat("-", 120) + "\n"
+	run := func(nodes string) *Stage2 {
+		sections := []string{"", "", ";\n", nodes, "file: ';'\n", ""}
+		return RunStage2(RunStage1(strings.Join(sections, divider)))
+	}
+	s2 := run("func_decl <name:token params* results? label?:token body>\n")
+	if err := s2.Error.ToError(); err != nil {
+		t.Fatal(err)
+	}
+	fields := make([]string, 0)
+	for _, field := range s2.Language.AstNodes()[0].Fields() {
+		fields = append(fields, fmt.Sprintf("%s %v %v %v", field.Normal(), field.Optional(), field.List(), field.Token()))
+	}
+	expect := "name false false true, params false true false, results true false false, label true false true, body false false false"
+	if strings.Join(fields, ", ") != expect {
+		t.Fatalf("unexpected fields: %v", fields)
+	}
+	for _, nodes := range []string{"x <a?*>\n", "x <a:node>\n", "x <a:token?>\n", "x <?>\n"} {
+		if run(nodes).Error.ToError() == nil {
+			t.Fatalf("expect error for %s", nodes)
+		}
+	}
+}
+
+func TestStage2Docs(t *testing.T) {
+	divider := strings.Repeat("-", 120) + "\n"
+	sections := []string{
+		"## identifiers\nident:\n    | [a-z]+\n",
+		"## keywords\nlet\n",
+		";\n",
+		"## A name.\n##\n## Names are identifiers.\nname <ident>\n# not a doc\nlet <x>\n## Expressions.\n@expr name\n",
+		"## file parses a file.\nfile: x=name* {x}\n# not a doc\nname: i=IDENT {name(i)}\n",
+		"",
+	}
+	s2 := RunStage2(RunStage1(strings.Join(sections, divider)))
+	if err := s2.Error.ToError(); err != nil {
+		t.Fatal(err)
+	}
+	nodes := s2.Language.AstNodes()
+	if nodes[0].Doc() != "A name.\n\nNames are identifiers." || nodes[1].Doc() != "" {
+		t.Fatalf("unexpected node docs: %q, %q", nodes[0].Doc(), nodes[1].Doc())
+	}
+	if doc := s2.Language.NodeCategory("expr").Doc(); doc != "Expressions." {
+		t.Fatalf("unexpected category doc: %q", doc)
+	}
+	rules := s2.Language.GrammarRules()
+	if rules[0].Doc() != "file parses a file." || rules[1].Doc() != "" {
+		t.Fatalf("unexpected rule docs: %q, %q", rules[0].Doc(), rules[1].Doc())
+	}
+	s32 := RunStage32(s2)
+	if !strings.Contains(s32.Gen.String(), "// file parses a file.\n/*\nfile:") {
+		t.Fatal("expect doc comment on parse function")
+	}
+	s33 := RunStage33(s2)
+	if !strings.Contains(s33.Gen.String(), "// A name.\n//\n// Names are identifiers.\ntype NameNode struct {") {
+		t.Fatal("expect doc comment on node struct")
+	}
+}
@@ -242,7 +242,6 @@ func (s *Stage31) genEnterCode(node *models.TokenRuleNode, depth int) (int, erro
 	default:
 		panic("unreachable")
 	}
-	return depth, nil
 }
 
 func (s *Stage31) genLeaveCode(node *models.TokenRuleNode, depth int) int {
@@ -275,5 +274,4 @@ func (s *Stage31) genLeaveCode(node *models.TokenRuleNode, depth int) int {
 	default:
 		panic("this should never happen")
 	}
-	return depth
 }
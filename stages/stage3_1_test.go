@@ -6,7 +6,7 @@ import (
 )
 
 func TestStage31(t *testing.T) {
-	b, err := os.ReadFile("../../go.txt")
+	b, err := os.ReadFile("../parsers/go/go.txt")
 	if err != nil {
 		t.Fatal(err)
 	}
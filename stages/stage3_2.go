@@ -7,7 +7,9 @@ import (
 	"github.com/lincaiyong/pgen/snippet"
 	"github.com/lincaiyong/pgen/util"
 	"regexp"
+	"slices"
 	"sort"
+	"strconv"
 	"strings"
 )
 
@@ -31,7 +33,9 @@ type Stage32 struct {
 
 func (s *Stage32) run() {
 	s.genMemoIdConsts().PutNL()
+	s.genParserState().PutNL()
 	s.Gen.Put(snippet.NodeCacheStruct).PutNL()
+	s.Gen.Put(snippet.LimitErrorStruct).PutNL()
 	s.Gen.Put(snippet.ParserStruct).PutNL()
 	for _, rule := range s.Input.Language.GrammarRules() {
 		err := s.genGrammarRuleCode(rule)
@@ -53,7 +57,13 @@ func (s *Stage32) genGrammarRuleCode(rule *models.GrammarRuleNode) error {
 			simpleChoices = append(simpleChoices, choice)
 		}
 	}
-	if len(leftRecChoices) > 0 {
+	putDocComment(s.Gen, rule.Doc())
+	if len(rule.Precedences()) > 0 {
+		if len(leftRecChoices) > 0 {
+			return fmt.Errorf("left recursive operand of precedence rule: %s", rule.Name())
+		}
+		s.gramPrecedenceRuleCode(rule)
+	} else if len(leftRecChoices) > 0 {
 		s.gramLeftRecRuleCode(rule, leftRecChoices, simpleChoices)
 	} else {
 		s.gramSimpleRuleCode(rule)
@@ -83,8 +93,11 @@ func (s *Stage32) gramLeftMost(node *models.GrammarRuleNode, leftmost map[string
 		}
 		return false
 	case models.GrammarRuleNodeTypeNameAtom:
-		leftmost[node.Snippet().Text()] = true
+		leftmost[node.Name()] = true
 		return false
+	case models.GrammarRuleNodeTypePositivePredicateItem, models.GrammarRuleNodeTypeNegativePredicateItem,
+		models.GrammarRuleNodeTypeStateActionItem:
+		return true
 	default:
 		return false
 	}
@@ -95,35 +108,44 @@ func (s *Stage32) gramMemoCode(funName string) {
 	s.Gen.Put("pos := ps._mark()")
 	s.Gen.Put("var ok bool")
 	s.Gen.Put("var cache *NodeCache")
-	s.Gen.Put("cacheAtPos := ps._nodeCache[pos]")
+	s.Gen.Put("cacheAtPos := ps._nodeCache[pos.pos]")
 	s.Gen.Put("if cacheAtPos != nil {").Push()
-	s.Gen.Put("if cache, ok = cacheAtPos[%sMemoId]; ok {", funName).Push()
+	s.Gen.Put("if cache, ok = cacheAtPos[%sMemoId]; ok && cache.state == ps.state {", funName).Push()
 	s.Gen.Put("if cache.val == nil {").Push()
 	s.Gen.Put("return nil").Pop()
 	s.Gen.Put("}")
-	s.Gen.Put("ps._reset(cache.pos)")
+	s.Gen.Put("ps._reset(cache.end)")
 	s.Gen.Put("return cache.val").Pop()
 	s.Gen.Put("}").Pop()
 	s.Gen.Put("} else {").Push()
 	s.Gen.Put("cacheAtPos = make(map[int]*NodeCache)")
-	s.Gen.Put("ps._nodeCache[pos] = cacheAtPos").Pop()
+	s.Gen.Put("ps._nodeCache[pos.pos] = cacheAtPos").Pop()
 	s.Gen.Put("}")
+	s.Gen.Put("state := ps.state")
 	s.Gen.Put("t := ps.%s_()", funName)
-	s.Gen.Put("cacheAtPos[%sMemoId] = &NodeCache{t, ps._mark()}", funName)
+	s.Gen.Put("cacheAtPos[%sMemoId] = &NodeCache{t, ps._mark(), state}", funName)
 	s.Gen.Put("return t").Pop()
 	s.Gen.Put("}").PutNL()
 }
 
-func (s *Stage32) gramSimpleRuleCode(rule *models.GrammarRuleNode) {
-	memo := ""
-	funName := util.SafeName(util.ToCamelCase(rule.Name()))
-	if rule.RuleMemo() {
-		s.gramMemoCode(funName)
-		memo = "!"
-		funName += "_"
-	}
+func (s *Stage32) gramEnterCode() {
+	s.Gen.Put("if !ps._enterDepth() {").Push()
+	s.Gen.Put("return nil").Pop()
+	s.Gen.Put("}")
+	s.Gen.Put("defer ps._leaveDepth()")
+}
+
+// hackDefines tells whether the hack code defines the parser method name, such as
+// the _enter and _leave hooks called around [ ] regions.
+func (s *Stage32) hackDefines(name string) bool {
+	return regexp.MustCompile(`func \(\w+ \*Parser\) ` + name + `\(`).MatchString(s.Input.Language.HackCode())
+}
 
+func (s *Stage32) gramRuleComment(rule *models.GrammarRuleNode, memo string) {
 	s.Gen.Put("/*\n%s%s:", rule.Name(), memo)
+	for _, level := range rule.Precedences() {
+		s.Gen.Put("%s", level.Snippet().Text())
+	}
 	for _, choice := range rule.Children() {
 		s.Gen.Put("| %s", choice.Snippet().Text())
 	}
@@ -133,8 +155,21 @@ func (s *Stage32) gramSimpleRuleCode(rule *models.GrammarRuleNode) {
 		}
 	})
 	s.Gen.Put("*/")
+}
+
+func (s *Stage32) gramSimpleRuleCode(rule *models.GrammarRuleNode) {
+	memo := ""
+	funName := util.SafeName(util.ToCamelCase(rule.Name()))
+	if rule.RuleMemo() {
+		s.gramMemoCode(funName)
+		memo = "!"
+		funName += "_"
+	}
+
+	s.gramRuleComment(rule, memo)
 
 	s.Gen.Put("func (ps *Parser) %s() Node {", funName).Push()
+	s.gramEnterCode()
 	s.gramChoicesCode(rule.Children(), "")
 	s.Gen.Put("return nil")
 	s.Gen.Pop().Put("}").PutNL()
@@ -149,19 +184,11 @@ func (s *Stage32) gramLeftRecRuleCode(rule *models.GrammarRuleNode, leftRecChoic
 		funName += "_"
 	}
 
-	s.Gen.Put("/*\n%s%s:", rule.Name(), memo)
-	for _, choice := range rule.Children() {
-		s.Gen.Put("| %s", choice.Snippet().Text())
-	}
-	rule.Visit(func(node *models.GrammarRuleNode) {
-		if node.Kind() == models.GrammarRuleNodeTypeNameAtom && strings.HasPrefix(node.Name(), "_group_") {
-			s.Gen.Put("%s <-- %s", node.Name(), node.Snippet().Text())
-		}
-	})
-	s.Gen.Put("*/")
+	s.gramRuleComment(rule, memo)
 
 	camelName := util.ToCamelCase(rule.Name())
 	s.Gen.Put("func (ps *Parser) %s() Node {", funName).Push()
+	s.gramEnterCode()
 	s.Gen.Put("_left := ps.%sLeftMost()", camelName)
 	s.Gen.Put("if _left == nil {").Push()
 	s.Gen.Put("return nil")
@@ -185,12 +212,79 @@ func (s *Stage32) gramLeftRecRuleCode(rule *models.GrammarRuleNode, leftRecChoic
 	s.Gen.Pop().Put("}").PutNL()
 }
 
+func (s *Stage32) gramPrecedenceRuleCode(rule *models.GrammarRuleNode) {
+	memo := ""
+	funName := util.SafeName(util.ToCamelCase(rule.Name()))
+	if rule.RuleMemo() {
+		s.gramMemoCode(funName)
+		memo = "!"
+		funName += "_"
+	}
+	s.gramRuleComment(rule, memo)
+
+	camelName := util.ToCamelCase(rule.Name())
+	s.Gen.Put("func (ps *Parser) %s() Node {", funName).Push()
+	s.gramEnterCode()
+	s.Gen.Put("return ps.%sPrecedence(0)", camelName)
+	s.Gen.Pop().Put("}").PutNL()
+
+	s.Gen.Put("func (ps *Parser) %sOperand() Node {", camelName).Push()
+	s.gramChoicesCode(rule.Children(), "")
+	s.Gen.Put("return nil")
+	s.Gen.Pop().Put("}").PutNL()
+
+	s.Gen.Put("func (ps *Parser) %sOperator(minLevel int) (Node, int) {", camelName).Push()
+	s.Gen.Put("var op Node")
+	for i, level := range rule.Precedences() {
+		s.Gen.Put("if minLevel <= %d {", i).Push()
+		for _, operator := range level.Children() {
+			s.gramCode(operator, "op", "")
+			s.Gen.Put("if op != nil {").Push()
+			s.Gen.Put("return op, %d", i)
+			s.Gen.Pop().Put("}")
+		}
+		s.Gen.Pop().Put("}")
+	}
+	s.Gen.Put("return nil, -1")
+	s.Gen.Pop().Put("}").PutNL()
+
+	s.Gen.Put("func (ps *Parser) %sPrecedence(minLevel int) Node {", camelName).Push()
+	s.gramEnterCode()
+	s.Gen.Put("lhs := ps.%sOperand()", camelName)
+	s.Gen.Put("if lhs == nil {").Push()
+	s.Gen.Put("return nil")
+	s.Gen.Pop().Put("}")
+	s.Gen.Put("for {").Push()
+	s.Gen.Put("pos := ps._mark()")
+	s.Gen.Put("op, level := ps.%sOperator(minLevel)", camelName)
+	s.Gen.Put("_ = op")
+	s.Gen.Put("var rhs Node")
+	s.Gen.Put("switch level {")
+	for i, level := range rule.Precedences() {
+		next := i + 1
+		if level.Name() == "right" {
+			next = i
+		}
+		s.Gen.Put("case %d:", i).Push()
+		s.Gen.Put("if rhs = ps.%sPrecedence(%d); rhs != nil {", camelName, next).Push()
+		s.Gen.Put("lhs = %s", s.gramActionCode(level.Action(), "lhs"))
+		s.Gen.Put("continue")
+		s.Gen.Pop().Put("}").Pop()
+	}
+	s.Gen.Put("}")
+	s.Gen.Put("ps._reset(pos)")
+	s.Gen.Put("return lhs")
+	s.Gen.Pop().Put("}")
+	s.Gen.Pop().Put("}").PutNL()
+}
+
 func (s *Stage32) gramChoicesCode(choices []*models.GrammarRuleNode, leftVar string) {
 	posDefined := false
 	for _, choice := range choices {
 		s.Gen.Put("/* %s", regexp.MustCompile(`\s+`).ReplaceAllString(choice.Snippet().Text(), " "))
 		s.Gen.Put(" */")
-		needMarkReset := len(choice.Children()) > 1 || choice.Action() != nil
+		// the mark also holds the state, so a failed choice undoes its state actions
+		needMarkReset := len(choice.Children()) > 1 || choice.Action() != nil || s.gramHasStateAction(choice)
 		if needMarkReset {
 			if !posDefined {
 				posDefined = true
@@ -206,11 +300,20 @@ func (s *Stage32) gramChoicesCode(choices []*models.GrammarRuleNode, leftVar str
 	}
 }
 
+func (s *Stage32) gramHasStateAction(choice *models.GrammarRuleNode) bool {
+	for _, item := range choice.Children() {
+		if item.Kind() == models.GrammarRuleNodeTypeStateActionItem {
+			return true
+		}
+	}
+	return false
+}
+
 func (s *Stage32) gramHoistingGroupVars(atom *models.GrammarRuleNode) {
 	if atom != nil && atom.Kind() == models.GrammarRuleNodeTypeGroupAtom {
 		for _, item := range atom.Child().Children() {
 			if item.Name() != "" {
-				s.Gen.Put("var %s Node", item.Name())
+				s.Gen.Put("var %s Node", util.SafeName(item.Name()))
 			}
 		}
 	}
@@ -227,7 +330,7 @@ func (s *Stage32) gramItemNames(node *models.GrammarRuleNode, names []string) []
 		models.GrammarRuleNodeTypeRepeat1Item, models.GrammarRuleNodeTypeAtomItem,
 		models.GrammarRuleNodeTypeSeparatedRepeat1Item, models.GrammarRuleNodeTypePositiveLookaheadItem:
 		if node.Name() != "" {
-			names = append(names, node.Name())
+			names = append(names, util.SafeName(node.Name()))
 		}
 		if node.Child() != nil {
 			names = s.gramItemNames(node.Child(), names)
@@ -254,19 +357,27 @@ func (s *Stage32) gramCode(node *models.GrammarRuleNode, itemName string, leftVa
 		var breakVar string
 		for i, item := range node.Children() {
 			if leftVar != "" && i == 0 {
-				s.Gen.Put("%s = %s", item.Name(), leftVar) // FIXME: 是不是name可能为空
+				s.Gen.Put("%s = %s", util.SafeName(item.Name()), leftVar) // FIXME: 是不是name可能为空
 			} else {
-				s.gramCode(item, item.Name(), "")
+				s.gramCode(item, util.SafeName(item.Name()), "")
 				if item.Suffix() == "[" {
 					breakVar = s.Gen.CreateVar("break")
 					s.Gen.Put("%s := true", breakVar)
-					s.Gen.Put("ps._enter()")
+					s.Gen.Put("if !ps._enterDepth() {").Push()
+					s.Gen.Put("return nil").Pop()
+					s.Gen.Put("}")
+					if s.hackDefines("_enter") {
+						s.Gen.Put("ps._enter()")
+					}
 					s.Gen.Put("for {").Push()
 				} else if item.Suffix() == "]" {
 					s.Gen.Put("%s = false", breakVar)
 					s.Gen.Put("break")
 					s.Gen.Pop().Put("}")
-					s.Gen.Put("ps._leave()")
+					if s.hackDefines("_leave") {
+						s.Gen.Put("ps._leave()")
+					}
+					s.Gen.Put("ps._leaveDepth()")
 					s.Gen.Put("if %s {", breakVar).Push()
 					s.Gen.Put("break")
 					s.Gen.Pop().Put("}")
@@ -429,6 +540,16 @@ func (s *Stage32) gramCode(node *models.GrammarRuleNode, itemName string, leftVa
 		s.Gen.Pop().Put("} else {").Push()
 		s.Gen.Put("break")
 		s.Gen.Pop().Put("}")
+	case models.GrammarRuleNodeTypePositivePredicateItem:
+		s.Gen.Put("if !(%s) {", strings.TrimSpace(node.Child().Snippet().Text())).Push()
+		s.Gen.Put("break")
+		s.Gen.Pop().Put("}")
+	case models.GrammarRuleNodeTypeNegativePredicateItem:
+		s.Gen.Put("if %s {", strings.TrimSpace(node.Child().Snippet().Text())).Push()
+		s.Gen.Put("break")
+		s.Gen.Pop().Put("}")
+	case models.GrammarRuleNodeTypeStateActionItem:
+		s.Gen.Put("ps.%s()", util.SafeName(util.ToCamelCase(node.Child().Name())))
 	case models.GrammarRuleNodeTypeAtomItem:
 		if itemName == "" {
 			itemName = s.Gen.CreateVar("_")
@@ -465,14 +586,14 @@ func (s *Stage32) gramCode(node *models.GrammarRuleNode, itemName string, leftVa
 		for i, item := range node.Children() {
 			if i == len(node.Children())-1 {
 				if item.Name() != "" {
-					s.gramCode(item, item.Name(), "")
-					s.Gen.Put("%s = %s", inputItemName, item.Name())
+					s.gramCode(item, util.SafeName(item.Name()), "")
+					s.Gen.Put("%s = %s", inputItemName, util.SafeName(item.Name()))
 				} else {
 					s.gramCode(item, inputItemName, "")
 				}
 				break
 			}
-			itemName = item.Name()
+			itemName = util.SafeName(item.Name())
 			if itemName == "" {
 				itemName = s.Gen.CreateVar("_")
 				s.Gen.Put("var %s Node", itemName)
@@ -514,10 +635,13 @@ func (s *Stage32) gramCode(node *models.GrammarRuleNode, itemName string, leftVa
 		s.Gen.Put("break")
 		s.Gen.Pop().Put("}")
 		s.Gen.Pop().Put("} else if ps._expectK(TokenTypeEndOfFile) != nil {").Push()
-		s.Gen.Put("panic(\"bracket ellipsis reach end of file\")")
-		s.Gen.Pop().Put("} else {").Push()
-		s.Gen.Put("ps._anyToken()")
+		s.Gen.Put("break")
+		s.Gen.Pop().Put("} else if ps._anyToken() == nil {").Push()
+		s.Gen.Put("break")
+		s.Gen.Pop().Put("}")
 		s.Gen.Pop().Put("}")
+		s.Gen.Put("if %s != 0 {", depthVar).Push()
+		s.Gen.Put("break")
 		s.Gen.Pop().Put("}")
 		s.Gen.Put("%s = ps._pseudoToken(%s, %s)", itemName, firstVar, lastVar)
 		s.Gen.Put("break")
@@ -528,9 +652,9 @@ func (s *Stage32) gramCode(node *models.GrammarRuleNode, itemName string, leftVa
 }
 
 func (s *Stage32) gramActionCode(action *models.GrammarRuleNode, leftVar string) string {
-	position := "ps._tokens[pos].Start, ps._visibleTokenBefore(ps._mark()).End"
+	position := "ps._tokens[pos.pos].Start, ps._visibleTokenBefore(ps._pos).End"
 	if leftVar != "" {
-		position = fmt.Sprintf("%s.RangeStart(), ps._visibleTokenBefore(ps._mark()).End", leftVar)
+		position = fmt.Sprintf("%s.RangeStart(), ps._visibleTokenBefore(ps._pos).End", leftVar)
 	}
 	switch action.Kind() {
 	case models.GrammarRuleNodeTypeCallAction:
@@ -543,20 +667,345 @@ func (s *Stage32) gramActionCode(action *models.GrammarRuleNode, leftVar string)
 		if strings.HasPrefix(calleeName, "_") {
 			return fmt.Sprintf("ps.%s(%s)", util.ToCamelCase(calleeName), argsText)
 		}
+		if node := s.astNode(calleeName); node == nil {
+			s.Error.AddError(fmt.Errorf("unknown node %s in action: %s", calleeName, action.Snippet().Text()))
+		} else if len(node.Args()) != len(args) {
+			s.Error.AddError(fmt.Errorf("node %s expects %d arguments, got %d: %s",
+				calleeName, len(node.Args()), len(args), action.Snippet().Text()))
+		} else {
+			for i, field := range node.Fields() {
+				s.checkActionField(node, field, action.Children()[i])
+			}
+		}
 		if argsText != "" {
 			position = fmt.Sprintf(", %s", position)
 		}
 		return fmt.Sprintf("New%sNode(ps._filePath, ps._fileContent, %s%s)", util.ToPascalCase(calleeName), argsText, position)
 	case models.GrammarRuleNodeTypeListAction:
-		elem := s.gramActionCode(action.Child(), leftVar)
-		return fmt.Sprintf("NewNodesNode([]Node{%s})", elem)
+		parts := make([]string, 0)
+		elems := make([]string, 0)
+		for _, elem := range action.Children() {
+			if elem.Kind() != models.GrammarRuleNodeTypeSpreadAction {
+				elems = append(elems, s.gramActionCode(elem, leftVar))
+				continue
+			}
+			if len(elems) > 0 {
+				parts = append(parts, fmt.Sprintf("[]Node{%s}", strings.Join(elems, ", ")))
+				elems = elems[:0]
+			}
+			parts = append(parts, fmt.Sprintf("ps._spread(%s)", s.gramActionCode(elem.Child(), leftVar)))
+		}
+		if len(parts) == 0 {
+			return fmt.Sprintf("NewNodesNode([]Node{%s})", strings.Join(elems, ", "))
+		}
+		if len(elems) > 0 {
+			parts = append(parts, fmt.Sprintf("[]Node{%s}", strings.Join(elems, ", ")))
+		}
+		return fmt.Sprintf("NewNodesNode(slices.Concat(%s))", strings.Join(parts, ", "))
 	case models.GrammarRuleNodeTypeNullAction:
 		return "nil"
+	case models.GrammarRuleNodeTypeNameAction:
+		return util.SafeName(action.Name())
+	case models.GrammarRuleNodeTypeLiteralAction:
+		text := action.Snippet().Text()
+		if _, err := strconv.Unquote(text); err != nil {
+			text = strconv.Quote(text)
+			if action.Snippet().Text()[0] == '"' {
+				s.Error.AddError(fmt.Errorf("invalid string literal in action: %s", action.Snippet().Text()))
+			}
+		}
+		return fmt.Sprintf("ps._literalToken(%s)", text)
+	case models.GrammarRuleNodeTypeFieldAction:
+		field := util.SafeName(action.Name())
+		s.checkSelectedField(action, field)
+		return fmt.Sprintf("ps._selectField(%s, %s)", s.gramActionCode(action.Child(), leftVar), strconv.Quote(field))
+	case models.GrammarRuleNodeTypeCoalesceAction:
+		return fmt.Sprintf("ps._coalesce(%s, %s)",
+			s.gramActionCode(action.Children()[0], leftVar), s.gramActionCode(action.Children()[1], leftVar))
+	case models.GrammarRuleNodeTypeSpanAction:
+		return fmt.Sprintf("ps._pseudoToken(%s, %s)",
+			s.gramActionCode(action.Children()[0], leftVar), s.gramActionCode(action.Children()[1], leftVar))
 	default:
 		return action.Snippet().Text()
 	}
 }
 
+func (s *Stage32) astNode(name string) *models.AstNode {
+	for _, node := range s.Input.Language.AstNodes() {
+		if node.Name() == name {
+			return node
+		}
+	}
+	return nil
+}
+
+const (
+	actionShapeUnknown = iota
+	actionShapeNil
+	actionShapeToken
+	actionShapeList
+	actionShapeNode
+)
+
+func (s *Stage32) checkActionField(node *models.AstNode, field *models.AstField, arg *models.GrammarRuleNode) {
+	shape, optional := s.actionShape(arg)
+	var problem string
+	switch {
+	case !field.Optional() && !field.List() && shape == actionShapeNil:
+		problem = "is required"
+	case !field.Optional() && !field.List() && optional:
+		problem = "is required but the argument may be missing"
+	case field.List() && (shape == actionShapeToken || shape == actionShapeNode):
+		problem = "expects a list"
+	case field.Token() && !field.List() && (shape == actionShapeList || shape == actionShapeNode):
+		problem = "expects a token"
+	default:
+		return
+	}
+	s.Error.AddError(fmt.Errorf("field %s of node %s %s: %s", field.Normal(), node.Name(), problem, arg.Snippet().Text()))
+}
+
+// actionShape tells what an action evaluates to as far as it is known statically,
+// and whether the value may be missing.
+func (s *Stage32) actionShape(action *models.GrammarRuleNode) (int, bool) {
+	switch action.Kind() {
+	case models.GrammarRuleNodeTypeNullAction:
+		return actionShapeNil, true
+	case models.GrammarRuleNodeTypeLiteralAction, models.GrammarRuleNodeTypeSpanAction:
+		return actionShapeToken, false
+	case models.GrammarRuleNodeTypeListAction:
+		return actionShapeList, false
+	case models.GrammarRuleNodeTypeCallAction:
+		if strings.HasPrefix(action.Name(), "_") {
+			return actionShapeUnknown, false
+		}
+		return actionShapeNode, false
+	case models.GrammarRuleNodeTypeCoalesceAction:
+		shape, _ := s.actionShape(action.Children()[0])
+		fallback, optional := s.actionShape(action.Children()[1])
+		if fallback != actionShapeNil && fallback != shape {
+			shape = actionShapeUnknown
+		}
+		return shape, optional
+	case models.GrammarRuleNodeTypeNameAction:
+		for parent := action.Parent(); parent != nil; parent = parent.Parent() {
+			if parent.Kind() == models.GrammarRuleNodeTypePrecedenceLevel && action.Name() == "op" {
+				return actionShapeToken, false
+			}
+			if parent.Kind() == models.GrammarRuleNodeTypeChoice {
+				return s.itemShape(parent, action.Name(), false)
+			}
+		}
+	}
+	return actionShapeUnknown, false
+}
+
+// itemShape looks up the item bound to name among the items of a choice or an inlined group.
+func (s *Stage32) itemShape(parent *models.GrammarRuleNode, name string, optional bool) (int, bool) {
+	for _, item := range parent.Children() {
+		itemOptional := optional || item.Kind() == models.GrammarRuleNodeTypeOptionalItem
+		if item.Name() == name {
+			switch item.Kind() {
+			case models.GrammarRuleNodeTypeRepeat0Item, models.GrammarRuleNodeTypeRepeat1Item,
+				models.GrammarRuleNodeTypeSeparatedRepeat0Item, models.GrammarRuleNodeTypeSeparatedRepeat1Item:
+				return actionShapeList, optional
+			case models.GrammarRuleNodeTypeOptionalItem, models.GrammarRuleNodeTypeAtomItem:
+				switch item.Child().Kind() {
+				case models.GrammarRuleNodeTypeTokenAtom, models.GrammarRuleNodeTypeStringAtom,
+					models.GrammarRuleNodeTypeBracketEllipsisAtom:
+					return actionShapeToken, itemOptional
+				}
+				return actionShapeUnknown, itemOptional
+			}
+			return actionShapeUnknown, false
+		}
+		if atom := item.Child(); atom != nil && atom.Kind() == models.GrammarRuleNodeTypeGroupAtom {
+			if shape, ok := s.itemShape(atom, name, itemOptional); shape != actionShapeUnknown || ok {
+				return shape, ok
+			}
+		}
+	}
+	return actionShapeUnknown, false
+}
+
+// checkSelectedField checks that every node the selected action may build has the field.
+// When the nodes are not known statically, it is enough that some node has the field.
+func (s *Stage32) checkSelectedField(action *models.GrammarRuleNode, field string) {
+	nodes, ok := s.actionNodes(action.Child(), map[*models.GrammarRuleNode]bool{})
+	if !ok {
+		if !s.astField(field) {
+			s.Error.AddError(fmt.Errorf("unknown field %s in action: %s", action.Name(), action.Snippet().Text()))
+		}
+		return
+	}
+	if len(nodes) == 0 {
+		s.Error.AddError(fmt.Errorf("field %s selected from a value that is not a node: %s", action.Name(), action.Snippet().Text()))
+		return
+	}
+	for _, node := range nodes {
+		if !s.nodeHasField(node, field) {
+			s.Error.AddError(fmt.Errorf("node %s has no field %s: %s", node.Name(), action.Name(), action.Snippet().Text()))
+		}
+	}
+}
+
+// actionNodes resolves the nodes an action may build. It reports false when that is
+// not known statically, e.g. for hack calls, template parameters or selected fields.
+func (s *Stage32) actionNodes(action *models.GrammarRuleNode, visited map[*models.GrammarRuleNode]bool) ([]*models.AstNode, bool) {
+	switch action.Kind() {
+	case models.GrammarRuleNodeTypeNullAction, models.GrammarRuleNodeTypeLiteralAction,
+		models.GrammarRuleNodeTypeSpanAction, models.GrammarRuleNodeTypeListAction:
+		return nil, true
+	case models.GrammarRuleNodeTypeCallAction:
+		if strings.HasPrefix(action.Name(), "_") {
+			return nil, false
+		}
+		node := s.astNode(action.Name())
+		if node == nil {
+			return nil, false
+		}
+		return []*models.AstNode{node}, true
+	case models.GrammarRuleNodeTypeCoalesceAction:
+		nodes, ok := s.actionNodes(action.Children()[0], visited)
+		fallback, fallbackOk := s.actionNodes(action.Children()[1], visited)
+		return append(nodes, fallback...), ok && fallbackOk
+	case models.GrammarRuleNodeTypeNameAction:
+		for parent := action.Parent(); parent != nil; parent = parent.Parent() {
+			if parent.Kind() == models.GrammarRuleNodeTypePrecedenceLevel {
+				switch action.Name() {
+				case "op":
+					return nil, true
+				case "lhs", "rhs":
+					return s.ruleNodes(parent.Parent(), visited)
+				}
+			}
+			if parent.Kind() == models.GrammarRuleNodeTypeChoice {
+				if item := s.boundItem(parent, action.Name()); item != nil {
+					return s.itemNodes(item, visited)
+				}
+				return nil, false
+			}
+		}
+	}
+	return nil, false
+}
+
+// boundItem finds the item bound to name among the items of a choice or an inlined group.
+func (s *Stage32) boundItem(parent *models.GrammarRuleNode, name string) *models.GrammarRuleNode {
+	for _, item := range parent.Children() {
+		if item.Name() == name {
+			return item
+		}
+		if atom := item.Child(); atom != nil && atom.Kind() == models.GrammarRuleNodeTypeGroupAtom {
+			if found := s.boundItem(atom, name); found != nil {
+				return found
+			}
+		}
+	}
+	return nil
+}
+
+func (s *Stage32) itemNodes(item *models.GrammarRuleNode, visited map[*models.GrammarRuleNode]bool) ([]*models.AstNode, bool) {
+	switch item.Kind() {
+	case models.GrammarRuleNodeTypeRepeat0Item, models.GrammarRuleNodeTypeRepeat1Item,
+		models.GrammarRuleNodeTypeSeparatedRepeat0Item, models.GrammarRuleNodeTypeSeparatedRepeat1Item:
+		return nil, true
+	case models.GrammarRuleNodeTypeOptionalItem, models.GrammarRuleNodeTypeAtomItem:
+	default:
+		return nil, false
+	}
+	atom := item.Child()
+	switch atom.Kind() {
+	case models.GrammarRuleNodeTypeTokenAtom, models.GrammarRuleNodeTypeStringAtom,
+		models.GrammarRuleNodeTypeBracketEllipsisAtom:
+		return nil, true
+	case models.GrammarRuleNodeTypeGroupAtom:
+		return s.choicesNodes(atom.Children(), visited)
+	case models.GrammarRuleNodeTypeNameAtom:
+		for _, rule := range s.Input.Language.GrammarRules() {
+			if rule.Name() == atom.Name() {
+				return s.ruleNodes(rule, visited)
+			}
+		}
+	}
+	return nil, false
+}
+
+func (s *Stage32) ruleNodes(rule *models.GrammarRuleNode, visited map[*models.GrammarRuleNode]bool) ([]*models.AstNode, bool) {
+	if visited[rule] {
+		return nil, true
+	}
+	visited[rule] = true
+	nodes, ok := s.choicesNodes(rule.Children(), visited)
+	for _, level := range rule.Precedences() {
+		levelNodes, levelOk := s.actionNodes(level.Action(), visited)
+		nodes, ok = append(nodes, levelNodes...), ok && levelOk
+	}
+	return nodes, ok
+}
+
+// choicesNodes collects the nodes built by the actions of choices. A choice without
+// an action builds what its first item builds.
+func (s *Stage32) choicesNodes(choices []*models.GrammarRuleNode, visited map[*models.GrammarRuleNode]bool) ([]*models.AstNode, bool) {
+	nodes := make([]*models.AstNode, 0)
+	for _, choice := range choices {
+		var choiceNodes []*models.AstNode
+		var ok bool
+		if choice.Action() != nil {
+			choiceNodes, ok = s.actionNodes(choice.Action(), visited)
+		} else if len(choice.Children()) > 0 {
+			choiceNodes, ok = s.itemNodes(choice.Children()[0], visited)
+		}
+		if !ok {
+			return nil, false
+		}
+		for _, node := range choiceNodes {
+			if !slices.Contains(nodes, node) {
+				nodes = append(nodes, node)
+			}
+		}
+	}
+	return nodes, true
+}
+
+func (s *Stage32) nodeHasField(node *models.AstNode, name string) bool {
+	for _, arg := range node.Args() {
+		if arg.Normal() == name {
+			return true
+		}
+	}
+	return false
+}
+
+func (s *Stage32) astField(name string) bool {
+	for _, node := range s.Input.Language.AstNodes() {
+		for _, arg := range node.Args() {
+			if arg.Normal() == name {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func (s *Stage32) genParserState() models.Generator {
+	fields := s.Input.Language.StateFields()
+	s.Gen.Put("type parserState struct {").Push()
+	for _, field := range fields {
+		s.Gen.Put("%s %s", field.Name(), field.Type())
+	}
+	s.Gen.Pop().Put("}")
+	if len(fields) > 0 {
+		snippet := fields[0].Snippet()
+		s.Gen.PutNL()
+		s.Gen.Put("// The memo cache compares parser states, so every field of the state block at")
+		s.Gen.Put("// %d:%d of the grammar must be comparable; this fails to compile otherwise.",
+			snippet.Start.LineIdx+1, snippet.End.LineIdx+1)
+		s.Gen.Put("var _ = parserState{} == parserState{}")
+	}
+	return s.Gen
+}
+
 func (s *Stage32) genMemoIdConsts() models.Generator {
 	memoIds := make(map[int]string)
 	memos := make([]int, 0)
@@ -2,11 +2,12 @@ package stages
 
 import (
 	"os"
+	"strings"
 	"testing"
 )
 
 func TestStage32(t *testing.T) {
-	b, err := os.ReadFile("../../go.txt")
+	b, err := os.ReadFile("../parsers/go/go.txt")
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -16,3 +17,111 @@ func TestStage32(t *testing.T) {
 	text := s32.Gen.String()
 	_ = os.WriteFile("test2.txt", []byte(text), 0644)
 }
+
+func TestStage32Actions(t *testing.T) {
+	divider := strings.Repeat("-", 120) + "\n"
+	run := func(grammar string) *Stage32 {
+		sections := []string{"", "", "(\n)\n,\n;\n", "pair <lhs rhs>\nname <ident?:token>\n", grammar, ""}
+		return RunStage32(RunStage2(RunStage1(strings.Join(sections, divider))))
+	}
+	s32 := run("file: a=name b=name* c=name? {pair([a, *b, c.ident], \"x\\\"y\" ?? 1)}\n" +
+		"name: i=IDENT j=IDENT? {name(i..j ?? _)}\n")
+	if err := s32.Error.ToError(); err != nil {
+		t.Fatal(err)
+	}
+	text := s32.Gen.String()
+	for _, code := range []string{
+		`NewPairNode(ps._filePath, ps._fileContent, NewNodesNode(slices.Concat([]Node{a}, ps._spread(b), []Node{ps._selectField(c, "ident")})), ps._coalesce(ps._literalToken("x\"y"), ps._literalToken("1"))`,
+		`NewNameNode(ps._filePath, ps._fileContent, ps._coalesce(ps._pseudoToken(i, j), nil)`,
+	} {
+		if !strings.Contains(text, code) {
+			t.Fatalf("expect code: %s", code)
+		}
+	}
+	s32 = run("file: a=inner {name(a.ident)}\ninner: | x=single | '(' x=inner ')' {x}\nsingle: i=IDENT {name(i)}\n")
+	if err := s32.Error.ToError(); err != nil {
+		t.Fatal(err)
+	}
+	for _, grammar := range []string{
+		"file: a=IDENT {pair(a)}\n",
+		"file: a=IDENT {nothing(a)}\n",
+		"file: a=IDENT {name(a.nothing)}\n",
+		"file: a=IDENT {name(a.ident)}\n",
+		"file: a=two {name(a.ident)}\ntwo: x=name y=name {pair(x, y)}\nname: i=IDENT {name(i)}\n",
+		"file: a=IDENT {name([a, b)}\n",
+		"file: a=IDENT {name(\"a)}\n",
+	} {
+		if s32 := run(grammar); s32.Error.ToError() == nil && s32.Input.Error.ToError() == nil {
+			t.Fatalf("expect error for %s", grammar)
+		}
+	}
+}
+
+func TestStage32ActionFields(t *testing.T) {
+	divider := strings.Repeat("-", 120) + "\n"
+	run := func(grammar string) *Stage32 {
+		sections := []string{"", "", "(\n)\n,\n;\n", "call <fn:token args* ret?>\n", grammar, ""}
+		return RunStage32(RunStage2(RunStage1(strings.Join(sections, divider))))
+	}
+	for _, grammar := range []string{
+		"file: f=IDENT a=IDENT* r=IDENT? {call(f, a, r)}\n",
+		"file: f=IDENT ('(' a=','.IDENT+ ')')? {call(f, a, _)}\n",
+		"file: f=IDENT a=IDENT {call(f..a, [a, a], f)}\n",
+		"file: f=IDENT? {call(f ?? \"x\", _, _)}\n",
+	} {
+		if err := run(grammar).Error.ToError(); err != nil {
+			t.Fatalf("unexpected error for %s: %v", grammar, err)
+		}
+	}
+	for _, grammar := range []string{
+		"file: a=IDENT* {call(_, a, _)}\n",
+		"file: f=IDENT? {call(f, _, _)}\n",
+		"file: (f=IDENT ';')? {call(f, _, _)}\n",
+		"file: f=IDENT a=IDENT* {call(a, _, _)}\n",
+		"file: f=IDENT {call(f, f, _)}\n",
+		"file: f=IDENT {call(f, call(f, _, _), _)}\n",
+	} {
+		if run(grammar).Error.ToError() == nil {
+			t.Fatalf("expect error for %s", grammar)
+		}
+	}
+}
+
+func TestStage32Depth(t *testing.T) {
+	divider := strings.Repeat("-", 120) + "\n"
+	run := func(hack string) string {
+		sections := []string{"", "", "(\n)\n;\n", "", "file: '(' [ x=IDENT ] ')' {x}\n", hack}
+		s32 := RunStage32(RunStage2(RunStage1(strings.Join(sections, divider))))
+		if err := s32.Error.ToError(); err != nil {
+			t.Fatal(err)
+		}
+		return s32.Gen.String()
+	}
+	text := run("")
+	if n := strings.Count(text, "if !ps._enterDepth() {\n"); n != 2 {
+		t.Fatalf("expect depth check on rule entry and [ ] region, got %d", n)
+	}
+	if !strings.Contains(text, "defer ps._leaveDepth()") || !strings.Contains(text, "}\n\t\tps._leaveDepth()\n") {
+		t.Fatal("expect _leaveDepth for rule and [ ] region")
+	}
+	if strings.Contains(text, "ps._enter()") || strings.Contains(text, "ps._leave()") {
+		t.Fatal("unexpected hook call without hack hooks")
+	}
+	text = run("func (ps *Parser) _enter() {\n}\n\nfunc (ps *Parser) _leave() {\n}\n")
+	if !strings.Contains(text, "ps._enter()\n") || !strings.Contains(text, "}\n\t\tps._leave()\n\t\tps._leaveDepth()\n") {
+		t.Fatal("expect hook calls around [ ] region")
+	}
+}
+
+func TestStage32StateGuard(t *testing.T) {
+	divider := strings.Repeat("-", 120) + "\n"
+	sections := []string{"", "", ";\n", "", "state { xs [2][]int }\nfile: ';'\n", ""}
+	s32 := RunStage32(RunStage2(RunStage1(strings.Join(sections, divider))))
+	if err := s32.Error.ToError(); err != nil {
+		t.Fatal(err)
+	}
+	text := s32.Gen.String()
+	if !strings.Contains(text, "// 6:7 of the grammar must be comparable") || !strings.Contains(text, "var _ = parserState{} == parserState{}") {
+		t.Fatalf("expect comparability guard:\n%s", text)
+	}
+}
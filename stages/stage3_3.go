@@ -5,6 +5,8 @@ import (
 	"github.com/lincaiyong/pgen/langgen"
 	"github.com/lincaiyong/pgen/models"
 	"github.com/lincaiyong/pgen/util"
+	"hash/fnv"
+	"slices"
 	"strings"
 )
 
@@ -27,9 +29,167 @@ type Stage33 struct {
 }
 
 func (s *Stage33) run() {
+	s.nodeFieldTable()
+	s.newNodeOfKind()
+	s.visitorInterfaces()
+	s.nodeCategories()
 	s.nodeStructs()
 }
 
+func (s *Stage33) nodeFieldTable() {
+	h := fnv.New64a()
+	s.Gen.Put("var nodeKinds = []string{").Push()
+	s.Gen.Put("NodeTypeToken,")
+	s.Gen.Put("NodeTypeNodes,")
+	for _, node := range s.Input.Language.AstNodes() {
+		s.Gen.Put("NodeType%s,", util.ToPascalCase(node.Name()))
+		_, _ = fmt.Fprintf(h, "%s", node.Name())
+		for _, arg := range node.Args() {
+			_, _ = fmt.Fprintf(h, " %s", arg.Normal())
+		}
+		_, _ = fmt.Fprintf(h, "\n")
+	}
+	s.Gen.Pop().Put("}").PutNL()
+	s.Gen.Put("const nodeSchemaHash uint64 = 0x%016x", h.Sum64()).PutNL()
+
+	s.Gen.Put("var nodeKindFields = map[string][]string{").Push()
+	s.Gen.Put("NodeTypeToken: nil,")
+	s.Gen.Put("NodeTypeNodes: nil,")
+	for _, node := range s.Input.Language.AstNodes() {
+		fields := make([]string, 0)
+		for _, arg := range node.Args() {
+			fields = append(fields, fmt.Sprintf("\"%s\"", arg.Normal()))
+		}
+		s.Gen.Put("NodeType%s: {%s},", util.ToPascalCase(node.Name()), strings.Join(fields, ", "))
+	}
+	s.Gen.Pop().Put("}").PutNL()
+
+	s.Gen.Put("var nodeKindFieldInfos = map[string][]FieldInfo{").Push()
+	s.Gen.Put("NodeTypeToken: nil,")
+	s.Gen.Put("NodeTypeNodes: nil,")
+	for _, node := range s.Input.Language.AstNodes() {
+		infos := make([]string, 0)
+		for _, field := range node.Fields() {
+			info := fmt.Sprintf("Name: \"%s\"", field.Normal())
+			if field.Optional() {
+				info += ", Optional: true"
+			}
+			if field.List() {
+				info += ", List: true"
+			}
+			if field.Token() {
+				info += ", Token: true"
+			}
+			infos = append(infos, fmt.Sprintf("{%s}", info))
+		}
+		s.Gen.Put("NodeType%s: {%s},", util.ToPascalCase(node.Name()), strings.Join(infos, ", "))
+	}
+	s.Gen.Pop().Put("}").PutNL()
+}
+
+func (s *Stage33) newNodeOfKind() {
+	s.Gen.Put("func newNodeOfKind(kind, filePath string, fileContent []rune, children []Node, start, end Position) Node {").Push()
+	s.Gen.Put("switch kind {")
+	for _, node := range s.Input.Language.AstNodes() {
+		pascalName := util.ToPascalCase(node.Name())
+		args := make([]string, 0)
+		for i := range node.Args() {
+			args = append(args, fmt.Sprintf("children[%d], ", i))
+		}
+		s.Gen.Put("case NodeType%s:", pascalName).Push()
+		s.Gen.Put("return New%sNode(filePath, fileContent, %sstart, end)", pascalName, strings.Join(args, "")).Pop()
+	}
+	s.Gen.Put("}")
+	s.Gen.Put("return nil")
+	s.Gen.Pop().Put("}").PutNL()
+}
+
+func (s *Stage33) visitorInterfaces() {
+	names := []string{"token", "nodes"}
+	for _, node := range s.Input.Language.AstNodes() {
+		names = append(names, node.Name())
+	}
+
+	s.Gen.Put("type Visitor interface {").Push()
+	for _, name := range names {
+		s.Gen.Put("Visit%sNode(n *%sNode) (visitChildren bool)", util.ToPascalCase(name), util.ToPascalCase(name))
+	}
+	s.Gen.Pop().Put("}").PutNL()
+
+	s.Gen.Put("type BaseVisitor struct{}").PutNL()
+	for _, name := range names {
+		s.Gen.Put("func (v *BaseVisitor) Visit%sNode(*%sNode) bool {", util.ToPascalCase(name), util.ToPascalCase(name)).Push()
+		s.Gen.Put("return true")
+		s.Gen.Pop().Put("}").PutNL()
+	}
+
+	s.Gen.Put("type Rewriter interface {").Push()
+	for _, name := range names {
+		s.Gen.Put("Rewrite%sNode(n *%sNode) Node", util.ToPascalCase(name), util.ToPascalCase(name))
+	}
+	s.Gen.Pop().Put("}").PutNL()
+
+	s.Gen.Put("type BaseRewriter struct{}").PutNL()
+	for _, name := range names {
+		s.Gen.Put("func (r *BaseRewriter) Rewrite%sNode(n *%sNode) Node {", util.ToPascalCase(name), util.ToPascalCase(name)).Push()
+		s.Gen.Put("return n")
+		s.Gen.Pop().Put("}").PutNL()
+	}
+}
+
+func (s *Stage33) nodeCategories() {
+	for _, category := range s.Input.Language.NodeCategories() {
+		pascalName := util.ToPascalCase(category.Name())
+		putDocComment(s.Gen, category.Doc())
+		s.Gen.Put("type %s interface {", pascalName).Push()
+		s.Gen.Put("Node")
+		s.Gen.Put("%sNode()", util.ToCamelCase(category.Name()))
+		s.Gen.Pop().Put("}").PutNL()
+
+		s.Gen.Put("type %sVisitor interface {", pascalName).Push()
+		s.Gen.Put("Visit%s(n %s) (visitChildren bool)", pascalName, pascalName)
+		s.Gen.Pop().Put("}").PutNL()
+
+		s.Gen.Put("func Walk%s(v %sVisitor, node Node) {", pascalName, pascalName).Push()
+		s.Gen.Put("node.Visit(func(n Node) (bool, bool) {").Push()
+		s.Gen.Put("if x, ok := n.(%s); ok {", pascalName).Push()
+		s.Gen.Put("return v.Visit%s(x), false", pascalName)
+		s.Gen.Pop().Put("}")
+		s.Gen.Put("return true, false")
+		s.Gen.Pop().Put("}, func(Node) bool {").Push()
+		s.Gen.Put("return false")
+		s.Gen.Pop().Put("})")
+		s.Gen.Pop().Put("}").PutNL()
+	}
+
+	s.Gen.Put("var nodeKindCategories = map[string][]string{").Push()
+	for _, node := range s.Input.Language.AstNodes() {
+		categories := s.categoriesOfNode(node.Name())
+		if len(categories) == 0 {
+			continue
+		}
+		for i, category := range categories {
+			categories[i] = fmt.Sprintf("\"%s\"", category)
+		}
+		s.Gen.Put("NodeType%s: {%s},", util.ToPascalCase(node.Name()), strings.Join(categories, ", "))
+	}
+	s.Gen.Pop().Put("}").PutNL()
+
+	s.Gen.Put("func NodeCategory(kind string) []string {").Push()
+	s.Gen.Put("return nodeKindCategories[kind]")
+	s.Gen.Pop().Put("}").PutNL()
+}
+
+func (s *Stage33) categoriesOfNode(name string) []string {
+	ret := make([]string, 0)
+	for _, category := range s.Input.Language.NodeCategories() {
+		if slices.Contains(category.Nodes(), name) {
+			ret = append(ret, category.Name())
+		}
+	}
+	return ret
+}
+
 func (s *Stage33) nodeStructs() {
 	for _, node := range s.Input.Language.AstNodes() {
 		pascalName := util.ToPascalCase(node.Name())
@@ -64,6 +224,7 @@ func (s *Stage33) nodeStructs() {
 		s.Gen.Put("return _1")
 		s.Gen.Pop().Put("}").PutNL()
 
+		putDocComment(s.Gen, node.Doc())
 		s.Gen.Put("type %sNode struct {", pascalName).Push()
 		s.Gen.Put("*BaseNode")
 		for _, arg := range node.Args() {
@@ -158,6 +319,21 @@ func (s *Stage33) nodeStructs() {
 		s.Gen.Put("return false")
 		s.Gen.Pop().Put("}").PutNL()
 
+		s.Gen.Put("func (n *%sNode) Accept(v Visitor) bool {", pascalName).Push()
+		s.Gen.Put("return v.Visit%sNode(n)", pascalName)
+		s.Gen.Pop().Put("}").PutNL()
+
+		for _, category := range s.categoriesOfNode(node.Name()) {
+			s.Gen.Put("func (n *%sNode) %sNode() {}", pascalName, util.ToCamelCase(category)).PutNL()
+		}
+
+		s.Gen.Put("func (n *%sNode) Rewrite(r Rewriter) Node {", pascalName).Push()
+		for _, arg := range node.Args() {
+			s.Gen.Put("n.%s = rewriteChild(r, n.%s)", arg.Camel(), arg.Camel())
+		}
+		s.Gen.Put("return r.Rewrite%sNode(n)", pascalName)
+		s.Gen.Pop().Put("}").PutNL()
+
 		dumpFunHead := "func (n *%sNode) Dump(hook func(Node, map[string]string) string) map[string]string {"
 		if len(node.Args()) == 0 {
 			dumpFunHead = strings.ReplaceAll(dumpFunHead, "hook", "_")
@@ -166,8 +342,12 @@ func (s *Stage33) nodeStructs() {
 		s.Gen.Put(`ret := make(map[string]string)`)
 		s.Gen.Put(`ret["kind"] = "\"%s\""`, node.Name())
 
-		for _, arg := range node.Args() {
-			s.Gen.Put(`ret["%s"] = DumpNode(n.%s(), hook)`, strings.TrimRight(arg.Normal(), "_"), arg.Pascal())
+		for _, field := range node.Fields() {
+			dumpFunc := "DumpNode"
+			if field.List() {
+				dumpFunc = "dumpNodeList"
+			}
+			s.Gen.Put(`ret["%s"] = %s(n.%s(), hook)`, strings.TrimRight(field.Normal(), "_"), dumpFunc, field.Pascal())
 		}
 		s.Gen.Put("return ret")
 		s.Gen.Pop().Put("}").PutNL()
@@ -43,6 +43,7 @@ func (s *Stage4) run() {
 	s.constNodeTypes().PutNL()
 	//
 	s.Gen.Put(snippet.ErrorContextFunc).PutNL()
+	s.Gen.Put(snippet.LineIndexStruct).PutNL()
 	s.Gen.Put(snippet.ToSnakeCaseFunc).PutNL()
 	s.Gen.Put(snippet.ToCamelCaseFunc).PutNL()
 	s.Gen.Put(snippet.DecodeBytesFunc).PutNL()
@@ -51,17 +52,26 @@ func (s *Stage4) run() {
 	s.Gen.Put(snippet.InRangeFunc).PutNL()
 	s.Gen.Put(snippet.NodesSetParentFunc).PutNL()
 	s.Gen.Put(snippet.NodesVisitFunc).PutNL()
+	s.Gen.Put(snippet.WalkFunc).PutNL()
 	s.Gen.Put(snippet.CreationHookVar).PutNL()
 	s.Gen.Put(snippet.DummyNodeVar).PutNL()
 	s.Gen.Put(snippet.BaseNodeStruct).PutNL()
 	s.Gen.Put(snippet.NodesNodeStruct).PutNL()
 	s.Gen.Put(snippet.TokenNodeStruct).PutNL()
+	s.Gen.Put(snippet.CursorStruct).PutNL()
+	s.Gen.Put(snippet.NodeAtFunc).PutNL()
+	s.Gen.Put(snippet.FieldInfoStruct).PutNL()
 	s.Gen.Put(s.Input3.Gen.String()).PutNL()
 	s.Gen.Put(s.Input1.Gen.String()).PutNL()
 	s.Gen.Put(s.Input2.Gen.String()).PutNL()
 	s.Gen.Put(s.Input1.Input.Language.HackCode())
 	s.Gen.Put(snippet.DumpNodeFunc).PutNL()
+	s.Gen.Put(snippet.LoadNodeFunc).PutNL()
+	s.Gen.Put(snippet.BinaryFunc).PutNL()
 	s.Gen.Put(snippet.QueryNodeFunc).PutNL()
+	s.Gen.Put(snippet.PatternFunc).PutNL()
+	s.Gen.Put(snippet.EqualFunc).PutNL()
+	s.Gen.Put(snippet.DiffFunc).PutNL()
 	s.Gen.Put(snippet.ParseFunc).PutNL()
 }
 
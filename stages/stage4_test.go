@@ -3,12 +3,14 @@ package stages
 import (
 	"fmt"
 	"os"
+	"os/exec"
+	"path/filepath"
 	"strings"
 	"testing"
 )
 
 func TestStage4(t *testing.T) {
-	b, err := os.ReadFile("../../go.txt")
+	b, err := os.ReadFile("../parsers/go/go.txt")
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -30,3 +32,74 @@ func TestStage4(t *testing.T) {
 		fmt.Println(err)
 	}
 }
+
+func TestStage4Errors(t *testing.T) {
+	if testing.Short() {
+		t.Skip("compiles a generated parser")
+	}
+	divider := strings.Repeat("-", 120) + "\n"
+	sections := []string{
+		"ident:\n    | _letter+\n_letter:\n    | [a-z]\n",
+		"",
+		"(\n)\n;\n->\n",
+		"call <name body>\n",
+		"file: x=stmt* {x}\n" +
+			"stmt:\n    | n=IDENT b='('...')' ';' {call(n, b)}\n    | n=IDENT '->' b=IDENT ';' {call(n, b)}\n",
+		"func (tk *Tokenizer) Clean(tokens []*Token) []*Token {\n" +
+			"\tret := make([]*Token, 0)\n" +
+			"\tfor _, tok := range tokens {\n" +
+			"\t\tif tok.Kind != TokenTypeWhitespace && tok.Kind != TokenTypeNewline {\n" +
+			"\t\t\tret = append(ret, tok)\n" +
+			"\t\t}\n" +
+			"\t}\n" +
+			"\treturn ret\n" +
+			"}\n",
+	}
+	s2 := RunStage2(RunStage1(strings.Join(sections, divider)))
+	s31, s32, s33 := RunStage31(s2), RunStage32(s2), RunStage33(s2)
+	for _, err := range []error{s2.Error.ToError(), s31.Error.ToError(), s32.Error.ToError(), s33.Error.ToError()} {
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	s4 := RunStage4(s31, s32, s33)
+	dir, err := os.MkdirTemp(".", "testgen")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() { _ = os.RemoveAll(dir) }()
+	if err = os.WriteFile(filepath.Join(dir, "goparser.go"), []byte(s4.Gen.String()), 0644); err != nil {
+		t.Fatal(err)
+	}
+	errorTest := `package goparser
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestErrors(t *testing.T) {
+	if _, err := ParseBytes("main", []byte("f(a (b) c")); err == nil {
+		t.Fatal("expect error for unterminated bracket ellipsis")
+	}
+	if _, err := ParseBytes("main", []byte("a - b;")); err == nil || !strings.Contains(err.Error(), "tokenize op") {
+		t.Fatal("expect error for partial operator")
+	}
+	ps := NewParser("main", []rune("a;"), nil)
+	ps._mergeNodes(1)
+	if _, err := ps.Parse(); err == nil || !strings.Contains(err.Error(), "merge_nodes") {
+		t.Fatal("expect error for misused merge nodes")
+	}
+	if _, err := ParseBytes("main", []byte("f(a (b) c);g->h;")); err != nil {
+		t.Fatal(err)
+	}
+}
+`
+	if err = os.WriteFile(filepath.Join(dir, "goparser_test.go"), []byte(errorTest), 0644); err != nil {
+		t.Fatal(err)
+	}
+	out, err := exec.Command("go", "test", "./"+dir).CombinedOutput()
+	if err != nil {
+		t.Fatalf("%v\n%s", err, out)
+	}
+}
@@ -0,0 +1,140 @@
+package stages
+
+import (
+	"fmt"
+	"github.com/lincaiyong/pgen/langgen"
+	"github.com/lincaiyong/pgen/models"
+	"github.com/lincaiyong/pgen/snippet"
+	"github.com/lincaiyong/pgen/util"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+func RunStage5(s2 *Stage2, samples []string) *Stage5 {
+	stage5 := &Stage5{
+		Description: "generate fuzz test code",
+		Input:       s2,
+		Samples:     samples,
+		Gen:         langgen.NewGenerator(),
+		Error:       models.NewError(),
+	}
+	stage5.run()
+	return stage5
+}
+
+type Stage5 struct {
+	Description string
+	Input       *Stage2
+	Samples     []string // sample programs, each must parse without error
+	Gen         models.Generator
+	Error       *models.Error
+}
+
+func (s *Stage5) run() {
+	seeds := langgen.NewGenerator()
+	seeds.PutNL().Push()
+	for _, seed := range s.fuzzSeeds() {
+		seeds.Put("%s,", strconv.Quote(seed))
+	}
+	samples := langgen.NewGenerator()
+	samples.PutNL().Push()
+	for _, sample := range s.Samples {
+		samples.Put("%s,", strconv.Quote(sample))
+	}
+	fuzzTest := strings.ReplaceAll(snippet.FuzzTestFile, "<seeds_placeholder>", seeds.String())
+	fuzzTest = strings.ReplaceAll(fuzzTest, "<samples_placeholder>", samples.String())
+	s.Gen.Put("package goparser").PutNL()
+	s.Gen.Put(fuzzTest)
+}
+
+// fuzzSeeds builds seeds from the grammar: its keywords, operators and string atoms, a
+// sample of each choice of the token rules, and all string atoms joined together.
+func (s *Stage5) fuzzSeeds() []string {
+	seeds := make([]string, 0)
+	seen := map[string]bool{"": true}
+	add := func(seed string) {
+		if !seen[seed] {
+			seen[seed] = true
+			seeds = append(seeds, seed)
+		}
+	}
+	for _, keyword := range s.Input.Language.Keywords() {
+		add(keyword)
+	}
+	for _, operator := range s.Input.Language.Operators() {
+		add(operator)
+	}
+	atoms := make([]string, 0)
+	for _, rule := range s.Input.Language.GrammarRules() {
+		rule.Visit(func(node *models.GrammarRuleNode) {
+			if node.Kind() == models.GrammarRuleNodeTypeStringAtom {
+				val := node.Snippet().Text()
+				val = util.SingleQuoteStringUnescape(val[1 : len(val)-1])
+				if !slices.Contains(atoms, val) {
+					atoms = append(atoms, val)
+				}
+				add(val)
+			}
+		})
+	}
+	rules := make(map[string]*models.TokenRuleNode)
+	for _, rule := range s.Input.Language.TokenRules() {
+		rules[rule.Name()] = rule
+	}
+	for _, rule := range s.Input.Language.TokenRules() {
+		if strings.HasPrefix(rule.Name(), "_") {
+			continue
+		}
+		for _, choice := range rule.Children() {
+			add(s.tokenSample(choice, rules, 0))
+		}
+	}
+	add(strings.Join(atoms, " "))
+	return seeds
+}
+
+// tokenSample builds a short text matched by a token rule node, taking the first
+// alternative everywhere and skipping optional parts.
+func (s *Stage5) tokenSample(node *models.TokenRuleNode, rules map[string]*models.TokenRuleNode, depth int) string {
+	if depth > 16 {
+		return ""
+	}
+	switch node.Kind() {
+	case models.TokenRuleNodeTypeRule, models.TokenRuleNodeTypeGroupAtom:
+		return s.tokenSample(node.Child(), rules, depth+1)
+	case models.TokenRuleNodeTypeChoice:
+		var sb strings.Builder
+		for _, item := range node.Children() {
+			sb.WriteString(s.tokenSample(item, rules, depth))
+		}
+		return sb.String()
+	case models.TokenRuleNodeTypeRepeat1Item, models.TokenRuleNodeTypeAtomItem:
+		return s.tokenSample(node.Child(), rules, depth)
+	case models.TokenRuleNodeTypeNameAtom:
+		if rule := rules[node.Name()]; rule != nil {
+			return s.tokenSample(rule, rules, depth+1)
+		}
+		switch node.Name() {
+		case "newline":
+			return "\n"
+		case "whitespace", "_whitespace_ch":
+			return " "
+		default:
+			return "a"
+		}
+	case models.TokenRuleNodeTypeStringAtom:
+		val := node.Snippet().Text()
+		return util.SingleQuoteStringUnescape(val[1 : len(val)-1])
+	case models.TokenRuleNodeTypeCharacterClassAtom:
+		val := node.Snippet().Text()
+		ret, err := util.ParseCharacterClass(val[1 : len(val)-1])
+		if err != nil || len(ret) == 0 {
+			s.Error.AddError(fmt.Errorf("invalid character class: %s", val))
+			return ""
+		}
+		return string(ret[0][0])
+	default:
+		return ""
+	}
+}
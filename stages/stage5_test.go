@@ -0,0 +1,35 @@
+package stages
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestStage5(t *testing.T) {
+	divider := strings.Repeat("-", 120) + "\n"
+	sections := []string{"ident:\n    | [a-z] [a-z0-9]*\n", "let\n", ";\n=\n", "let_stmt <name>\n",
+		"file: x=let_stmt* {x}\nlet_stmt: 'let' n=IDENT '=' ';' {let_stmt(n)}\n", ""}
+	s2 := RunStage2(RunStage1(strings.Join(sections, divider)))
+	if err := s2.Error.ToError(); err != nil {
+		t.Fatal(err)
+	}
+	s5 := RunStage5(s2, []string{"let a = ;\n", "let \"b\" = ;"})
+	if err := s5.Error.ToError(); err != nil {
+		t.Fatal(err)
+	}
+	text := s5.Gen.String()
+	for _, code := range []string{
+		"var fuzzSeeds = []string{\n\t\"let\",\n\t\";\",\n\t\"=\",\n\t\"a\",\n\t\"let = ;\",\n}",
+		"var fuzzSamples = []string{\n\t\"let a = ;\\n\",\n\t\"let \\\"b\\\" = ;\",\n}",
+		"prev = childEnd.Offset",
+		`node, err := ParseBytes("fuzz", b, WithMaxSteps(fuzzMaxSteps))`,
+		`node, err := ParseBytes("seed", []byte(seed), WithMaxSteps(fuzzMaxSteps))`,
+		`node, err := ParseBytes("sample", []byte(sample), WithMaxSteps(fuzzMaxSteps))`,
+		"func TestFuzzSeeds(t *testing.T) {",
+		"func FuzzParseBytes(f *testing.F) {",
+	} {
+		if !strings.Contains(text, code) {
+			t.Fatalf("expect code: %s\n%s", code, text)
+		}
+	}
+}
@@ -0,0 +1,402 @@
+package stages
+
+import (
+	"fmt"
+	"github.com/lincaiyong/pgen/config"
+	"github.com/lincaiyong/pgen/models"
+	"github.com/lincaiyong/pgen/util"
+	"regexp"
+	"slices"
+	"sort"
+	"strings"
+)
+
+// builtinTokenPatterns summarizes the token rules built into the tokenizer.
+var builtinTokenPatterns = map[string]string{
+	"newline":        `\r\n|\n|\r`,
+	"whitespace":     `[ \t\f\u1680\u180E\u2000-\u200A\u202F\u205F\u3000\uFEFF\u00A0]+`,
+	"_whitespace_ch": `[ \t\f\u1680\u180E\u2000-\u200A\u202F\u205F\u3000\uFEFF\u00A0]`,
+	"_any_but_eof":   `(?s:.)`,
+	"_any_but_eol":   `.`,
+}
+
+func RunStage6(s2 *Stage2) *Stage6 {
+	stage6 := &Stage6{
+		Description: "generate reference docs",
+		Input:       s2,
+		Files:       make(map[string]string),
+		Error:       models.NewError(),
+	}
+	stage6.run()
+	return stage6
+}
+
+type Stage6 struct {
+	Description string
+	Input       *Stage2
+	Files       map[string]string // relative path -> content
+	Error       *models.Error
+
+	rules map[string]*models.GrammarRuleNode
+}
+
+func (s *Stage6) run() {
+	s.rules = make(map[string]*models.GrammarRuleNode)
+	for _, rule := range s.Input.Language.GrammarRules() {
+		s.rules[rule.Name()] = rule
+	}
+	s.indexPage()
+	s.rulesPage()
+	s.nodePages()
+}
+
+func (s *Stage6) publicRules() []*models.GrammarRuleNode {
+	ret := make([]*models.GrammarRuleNode, 0)
+	for _, rule := range s.Input.Language.GrammarRules() {
+		if !strings.HasPrefix(rule.Name(), "_group_") {
+			ret = append(ret, rule)
+		}
+	}
+	return ret
+}
+
+func (s *Stage6) indexPage() {
+	lang := s.Input.Language
+	var sb strings.Builder
+	sb.WriteString("# Language reference\n\n")
+	sb.WriteString("- [Tokens](#tokens)\n- [Keywords](#keywords)\n- [Operators](#operators)\n")
+	sb.WriteString("- [Grammar rules](rules.md)\n- [Nodes](#nodes)\n\n")
+
+	sb.WriteString("## Tokens\n\n| Token | Pattern |\n| --- | --- |\n")
+	tokenRules := make(map[string]*models.TokenRuleNode)
+	for _, rule := range lang.TokenRules() {
+		tokenRules[rule.Name()] = rule
+	}
+	for _, name := range config.BuiltinTokens() {
+		if tokenRules[name] != nil {
+			continue
+		}
+		if pattern, ok := builtinTokenPatterns[name]; ok {
+			_, _ = fmt.Fprintf(&sb, "| `%s` | %s |\n", name, markdownCode(pattern))
+		} else {
+			_, _ = fmt.Fprintf(&sb, "| `%s` | *builtin* |\n", name)
+		}
+	}
+	for _, rule := range lang.TokenRules() {
+		if strings.HasPrefix(rule.Name(), "_") {
+			continue
+		}
+		pattern, _ := s.tokenPattern(rule, tokenRules, map[string]bool{rule.Name(): true})
+		_, _ = fmt.Fprintf(&sb, "| `%s` | %s |\n", rule.Name(), markdownCode(pattern))
+	}
+
+	sb.WriteString("\n## Keywords\n\n| Keyword | Token type |\n| --- | --- |\n")
+	for _, keyword := range lang.Keywords() {
+		_, _ = fmt.Fprintf(&sb, "| `%s` | `kw_%s` |\n", keyword, keyword)
+	}
+
+	// the token type of an operator is the operator itself, so the table names the constant
+	sb.WriteString("\n## Operators\n\n| Operator | Constant |\n| --- | --- |\n")
+	for _, operator := range lang.Operators() {
+		name := fmt.Sprintf("op_%s", lang.OperatorMap()[operator])
+		_, _ = fmt.Fprintf(&sb, "| %s | `TokenType%s` |\n", markdownCode(operator), util.ToPascalCase(name))
+	}
+
+	sb.WriteString("\n## Nodes\n\n")
+	for _, category := range lang.NodeCategories() {
+		links := make([]string, 0)
+		for _, node := range category.Nodes() {
+			links = append(links, fmt.Sprintf("[%s](nodes/%s.md)", node, node))
+		}
+		_, _ = fmt.Fprintf(&sb, "- **%s**: %s\n", category.Name(), strings.Join(links, ", "))
+	}
+	if len(lang.NodeCategories()) > 0 {
+		sb.WriteString("\n")
+	}
+	sb.WriteString("| Node | Description |\n| --- | --- |\n")
+	for _, node := range lang.AstNodes() {
+		_, _ = fmt.Fprintf(&sb, "| [%s](nodes/%s.md) | %s |\n", node.Name(), node.Name(), markdownCell(firstLine(node.Doc())))
+	}
+	s.Files["README.md"] = sb.String()
+}
+
+func (s *Stage6) rulesPage() {
+	var sb strings.Builder
+	sb.WriteString("# Grammar rules\n\n[Back to the reference](README.md)\n")
+	for _, rule := range s.publicRules() {
+		_, _ = fmt.Fprintf(&sb, "\n## %s\n\n", rule.Name())
+		if rule.Doc() != "" {
+			_, _ = fmt.Fprintf(&sb, "%s\n\n", rule.Doc())
+		}
+		svg := fmt.Sprintf("diagrams/%s.svg", rule.Name())
+		s.Files[svg] = railSVG(s.ruleDiagram(rule, map[string]bool{}))
+		_, _ = fmt.Fprintf(&sb, "![%s](%s)\n\n", rule.Name(), svg)
+		if rule.Instance() != "" {
+			_, _ = fmt.Fprintf(&sb, "Instance of `%s`:\n\n", rule.Instance())
+		}
+		_, _ = fmt.Fprintf(&sb, "```\n%s\n```\n", strings.TrimSpace(rule.Snippet().Text()))
+		refs := make([]string, 0)
+		for _, name := range s.ruleRefs(rule) {
+			refs = append(refs, fmt.Sprintf("[%s](#%s)", name, name))
+		}
+		if len(refs) > 0 {
+			_, _ = fmt.Fprintf(&sb, "\nUses: %s\n", strings.Join(refs, ", "))
+		}
+		nodes := make([]string, 0)
+		for _, name := range s.ruleNodes(rule) {
+			nodes = append(nodes, fmt.Sprintf("[%s](nodes/%s.md)", name, name))
+		}
+		if len(nodes) > 0 {
+			_, _ = fmt.Fprintf(&sb, "\nBuilds: %s\n", strings.Join(nodes, ", "))
+		}
+	}
+	s.Files["rules.md"] = sb.String()
+}
+
+func (s *Stage6) nodePages() {
+	builders := make(map[string][]string)
+	for _, rule := range s.publicRules() {
+		for _, name := range s.ruleNodes(rule) {
+			builders[name] = append(builders[name], rule.Name())
+		}
+	}
+	for _, node := range s.Input.Language.AstNodes() {
+		var sb strings.Builder
+		_, _ = fmt.Fprintf(&sb, "# %s\n\n[Back to the reference](../README.md)\n\n", node.Name())
+		if node.Doc() != "" {
+			_, _ = fmt.Fprintf(&sb, "%s\n\n", node.Doc())
+		}
+		categories := make([]string, 0)
+		for _, category := range s.Input.Language.NodeCategories() {
+			if slices.Contains(category.Nodes(), node.Name()) {
+				categories = append(categories, category.Name())
+			}
+		}
+		if len(categories) > 0 {
+			_, _ = fmt.Fprintf(&sb, "Categories: %s\n\n", strings.Join(categories, ", "))
+		}
+		if len(node.Fields()) > 0 {
+			sb.WriteString("| Field | Holds | Optional |\n| --- | --- | --- |\n")
+			for _, field := range node.Fields() {
+				holds := "node"
+				if field.List() && field.Token() {
+					holds = "list of tokens"
+				} else if field.List() {
+					holds = "list of nodes"
+				} else if field.Token() {
+					holds = "token"
+				}
+				optional := "no"
+				if field.Optional() {
+					optional = "yes"
+				}
+				_, _ = fmt.Fprintf(&sb, "| `%s` | %s | %s |\n", field.Normal(), holds, optional)
+			}
+			sb.WriteString("\n")
+		}
+		links := make([]string, 0)
+		for _, name := range builders[node.Name()] {
+			links = append(links, fmt.Sprintf("[%s](../rules.md#%s)", name, name))
+		}
+		if len(links) > 0 {
+			_, _ = fmt.Fprintf(&sb, "Constructed by: %s\n", strings.Join(links, ", "))
+		}
+		s.Files[fmt.Sprintf("nodes/%s.md", node.Name())] = sb.String()
+	}
+}
+
+// ruleNodes returns the nodes built by the actions of a rule, including the
+// actions of the groups it was split into.
+func (s *Stage6) ruleNodes(rule *models.GrammarRuleNode) []string {
+	ret := make([]string, 0)
+	s.visitRule(rule, map[string]bool{}, func(node *models.GrammarRuleNode) {
+		visit := func(action *models.GrammarRuleNode) {
+			if action.Kind() == models.GrammarRuleNodeTypeCallAction && !strings.HasPrefix(action.Name(), "_") &&
+				!slices.Contains(ret, action.Name()) {
+				ret = append(ret, action.Name())
+			}
+		}
+		if node.Action() != nil {
+			node.Action().Visit(visit)
+		}
+	})
+	sort.Strings(ret)
+	return ret
+}
+
+func (s *Stage6) ruleRefs(rule *models.GrammarRuleNode) []string {
+	ret := make([]string, 0)
+	s.visitRule(rule, map[string]bool{}, func(node *models.GrammarRuleNode) {
+		if node.Kind() == models.GrammarRuleNodeTypeNameAtom && !strings.HasPrefix(node.Name(), "_group_") &&
+			s.rules[node.Name()] != nil && !slices.Contains(ret, node.Name()) {
+			ret = append(ret, node.Name())
+		}
+	})
+	return ret
+}
+
+func (s *Stage6) visitRule(rule *models.GrammarRuleNode, seen map[string]bool, fn func(*models.GrammarRuleNode)) {
+	seen[rule.Name()] = true
+	rule.Visit(func(node *models.GrammarRuleNode) {
+		fn(node)
+		if node.Kind() == models.GrammarRuleNodeTypeNameAtom && strings.HasPrefix(node.Name(), "_group_") && !seen[node.Name()] {
+			if group := s.rules[node.Name()]; group != nil {
+				s.visitRule(group, seen, fn)
+			}
+		}
+	})
+}
+
+func (s *Stage6) ruleDiagram(rule *models.GrammarRuleNode, seen map[string]bool) railElement {
+	seen[rule.Name()] = true
+	defer delete(seen, rule.Name())
+	choices := make([]railElement, 0)
+	for _, choice := range rule.Children() {
+		choices = append(choices, s.itemsDiagram(choice.Children(), seen))
+	}
+	var operand railElement = &railChoice{items: choices}
+	if len(choices) == 0 {
+		operand = railSkip{}
+	} else if len(choices) == 1 {
+		operand = choices[0]
+	}
+	if len(rule.Precedences()) == 0 {
+		return operand
+	}
+	operators := make([]railElement, 0)
+	for _, level := range rule.Precedences() {
+		for _, operator := range level.Children() {
+			operators = append(operators, s.atomDiagram(operator, seen))
+		}
+	}
+	return &railLoop{item: operand, sep: &railChoice{items: operators}}
+}
+
+func (s *Stage6) itemsDiagram(items []*models.GrammarRuleNode, seen map[string]bool) railElement {
+	elements := make([]railElement, 0)
+	for _, item := range items {
+		elements = append(elements, s.itemDiagram(item, seen))
+	}
+	if len(elements) == 1 {
+		return elements[0]
+	}
+	return &railSequence{items: elements}
+}
+
+func (s *Stage6) itemDiagram(item *models.GrammarRuleNode, seen map[string]bool) railElement {
+	switch item.Kind() {
+	case models.GrammarRuleNodeTypeAtomItem:
+		return s.atomDiagram(item.Child(), seen)
+	case models.GrammarRuleNodeTypeOptionalItem:
+		return railOptional(s.atomDiagram(item.Child(), seen))
+	case models.GrammarRuleNodeTypeRepeat0Item:
+		return railOptional(&railLoop{item: s.atomDiagram(item.Child(), seen), sep: railSkip{}})
+	case models.GrammarRuleNodeTypeRepeat1Item:
+		return &railLoop{item: s.atomDiagram(item.Child(), seen), sep: railSkip{}}
+	case models.GrammarRuleNodeTypeSeparatedRepeat0Item:
+		return railOptional(&railLoop{item: s.atomDiagram(item.Child(), seen), sep: s.atomDiagram(item.Separator(), seen)})
+	case models.GrammarRuleNodeTypeSeparatedRepeat1Item:
+		return &railLoop{item: s.atomDiagram(item.Child(), seen), sep: s.atomDiagram(item.Separator(), seen)}
+	}
+	return &railBox{text: strings.TrimSpace(item.Snippet().Text()), kind: railBoxAnnotation}
+}
+
+func (s *Stage6) atomDiagram(atom *models.GrammarRuleNode, seen map[string]bool) railElement {
+	switch atom.Kind() {
+	case models.GrammarRuleNodeTypeNameAtom:
+		if group := s.rules[atom.Name()]; group != nil && strings.HasPrefix(atom.Name(), "_group_") && !seen[atom.Name()] {
+			return s.ruleDiagram(group, seen)
+		}
+		return &railBox{text: atom.Name(), kind: railBoxNonTerminal}
+	case models.GrammarRuleNodeTypeGroupAtom:
+		return s.itemsDiagram(atom.Children(), seen)
+	}
+	return &railBox{text: atom.Snippet().Text(), kind: railBoxTerminal}
+}
+
+// tokenPattern summarizes a token rule as a regular expression, inlining the
+// private helper rules it refers to. The second result reports whether the
+// pattern can take a quantifier without parentheses.
+func (s *Stage6) tokenPattern(node *models.TokenRuleNode, rules map[string]*models.TokenRuleNode, seen map[string]bool) (string, bool) {
+	switch node.Kind() {
+	case models.TokenRuleNodeTypeRule:
+		if len(node.Children()) == 1 {
+			return s.tokenPattern(node.Child(), rules, seen)
+		}
+		choices := make([]string, 0)
+		for _, choice := range node.Children() {
+			pattern, _ := s.tokenPattern(choice, rules, seen)
+			choices = append(choices, pattern)
+		}
+		return strings.Join(choices, "|"), false
+	case models.TokenRuleNodeTypeChoice:
+		if len(node.Children()) == 1 {
+			return s.tokenPattern(node.Child(), rules, seen)
+		}
+		items := make([]string, 0)
+		for _, item := range node.Children() {
+			pattern, _ := s.tokenPattern(item, rules, seen)
+			items = append(items, pattern)
+		}
+		return strings.Join(items, ""), false
+	case models.TokenRuleNodeTypeAtomItem:
+		return s.tokenPattern(node.Child(), rules, seen)
+	case models.TokenRuleNodeTypeOptionalItem, models.TokenRuleNodeTypeRepeat0Item, models.TokenRuleNodeTypeRepeat1Item:
+		pattern, atomic := s.tokenPattern(node.Child(), rules, seen)
+		if !atomic {
+			pattern = "(" + pattern + ")"
+		}
+		suffix := map[string]string{
+			models.TokenRuleNodeTypeOptionalItem: "?",
+			models.TokenRuleNodeTypeRepeat0Item:  "*",
+			models.TokenRuleNodeTypeRepeat1Item:  "+",
+		}[node.Kind()]
+		return pattern + suffix, false
+	case models.TokenRuleNodeTypeNegativeLookaheadItem:
+		pattern, _ := s.tokenPattern(node.Child(), rules, seen)
+		return "(?!" + pattern + ")", true
+	case models.TokenRuleNodeTypePositiveLookaheadItem:
+		pattern, _ := s.tokenPattern(node.Child(), rules, seen)
+		return "(?=" + pattern + ")", true
+	case models.TokenRuleNodeTypeNameAtom:
+		rule := rules[node.Name()]
+		if pattern, ok := builtinTokenPatterns[node.Name()]; ok && rule == nil && strings.HasPrefix(node.Name(), "_") {
+			return pattern, true
+		}
+		if rule == nil || !strings.HasPrefix(node.Name(), "_") || seen[node.Name()] {
+			return "<" + node.Name() + ">", true
+		}
+		seen[node.Name()] = true
+		defer delete(seen, node.Name())
+		pattern, atomic := s.tokenPattern(rule, rules, seen)
+		if len(rule.Children()) > 1 {
+			return "(" + pattern + ")", true
+		}
+		return pattern, atomic
+	case models.TokenRuleNodeTypeStringAtom:
+		val := node.Snippet().Text()
+		val = util.SingleQuoteStringUnescape(val[1 : len(val)-1])
+		return regexp.QuoteMeta(val), len([]rune(val)) == 1
+	default:
+		return node.Snippet().Text(), true
+	}
+}
+
+func markdownCell(text string) string {
+	return strings.ReplaceAll(text, "|", "\\|")
+}
+
+// markdownCode formats text as a code span that may sit in a table cell.
+func markdownCode(text string) string {
+	if strings.Contains(text, "`") {
+		return "`` " + markdownCell(text) + " ``"
+	}
+	return "`" + markdownCell(text) + "`"
+}
+
+func firstLine(text string) string {
+	if idx := strings.Index(text, "\n"); idx != -1 {
+		return text[:idx]
+	}
+	return text
+}
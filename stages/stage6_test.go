@@ -0,0 +1,81 @@
+package stages
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestStage6(t *testing.T) {
+	divider := strings.Repeat("-", 120) + "\n"
+	sections := []string{
+		"## identifiers\nident:\n    | _letter+\n_letter:\n    | [a-z]\n    | '_'\n",
+		"let\n",
+		"=\n;\n|\n",
+		"## A binding.\nlet_stmt <name:token value? alts*>\n@stmt let_stmt\n",
+		"## file parses a file.\nfile: x=semi<let_stmt>* {x}\nsemi<X>: x=X ';' {x}\n" +
+			"let_stmt: 'let' n=IDENT ('=' v=IDENT)? a=('|'.IDENT+)? {let_stmt(n, v ?? _, a ?? _)}\n",
+		"",
+	}
+	s2 := RunStage2(RunStage1(strings.Join(sections, divider)))
+	if err := s2.Error.ToError(); err != nil {
+		t.Fatal(err)
+	}
+	s6 := RunStage6(s2)
+	if err := s6.Error.ToError(); err != nil {
+		t.Fatal(err)
+	}
+	expects := map[string][]string{
+		"README.md": {
+			"| `ident` | `([a-z]\\|_)+` |",
+			"| `let` | `kw_let` |",
+			"| `\\|` | `TokenTypeOpBar` |",
+			"- **stmt**: [let_stmt](nodes/let_stmt.md)",
+			"| [let_stmt](nodes/let_stmt.md) | A binding. |",
+		},
+		"rules.md": {
+			"## file\n\nfile parses a file.\n\n![file](diagrams/file.svg)",
+			"Uses: [semi_of_let_stmt](#semi_of_let_stmt)",
+			"## semi_of_let_stmt\n\n![semi_of_let_stmt](diagrams/semi_of_let_stmt.svg)\n\n" +
+				"Instance of `semi<let_stmt>`:\n\n```\nsemi<X>: x=X ';' {x}\n```\n\nUses: [let_stmt](#let_stmt)",
+			"Builds: [let_stmt](nodes/let_stmt.md)",
+		},
+		"nodes/let_stmt.md": {
+			"A binding.",
+			"Categories: stmt",
+			"| `name` | token | no |",
+			"| `value` | node | yes |",
+			"| `alts` | list of nodes | no |",
+			"Constructed by: [let_stmt](../rules.md#let_stmt)",
+		},
+		"diagrams/let_stmt.svg": {
+			"<svg ",
+			"<rect class=\"terminal\"",
+			"&#39;let&#39;",
+			">IDENT</text>",
+			"&#39;|&#39;",
+		},
+		"diagrams/file.svg": {
+			"<rect class=\"nonterminal\"",
+			">semi_of_let_stmt</text>",
+		},
+	}
+	for name, codes := range expects {
+		text, ok := s6.Files[name]
+		if !ok {
+			t.Fatalf("expect file: %s", name)
+		}
+		for _, code := range codes {
+			if !strings.Contains(text, code) {
+				t.Fatalf("expect %q in %s:\n%s", code, name, text)
+			}
+		}
+	}
+	for name := range s6.Files {
+		if strings.Contains(name, "_group_") {
+			t.Fatalf("unexpected file: %s", name)
+		}
+	}
+	if w, up, down := (&railChoice{}).size(); w != 0 || up != 0 || down != 0 {
+		t.Fatalf("expect an empty choice to be skipped, got %d %d %d", w, up, down)
+	}
+}
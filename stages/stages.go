@@ -1,5 +1,10 @@
 package stages
 
+import (
+	"github.com/lincaiyong/pgen/models"
+	"strings"
+)
+
 func Run(content string) error {
 	s1 := RunStage1(content)
 	if len(s1.Error.Errors()) > 0 {
@@ -19,3 +24,12 @@ func Run(content string) error {
 	}
 	return nil
 }
+
+func putDocComment(gen models.Generator, doc string) {
+	if doc == "" {
+		return
+	}
+	for _, line := range strings.Split(doc, "\n") {
+		gen.Put("%s", strings.TrimRight("// "+line, " "))
+	}
+}